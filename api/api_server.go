@@ -1,7 +1,9 @@
 package api
 
 import (
+	"crypto/rand"
 	"crypto/tls"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -33,27 +35,74 @@ type MassNode interface {
 }
 
 type APIServer struct {
-	rpcServer  *grpc.Server
-	node       MassNode
-	config     *config.Config
-	massWallet *masswallet.WalletManager
-	quitClient func()
+	rpcServer     *grpc.Server
+	node          MassNode   // nil if following a remote node
+	remote        RemoteNode // nil if the chain is embedded
+	config        *config.Config
+	massWallet    *masswallet.WalletManager
+	quitClient    func()
+	gatewaySecret string // proves metadata forwarded by the gateway, empty if auth is disabled
 }
 
 func NewAPIServer(node MassNode, masswallet *masswallet.WalletManager, quitClient func(), config *config.Config) (*APIServer, error) {
+	return newAPIServer(node, nil, masswallet, quitClient, config)
+}
+
+// NewRemoteAPIServer returns the api server of a wallet following a remote full
+// node, the rpcs requiring the embedded chain fail with ErrAPIChainUnavailable.
+func NewRemoteAPIServer(remote RemoteNode, masswallet *masswallet.WalletManager, quitClient func(), config *config.Config) (*APIServer, error) {
+	return newAPIServer(nil, remote, masswallet, quitClient, config)
+}
+
+func newAPIServer(node MassNode, remote RemoteNode, masswallet *masswallet.WalletManager, quitClient func(), config *config.Config) (*APIServer, error) {
 
 	// set the size for receive Msg
 	opts := []grpc.ServerOption{
 		grpc.MaxRecvMsgSize(maxMsgSize),
 		grpc.MaxSendMsgSize(maxMsgSize),
 	}
+	var (
+		gatewaySecret      string
+		interceptors       []grpc.UnaryServerInterceptor
+		streamInterceptors []grpc.StreamServerInterceptor
+	)
+	if m := config.Wallet.Metrics; m != nil && m.Enable {
+		interceptors = append(interceptors, metricsInterceptor)
+		streamInterceptors = append(streamInterceptors, metricsStreamInterceptor)
+	}
+	if auth := config.Wallet.Auth; auth != nil && auth.Enable {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, err
+		}
+		gatewaySecret = hex.EncodeToString(secret)
+		authenticator, err := loadAuthenticator(auth.KeyFile, gatewaySecret)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to load api keys", logging.LogFormat{"key_file": auth.KeyFile, "error": err})
+			return nil, err
+		}
+		interceptors = append(interceptors, authenticator.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, authenticator.streamInterceptor)
+		logging.CPrint(logging.INFO, "api authentication enabled", logging.LogFormat{"key_file": auth.KeyFile})
+	}
+	if remote != nil {
+		interceptors = append(interceptors, embeddedChainInterceptor)
+	}
+	if len(interceptors) > 0 {
+		opts = append(opts, grpc.UnaryInterceptor(chainUnaryInterceptors(interceptors)))
+	}
+	if len(streamInterceptors) > 0 {
+		opts = append(opts, grpc.StreamInterceptor(chainStreamInterceptors(streamInterceptors)))
+	}
 	s := grpc.NewServer(opts...)
 	srv := &APIServer{
-		rpcServer:  s,
-		node:       node,
-		config:     config,
-		massWallet: masswallet,
-		quitClient: quitClient,
+		rpcServer:     s,
+		node:          node,
+		remote:        remote,
+		config:        config,
+		massWallet:    masswallet,
+		quitClient:    quitClient,
+		gatewaySecret: gatewaySecret,
 	}
 	pb.RegisterApiServiceServer(s, srv)
 	// Register reflection service on gRPC server.
@@ -80,7 +129,7 @@ func (s *APIServer) Stop() {
 
 func (s *APIServer) RunGateway() {
 	go func() {
-		if err := Run(s.config); err != nil {
+		if err := Run(s.config, s.gatewaySecret); err != nil {
 			logging.CPrint(logging.ERROR, "failed to start grpc-gateway", logging.LogFormat{"port": s.config.Wallet.API.HttpPort, "error": err})
 			return
 		}
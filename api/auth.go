@@ -0,0 +1,312 @@
+package api
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/massnetorg/mass-core/logging"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Role grants access to the api, each role is granted the access of the roles before it.
+type Role int
+
+const (
+	RoleReadOnly Role = iota + 1
+	RoleSpender
+	RoleAdmin
+)
+
+var roleNames = map[string]Role{
+	"readonly": RoleReadOnly,
+	"spender":  RoleSpender,
+	"admin":    RoleAdmin,
+}
+
+func (r Role) String() string {
+	for name, role := range roleNames {
+		if role == r {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// methodRoles maps rpc names to the least role allowed to call them. Rpcs not
+// listed require RoleAdmin.
+var methodRoles = map[string]Role{
+	"GetBestBlock":          RoleReadOnly,
+	"GetBlockByHeight":      RoleReadOnly,
+	"GetBlockStakingReward": RoleReadOnly,
+	"GetClientStatus":       RoleReadOnly,
+	"Wallets":               RoleReadOnly,
+	"GetWalletBalance":      RoleReadOnly,
+	"GetAddresses":          RoleReadOnly,
+	"GetAddressBalance":     RoleReadOnly,
+	"ValidateAddress":       RoleReadOnly,
+	"VerifyMessage":         RoleReadOnly,
+	"GetUtxo":               RoleReadOnly,
+	"ListLockUnspent":       RoleReadOnly,
+	"GetLabels":             RoleReadOnly,
+	"SearchLabels":          RoleReadOnly,
+	"DecodeRawTransaction":  RoleReadOnly,
+	"DecodePsbt":            RoleReadOnly,
+	"GetTransactionFee":     RoleReadOnly,
+	"EstimateFeeRate":       RoleReadOnly,
+	"GetRawTransaction":     RoleReadOnly,
+	"GetTxStatus":           RoleReadOnly,
+	"ListUnconfirmed":       RoleReadOnly,
+	"GetPayoutBatch":        RoleReadOnly,
+	"TxHistory":             RoleReadOnly,
+	"ListTxHistory":         RoleReadOnly,
+	"ExportHistory":         RoleReadOnly,
+	"GetStakingHistory":     RoleReadOnly,
+	"GetBindingHistory":     RoleReadOnly,
+	"GetNetworkBinding":     RoleReadOnly,
+	"CheckPoolPkCoinbase":   RoleReadOnly,
+	"CheckTargetBinding":    RoleReadOnly,
+	"SubscribeWalletEvents": RoleReadOnly,
+
+	"UseWallet":                        RoleSpender,
+	"UnlockWallet":                     RoleSpender,
+	"LockWallet":                       RoleSpender,
+	"CreateAddress":                    RoleSpender,
+	"LockUnspent":                      RoleSpender,
+	"UnlockUnspent":                    RoleSpender,
+	"SetLabel":                         RoleSpender,
+	"SignMessage":                      RoleSpender,
+	"CreateRawTransaction":             RoleSpender,
+	"AutoCreateTransaction":            RoleSpender,
+	"SignRawTransaction":               RoleSpender,
+	"CreatePsbt":                       RoleSpender,
+	"SignPsbt":                         RoleSpender,
+	"CombinePsbt":                      RoleSpender,
+	"FinalizePsbt":                     RoleSpender,
+	"SendRawTransaction":               RoleSpender,
+	"AbandonTransaction":               RoleSpender,
+	"CreatePayoutBatch":                RoleSpender,
+	"CreateStakingTransaction":         RoleSpender,
+	"CreateBindingTransaction":         RoleSpender,
+	"CreatePoolPkCoinbaseTransaction":  RoleSpender,
+	"CreateWithdrawStakingTransaction": RoleSpender,
+	"CreateWithdrawBindingTransaction": RoleSpender,
+}
+
+// methodRole returns the least role allowed to call fullMethod, in the form of
+// "/package.service/method".
+func methodRole(fullMethod string) Role {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if role, ok := methodRoles[name]; ok {
+		return role
+	}
+	return RoleAdmin
+}
+
+// Metadata set by the gateway to forward the verified client certificate, it's
+// trusted only along with the gateway secret.
+const (
+	authMetadataPrefix        = "x-masswallet-"
+	mdGatewaySecret           = authMetadataPrefix + "gateway-secret"
+	mdClientCertFingerprint   = authMetadataPrefix + "client-cert-fingerprint"
+	mdClientCertCommonName    = authMetadataPrefix + "client-cert-cn"
+	authorizationBearerPrefix = "bearer "
+)
+
+// AuthKeyFile is the content of the key file configured by auth.key_file.
+type AuthKeyFile struct {
+	Tokens       []*AuthToken       `json:"tokens"`
+	Certificates []*AuthCertificate `json:"certificates"`
+}
+
+// AuthToken is a static bearer token, sent in the header "Authorization: Bearer <token>".
+type AuthToken struct {
+	Name  string `json:"name"`
+	Token string `json:"token"`
+	Role  string `json:"role"`
+}
+
+// AuthCertificate is a client certificate verified by the https gateway, matched
+// by either the hex sha256 fingerprint or the common name.
+type AuthCertificate struct {
+	Name        string `json:"name"`
+	Fingerprint string `json:"fingerprint"`
+	CommonName  string `json:"common_name"`
+	Role        string `json:"role"`
+}
+
+type apiIdentity struct {
+	name string
+	role Role
+}
+
+// authenticator identifies api callers and authorizes them by role.
+type authenticator struct {
+	tokens        map[[sha256.Size]byte]*apiIdentity // sha256 of token -> identity
+	fingerprints  map[string]*apiIdentity
+	commonNames   map[string]*apiIdentity
+	gatewaySecret string
+}
+
+// loadAuthenticator returns an authenticator of the identities in keyFile.
+func loadAuthenticator(keyFile, gatewaySecret string) (*authenticator, error) {
+	buf, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+	keys := &AuthKeyFile{}
+	if err = json.Unmarshal(buf, keys); err != nil {
+		return nil, fmt.Errorf("invalid key file %s: %v", keyFile, err)
+	}
+	return newAuthenticator(keys, gatewaySecret)
+}
+
+func newAuthenticator(keys *AuthKeyFile, gatewaySecret string) (*authenticator, error) {
+	a := &authenticator{
+		tokens:        make(map[[sha256.Size]byte]*apiIdentity),
+		fingerprints:  make(map[string]*apiIdentity),
+		commonNames:   make(map[string]*apiIdentity),
+		gatewaySecret: gatewaySecret,
+	}
+	for _, t := range keys.Tokens {
+		role, ok := roleNames[strings.ToLower(t.Role)]
+		if !ok {
+			return nil, fmt.Errorf("unknown role %q of token %s", t.Role, t.Name)
+		}
+		if len(t.Token) == 0 {
+			return nil, fmt.Errorf("empty token %s", t.Name)
+		}
+		hash := sha256.Sum256([]byte(t.Token))
+		if _, ok := a.tokens[hash]; ok {
+			return nil, fmt.Errorf("duplicate token %s", t.Name)
+		}
+		a.tokens[hash] = &apiIdentity{name: t.Name, role: role}
+	}
+	for _, c := range keys.Certificates {
+		role, ok := roleNames[strings.ToLower(c.Role)]
+		if !ok {
+			return nil, fmt.Errorf("unknown role %q of certificate %s", c.Role, c.Name)
+		}
+		identity := &apiIdentity{name: c.Name, role: role}
+		switch {
+		case len(c.Fingerprint) > 0:
+			fingerprint := strings.ToLower(strings.Replace(c.Fingerprint, ":", "", -1))
+			if b, err := hex.DecodeString(fingerprint); err != nil || len(b) != sha256.Size {
+				return nil, fmt.Errorf("invalid fingerprint of certificate %s", c.Name)
+			}
+			a.fingerprints[fingerprint] = identity
+		case len(c.CommonName) > 0:
+			a.commonNames[c.CommonName] = identity
+		default:
+			return nil, fmt.Errorf("neither fingerprint nor common_name of certificate %s", c.Name)
+		}
+	}
+	return a, nil
+}
+
+// identify returns the caller identity of an incoming context, a bearer token
+// takes precedence over the client certificate.
+func (a *authenticator) identify(ctx context.Context) (*apiIdentity, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get("authorization"); len(values) > 0 {
+		if len(values) != 1 || !strings.HasPrefix(strings.ToLower(values[0]), authorizationBearerPrefix) {
+			return nil, status.New(ErrAPIUnauthenticated, ErrCode[ErrAPIUnauthenticated]).Err()
+		}
+		hash := sha256.Sum256([]byte(strings.TrimSpace(values[0][len(authorizationBearerPrefix):])))
+		if identity, ok := a.tokens[hash]; ok {
+			return identity, nil
+		}
+		return nil, status.New(ErrAPIUnauthenticated, ErrCode[ErrAPIUnauthenticated]).Err()
+	}
+
+	secrets := md.Get(mdGatewaySecret)
+	if len(secrets) == 1 && len(a.gatewaySecret) > 0 &&
+		subtle.ConstantTimeCompare([]byte(secrets[0]), []byte(a.gatewaySecret)) == 1 {
+		if values := md.Get(mdClientCertFingerprint); len(values) == 1 {
+			if identity, ok := a.fingerprints[values[0]]; ok {
+				return identity, nil
+			}
+		}
+		if values := md.Get(mdClientCertCommonName); len(values) == 1 {
+			if identity, ok := a.commonNames[values[0]]; ok {
+				return identity, nil
+			}
+		}
+	}
+	return nil, status.New(ErrAPIUnauthenticated, ErrCode[ErrAPIUnauthenticated]).Err()
+}
+
+func (a *authenticator) authorize(ctx context.Context, fullMethod string) error {
+	identity, err := a.identify(ctx)
+	if err != nil {
+		logging.CPrint(logging.WARN, "api: unauthenticated call", logging.LogFormat{"method": fullMethod})
+		return err
+	}
+	if required := methodRole(fullMethod); identity.role < required {
+		logging.CPrint(logging.WARN, "api: permission denied", logging.LogFormat{
+			"method":   fullMethod,
+			"name":     identity.name,
+			"role":     identity.role.String(),
+			"required": required.String(),
+		})
+		return status.New(ErrAPIPermissionDenied, ErrCode[ErrAPIPermissionDenied]).Err()
+	}
+	return nil
+}
+
+func (a *authenticator) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authenticator) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// gatewayAuthMetadata returns the metadata forwarding the verified client
+// certificate of r to the grpc server.
+func gatewayAuthMetadata(r *http.Request, gatewaySecret string) metadata.MD {
+	if len(gatewaySecret) == 0 {
+		return nil
+	}
+	md := metadata.Pairs(mdGatewaySecret, gatewaySecret)
+	if r.TLS != nil && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+		cert := r.TLS.VerifiedChains[0][0]
+		md.Set(mdClientCertFingerprint, certFingerprint(cert))
+		md.Set(mdClientCertCommonName, cert.Subject.CommonName)
+	}
+	return md
+}
+
+// gatewayHeaderMatcher forwards http headers as the default matcher does, except
+// those spoofing the metadata set by the gateway.
+func gatewayHeaderMatcher(key string) (string, bool) {
+	h, ok := runtime.DefaultHeaderMatcher(key)
+	if ok && strings.HasPrefix(strings.ToLower(h), authMetadataPrefix) {
+		return "", false
+	}
+	return h, ok
+}
+
+func certFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,160 @@
+package api
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testReadOnlyToken = "readonly-token"
+	testSpenderToken  = "spender-token"
+	testFingerprint   = "6e340b9cffb37a989ca544e6bb780a2c78901d3fb33738768511a30617afa01d"
+	testGatewaySecret = "gateway-secret"
+)
+
+const testKeyFile = `{
+  "tokens": [
+    {"name": "monitor", "token": "readonly-token", "role": "readonly"},
+    {"name": "payout", "token": "spender-token", "role": "Spender"}
+  ],
+  "certificates": [
+    {"name": "ops", "fingerprint": "6E:34:0B:9C:FF:B3:7A:98:9C:A5:44:E6:BB:78:0A:2C:78:90:1D:3F:B3:37:38:76:85:11:A3:06:17:AF:A0:1D", "role": "admin"},
+    {"name": "auditor", "common_name": "auditor", "role": "readonly"}
+  ]
+}`
+
+func newTestAuthenticator(t *testing.T) *authenticator {
+	dir, err := ioutil.TempDir("", "apiauth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	keyFile := filepath.Join(dir, "api-keys.json")
+	if err = ioutil.WriteFile(keyFile, []byte(testKeyFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	a, err := loadAuthenticator(keyFile, testGatewaySecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func incomingContext(kv ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+}
+
+func assertStatusCode(t *testing.T, code uint32, err error, msg string) {
+	st, ok := status.FromError(err)
+	if !assert.True(t, ok && err != nil, msg) {
+		return
+	}
+	assert.Equal(t, code, uint32(st.Code()), msg)
+}
+
+func TestLoadAuthenticator(t *testing.T) {
+	a := newTestAuthenticator(t)
+	assert.Equal(t, 2, len(a.tokens))
+	assert.Equal(t, 1, len(a.fingerprints))
+	assert.Equal(t, 1, len(a.commonNames))
+
+	invalid := []*AuthKeyFile{
+		{Tokens: []*AuthToken{{Name: "a", Token: "x", Role: "root"}}},
+		{Tokens: []*AuthToken{{Name: "a", Token: "", Role: "admin"}}},
+		{Tokens: []*AuthToken{{Name: "a", Token: "x", Role: "admin"}, {Name: "b", Token: "x", Role: "readonly"}}},
+		{Certificates: []*AuthCertificate{{Name: "a", Fingerprint: "abcd", Role: "admin"}}},
+		{Certificates: []*AuthCertificate{{Name: "a", Role: "admin"}}},
+	}
+	for i, keys := range invalid {
+		_, err := newAuthenticator(keys, testGatewaySecret)
+		assert.NotNil(t, err, i)
+	}
+
+	_, err := loadAuthenticator(filepath.Join(os.TempDir(), "not-exist-api-keys.json"), testGatewaySecret)
+	assert.NotNil(t, err)
+}
+
+func TestAuthenticator_Authorize(t *testing.T) {
+	a := newTestAuthenticator(t)
+	const (
+		readMethod  = "/rpcprotobuf.ApiService/GetWalletBalance"
+		spendMethod = "/rpcprotobuf.ApiService/SendRawTransaction"
+		adminMethod = "/rpcprotobuf.ApiService/RemoveWallet"
+	)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		code   uint32 // 0 if authorized
+	}{
+		{"no credential", context.Background(), readMethod, ErrAPIUnauthenticated},
+		{"unknown token", incomingContext("authorization", "Bearer unknown"), readMethod, ErrAPIUnauthenticated},
+		{"not bearer", incomingContext("authorization", "Basic "+testReadOnlyToken), readMethod, ErrAPIUnauthenticated},
+		{"duplicate authorization", incomingContext("authorization", "Bearer "+testReadOnlyToken,
+			"authorization", "Bearer "+testSpenderToken), readMethod, ErrAPIUnauthenticated},
+		{"readonly reads", incomingContext("authorization", "Bearer "+testReadOnlyToken), readMethod, 0},
+		{"readonly spends", incomingContext("authorization", "bearer "+testReadOnlyToken), spendMethod, ErrAPIPermissionDenied},
+		{"spender spends", incomingContext("authorization", "Bearer "+testSpenderToken), spendMethod, 0},
+		{"spender removes wallet", incomingContext("authorization", "Bearer "+testSpenderToken), adminMethod, ErrAPIPermissionDenied},
+		{"spender calls unlisted", incomingContext("authorization", "Bearer "+testSpenderToken), "/rpcprotobuf.ApiService/Unknown", ErrAPIPermissionDenied},
+		{"certificate fingerprint", incomingContext(mdGatewaySecret, testGatewaySecret,
+			mdClientCertFingerprint, testFingerprint, mdClientCertCommonName, "ops"), adminMethod, 0},
+		{"certificate common name", incomingContext(mdGatewaySecret, testGatewaySecret,
+			mdClientCertFingerprint, strings.Repeat("0", 64), mdClientCertCommonName, "auditor"), readMethod, 0},
+		{"certificate common name denied", incomingContext(mdGatewaySecret, testGatewaySecret,
+			mdClientCertCommonName, "auditor"), spendMethod, ErrAPIPermissionDenied},
+		{"certificate without gateway secret", incomingContext(mdClientCertFingerprint, testFingerprint), readMethod, ErrAPIUnauthenticated},
+		{"certificate with wrong gateway secret", incomingContext(mdGatewaySecret, "guess",
+			mdClientCertFingerprint, testFingerprint), readMethod, ErrAPIUnauthenticated},
+		{"token takes precedence", incomingContext("authorization", "Bearer "+testReadOnlyToken,
+			mdGatewaySecret, testGatewaySecret, mdClientCertFingerprint, testFingerprint), adminMethod, ErrAPIPermissionDenied},
+	}
+	for _, test := range tests {
+		err := a.authorize(test.ctx, test.method)
+		if test.code == 0 {
+			assert.Nil(t, err, test.name)
+			continue
+		}
+		assertStatusCode(t, test.code, err, test.name)
+	}
+}
+
+func TestAuthenticator_UnaryInterceptor(t *testing.T) {
+	a := newTestAuthenticator(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/rpcprotobuf.ApiService/GetBestBlock"}
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "ok", nil
+	}
+
+	_, err := a.unaryInterceptor(context.Background(), nil, info, handler)
+	assertStatusCode(t, ErrAPIUnauthenticated, err, "unauthenticated")
+	assert.False(t, called)
+
+	resp, err := a.unaryInterceptor(incomingContext("authorization", "Bearer "+testReadOnlyToken), nil, info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", resp)
+	assert.True(t, called)
+}
+
+func TestGatewayHeaderMatcher(t *testing.T) {
+	_, ok := gatewayHeaderMatcher("Grpc-Metadata-X-Masswallet-Gateway-Secret")
+	assert.False(t, ok)
+	_, ok = gatewayHeaderMatcher("Grpc-Metadata-X-Masswallet-Client-Cert-Cn")
+	assert.False(t, ok)
+	key, ok := gatewayHeaderMatcher("Grpc-Metadata-Trace-Id")
+	assert.True(t, ok)
+	assert.Equal(t, "Trace-Id", key)
+}
@@ -11,6 +11,11 @@ const (
 	ErrAPIDoubleSpend        = 1108
 	ErrAPIOverfullInputs     = 1109
 	ErrAPIBigTransactionFee  = 1110
+	ErrAPIIncompletePsbt     = 1111
+	ErrAPINoUnconfirmedTx    = 1112
+	ErrAPITxInMempool        = 1113
+	ErrAPIPayoutKeyConflict  = 1114
+	ErrAPINoPayoutBatch      = 1115
 
 	// block err
 	ErrAPINewestHash          = 1201
@@ -29,6 +34,12 @@ const (
 	ErrAPIChangePassUnsupported     = 1309
 	ErrAPIWalletUnlocked            = 1310
 	ErrAPIInvalidKeystoreVersion    = 1311
+	ErrAPIEventCursorExpired        = 1312
+	ErrAPIEventSubscriberLagged     = 1313
+	ErrAPIWatchOnlyWallet           = 1314
+	ErrAPIMultisigWallet            = 1315
+	ErrAPIWalletLocked              = 1316
+	ErrAPIBackupExists              = 1317
 
 	// txScript
 	ErrAPIRejectTx          = 1401
@@ -60,12 +71,24 @@ const (
 	ErrAPIDustChange             = 1522
 	ErrAPIDustAmount             = 1523
 	ErrAPINotEnoughInputs        = 1524
+	ErrAPIInvalidAccountPubKey   = 1525
+	ErrAPIInvalidPsbt            = 1526
+	ErrAPIInvalidMultisigParams  = 1527
+	ErrAPIUnknownCoinSelection   = 1528
+	ErrAPIInvalidHistoryCursor   = 1529
+	ErrAPIInvalidSignature       = 1530
+	ErrAPIUnknownFeeMode         = 1531
 
 	// other err
-	ErrAPIUnknownErr      = 1701
-	ErrAPIQueryDataFailed = 1702
-	ErrAPIAbnormalData    = 1703
-	ErrAPIUnacceptable    = 1704
+	ErrAPIUnknownErr       = 1701
+	ErrAPIQueryDataFailed  = 1702
+	ErrAPIAbnormalData     = 1703
+	ErrAPIUnacceptable     = 1704
+	ErrAPIChainUnavailable = 1705
+
+	// auth err
+	ErrAPIUnauthenticated  = 1801
+	ErrAPIPermissionDenied = 1802
 )
 
 var ErrCode = map[uint32]string{
@@ -124,4 +147,25 @@ var ErrCode = map[uint32]string{
 	ErrAPIWalletUnlocked:        "Wallet unlocked, try again later",
 	ErrAPIBigTransactionFee:     "Big transaction fee",
 	ErrAPIUnacceptable:          "Request is unacceptable",
+	ErrAPIEventCursorExpired:    "Event cursor expired, resync and subscribe without cursor",
+	ErrAPIEventSubscriberLagged: "Event subscriber lagged behind, resubscribe with the last cursor",
+	ErrAPIWatchOnlyWallet:       "Not allowed for watch-only wallet",
+	ErrAPIInvalidAccountPubKey:  "Invalid account extended public key",
+	ErrAPIInvalidPsbt:           "Invalid partially-signed transaction",
+	ErrAPIIncompletePsbt:        "Partially-signed transaction is not fully signed",
+	ErrAPIMultisigWallet:        "Not allowed for multisig wallet",
+	ErrAPIWalletLocked:          "Wallet locked, provide the passphrase or unlock the wallet",
+	ErrAPIInvalidMultisigParams: "Invalid multisig threshold or cosigners",
+	ErrAPIUnknownCoinSelection:  "Unknown coin selection strategy",
+	ErrAPIInvalidHistoryCursor:  "Invalid transaction history cursor",
+	ErrAPIInvalidSignature:      "Invalid signature",
+	ErrAPIUnknownFeeMode:        "Unknown fee mode",
+	ErrAPINoUnconfirmedTx:       "No such unconfirmed transaction in the wallet",
+	ErrAPITxInMempool:           "Transaction is still in mempool",
+	ErrAPIPayoutKeyConflict:     "Payout key already used for a different payout",
+	ErrAPINoPayoutBatch:         "No such payout batch in the wallet",
+	ErrAPIUnauthenticated:       "Unauthenticated, a valid api token or client certificate is required",
+	ErrAPIPermissionDenied:      "Permission denied for the role of the caller",
+	ErrAPIChainUnavailable:      "Unavailable when following a remote full node",
+	ErrAPIBackupExists:          "Backup file already exists",
 }
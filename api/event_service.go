@@ -0,0 +1,88 @@
+package api
+
+import (
+	"github.com/massnetorg/mass-core/logging"
+	"google.golang.org/grpc/status"
+	pb "massnet.org/mass-wallet/api/proto"
+	"massnet.org/mass-wallet/masswallet"
+)
+
+func (s *APIServer) SubscribeWalletEvents(in *pb.SubscribeWalletEventsRequest, stream pb.ApiService_SubscribeWalletEventsServer) error {
+	logging.CPrint(logging.INFO, "api: SubscribeWalletEvents", logging.LogFormat{
+		"wallet_id": in.WalletId,
+		"cursor":    in.Cursor,
+	})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return err
+	}
+
+	var cursor *masswallet.EventCursor
+	if in.Cursor != nil {
+		cursor = &masswallet.EventCursor{
+			Height:   in.Cursor.Height,
+			Sequence: in.Cursor.Sequence,
+		}
+	}
+	sub, err := s.massWallet.SubscribeEvents(in.WalletId, cursor)
+	if err != nil {
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return cvtErr
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			logging.CPrint(logging.INFO, "api: SubscribeWalletEvents completed", logging.LogFormat{
+				"wallet_id": in.WalletId,
+			})
+			return nil
+
+		case ev, ok := <-sub.C:
+			if !ok {
+				if err := sub.Err(); err != nil {
+					return convertResponseError(err)
+				}
+				return nil
+			}
+			msg, err := marshalWalletEvent(ev)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(msg); err != nil {
+				logging.CPrint(logging.WARN, "failed to send wallet event", logging.LogFormat{
+					"wallet_id": in.WalletId,
+					"err":       err,
+				})
+				return err
+			}
+		}
+	}
+}
+
+func marshalWalletEvent(ev *masswallet.WalletEvent) (*pb.WalletEvent, error) {
+	msg := &pb.WalletEvent{
+		Cursor: &pb.EventCursor{
+			Height:   ev.Cursor.Height,
+			Sequence: ev.Cursor.Sequence,
+		},
+		Type:       ev.Type,
+		WalletId:   ev.WalletId,
+		TxId:       ev.TxId,
+		BlockHash:  ev.BlockHash,
+		Height:     ev.Height,
+		BestHeight: ev.BestHeight,
+	}
+	if ev.Type == masswallet.EventBalanceChanged {
+		balance, err := checkFormatAmount(ev.Balance)
+		if err != nil {
+			return nil, err
+		}
+		msg.Balance = balance
+	}
+	return msg, nil
+}
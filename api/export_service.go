@@ -0,0 +1,207 @@
+package api
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/massnetorg/mass-core/logging"
+	"google.golang.org/grpc/status"
+
+	pb "massnet.org/mass-wallet/api/proto"
+	"massnet.org/mass-wallet/masswallet"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+// Formats of ExportHistory.
+const (
+	exportFormatCSV   = "csv"
+	exportFormatJSONL = "jsonl"
+)
+
+// exportChunkRows is the number of ledger rows sent in one chunk of ExportHistory.
+const exportChunkRows = 100
+
+var ledgerColumns = []string{
+	"date", "tx_id", "block_height", "direction", "counterparts",
+	"amount", "fee", "balance", "class", "note",
+}
+
+// ledgerRow is a ledger record in jsonl.
+type ledgerRow struct {
+	Date         string   `json:"date"`
+	TxId         string   `json:"tx_id"`
+	BlockHeight  uint64   `json:"block_height"`
+	Direction    string   `json:"direction"`
+	Counterparts []string `json:"counterparts"`
+	Amount       string   `json:"amount"`
+	Fee          string   `json:"fee"`
+	Balance      string   `json:"balance"`
+	Class        string   `json:"class"`
+	Note         string   `json:"note"`
+}
+
+func (r *ledgerRow) csvRecord() []string {
+	return []string{
+		r.Date, r.TxId, strconv.FormatUint(r.BlockHeight, 10), r.Direction, csvText(strings.Join(r.Counterparts, ";")),
+		r.Amount, r.Fee, r.Balance, r.Class, csvText(r.Note),
+	}
+}
+
+// csvText prefixes free text starting like a formula, or with a tab or a
+// carriage return, with "'", so that spreadsheets opening the export show it
+// instead of evaluating it.
+func csvText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func (s *APIServer) ExportHistory(in *pb.ExportHistoryRequest, stream pb.ApiService_ExportHistoryServer) error {
+	logging.CPrint(logging.INFO, "api: ExportHistory", logging.LogFormat{
+		"format":      in.Format,
+		"from_height": in.FromHeight,
+		"to_height":   in.ToHeight,
+		"from_time":   in.FromTime,
+		"to_time":     in.ToTime,
+	})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return err
+	}
+	format := strings.ToLower(strings.TrimSpace(in.Format))
+	switch format {
+	case "":
+		format = exportFormatCSV
+	case exportFormatCSV, exportFormatJSONL:
+	default:
+		logging.CPrint(logging.ERROR, "invalid export format", logging.LogFormat{"format": in.Format})
+		return status.New(ErrAPIInvalidParameter, "format should be either csv or jsonl").Err()
+	}
+	if in.ToHeight > 0 && in.ToHeight < in.FromHeight {
+		logging.CPrint(logging.ERROR, "invalid height range", logging.LogFormat{})
+		return status.New(ErrAPIInvalidParameter, "to_height should not be less than from_height").Err()
+	}
+	labels, err := s.walletLabels(in.WalletId)
+	if err != nil {
+		return err
+	}
+
+	var (
+		buf  bytes.Buffer
+		rows int
+		num  int
+	)
+	csvWriter := csv.NewWriter(&buf)
+	if format == exportFormatCSV {
+		csvWriter.Write(ledgerColumns)
+	}
+	flush := func() error {
+		if format == exportFormatCSV {
+			csvWriter.Flush()
+			if err := csvWriter.Error(); err != nil {
+				return err
+			}
+		}
+		if buf.Len() == 0 {
+			return nil
+		}
+		err := stream.Send(&pb.ExportHistoryResponse{Data: buf.String()})
+		buf.Reset()
+		rows = 0
+		return err
+	}
+
+	query := &txmgr.TxHistoryQuery{
+		FromHeight: in.FromHeight,
+		ToHeight:   in.ToHeight,
+		FromTime:   in.FromTime,
+		ToTime:     in.ToTime,
+	}
+	err = s.massWallet.ExportLedger(in.WalletId, query, func(rec *masswallet.LedgerRecord) error {
+		row, err := newLedgerRow(rec, labels)
+		if err != nil {
+			return status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		if format == exportFormatCSV {
+			csvWriter.Write(row.csvRecord())
+		} else {
+			line, err := json.Marshal(row)
+			if err != nil {
+				return err
+			}
+			buf.Write(line)
+			buf.WriteByte('\n')
+		}
+		num++
+		if rows++; rows == exportChunkRows {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ExportHistory failed", logging.LogFormat{"err": err})
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: ExportHistory completed", logging.LogFormat{"num": num})
+	return nil
+}
+
+func newLedgerRow(rec *masswallet.LedgerRecord, labels *masswallet.WalletLabels) (*ledgerRow, error) {
+	amount, err := signedAmountToString(rec.Amount)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := AmountToString(rec.Fee.IntValue())
+	if err != nil {
+		return nil, err
+	}
+	balance, err := signedAmountToString(rec.Balance)
+	if err != nil {
+		return nil, err
+	}
+	txId := rec.TxHash.String()
+	counterparts := rec.Counterparts
+	if counterparts == nil {
+		counterparts = []string{}
+	}
+	return &ledgerRow{
+		Date:         rec.Timestamp.UTC().Format(time.RFC3339),
+		TxId:         txId,
+		BlockHeight:  rec.BlockHeight,
+		Direction:    rec.Direction,
+		Counterparts: counterparts,
+		Amount:       amount,
+		Fee:          fee,
+		Balance:      balance,
+		Class:        rec.Class,
+		Note:         labels.Transactions[txId],
+	}, nil
+}
+
+// signedAmountToString returns m maxwell in MASS, negative amounts are prefixed by "-".
+func signedAmountToString(m int64) (string, error) {
+	if m >= 0 {
+		return AmountToString(m)
+	}
+	str, err := AmountToString(-m)
+	if err != nil {
+		return "", err
+	}
+	return "-" + str, nil
+}
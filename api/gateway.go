@@ -12,6 +12,7 @@ import (
 	"github.com/rs/cors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	gw "massnet.org/mass-wallet/api/proto"
 	"massnet.org/mass-wallet/config"
 )
@@ -29,7 +30,7 @@ func statusUnavailableHandler(w http.ResponseWriter, r *http.Request) {
 func allowCORS(h http.Handler, config *config.Config) http.Handler {
 	httpCh := make(chan bool, DefaultHTTPLimit)
 	c := cors.New(cors.Options{
-		AllowedHeaders: []string{"Content-Type", "Accept"},
+		AllowedHeaders: []string{"Content-Type", "Accept", "Authorization"},
 		AllowedMethods: []string{"GET", "HEAD", "POST", "PUT", "DELETE"},
 		AllowedOrigins: config.Wallet.API.HttpCORSAddr,
 		MaxAge:         600,
@@ -66,28 +67,44 @@ func LoadTLSConfig(caPath string) (*tls.Config, error) {
 	}, nil
 }
 
-func Run(cfg *config.Config) error {
+// Run serves the http gateway of the grpc server. gatewaySecret is sent along with
+// the forwarded client certificate, it's empty if auth is disabled.
+func Run(cfg *config.Config, gatewaySecret string) error {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard,
-		&runtime.JSONPb{OrigName: true, EmitDefaults: true}))
+		&runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+		runtime.WithMetadata(func(_ context.Context, r *http.Request) metadata.MD {
+			return gatewayAuthMetadata(r, gatewaySecret)
+		}))
 
 	opts := []grpc.DialOption{
 		grpc.WithInsecure(),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize)),
 	}
-	err := gw.RegisterApiServiceHandlerFromEndpoint(ctx, mux, "localhost:"+cfg.Wallet.API.GRPCPort, opts)
+	conn, err := grpc.Dial("localhost:"+cfg.Wallet.API.GRPCPort, opts...)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	err = gw.RegisterApiServiceHandler(ctx, mux, conn)
 	if err != nil {
 		return err
 	}
 
 	handle := maxBytesHandler(mux)
+	root := http.NewServeMux()
+	// long-lived, not counted against DefaultHTTPLimit
+	root.Handle(walletEventsWebSocketPath, walletEventsWebSocket(gw.NewApiServiceClient(conn), cfg, gatewaySecret))
+	root.Handle("/", allowCORS(handle, cfg))
+
 	addr := fmt.Sprintf("%s%s%s", cfg.Wallet.API.Host, ":", cfg.Wallet.API.HttpPort)
 	serv := &http.Server{
 		Addr:    addr,
-		Handler: allowCORS(handle, cfg),
+		Handler: root,
 	}
 
 	// http
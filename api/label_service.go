@@ -0,0 +1,136 @@
+package api
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/status"
+
+	"github.com/massnetorg/mass-core/logging"
+
+	pb "massnet.org/mass-wallet/api/proto"
+	"massnet.org/mass-wallet/masswallet"
+)
+
+func (s *APIServer) SetLabel(ctx context.Context, in *pb.SetLabelRequest) (*pb.SetLabelResponse, error) {
+	logging.CPrint(logging.INFO, "api: SetLabel", logging.LogFormat{"address": in.Address, "tx_id": in.TxId})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	address := strings.TrimSpace(in.Address)
+	txId := strings.TrimSpace(in.TxId)
+	if (len(address) == 0) == (len(txId) == 0) {
+		logging.CPrint(logging.ERROR, "either address or tx_id is required", logging.LogFormat{})
+		return nil, status.New(ErrAPIInvalidParameter, "either address or tx_id is required").Err()
+	}
+	if utf8.RuneCountInString(in.Label) > masswallet.MaxLabelLen {
+		logging.CPrint(logging.ERROR, "label too long", logging.LogFormat{"len": utf8.RuneCountInString(in.Label)})
+		return nil, status.New(ErrAPIInvalidParameter, "label too long").Err()
+	}
+
+	var err error
+	if len(address) > 0 {
+		if err = checkAddressLen(address); err != nil {
+			return nil, err
+		}
+		err = s.massWallet.SetAddressLabel(in.WalletId, address, in.Label)
+	} else {
+		if err = checkTransactionIdLen(txId); err != nil {
+			return nil, err
+		}
+		err = s.massWallet.SetTxNote(in.WalletId, txId, in.Label)
+	}
+	if err != nil {
+		logging.CPrint(logging.ERROR, "SetLabel failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: SetLabel completed", logging.LogFormat{})
+	return &pb.SetLabelResponse{Ok: true}, nil
+}
+
+func (s *APIServer) GetLabels(ctx context.Context, in *pb.GetLabelsRequest) (*pb.LabelsResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetLabels", logging.LogFormat{
+		"addresses": len(in.Addresses),
+		"tx_ids":    len(in.TxIds),
+	})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	labels, err := s.walletLabels(in.WalletId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.Addresses) > 0 || len(in.TxIds) > 0 {
+		filtered := &masswallet.WalletLabels{
+			Addresses:    make(map[string]string),
+			Transactions: make(map[string]string),
+		}
+		for _, address := range in.Addresses {
+			if label, ok := labels.Addresses[strings.TrimSpace(address)]; ok {
+				filtered.Addresses[address] = label
+			}
+		}
+		for _, txId := range in.TxIds {
+			if note, ok := labels.Transactions[strings.ToLower(strings.TrimSpace(txId))]; ok {
+				filtered.Transactions[txId] = note
+			}
+		}
+		labels = filtered
+	}
+
+	logging.CPrint(logging.INFO, "api: GetLabels completed", logging.LogFormat{})
+	return &pb.LabelsResponse{
+		Addresses:    labels.Addresses,
+		Transactions: labels.Transactions,
+	}, nil
+}
+
+func (s *APIServer) SearchLabels(ctx context.Context, in *pb.SearchLabelsRequest) (*pb.LabelsResponse, error) {
+	logging.CPrint(logging.INFO, "api: SearchLabels", logging.LogFormat{"query": in.Query})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	labels, err := s.massWallet.SearchLabels(in.WalletId, strings.TrimSpace(in.Query))
+	if err != nil {
+		logging.CPrint(logging.ERROR, "SearchLabels failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: SearchLabels completed", logging.LogFormat{
+		"addresses":    len(labels.Addresses),
+		"transactions": len(labels.Transactions),
+	})
+	return &pb.LabelsResponse{
+		Addresses:    labels.Addresses,
+		Transactions: labels.Transactions,
+	}, nil
+}
+
+// walletLabels returns labels of the wallet, to be returned along with addresses
+// and transactions.
+func (s *APIServer) walletLabels(walletId string) (*masswallet.WalletLabels, error) {
+	labels, err := s.massWallet.GetLabels(walletId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "GetLabels failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+	return labels, nil
+}
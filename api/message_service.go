@@ -0,0 +1,86 @@
+package api
+
+import (
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/status"
+
+	"github.com/massnetorg/mass-core/logging"
+
+	pb "massnet.org/mass-wallet/api/proto"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet"
+)
+
+func checkMessageLen(message string) error {
+	if len(message) > LenMessageMax {
+		logging.CPrint(logging.ERROR, "message too long", logging.LogFormat{
+			"length": len(message),
+			"max":    LenMessageMax,
+		})
+		return status.New(ErrAPIInvalidParameter, "message too long").Err()
+	}
+	return nil
+}
+
+func (s *APIServer) SignMessage(ctx context.Context, in *pb.SignMessageRequest) (*pb.SignMessageResponse, error) {
+	logging.CPrint(logging.INFO, "api: SignMessage", logging.LogFormat{"address": in.Address})
+
+	if err := checkAddressLen(in.Address); err != nil {
+		return nil, err
+	}
+	if err := checkMessageLen(in.Message); err != nil {
+		return nil, err
+	}
+	// the passphrase is omitted if the wallet is unlocked by UnlockWallet
+	if len(in.Passphrase) > 0 {
+		if err := checkPassLen(in.Passphrase); err != nil {
+			return nil, err
+		}
+	}
+
+	sig, err := s.massWallet.SignMessage(in.Address, in.Message, []byte(in.Passphrase))
+	if err != nil {
+		logging.CPrint(logging.ERROR, "SignMessage failed", logging.LogFormat{
+			"err":     err,
+			"address": in.Address,
+		})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIInvalidAddress, ErrCode[ErrAPIInvalidAddress]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: SignMessage completed", logging.LogFormat{"address": in.Address})
+	return &pb.SignMessageResponse{Signature: sig}, nil
+}
+
+func (s *APIServer) VerifyMessage(ctx context.Context, in *pb.VerifyMessageRequest) (*pb.VerifyMessageResponse, error) {
+	logging.CPrint(logging.INFO, "api: VerifyMessage", logging.LogFormat{"address": in.Address})
+
+	if err := checkAddressLen(in.Address); err != nil {
+		return nil, err
+	}
+	if err := checkMessageLen(in.Message); err != nil {
+		return nil, err
+	}
+
+	valid, err := masswallet.VerifyMessage(in.Address, in.Signature, in.Message, config.ChainParams)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "VerifyMessage failed", logging.LogFormat{
+			"err":     err,
+			"address": in.Address,
+		})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIInvalidAddress, ErrCode[ErrAPIInvalidAddress]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: VerifyMessage completed", logging.LogFormat{
+		"address": in.Address,
+		"valid":   valid,
+	})
+	return &pb.VerifyMessageResponse{Valid: valid}, nil
+}
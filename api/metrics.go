@@ -0,0 +1,56 @@
+package api
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"massnet.org/mass-wallet/metrics"
+)
+
+var (
+	apiRequests = metrics.NewCounterVec("masswallet_api_requests_total",
+		"Number of unary api calls by method and error code, 0 if succeeded.", "method", "code")
+	apiRequestDuration = metrics.NewHistogramVec("masswallet_api_request_duration_seconds",
+		"Duration of unary api calls by method.", metrics.DefBuckets, "method")
+	apiStreams = metrics.NewCounterVec("masswallet_api_streams_total",
+		"Number of streaming api calls by method and error code, 0 if succeeded.", "method", "code")
+	// streams such as event subscriptions last for hours
+	apiStreamDuration = metrics.NewHistogramVec("masswallet_api_stream_duration_seconds",
+		"Duration of streaming api calls by method.", []float64{.1, 1, 10, 60, 600, 3600, 21600, 86400}, "method")
+)
+
+func init() {
+	metrics.DefaultRegistry.Register(apiRequests)
+	metrics.DefaultRegistry.Register(apiRequestDuration)
+	metrics.DefaultRegistry.Register(apiStreams)
+	metrics.DefaultRegistry.Register(apiStreamDuration)
+}
+
+// metricsInterceptor counts unary calls with their error codes and times them,
+// it runs ahead of other interceptors so that rejected calls are counted as well.
+func metricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	name := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	apiRequests.Inc(name, strconv.FormatUint(uint64(status.Code(err)), 10))
+	apiRequestDuration.Observe(time.Since(start).Seconds(), name)
+	return resp, err
+}
+
+// metricsStreamInterceptor counts streaming calls with their error codes and
+// times them until the stream ends.
+func metricsStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	name := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	apiStreams.Inc(name, strconv.FormatUint(uint64(status.Code(err)), 10))
+	apiStreamDuration.Observe(time.Since(start).Seconds(), name)
+	return err
+}
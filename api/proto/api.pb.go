@@ -18,15 +18,31 @@ It has these top-level messages:
 	ImportWalletRequest
 	ImportWalletResponse
 	ImportMnemonicRequest
+	ImportWatchOnlyRequest
+	ImportMultisigRequest
+	ExportWatchOnlyRequest
+	ExportWatchOnlyResponse
 	ExportWalletRequest
 	ExportWalletResponse
 	RemoveWalletRequest
 	RemoveWalletResponse
+	RescanWalletRequest
+	RescanWalletResponse
+	BackupWalletRequest
+	BackupWalletResponse
+	UnlockWalletRequest
+	UnlockWalletResponse
+	LockWalletRequest
+	LockWalletResponse
 	GetAddressBalanceRequest
 	AddressAndBalance
 	GetAddressBalanceResponse
 	ValidateAddressRequest
 	ValidateAddressResponse
+	SignMessageRequest
+	SignMessageResponse
+	VerifyMessageRequest
+	VerifyMessageResponse
 	CreateAddressRequest
 	CreateAddressResponse
 	GetAddressesRequest
@@ -36,6 +52,10 @@ It has these top-level messages:
 	TxHistoryDetails
 	TxHistoryResponse
 	TxHistoryRequest
+	ListTxHistoryRequest
+	ListTxHistoryResponse
+	ExportHistoryRequest
+	ExportHistoryResponse
 	TransactionInput
 	DecodeRawTransactionRequest
 	DecodeRawTransactionResponse
@@ -51,6 +71,8 @@ It has these top-level messages:
 	SendRawTransactionResponse
 	GetTransactionFeeRequest
 	GetTransactionFeeResponse
+	EstimateFeeRateRequest
+	EstimateFeeRateResponse
 	BlockInfoForTx
 	Vin
 	Vout
@@ -58,15 +80,44 @@ It has these top-level messages:
 	GetRawTransactionResponse
 	GetTxStatusRequest
 	GetTxStatusResponse
+	ListUnconfirmedRequest
+	ListUnconfirmedResponse
+	AbandonTransactionRequest
+	AbandonTransactionResponse
+	CreatePayoutBatchRequest
+	GetPayoutBatchRequest
+	PayoutBatchResponse
 	SignRawTransactionRequest
 	SignRawTransactionResponse
+	CreatePsbtRequest
+	PsbtResponse
+	DecodePsbtRequest
+	DecodePsbtResponse
+	SignPsbtRequest
+	SignPsbtResponse
+	CombinePsbtRequest
+	FinalizePsbtRequest
+	FinalizePsbtResponse
 	GetUtxoRequest
 	UTXO
 	AddressUTXO
 	GetUtxoResponse
+	LockUnspentRequest
+	LockedUnspent
+	LockUnspentResponse
+	UnlockUnspentRequest
+	UnlockUnspentResponse
+	ListLockUnspentRequest
+	ListLockUnspentResponse
+	SetLabelRequest
+	SetLabelResponse
+	GetLabelsRequest
+	SearchLabelsRequest
+	LabelsResponse
 	GetBindingHistoryRequest
 	GetBindingHistoryResponse
 	CreateBindingTransactionRequest
+	CreateWithdrawTransactionRequest
 	GetWalletMnemonicRequest
 	GetWalletMnemonicResponse
 	GetBlockByHeightRequest
@@ -78,6 +129,9 @@ It has these top-level messages:
 	GetNetworkBindingResponse
 	CheckTargetBindingRequest
 	CheckTargetBindingResponse
+	EventCursor
+	SubscribeWalletEventsRequest
+	WalletEvent
 */
 package rpcprotobuf
 
@@ -323,6 +377,9 @@ type WalletsResponse_WalletSummary struct {
 	Remarks   string `protobuf:"bytes,4,opt,name=remarks,proto3" json:"remarks,omitempty"`
 	Status    uint32 `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
 	StatusMsg string `protobuf:"bytes,6,opt,name=status_msg,json=statusMsg,proto3" json:"status_msg,omitempty"`
+	// "removing" - when status=2
+	// {synced_height} - when status=1
+	WatchOnly bool `protobuf:"varint,7,opt,name=watch_only,json=watchOnly,proto3" json:"watch_only,omitempty"`
 }
 
 func (m *WalletsResponse_WalletSummary) Reset()         { *m = WalletsResponse_WalletSummary{} }
@@ -374,6 +431,13 @@ func (m *WalletsResponse_WalletSummary) GetStatusMsg() string {
 	return ""
 }
 
+func (m *WalletsResponse_WalletSummary) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
 type UseWalletRequest struct {
 	WalletId string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
@@ -399,6 +463,7 @@ type UseWalletResponse struct {
 	ExternalKeyCount int32  `protobuf:"varint,6,opt,name=external_key_count,json=externalKeyCount,proto3" json:"external_key_count,omitempty"`
 	InternalKeyCount int32  `protobuf:"varint,7,opt,name=internal_key_count,json=internalKeyCount,proto3" json:"internal_key_count,omitempty"`
 	Remarks          string `protobuf:"bytes,8,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	WatchOnly        bool   `protobuf:"varint,9,opt,name=watch_only,json=watchOnly,proto3" json:"watch_only,omitempty"`
 }
 
 func (m *UseWalletResponse) Reset()                    { *m = UseWalletResponse{} }
@@ -462,6 +527,13 @@ func (m *UseWalletResponse) GetRemarks() string {
 	return ""
 }
 
+func (m *UseWalletResponse) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
 type CreateWalletRequest struct {
 	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 	Remarks    string `protobuf:"bytes,2,opt,name=remarks,proto3" json:"remarks,omitempty"`
@@ -551,11 +623,12 @@ func (m *ImportWalletRequest) GetPassphrase() string {
 }
 
 type ImportWalletResponse struct {
-	Ok       bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	WalletId string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	Type     uint32 `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	Version  uint32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
-	Remarks  string `protobuf:"bytes,5,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	Ok        bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	WalletId  string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Type      uint32 `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	Version   uint32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Remarks   string `protobuf:"bytes,5,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	WatchOnly bool   `protobuf:"varint,6,opt,name=watch_only,json=watchOnly,proto3" json:"watch_only,omitempty"`
 }
 
 func (m *ImportWalletResponse) Reset()                    { *m = ImportWalletResponse{} }
@@ -598,6 +671,13 @@ func (m *ImportWalletResponse) GetRemarks() string {
 	return ""
 }
 
+func (m *ImportWalletResponse) GetWatchOnly() bool {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return false
+}
+
 type ImportMnemonicRequest struct {
 	Mnemonic      string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
 	Passphrase    string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
@@ -646,6 +726,126 @@ func (m *ImportMnemonicRequest) GetInternalIndex() uint32 {
 	return 0
 }
 
+type ImportWatchOnlyRequest struct {
+	AccountPubKey string `protobuf:"bytes,1,opt,name=account_pub_key,json=accountPubKey,proto3" json:"account_pub_key,omitempty"`
+	Remarks       string `protobuf:"bytes,2,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	ExternalIndex uint32 `protobuf:"varint,3,opt,name=external_index,json=externalIndex,proto3" json:"external_index,omitempty"`
+	InternalIndex uint32 `protobuf:"varint,4,opt,name=internal_index,json=internalIndex,proto3" json:"internal_index,omitempty"`
+}
+
+func (m *ImportWatchOnlyRequest) Reset()                    { *m = ImportWatchOnlyRequest{} }
+func (m *ImportWatchOnlyRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportWatchOnlyRequest) ProtoMessage()               {}
+func (*ImportWatchOnlyRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
+
+func (m *ImportWatchOnlyRequest) GetAccountPubKey() string {
+	if m != nil {
+		return m.AccountPubKey
+	}
+	return ""
+}
+
+func (m *ImportWatchOnlyRequest) GetRemarks() string {
+	if m != nil {
+		return m.Remarks
+	}
+	return ""
+}
+
+func (m *ImportWatchOnlyRequest) GetExternalIndex() uint32 {
+	if m != nil {
+		return m.ExternalIndex
+	}
+	return 0
+}
+
+func (m *ImportWatchOnlyRequest) GetInternalIndex() uint32 {
+	if m != nil {
+		return m.InternalIndex
+	}
+	return 0
+}
+
+type ImportMultisigRequest struct {
+	Threshold      uint32   `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	AccountPubKeys []string `protobuf:"bytes,2,rep,name=account_pub_keys,json=accountPubKeys" json:"account_pub_keys,omitempty"`
+	Remarks        string   `protobuf:"bytes,3,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	ExternalIndex  uint32   `protobuf:"varint,4,opt,name=external_index,json=externalIndex,proto3" json:"external_index,omitempty"`
+	InternalIndex  uint32   `protobuf:"varint,5,opt,name=internal_index,json=internalIndex,proto3" json:"internal_index,omitempty"`
+}
+
+func (m *ImportMultisigRequest) Reset()                    { *m = ImportMultisigRequest{} }
+func (m *ImportMultisigRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportMultisigRequest) ProtoMessage()               {}
+func (*ImportMultisigRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
+
+func (m *ImportMultisigRequest) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ImportMultisigRequest) GetAccountPubKeys() []string {
+	if m != nil {
+		return m.AccountPubKeys
+	}
+	return nil
+}
+
+func (m *ImportMultisigRequest) GetRemarks() string {
+	if m != nil {
+		return m.Remarks
+	}
+	return ""
+}
+
+func (m *ImportMultisigRequest) GetExternalIndex() uint32 {
+	if m != nil {
+		return m.ExternalIndex
+	}
+	return 0
+}
+
+func (m *ImportMultisigRequest) GetInternalIndex() uint32 {
+	if m != nil {
+		return m.InternalIndex
+	}
+	return 0
+}
+
+type ExportWatchOnlyRequest struct {
+	WalletId string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *ExportWatchOnlyRequest) Reset()                    { *m = ExportWatchOnlyRequest{} }
+func (m *ExportWatchOnlyRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportWatchOnlyRequest) ProtoMessage()               {}
+func (*ExportWatchOnlyRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
+
+func (m *ExportWatchOnlyRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+type ExportWatchOnlyResponse struct {
+	AccountPubKey string `protobuf:"bytes,1,opt,name=account_pub_key,json=accountPubKey,proto3" json:"account_pub_key,omitempty"`
+}
+
+func (m *ExportWatchOnlyResponse) Reset()                    { *m = ExportWatchOnlyResponse{} }
+func (m *ExportWatchOnlyResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExportWatchOnlyResponse) ProtoMessage()               {}
+func (*ExportWatchOnlyResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
+
+func (m *ExportWatchOnlyResponse) GetAccountPubKey() string {
+	if m != nil {
+		return m.AccountPubKey
+	}
+	return ""
+}
+
 type ExportWalletRequest struct {
 	WalletId   string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
@@ -654,7 +854,7 @@ type ExportWalletRequest struct {
 func (m *ExportWalletRequest) Reset()                    { *m = ExportWalletRequest{} }
 func (m *ExportWalletRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExportWalletRequest) ProtoMessage()               {}
-func (*ExportWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{10} }
+func (*ExportWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
 
 func (m *ExportWalletRequest) GetWalletId() string {
 	if m != nil {
@@ -677,7 +877,7 @@ type ExportWalletResponse struct {
 func (m *ExportWalletResponse) Reset()                    { *m = ExportWalletResponse{} }
 func (m *ExportWalletResponse) String() string            { return proto.CompactTextString(m) }
 func (*ExportWalletResponse) ProtoMessage()               {}
-func (*ExportWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{11} }
+func (*ExportWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
 
 func (m *ExportWalletResponse) GetKeystore() string {
 	if m != nil {
@@ -694,7 +894,7 @@ type RemoveWalletRequest struct {
 func (m *RemoveWalletRequest) Reset()                    { *m = RemoveWalletRequest{} }
 func (m *RemoveWalletRequest) String() string            { return proto.CompactTextString(m) }
 func (*RemoveWalletRequest) ProtoMessage()               {}
-func (*RemoveWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{12} }
+func (*RemoveWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{16} }
 
 func (m *RemoveWalletRequest) GetWalletId() string {
 	if m != nil {
@@ -717,7 +917,7 @@ type RemoveWalletResponse struct {
 func (m *RemoveWalletResponse) Reset()                    { *m = RemoveWalletResponse{} }
 func (m *RemoveWalletResponse) String() string            { return proto.CompactTextString(m) }
 func (*RemoveWalletResponse) ProtoMessage()               {}
-func (*RemoveWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{13} }
+func (*RemoveWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{17} }
 
 func (m *RemoveWalletResponse) GetOk() bool {
 	if m != nil {
@@ -726,15 +926,256 @@ func (m *RemoveWalletResponse) GetOk() bool {
 	return false
 }
 
+type RescanWalletRequest struct {
+	WalletId   string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	FromHeight uint64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	GapLimit   uint32 `protobuf:"varint,3,opt,name=gap_limit,json=gapLimit,proto3" json:"gap_limit,omitempty"`
+}
+
+func (m *RescanWalletRequest) Reset()                    { *m = RescanWalletRequest{} }
+func (m *RescanWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*RescanWalletRequest) ProtoMessage()               {}
+func (*RescanWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{18} }
+
+func (m *RescanWalletRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *RescanWalletRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *RescanWalletRequest) GetGapLimit() uint32 {
+	if m != nil {
+		return m.GapLimit
+	}
+	return 0
+}
+
+type RescanWalletResponse struct {
+	WalletId            string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	FromHeight          uint64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	DiscoveredAddresses uint32 `protobuf:"varint,3,opt,name=discovered_addresses,json=discoveredAddresses,proto3" json:"discovered_addresses,omitempty"`
+}
+
+func (m *RescanWalletResponse) Reset()                    { *m = RescanWalletResponse{} }
+func (m *RescanWalletResponse) String() string            { return proto.CompactTextString(m) }
+func (*RescanWalletResponse) ProtoMessage()               {}
+func (*RescanWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{19} }
+
+func (m *RescanWalletResponse) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *RescanWalletResponse) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *RescanWalletResponse) GetDiscoveredAddresses() uint32 {
+	if m != nil {
+		return m.DiscoveredAddresses
+	}
+	return 0
+}
+
+type BackupWalletRequest struct {
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (m *BackupWalletRequest) Reset()                    { *m = BackupWalletRequest{} }
+func (m *BackupWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupWalletRequest) ProtoMessage()               {}
+func (*BackupWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{20} }
+
+func (m *BackupWalletRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type BackupWalletResponse struct {
+	Path          string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size         int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Checksum      string   `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Entries       uint64   `protobuf:"varint,4,opt,name=entries,proto3" json:"entries,omitempty"`
+	CreatedAt     int64    `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SchemaVersion uint32   `protobuf:"varint,6,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	SyncedHeight  uint64   `protobuf:"varint,7,opt,name=synced_height,json=syncedHeight,proto3" json:"synced_height,omitempty"`
+	Wallets       []string `protobuf:"bytes,8,rep,name=wallets" json:"wallets,omitempty"`
+	Encrypted     bool     `protobuf:"varint,9,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+}
+
+func (m *BackupWalletResponse) Reset()                    { *m = BackupWalletResponse{} }
+func (m *BackupWalletResponse) String() string            { return proto.CompactTextString(m) }
+func (*BackupWalletResponse) ProtoMessage()               {}
+func (*BackupWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{21} }
+
+func (m *BackupWalletResponse) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *BackupWalletResponse) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *BackupWalletResponse) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *BackupWalletResponse) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *BackupWalletResponse) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *BackupWalletResponse) GetSchemaVersion() uint32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+func (m *BackupWalletResponse) GetSyncedHeight() uint64 {
+	if m != nil {
+		return m.SyncedHeight
+	}
+	return 0
+}
+
+func (m *BackupWalletResponse) GetWallets() []string {
+	if m != nil {
+		return m.Wallets
+	}
+	return nil
+}
+
+func (m *BackupWalletResponse) GetEncrypted() bool {
+	if m != nil {
+		return m.Encrypted
+	}
+	return false
+}
+
+type UnlockWalletRequest struct {
+	WalletId   string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Timeout    uint32 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *UnlockWalletRequest) Reset()                    { *m = UnlockWalletRequest{} }
+func (m *UnlockWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnlockWalletRequest) ProtoMessage()               {}
+func (*UnlockWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{22} }
+
+func (m *UnlockWalletRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *UnlockWalletRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *UnlockWalletRequest) GetTimeout() uint32 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type UnlockWalletResponse struct {
+	Expires int64 `protobuf:"varint,1,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (m *UnlockWalletResponse) Reset()                    { *m = UnlockWalletResponse{} }
+func (m *UnlockWalletResponse) String() string            { return proto.CompactTextString(m) }
+func (*UnlockWalletResponse) ProtoMessage()               {}
+func (*UnlockWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{23} }
+
+func (m *UnlockWalletResponse) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+type LockWalletRequest struct {
+	WalletId string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *LockWalletRequest) Reset()                    { *m = LockWalletRequest{} }
+func (m *LockWalletRequest) String() string            { return proto.CompactTextString(m) }
+func (*LockWalletRequest) ProtoMessage()               {}
+func (*LockWalletRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{24} }
+
+func (m *LockWalletRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+type LockWalletResponse struct {
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (m *LockWalletResponse) Reset()                    { *m = LockWalletResponse{} }
+func (m *LockWalletResponse) String() string            { return proto.CompactTextString(m) }
+func (*LockWalletResponse) ProtoMessage()               {}
+func (*LockWalletResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25} }
+
+func (m *LockWalletResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
 type GetAddressBalanceRequest struct {
 	RequiredConfirmations int32    `protobuf:"varint,1,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
 	Addresses             []string `protobuf:"bytes,2,rep,name=addresses" json:"addresses,omitempty"`
+	WalletId              string   `protobuf:"bytes,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
 func (m *GetAddressBalanceRequest) Reset()                    { *m = GetAddressBalanceRequest{} }
 func (m *GetAddressBalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetAddressBalanceRequest) ProtoMessage()               {}
-func (*GetAddressBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{14} }
+func (*GetAddressBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{26} }
 
 func (m *GetAddressBalanceRequest) GetRequiredConfirmations() int32 {
 	if m != nil {
@@ -750,6 +1191,13 @@ func (m *GetAddressBalanceRequest) GetAddresses() []string {
 	return nil
 }
 
+func (m *GetAddressBalanceRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
 type AddressAndBalance struct {
 	Address             string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Total               string `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
@@ -761,7 +1209,7 @@ type AddressAndBalance struct {
 func (m *AddressAndBalance) Reset()                    { *m = AddressAndBalance{} }
 func (m *AddressAndBalance) String() string            { return proto.CompactTextString(m) }
 func (*AddressAndBalance) ProtoMessage()               {}
-func (*AddressAndBalance) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{15} }
+func (*AddressAndBalance) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{27} }
 
 func (m *AddressAndBalance) GetAddress() string {
 	if m != nil {
@@ -805,7 +1253,7 @@ type GetAddressBalanceResponse struct {
 func (m *GetAddressBalanceResponse) Reset()                    { *m = GetAddressBalanceResponse{} }
 func (m *GetAddressBalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetAddressBalanceResponse) ProtoMessage()               {}
-func (*GetAddressBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{16} }
+func (*GetAddressBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{28} }
 
 func (m *GetAddressBalanceResponse) GetBalances() []*AddressAndBalance {
 	if m != nil {
@@ -815,13 +1263,14 @@ func (m *GetAddressBalanceResponse) GetBalances() []*AddressAndBalance {
 }
 
 type ValidateAddressRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	WalletId string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
 func (m *ValidateAddressRequest) Reset()                    { *m = ValidateAddressRequest{} }
 func (m *ValidateAddressRequest) String() string            { return proto.CompactTextString(m) }
 func (*ValidateAddressRequest) ProtoMessage()               {}
-func (*ValidateAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{17} }
+func (*ValidateAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{29} }
 
 func (m *ValidateAddressRequest) GetAddress() string {
 	if m != nil {
@@ -830,6 +1279,13 @@ func (m *ValidateAddressRequest) GetAddress() string {
 	return ""
 }
 
+func (m *ValidateAddressRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
 type ValidateAddressResponse struct {
 	IsValid bool   `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
 	IsMine  bool   `protobuf:"varint,2,opt,name=is_mine,json=isMine,proto3" json:"is_mine,omitempty"`
@@ -840,7 +1296,7 @@ type ValidateAddressResponse struct {
 func (m *ValidateAddressResponse) Reset()                    { *m = ValidateAddressResponse{} }
 func (m *ValidateAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*ValidateAddressResponse) ProtoMessage()               {}
-func (*ValidateAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{18} }
+func (*ValidateAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{30} }
 
 func (m *ValidateAddressResponse) GetIsValid() bool {
 	if m != nil {
@@ -870,14 +1326,111 @@ func (m *ValidateAddressResponse) GetVersion() int32 {
 	return 0
 }
 
-type CreateAddressRequest struct {
-	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+type SignMessageRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Message    string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 }
 
-func (m *CreateAddressRequest) Reset()                    { *m = CreateAddressRequest{} }
+func (m *SignMessageRequest) Reset()                    { *m = SignMessageRequest{} }
+func (m *SignMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignMessageRequest) ProtoMessage()               {}
+func (*SignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{31} }
+
+func (m *SignMessageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignMessageRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *SignMessageRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type SignMessageResponse struct {
+	Signature string `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignMessageResponse) Reset()                    { *m = SignMessageResponse{} }
+func (m *SignMessageResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignMessageResponse) ProtoMessage()               {}
+func (*SignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{32} }
+
+func (m *SignMessageResponse) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+type VerifyMessageRequest struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Message   string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *VerifyMessageRequest) Reset()                    { *m = VerifyMessageRequest{} }
+func (m *VerifyMessageRequest) String() string            { return proto.CompactTextString(m) }
+func (*VerifyMessageRequest) ProtoMessage()               {}
+func (*VerifyMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{33} }
+
+func (m *VerifyMessageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VerifyMessageRequest) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *VerifyMessageRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type VerifyMessageResponse struct {
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (m *VerifyMessageResponse) Reset()                    { *m = VerifyMessageResponse{} }
+func (m *VerifyMessageResponse) String() string            { return proto.CompactTextString(m) }
+func (*VerifyMessageResponse) ProtoMessage()               {}
+func (*VerifyMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{34} }
+
+func (m *VerifyMessageResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+type CreateAddressRequest struct {
+	Version  int32  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	WalletId string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *CreateAddressRequest) Reset()                    { *m = CreateAddressRequest{} }
 func (m *CreateAddressRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateAddressRequest) ProtoMessage()               {}
-func (*CreateAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{19} }
+func (*CreateAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{35} }
 
 func (m *CreateAddressRequest) GetVersion() int32 {
 	if m != nil {
@@ -886,6 +1439,13 @@ func (m *CreateAddressRequest) GetVersion() int32 {
 	return 0
 }
 
+func (m *CreateAddressRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
 type CreateAddressResponse struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
@@ -893,7 +1453,7 @@ type CreateAddressResponse struct {
 func (m *CreateAddressResponse) Reset()                    { *m = CreateAddressResponse{} }
 func (m *CreateAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateAddressResponse) ProtoMessage()               {}
-func (*CreateAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{20} }
+func (*CreateAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{36} }
 
 func (m *CreateAddressResponse) GetAddress() string {
 	if m != nil {
@@ -903,13 +1463,14 @@ func (m *CreateAddressResponse) GetAddress() string {
 }
 
 type GetAddressesRequest struct {
-	Version int32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Version  int32  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	WalletId string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
 func (m *GetAddressesRequest) Reset()                    { *m = GetAddressesRequest{} }
 func (m *GetAddressesRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetAddressesRequest) ProtoMessage()               {}
-func (*GetAddressesRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{21} }
+func (*GetAddressesRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{37} }
 
 func (m *GetAddressesRequest) GetVersion() int32 {
 	if m != nil {
@@ -918,6 +1479,13 @@ func (m *GetAddressesRequest) GetVersion() int32 {
 	return 0
 }
 
+func (m *GetAddressesRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
 type GetAddressesResponse struct {
 	Details []*GetAddressesResponse_AddressDetail `protobuf:"bytes,1,rep,name=details" json:"details,omitempty"`
 }
@@ -925,7 +1493,7 @@ type GetAddressesResponse struct {
 func (m *GetAddressesResponse) Reset()                    { *m = GetAddressesResponse{} }
 func (m *GetAddressesResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetAddressesResponse) ProtoMessage()               {}
-func (*GetAddressesResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{22} }
+func (*GetAddressesResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{38} }
 
 func (m *GetAddressesResponse) GetDetails() []*GetAddressesResponse_AddressDetail {
 	if m != nil {
@@ -939,13 +1507,14 @@ type GetAddressesResponse_AddressDetail struct {
 	Version    int32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	Used       bool   `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
 	StdAddress string `protobuf:"bytes,4,opt,name=std_address,json=stdAddress,proto3" json:"std_address,omitempty"`
+	Label      string `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
 }
 
 func (m *GetAddressesResponse_AddressDetail) Reset()         { *m = GetAddressesResponse_AddressDetail{} }
 func (m *GetAddressesResponse_AddressDetail) String() string { return proto.CompactTextString(m) }
 func (*GetAddressesResponse_AddressDetail) ProtoMessage()    {}
 func (*GetAddressesResponse_AddressDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{22, 0}
+	return fileDescriptorApi, []int{38, 0}
 }
 
 func (m *GetAddressesResponse_AddressDetail) GetAddress() string {
@@ -976,15 +1545,23 @@ func (m *GetAddressesResponse_AddressDetail) GetStdAddress() string {
 	return ""
 }
 
+func (m *GetAddressesResponse_AddressDetail) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type GetWalletBalanceRequest struct {
-	RequiredConfirmations int32 `protobuf:"varint,1,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
-	Detail                bool  `protobuf:"varint,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	RequiredConfirmations int32  `protobuf:"varint,1,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	Detail                bool   `protobuf:"varint,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	WalletId              string `protobuf:"bytes,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
 func (m *GetWalletBalanceRequest) Reset()                    { *m = GetWalletBalanceRequest{} }
 func (m *GetWalletBalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetWalletBalanceRequest) ProtoMessage()               {}
-func (*GetWalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{23} }
+func (*GetWalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{39} }
 
 func (m *GetWalletBalanceRequest) GetRequiredConfirmations() int32 {
 	if m != nil {
@@ -1000,6 +1577,13 @@ func (m *GetWalletBalanceRequest) GetDetail() bool {
 	return false
 }
 
+func (m *GetWalletBalanceRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
 type GetWalletBalanceResponse struct {
 	WalletId string                           `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 	Total    string                           `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
@@ -1009,7 +1593,7 @@ type GetWalletBalanceResponse struct {
 func (m *GetWalletBalanceResponse) Reset()                    { *m = GetWalletBalanceResponse{} }
 func (m *GetWalletBalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetWalletBalanceResponse) ProtoMessage()               {}
-func (*GetWalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{24} }
+func (*GetWalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{40} }
 
 func (m *GetWalletBalanceResponse) GetWalletId() string {
 	if m != nil {
@@ -1042,7 +1626,7 @@ func (m *GetWalletBalanceResponse_Detail) Reset()         { *m = GetWalletBalanc
 func (m *GetWalletBalanceResponse_Detail) String() string { return proto.CompactTextString(m) }
 func (*GetWalletBalanceResponse_Detail) ProtoMessage()    {}
 func (*GetWalletBalanceResponse_Detail) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{24, 0}
+	return fileDescriptorApi, []int{40, 0}
 }
 
 func (m *GetWalletBalanceResponse_Detail) GetSpendable() string {
@@ -1072,12 +1656,13 @@ type TxHistoryDetails struct {
 	Inputs        []*TxHistoryDetails_Input  `protobuf:"bytes,3,rep,name=inputs" json:"inputs,omitempty"`
 	Outputs       []*TxHistoryDetails_Output `protobuf:"bytes,4,rep,name=outputs" json:"outputs,omitempty"`
 	FromAddresses []string                   `protobuf:"bytes,5,rep,name=from_addresses,json=fromAddresses" json:"from_addresses,omitempty"`
+	Note          string                     `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
 }
 
 func (m *TxHistoryDetails) Reset()                    { *m = TxHistoryDetails{} }
 func (m *TxHistoryDetails) String() string            { return proto.CompactTextString(m) }
 func (*TxHistoryDetails) ProtoMessage()               {}
-func (*TxHistoryDetails) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25} }
+func (*TxHistoryDetails) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{41} }
 
 func (m *TxHistoryDetails) GetTxId() string {
 	if m != nil {
@@ -1114,6 +1699,13 @@ func (m *TxHistoryDetails) GetFromAddresses() []string {
 	return nil
 }
 
+func (m *TxHistoryDetails) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
 type TxHistoryDetails_Input struct {
 	TxId  string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
 	Index int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -1122,7 +1714,7 @@ type TxHistoryDetails_Input struct {
 func (m *TxHistoryDetails_Input) Reset()                    { *m = TxHistoryDetails_Input{} }
 func (m *TxHistoryDetails_Input) String() string            { return proto.CompactTextString(m) }
 func (*TxHistoryDetails_Input) ProtoMessage()               {}
-func (*TxHistoryDetails_Input) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25, 0} }
+func (*TxHistoryDetails_Input) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{41, 0} }
 
 func (m *TxHistoryDetails_Input) GetTxId() string {
 	if m != nil {
@@ -1146,7 +1738,7 @@ type TxHistoryDetails_Output struct {
 func (m *TxHistoryDetails_Output) Reset()                    { *m = TxHistoryDetails_Output{} }
 func (m *TxHistoryDetails_Output) String() string            { return proto.CompactTextString(m) }
 func (*TxHistoryDetails_Output) ProtoMessage()               {}
-func (*TxHistoryDetails_Output) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{25, 1} }
+func (*TxHistoryDetails_Output) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{41, 1} }
 
 func (m *TxHistoryDetails_Output) GetAddress() string {
 	if m != nil {
@@ -1169,7 +1761,7 @@ type TxHistoryResponse struct {
 func (m *TxHistoryResponse) Reset()                    { *m = TxHistoryResponse{} }
 func (m *TxHistoryResponse) String() string            { return proto.CompactTextString(m) }
 func (*TxHistoryResponse) ProtoMessage()               {}
-func (*TxHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{26} }
+func (*TxHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{42} }
 
 func (m *TxHistoryResponse) GetHistories() []*TxHistoryDetails {
 	if m != nil {
@@ -1179,14 +1771,15 @@ func (m *TxHistoryResponse) GetHistories() []*TxHistoryDetails {
 }
 
 type TxHistoryRequest struct {
-	Count   uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Count    uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	WalletId string `protobuf:"bytes,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
 func (m *TxHistoryRequest) Reset()                    { *m = TxHistoryRequest{} }
 func (m *TxHistoryRequest) String() string            { return proto.CompactTextString(m) }
 func (*TxHistoryRequest) ProtoMessage()               {}
-func (*TxHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{27} }
+func (*TxHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{43} }
 
 func (m *TxHistoryRequest) GetCount() uint32 {
 	if m != nil {
@@ -1202,6 +1795,271 @@ func (m *TxHistoryRequest) GetAddress() string {
 	return ""
 }
 
+func (m *TxHistoryRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+type ListTxHistoryRequest struct {
+	Cursor     string   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit      uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Order      string   `protobuf:"bytes,3,opt,name=order,proto3" json:"order,omitempty"`
+	FromHeight uint64   `protobuf:"varint,4,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   uint64   `protobuf:"varint,5,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	FromTime   int64    `protobuf:"varint,6,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime     int64    `protobuf:"varint,7,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	Address    string   `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	Types      []string `protobuf:"bytes,9,rep,name=types" json:"types,omitempty"`
+	WalletId   string   `protobuf:"bytes,10,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *ListTxHistoryRequest) Reset()                    { *m = ListTxHistoryRequest{} }
+func (m *ListTxHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListTxHistoryRequest) ProtoMessage()               {}
+func (*ListTxHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{44} }
+
+func (m *ListTxHistoryRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListTxHistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListTxHistoryRequest) GetOrder() string {
+	if m != nil {
+		return m.Order
+	}
+	return ""
+}
+
+func (m *ListTxHistoryRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *ListTxHistoryRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *ListTxHistoryRequest) GetFromTime() int64 {
+	if m != nil {
+		return m.FromTime
+	}
+	return 0
+}
+
+func (m *ListTxHistoryRequest) GetToTime() int64 {
+	if m != nil {
+		return m.ToTime
+	}
+	return 0
+}
+
+func (m *ListTxHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ListTxHistoryRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *ListTxHistoryRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+type ListTxHistoryResponse struct {
+	Histories  []*ListTxHistoryResponse_History `protobuf:"bytes,1,rep,name=histories" json:"histories,omitempty"`
+	NextCursor string                           `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+}
+
+func (m *ListTxHistoryResponse) Reset()                    { *m = ListTxHistoryResponse{} }
+func (m *ListTxHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListTxHistoryResponse) ProtoMessage()               {}
+func (*ListTxHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{45} }
+
+func (m *ListTxHistoryResponse) GetHistories() []*ListTxHistoryResponse_History {
+	if m != nil {
+		return m.Histories
+	}
+	return nil
+}
+
+func (m *ListTxHistoryResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type ListTxHistoryResponse_History struct {
+	TxId        string   `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BlockHeight uint64   `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTime   int64    `protobuf:"varint,3,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	Type        string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	NetAmount   string   `protobuf:"bytes,5,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	Fee         string   `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	Addresses   []string `protobuf:"bytes,7,rep,name=addresses" json:"addresses,omitempty"`
+	Note        string   `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+}
+
+func (m *ListTxHistoryResponse_History) Reset()         { *m = ListTxHistoryResponse_History{} }
+func (m *ListTxHistoryResponse_History) String() string { return proto.CompactTextString(m) }
+func (*ListTxHistoryResponse_History) ProtoMessage()    {}
+func (*ListTxHistoryResponse_History) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{45, 0}
+}
+
+func (m *ListTxHistoryResponse_History) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *ListTxHistoryResponse_History) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ListTxHistoryResponse_History) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *ListTxHistoryResponse_History) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListTxHistoryResponse_History) GetNetAmount() string {
+	if m != nil {
+		return m.NetAmount
+	}
+	return ""
+}
+
+func (m *ListTxHistoryResponse_History) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *ListTxHistoryResponse_History) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *ListTxHistoryResponse_History) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+type ExportHistoryRequest struct {
+	Format     string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	FromHeight uint64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   uint64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	FromTime   int64  `protobuf:"varint,4,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	ToTime     int64  `protobuf:"varint,5,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	WalletId   string `protobuf:"bytes,6,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *ExportHistoryRequest) Reset()                    { *m = ExportHistoryRequest{} }
+func (m *ExportHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportHistoryRequest) ProtoMessage()               {}
+func (*ExportHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{46} }
+
+func (m *ExportHistoryRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ExportHistoryRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *ExportHistoryRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *ExportHistoryRequest) GetFromTime() int64 {
+	if m != nil {
+		return m.FromTime
+	}
+	return 0
+}
+
+func (m *ExportHistoryRequest) GetToTime() int64 {
+	if m != nil {
+		return m.ToTime
+	}
+	return 0
+}
+
+func (m *ExportHistoryRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+type ExportHistoryResponse struct {
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ExportHistoryResponse) Reset()                    { *m = ExportHistoryResponse{} }
+func (m *ExportHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*ExportHistoryResponse) ProtoMessage()               {}
+func (*ExportHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{47} }
+
+func (m *ExportHistoryResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
 type TransactionInput struct {
 	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
 	Vout uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
@@ -1210,7 +2068,7 @@ type TransactionInput struct {
 func (m *TransactionInput) Reset()                    { *m = TransactionInput{} }
 func (m *TransactionInput) String() string            { return proto.CompactTextString(m) }
 func (*TransactionInput) ProtoMessage()               {}
-func (*TransactionInput) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{28} }
+func (*TransactionInput) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{48} }
 
 func (m *TransactionInput) GetTxId() string {
 	if m != nil {
@@ -1233,7 +2091,7 @@ type DecodeRawTransactionRequest struct {
 func (m *DecodeRawTransactionRequest) Reset()                    { *m = DecodeRawTransactionRequest{} }
 func (m *DecodeRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*DecodeRawTransactionRequest) ProtoMessage()               {}
-func (*DecodeRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{29} }
+func (*DecodeRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{49} }
 
 func (m *DecodeRawTransactionRequest) GetHex() string {
 	if m != nil {
@@ -1256,7 +2114,7 @@ type DecodeRawTransactionResponse struct {
 func (m *DecodeRawTransactionResponse) Reset()                    { *m = DecodeRawTransactionResponse{} }
 func (m *DecodeRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*DecodeRawTransactionResponse) ProtoMessage()               {}
-func (*DecodeRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{30} }
+func (*DecodeRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{50} }
 
 func (m *DecodeRawTransactionResponse) GetTxId() string {
 	if m != nil {
@@ -1325,7 +2183,7 @@ func (m *DecodeRawTransactionResponse_Vin) Reset()         { *m = DecodeRawTrans
 func (m *DecodeRawTransactionResponse_Vin) String() string { return proto.CompactTextString(m) }
 func (*DecodeRawTransactionResponse_Vin) ProtoMessage()    {}
 func (*DecodeRawTransactionResponse_Vin) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{30, 0}
+	return fileDescriptorApi, []int{50, 0}
 }
 
 func (m *DecodeRawTransactionResponse_Vin) GetTxId() string {
@@ -1371,7 +2229,7 @@ func (m *DecodeRawTransactionResponse_Vout) Reset()         { *m = DecodeRawTran
 func (m *DecodeRawTransactionResponse_Vout) String() string { return proto.CompactTextString(m) }
 func (*DecodeRawTransactionResponse_Vout) ProtoMessage()    {}
 func (*DecodeRawTransactionResponse_Vout) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{30, 1}
+	return fileDescriptorApi, []int{50, 1}
 }
 
 func (m *DecodeRawTransactionResponse_Vout) GetValue() string {
@@ -1436,12 +2294,14 @@ type CreateRawTransactionRequest struct {
 	LockTime        uint64              `protobuf:"varint,3,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
 	ChangeAddress   string              `protobuf:"bytes,4,opt,name=change_address,json=changeAddress,proto3" json:"change_address,omitempty"`
 	Subtractfeefrom []string            `protobuf:"bytes,5,rep,name=subtractfeefrom" json:"subtractfeefrom,omitempty"`
+	// If not specified, sender pays the fee.
+	WalletId string `protobuf:"bytes,6,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
 func (m *CreateRawTransactionRequest) Reset()                    { *m = CreateRawTransactionRequest{} }
 func (m *CreateRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateRawTransactionRequest) ProtoMessage()               {}
-func (*CreateRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{31} }
+func (*CreateRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{51} }
 
 func (m *CreateRawTransactionRequest) GetInputs() []*TransactionInput {
 	if m != nil {
@@ -1478,18 +2338,28 @@ func (m *CreateRawTransactionRequest) GetSubtractfeefrom() []string {
 	return nil
 }
 
+func (m *CreateRawTransactionRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
 type AutoCreateTransactionRequest struct {
 	Amounts       map[string]string `protobuf:"bytes,1,rep,name=amounts" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	LockTime      uint64            `protobuf:"varint,2,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
 	Fee           string            `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
 	FromAddress   string            `protobuf:"bytes,4,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 	ChangeAddress string            `protobuf:"bytes,5,opt,name=change_address,json=changeAddress,proto3" json:"change_address,omitempty"`
+	WalletId      string            `protobuf:"bytes,6,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	CoinSelection string            `protobuf:"bytes,7,opt,name=coin_selection,json=coinSelection,proto3" json:"coin_selection,omitempty"`
+	FeeMode       string            `protobuf:"bytes,8,opt,name=fee_mode,json=feeMode,proto3" json:"fee_mode,omitempty"`
 }
 
 func (m *AutoCreateTransactionRequest) Reset()                    { *m = AutoCreateTransactionRequest{} }
 func (m *AutoCreateTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*AutoCreateTransactionRequest) ProtoMessage()               {}
-func (*AutoCreateTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{32} }
+func (*AutoCreateTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{52} }
 
 func (m *AutoCreateTransactionRequest) GetAmounts() map[string]string {
 	if m != nil {
@@ -1526,6 +2396,27 @@ func (m *AutoCreateTransactionRequest) GetChangeAddress() string {
 	return ""
 }
 
+func (m *AutoCreateTransactionRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *AutoCreateTransactionRequest) GetCoinSelection() string {
+	if m != nil {
+		return m.CoinSelection
+	}
+	return ""
+}
+
+func (m *AutoCreateTransactionRequest) GetFeeMode() string {
+	if m != nil {
+		return m.FeeMode
+	}
+	return ""
+}
+
 type CreateRawTransactionResponse struct {
 	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
 }
@@ -1533,7 +2424,7 @@ type CreateRawTransactionResponse struct {
 func (m *CreateRawTransactionResponse) Reset()                    { *m = CreateRawTransactionResponse{} }
 func (m *CreateRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*CreateRawTransactionResponse) ProtoMessage()               {}
-func (*CreateRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{33} }
+func (*CreateRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{53} }
 
 func (m *CreateRawTransactionResponse) GetHex() string {
 	if m != nil {
@@ -1548,13 +2439,15 @@ type CreateStakingTransactionRequest struct {
 	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	FrozenPeriod   uint32 `protobuf:"varint,4,opt,name=frozen_period,json=frozenPeriod,proto3" json:"frozen_period,omitempty"`
 	Fee            string `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	WalletId       string `protobuf:"bytes,6,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	FeeMode        string `protobuf:"bytes,7,opt,name=fee_mode,json=feeMode,proto3" json:"fee_mode,omitempty"`
 }
 
 func (m *CreateStakingTransactionRequest) Reset()         { *m = CreateStakingTransactionRequest{} }
 func (m *CreateStakingTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateStakingTransactionRequest) ProtoMessage()    {}
 func (*CreateStakingTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{34}
+	return fileDescriptorApi, []int{54}
 }
 
 func (m *CreateStakingTransactionRequest) GetFromAddress() string {
@@ -1592,6 +2485,20 @@ func (m *CreateStakingTransactionRequest) GetFee() string {
 	return ""
 }
 
+func (m *CreateStakingTransactionRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *CreateStakingTransactionRequest) GetFeeMode() string {
+	if m != nil {
+		return m.FeeMode
+	}
+	return ""
+}
+
 type GetBlockStakingRewardRequest struct {
 	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
@@ -1599,7 +2506,7 @@ type GetBlockStakingRewardRequest struct {
 func (m *GetBlockStakingRewardRequest) Reset()                    { *m = GetBlockStakingRewardRequest{} }
 func (m *GetBlockStakingRewardRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetBlockStakingRewardRequest) ProtoMessage()               {}
-func (*GetBlockStakingRewardRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{35} }
+func (*GetBlockStakingRewardRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{55} }
 
 func (m *GetBlockStakingRewardRequest) GetHeight() uint64 {
 	if m != nil {
@@ -1617,7 +2524,7 @@ func (m *GetBlockStakingRewardResponse) Reset()         { *m = GetBlockStakingRe
 func (m *GetBlockStakingRewardResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockStakingRewardResponse) ProtoMessage()    {}
 func (*GetBlockStakingRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{36}
+	return fileDescriptorApi, []int{56}
 }
 
 func (m *GetBlockStakingRewardResponse) GetDetails() []*GetBlockStakingRewardResponse_RewardDetail {
@@ -1650,7 +2557,7 @@ func (m *GetBlockStakingRewardResponse_RewardDetail) String() string {
 }
 func (*GetBlockStakingRewardResponse_RewardDetail) ProtoMessage() {}
 func (*GetBlockStakingRewardResponse_RewardDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{36, 0}
+	return fileDescriptorApi, []int{56, 0}
 }
 
 func (m *GetBlockStakingRewardResponse_RewardDetail) GetRank() int32 {
@@ -1690,12 +2597,14 @@ func (m *GetBlockStakingRewardResponse_RewardDetail) GetProfit() string {
 
 type GetStakingHistoryRequest struct {
 	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// "all"    - including withdrawn
+	WalletId string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
 func (m *GetStakingHistoryRequest) Reset()                    { *m = GetStakingHistoryRequest{} }
 func (m *GetStakingHistoryRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetStakingHistoryRequest) ProtoMessage()               {}
-func (*GetStakingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{37} }
+func (*GetStakingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{57} }
 
 func (m *GetStakingHistoryRequest) GetType() string {
 	if m != nil {
@@ -1704,6 +2613,13 @@ func (m *GetStakingHistoryRequest) GetType() string {
 	return ""
 }
 
+func (m *GetStakingHistoryRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
 type GetStakingHistoryResponse struct {
 	Txs     []*GetStakingHistoryResponse_Tx `protobuf:"bytes,1,rep,name=txs" json:"txs,omitempty"`
 	Weights map[string]float64              `protobuf:"bytes,2,rep,name=weights" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
@@ -1712,7 +2628,7 @@ type GetStakingHistoryResponse struct {
 func (m *GetStakingHistoryResponse) Reset()                    { *m = GetStakingHistoryResponse{} }
 func (m *GetStakingHistoryResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetStakingHistoryResponse) ProtoMessage()               {}
-func (*GetStakingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{38} }
+func (*GetStakingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{58} }
 
 func (m *GetStakingHistoryResponse) GetTxs() []*GetStakingHistoryResponse_Tx {
 	if m != nil {
@@ -1734,13 +2650,14 @@ type GetStakingHistoryResponse_StakingUTXO struct {
 	Address      string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	Amount       string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	FrozenPeriod uint32 `protobuf:"varint,5,opt,name=frozen_period,json=frozenPeriod,proto3" json:"frozen_period,omitempty"`
+	Label        string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
 }
 
 func (m *GetStakingHistoryResponse_StakingUTXO) Reset()         { *m = GetStakingHistoryResponse_StakingUTXO{} }
 func (m *GetStakingHistoryResponse_StakingUTXO) String() string { return proto.CompactTextString(m) }
 func (*GetStakingHistoryResponse_StakingUTXO) ProtoMessage()    {}
 func (*GetStakingHistoryResponse_StakingUTXO) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{38, 0}
+	return fileDescriptorApi, []int{58, 0}
 }
 
 func (m *GetStakingHistoryResponse_StakingUTXO) GetTxId() string {
@@ -1778,18 +2695,26 @@ func (m *GetStakingHistoryResponse_StakingUTXO) GetFrozenPeriod() uint32 {
 	return 0
 }
 
+func (m *GetStakingHistoryResponse_StakingUTXO) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type GetStakingHistoryResponse_Tx struct {
 	TxId        string                                 `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
 	Status      uint32                                 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	BlockHeight uint64                                 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	Utxo        *GetStakingHistoryResponse_StakingUTXO `protobuf:"bytes,4,opt,name=utxo" json:"utxo,omitempty"`
+	Note        string                                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
 }
 
 func (m *GetStakingHistoryResponse_Tx) Reset()         { *m = GetStakingHistoryResponse_Tx{} }
 func (m *GetStakingHistoryResponse_Tx) String() string { return proto.CompactTextString(m) }
 func (*GetStakingHistoryResponse_Tx) ProtoMessage()    {}
 func (*GetStakingHistoryResponse_Tx) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{38, 1}
+	return fileDescriptorApi, []int{58, 1}
 }
 
 func (m *GetStakingHistoryResponse_Tx) GetTxId() string {
@@ -1820,6 +2745,13 @@ func (m *GetStakingHistoryResponse_Tx) GetUtxo() *GetStakingHistoryResponse_Stak
 	return nil
 }
 
+func (m *GetStakingHistoryResponse_Tx) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
 type SendRawTransactionRequest struct {
 	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
 }
@@ -1827,7 +2759,7 @@ type SendRawTransactionRequest struct {
 func (m *SendRawTransactionRequest) Reset()                    { *m = SendRawTransactionRequest{} }
 func (m *SendRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendRawTransactionRequest) ProtoMessage()               {}
-func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{39} }
+func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{59} }
 
 func (m *SendRawTransactionRequest) GetHex() string {
 	if m != nil {
@@ -1843,7 +2775,7 @@ type SendRawTransactionResponse struct {
 func (m *SendRawTransactionResponse) Reset()                    { *m = SendRawTransactionResponse{} }
 func (m *SendRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendRawTransactionResponse) ProtoMessage()               {}
-func (*SendRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{40} }
+func (*SendRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{60} }
 
 func (m *SendRawTransactionResponse) GetTxId() string {
 	if m != nil {
@@ -1856,12 +2788,13 @@ type GetTransactionFeeRequest struct {
 	Amounts    map[string]string   `protobuf:"bytes,1,rep,name=amounts" json:"amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Inputs     []*TransactionInput `protobuf:"bytes,2,rep,name=inputs" json:"inputs,omitempty"`
 	HasBinding bool                `protobuf:"varint,3,opt,name=has_binding,json=hasBinding,proto3" json:"has_binding,omitempty"`
+	WalletId   string              `protobuf:"bytes,4,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
 func (m *GetTransactionFeeRequest) Reset()                    { *m = GetTransactionFeeRequest{} }
 func (m *GetTransactionFeeRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTransactionFeeRequest) ProtoMessage()               {}
-func (*GetTransactionFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{41} }
+func (*GetTransactionFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{61} }
 
 func (m *GetTransactionFeeRequest) GetAmounts() map[string]string {
 	if m != nil {
@@ -1884,6 +2817,13 @@ func (m *GetTransactionFeeRequest) GetHasBinding() bool {
 	return false
 }
 
+func (m *GetTransactionFeeRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
 type GetTransactionFeeResponse struct {
 	Fee string `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee,omitempty"`
 }
@@ -1891,7 +2831,7 @@ type GetTransactionFeeResponse struct {
 func (m *GetTransactionFeeResponse) Reset()                    { *m = GetTransactionFeeResponse{} }
 func (m *GetTransactionFeeResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTransactionFeeResponse) ProtoMessage()               {}
-func (*GetTransactionFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{42} }
+func (*GetTransactionFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{62} }
 
 func (m *GetTransactionFeeResponse) GetFee() string {
 	if m != nil {
@@ -1900,6 +2840,54 @@ func (m *GetTransactionFeeResponse) GetFee() string {
 	return ""
 }
 
+type EstimateFeeRateRequest struct {
+	TargetBlocks uint32 `protobuf:"varint,1,opt,name=target_blocks,json=targetBlocks,proto3" json:"target_blocks,omitempty"`
+}
+
+func (m *EstimateFeeRateRequest) Reset()                    { *m = EstimateFeeRateRequest{} }
+func (m *EstimateFeeRateRequest) String() string            { return proto.CompactTextString(m) }
+func (*EstimateFeeRateRequest) ProtoMessage()               {}
+func (*EstimateFeeRateRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{63} }
+
+func (m *EstimateFeeRateRequest) GetTargetBlocks() uint32 {
+	if m != nil {
+		return m.TargetBlocks
+	}
+	return 0
+}
+
+type EstimateFeeRateResponse struct {
+	TargetBlocks uint32 `protobuf:"varint,1,opt,name=target_blocks,json=targetBlocks,proto3" json:"target_blocks,omitempty"`
+	FeeRate      string `protobuf:"bytes,2,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	Estimated    bool   `protobuf:"varint,3,opt,name=estimated,proto3" json:"estimated,omitempty"`
+}
+
+func (m *EstimateFeeRateResponse) Reset()                    { *m = EstimateFeeRateResponse{} }
+func (m *EstimateFeeRateResponse) String() string            { return proto.CompactTextString(m) }
+func (*EstimateFeeRateResponse) ProtoMessage()               {}
+func (*EstimateFeeRateResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{64} }
+
+func (m *EstimateFeeRateResponse) GetTargetBlocks() uint32 {
+	if m != nil {
+		return m.TargetBlocks
+	}
+	return 0
+}
+
+func (m *EstimateFeeRateResponse) GetFeeRate() string {
+	if m != nil {
+		return m.FeeRate
+	}
+	return ""
+}
+
+func (m *EstimateFeeRateResponse) GetEstimated() bool {
+	if m != nil {
+		return m.Estimated
+	}
+	return false
+}
+
 type BlockInfoForTx struct {
 	Height    uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
@@ -1909,7 +2897,7 @@ type BlockInfoForTx struct {
 func (m *BlockInfoForTx) Reset()                    { *m = BlockInfoForTx{} }
 func (m *BlockInfoForTx) String() string            { return proto.CompactTextString(m) }
 func (*BlockInfoForTx) ProtoMessage()               {}
-func (*BlockInfoForTx) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{43} }
+func (*BlockInfoForTx) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{65} }
 
 func (m *BlockInfoForTx) GetHeight() uint64 {
 	if m != nil {
@@ -1942,7 +2930,7 @@ type Vin struct {
 func (m *Vin) Reset()                    { *m = Vin{} }
 func (m *Vin) String() string            { return proto.CompactTextString(m) }
 func (*Vin) ProtoMessage()               {}
-func (*Vin) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{44} }
+func (*Vin) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{66} }
 
 func (m *Vin) GetValue() string {
 	if m != nil {
@@ -1985,7 +2973,7 @@ type Vin_RedeemDetail struct {
 func (m *Vin_RedeemDetail) Reset()                    { *m = Vin_RedeemDetail{} }
 func (m *Vin_RedeemDetail) String() string            { return proto.CompactTextString(m) }
 func (*Vin_RedeemDetail) ProtoMessage()               {}
-func (*Vin_RedeemDetail) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{44, 0} }
+func (*Vin_RedeemDetail) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{66, 0} }
 
 func (m *Vin_RedeemDetail) GetTxId() string {
 	if m != nil {
@@ -2046,7 +3034,7 @@ type Vout struct {
 func (m *Vout) Reset()                    { *m = Vout{} }
 func (m *Vout) String() string            { return proto.CompactTextString(m) }
 func (*Vout) ProtoMessage()               {}
-func (*Vout) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{45} }
+func (*Vout) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{67} }
 
 func (m *Vout) GetValue() string {
 	if m != nil {
@@ -2088,7 +3076,7 @@ type Vout_ScriptDetail struct {
 func (m *Vout_ScriptDetail) Reset()                    { *m = Vout_ScriptDetail{} }
 func (m *Vout_ScriptDetail) String() string            { return proto.CompactTextString(m) }
 func (*Vout_ScriptDetail) ProtoMessage()               {}
-func (*Vout_ScriptDetail) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{45, 0} }
+func (*Vout_ScriptDetail) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{67, 0} }
 
 func (m *Vout_ScriptDetail) GetAsm() string {
 	if m != nil {
@@ -2139,7 +3127,7 @@ type GetRawTransactionRequest struct {
 func (m *GetRawTransactionRequest) Reset()                    { *m = GetRawTransactionRequest{} }
 func (m *GetRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetRawTransactionRequest) ProtoMessage()               {}
-func (*GetRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{46} }
+func (*GetRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{68} }
 
 func (m *GetRawTransactionRequest) GetTxId() string {
 	if m != nil {
@@ -2167,7 +3155,7 @@ type GetRawTransactionResponse struct {
 func (m *GetRawTransactionResponse) Reset()                    { *m = GetRawTransactionResponse{} }
 func (m *GetRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetRawTransactionResponse) ProtoMessage()               {}
-func (*GetRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{47} }
+func (*GetRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{69} }
 
 func (m *GetRawTransactionResponse) GetHex() string {
 	if m != nil {
@@ -2267,7 +3255,7 @@ type GetTxStatusRequest struct {
 func (m *GetTxStatusRequest) Reset()                    { *m = GetTxStatusRequest{} }
 func (m *GetTxStatusRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTxStatusRequest) ProtoMessage()               {}
-func (*GetTxStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{48} }
+func (*GetTxStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{70} }
 
 func (m *GetTxStatusRequest) GetTxId() string {
 	if m != nil {
@@ -2284,7 +3272,7 @@ type GetTxStatusResponse struct {
 func (m *GetTxStatusResponse) Reset()                    { *m = GetTxStatusResponse{} }
 func (m *GetTxStatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTxStatusResponse) ProtoMessage()               {}
-func (*GetTxStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{49} }
+func (*GetTxStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{71} }
 
 func (m *GetTxStatusResponse) GetCode() int32 {
 	if m != nil {
@@ -2300,2288 +3288,4458 @@ func (m *GetTxStatusResponse) GetStatus() string {
 	return ""
 }
 
-type SignRawTransactionRequest struct {
-	RawTx      string `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
-	Flags      string `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
-	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+type ListUnconfirmedRequest struct {
+	WalletId string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
-func (m *SignRawTransactionRequest) Reset()                    { *m = SignRawTransactionRequest{} }
-func (m *SignRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*SignRawTransactionRequest) ProtoMessage()               {}
-func (*SignRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{50} }
+func (m *ListUnconfirmedRequest) Reset()                    { *m = ListUnconfirmedRequest{} }
+func (m *ListUnconfirmedRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListUnconfirmedRequest) ProtoMessage()               {}
+func (*ListUnconfirmedRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{72} }
 
-func (m *SignRawTransactionRequest) GetRawTx() string {
+func (m *ListUnconfirmedRequest) GetWalletId() string {
 	if m != nil {
-		return m.RawTx
+		return m.WalletId
 	}
 	return ""
 }
 
-func (m *SignRawTransactionRequest) GetFlags() string {
-	if m != nil {
-		return m.Flags
-	}
-	return ""
+type ListUnconfirmedResponse struct {
+	Txs []*ListUnconfirmedResponse_UnconfirmedTx `protobuf:"bytes,1,rep,name=txs" json:"txs,omitempty"`
 }
 
-func (m *SignRawTransactionRequest) GetPassphrase() string {
+func (m *ListUnconfirmedResponse) Reset()                    { *m = ListUnconfirmedResponse{} }
+func (m *ListUnconfirmedResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListUnconfirmedResponse) ProtoMessage()               {}
+func (*ListUnconfirmedResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{73} }
+
+func (m *ListUnconfirmedResponse) GetTxs() []*ListUnconfirmedResponse_UnconfirmedTx {
 	if m != nil {
-		return m.Passphrase
+		return m.Txs
 	}
-	return ""
+	return nil
 }
 
-type SignRawTransactionResponse struct {
-	Hex      string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
-	Complete bool   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+type ListUnconfirmedResponse_UnconfirmedTx struct {
+	TxId      string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Received  int64  `protobuf:"varint,2,opt,name=received,proto3" json:"received,omitempty"`
+	Size     int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	InMempool bool   `protobuf:"varint,4,opt,name=in_mempool,json=inMempool,proto3" json:"in_mempool,omitempty"`
 }
 
-func (m *SignRawTransactionResponse) Reset()                    { *m = SignRawTransactionResponse{} }
-func (m *SignRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*SignRawTransactionResponse) ProtoMessage()               {}
-func (*SignRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{51} }
+func (m *ListUnconfirmedResponse_UnconfirmedTx) Reset()         { *m = ListUnconfirmedResponse_UnconfirmedTx{} }
+func (m *ListUnconfirmedResponse_UnconfirmedTx) String() string { return proto.CompactTextString(m) }
+func (*ListUnconfirmedResponse_UnconfirmedTx) ProtoMessage()    {}
+func (*ListUnconfirmedResponse_UnconfirmedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{73, 0}
+}
 
-func (m *SignRawTransactionResponse) GetHex() string {
+func (m *ListUnconfirmedResponse_UnconfirmedTx) GetTxId() string {
 	if m != nil {
-		return m.Hex
+		return m.TxId
 	}
 	return ""
 }
 
-func (m *SignRawTransactionResponse) GetComplete() bool {
+func (m *ListUnconfirmedResponse_UnconfirmedTx) GetReceived() int64 {
 	if m != nil {
-		return m.Complete
+		return m.Received
 	}
-	return false
+	return 0
 }
 
-type GetUtxoRequest struct {
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+func (m *ListUnconfirmedResponse_UnconfirmedTx) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
 }
 
-func (m *GetUtxoRequest) Reset()                    { *m = GetUtxoRequest{} }
-func (m *GetUtxoRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetUtxoRequest) ProtoMessage()               {}
-func (*GetUtxoRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{52} }
-
-func (m *GetUtxoRequest) GetAddresses() []string {
+func (m *ListUnconfirmedResponse_UnconfirmedTx) GetInMempool() bool {
 	if m != nil {
-		return m.Addresses
+		return m.InMempool
 	}
-	return nil
+	return false
 }
 
-type UTXO struct {
-	TxId           string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Vout           uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
-	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	BlockHeight    uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Maturity       uint32 `protobuf:"varint,5,opt,name=maturity,proto3" json:"maturity,omitempty"`
-	Confirmations  uint32 `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
-	SpentByUnmined bool   `protobuf:"varint,7,opt,name=spent_by_unmined,json=spentByUnmined,proto3" json:"spent_by_unmined,omitempty"`
+type AbandonTransactionRequest struct {
+	TxId     string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	WalletId string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
-func (m *UTXO) Reset()                    { *m = UTXO{} }
-func (m *UTXO) String() string            { return proto.CompactTextString(m) }
-func (*UTXO) ProtoMessage()               {}
-func (*UTXO) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{53} }
+func (m *AbandonTransactionRequest) Reset()                    { *m = AbandonTransactionRequest{} }
+func (m *AbandonTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*AbandonTransactionRequest) ProtoMessage()               {}
+func (*AbandonTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{74} }
 
-func (m *UTXO) GetTxId() string {
+func (m *AbandonTransactionRequest) GetTxId() string {
 	if m != nil {
 		return m.TxId
 	}
 	return ""
 }
 
-func (m *UTXO) GetVout() uint32 {
+func (m *AbandonTransactionRequest) GetWalletId() string {
 	if m != nil {
-		return m.Vout
+		return m.WalletId
 	}
-	return 0
+	return ""
 }
 
-func (m *UTXO) GetAmount() string {
+type AbandonTransactionResponse struct {
+	TxIds []string `protobuf:"bytes,1,rep,name=tx_ids,json=txIds" json:"tx_ids,omitempty"`
+}
+
+func (m *AbandonTransactionResponse) Reset()                    { *m = AbandonTransactionResponse{} }
+func (m *AbandonTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*AbandonTransactionResponse) ProtoMessage()               {}
+func (*AbandonTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{75} }
+
+func (m *AbandonTransactionResponse) GetTxIds() []string {
 	if m != nil {
-		return m.Amount
+		return m.TxIds
 	}
-	return ""
+	return nil
 }
 
-func (m *UTXO) GetBlockHeight() uint64 {
+type CreatePayoutBatchRequest struct {
+	Payouts    []*CreatePayoutBatchRequest_Payout `protobuf:"bytes,1,rep,name=payouts" json:"payouts,omitempty"`
+	Passphrase string                             `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	WalletId   string                             `protobuf:"bytes,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	FeeMode    string                             `protobuf:"bytes,4,opt,name=fee_mode,json=feeMode,proto3" json:"fee_mode,omitempty"`
+}
+
+func (m *CreatePayoutBatchRequest) Reset()                    { *m = CreatePayoutBatchRequest{} }
+func (m *CreatePayoutBatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreatePayoutBatchRequest) ProtoMessage()               {}
+func (*CreatePayoutBatchRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{76} }
+
+func (m *CreatePayoutBatchRequest) GetPayouts() []*CreatePayoutBatchRequest_Payout {
 	if m != nil {
-		return m.BlockHeight
+		return m.Payouts
 	}
-	return 0
+	return nil
 }
 
-func (m *UTXO) GetMaturity() uint32 {
+func (m *CreatePayoutBatchRequest) GetPassphrase() string {
 	if m != nil {
-		return m.Maturity
+		return m.Passphrase
 	}
-	return 0
+	return ""
 }
 
-func (m *UTXO) GetConfirmations() uint32 {
+func (m *CreatePayoutBatchRequest) GetWalletId() string {
 	if m != nil {
-		return m.Confirmations
+		return m.WalletId
 	}
-	return 0
+	return ""
 }
 
-func (m *UTXO) GetSpentByUnmined() bool {
+func (m *CreatePayoutBatchRequest) GetFeeMode() string {
 	if m != nil {
-		return m.SpentByUnmined
+		return m.FeeMode
 	}
-	return false
+	return ""
 }
 
-type AddressUTXO struct {
-	Address string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Utxos   []*UTXO `protobuf:"bytes,2,rep,name=utxos" json:"utxos,omitempty"`
+type CreatePayoutBatchRequest_Payout struct {
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *AddressUTXO) Reset()                    { *m = AddressUTXO{} }
-func (m *AddressUTXO) String() string            { return proto.CompactTextString(m) }
-func (*AddressUTXO) ProtoMessage()               {}
-func (*AddressUTXO) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{54} }
+func (m *CreatePayoutBatchRequest_Payout) Reset()         { *m = CreatePayoutBatchRequest_Payout{} }
+func (m *CreatePayoutBatchRequest_Payout) String() string { return proto.CompactTextString(m) }
+func (*CreatePayoutBatchRequest_Payout) ProtoMessage()    {}
+func (*CreatePayoutBatchRequest_Payout) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{76, 0}
+}
 
-func (m *AddressUTXO) GetAddress() string {
+func (m *CreatePayoutBatchRequest_Payout) GetKey() string {
 	if m != nil {
-		return m.Address
+		return m.Key
 	}
 	return ""
 }
 
-func (m *AddressUTXO) GetUtxos() []*UTXO {
+func (m *CreatePayoutBatchRequest_Payout) GetAddress() string {
 	if m != nil {
-		return m.Utxos
+		return m.Address
 	}
-	return nil
-}
-
-type GetUtxoResponse struct {
-	AddressUtxos []*AddressUTXO `protobuf:"bytes,1,rep,name=address_utxos,json=addressUtxos" json:"address_utxos,omitempty"`
+	return ""
 }
 
-func (m *GetUtxoResponse) Reset()                    { *m = GetUtxoResponse{} }
-func (m *GetUtxoResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetUtxoResponse) ProtoMessage()               {}
-func (*GetUtxoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{55} }
-
-func (m *GetUtxoResponse) GetAddressUtxos() []*AddressUTXO {
+func (m *CreatePayoutBatchRequest_Payout) GetAmount() string {
 	if m != nil {
-		return m.AddressUtxos
+		return m.Amount
 	}
-	return nil
+	return ""
 }
 
-type GetBindingHistoryRequest struct {
-	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+type GetPayoutBatchRequest struct {
+	BatchId   string `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	PayoutKey string `protobuf:"bytes,2,opt,name=payout_key,json=payoutKey,proto3" json:"payout_key,omitempty"`
+	WalletId  string `protobuf:"bytes,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
-func (m *GetBindingHistoryRequest) Reset()                    { *m = GetBindingHistoryRequest{} }
-func (m *GetBindingHistoryRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetBindingHistoryRequest) ProtoMessage()               {}
-func (*GetBindingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{56} }
+func (m *GetPayoutBatchRequest) Reset()                    { *m = GetPayoutBatchRequest{} }
+func (m *GetPayoutBatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetPayoutBatchRequest) ProtoMessage()               {}
+func (*GetPayoutBatchRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{77} }
 
-func (m *GetBindingHistoryRequest) GetType() string {
+func (m *GetPayoutBatchRequest) GetBatchId() string {
 	if m != nil {
-		return m.Type
+		return m.BatchId
 	}
 	return ""
 }
 
-type GetBindingHistoryResponse struct {
-	Histories []*GetBindingHistoryResponse_History `protobuf:"bytes,1,rep,name=histories" json:"histories,omitempty"`
+func (m *GetPayoutBatchRequest) GetPayoutKey() string {
+	if m != nil {
+		return m.PayoutKey
+	}
+	return ""
 }
 
-func (m *GetBindingHistoryResponse) Reset()                    { *m = GetBindingHistoryResponse{} }
-func (m *GetBindingHistoryResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetBindingHistoryResponse) ProtoMessage()               {}
-func (*GetBindingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{57} }
-
-func (m *GetBindingHistoryResponse) GetHistories() []*GetBindingHistoryResponse_History {
+func (m *GetPayoutBatchRequest) GetWalletId() string {
 	if m != nil {
-		return m.Histories
+		return m.WalletId
 	}
-	return nil
+	return ""
 }
 
-type GetBindingHistoryResponse_BindingUTXO struct {
-	TxId          string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Vout          uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
-	HolderAddress string `protobuf:"bytes,3,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
-	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	BindingTarget string `protobuf:"bytes,5,opt,name=binding_target,json=bindingTarget,proto3" json:"binding_target,omitempty"`
-	TargetType    string `protobuf:"bytes,6,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
-	TargetSize    uint32 `protobuf:"varint,7,opt,name=target_size,json=targetSize,proto3" json:"target_size,omitempty"`
+type PayoutBatchResponse struct {
+	BatchId string                          `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Created int64                           `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Payouts []*PayoutBatchResponse_Payout   `protobuf:"bytes,3,rep,name=payouts" json:"payouts,omitempty"`
+	Txs     []*PayoutBatchResponse_PayoutTx `protobuf:"bytes,4,rep,name=txs" json:"txs,omitempty"`
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) Reset()         { *m = GetBindingHistoryResponse_BindingUTXO{} }
-func (m *GetBindingHistoryResponse_BindingUTXO) String() string { return proto.CompactTextString(m) }
-func (*GetBindingHistoryResponse_BindingUTXO) ProtoMessage()    {}
-func (*GetBindingHistoryResponse_BindingUTXO) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{57, 0}
-}
+func (m *PayoutBatchResponse) Reset()                    { *m = PayoutBatchResponse{} }
+func (m *PayoutBatchResponse) String() string            { return proto.CompactTextString(m) }
+func (*PayoutBatchResponse) ProtoMessage()               {}
+func (*PayoutBatchResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{78} }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetTxId() string {
+func (m *PayoutBatchResponse) GetBatchId() string {
 	if m != nil {
-		return m.TxId
+		return m.BatchId
 	}
 	return ""
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetVout() uint32 {
+func (m *PayoutBatchResponse) GetCreated() int64 {
 	if m != nil {
-		return m.Vout
+		return m.Created
 	}
 	return 0
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetHolderAddress() string {
+func (m *PayoutBatchResponse) GetPayouts() []*PayoutBatchResponse_Payout {
 	if m != nil {
-		return m.HolderAddress
+		return m.Payouts
 	}
-	return ""
+	return nil
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetAmount() string {
+func (m *PayoutBatchResponse) GetTxs() []*PayoutBatchResponse_PayoutTx {
 	if m != nil {
-		return m.Amount
+		return m.Txs
 	}
-	return ""
+	return nil
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetBindingTarget() string {
+type PayoutBatchResponse_Payout struct {
+	Key     string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	TxId    string `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Status  string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Error   string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PayoutBatchResponse_Payout) Reset()         { *m = PayoutBatchResponse_Payout{} }
+func (m *PayoutBatchResponse_Payout) String() string { return proto.CompactTextString(m) }
+func (*PayoutBatchResponse_Payout) ProtoMessage()    {}
+func (*PayoutBatchResponse_Payout) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{78, 0}
+}
+
+func (m *PayoutBatchResponse_Payout) GetKey() string {
 	if m != nil {
-		return m.BindingTarget
+		return m.Key
 	}
 	return ""
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetTargetType() string {
+func (m *PayoutBatchResponse_Payout) GetAddress() string {
 	if m != nil {
-		return m.TargetType
+		return m.Address
 	}
 	return ""
 }
 
-func (m *GetBindingHistoryResponse_BindingUTXO) GetTargetSize() uint32 {
+func (m *PayoutBatchResponse_Payout) GetAmount() string {
 	if m != nil {
-		return m.TargetSize
+		return m.Amount
 	}
-	return 0
-}
-
-type GetBindingHistoryResponse_History struct {
-	TxId          string                                 `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	Status        uint32                                 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	BlockHeight   uint64                                 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Utxo          *GetBindingHistoryResponse_BindingUTXO `protobuf:"bytes,4,opt,name=utxo" json:"utxo,omitempty"`
-	FromAddresses []string                               `protobuf:"bytes,5,rep,name=from_addresses,json=fromAddresses" json:"from_addresses,omitempty"`
-}
-
-func (m *GetBindingHistoryResponse_History) Reset()         { *m = GetBindingHistoryResponse_History{} }
-func (m *GetBindingHistoryResponse_History) String() string { return proto.CompactTextString(m) }
-func (*GetBindingHistoryResponse_History) ProtoMessage()    {}
-func (*GetBindingHistoryResponse_History) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{57, 1}
+	return ""
 }
 
-func (m *GetBindingHistoryResponse_History) GetTxId() string {
+func (m *PayoutBatchResponse_Payout) GetTxId() string {
 	if m != nil {
 		return m.TxId
 	}
 	return ""
 }
 
-func (m *GetBindingHistoryResponse_History) GetStatus() uint32 {
+func (m *PayoutBatchResponse_Payout) GetStatus() string {
 	if m != nil {
 		return m.Status
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBindingHistoryResponse_History) GetBlockHeight() uint64 {
+func (m *PayoutBatchResponse_Payout) GetError() string {
 	if m != nil {
-		return m.BlockHeight
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBindingHistoryResponse_History) GetUtxo() *GetBindingHistoryResponse_BindingUTXO {
+type PayoutBatchResponse_PayoutTx struct {
+	TxId   string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error  string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PayoutBatchResponse_PayoutTx) Reset()         { *m = PayoutBatchResponse_PayoutTx{} }
+func (m *PayoutBatchResponse_PayoutTx) String() string { return proto.CompactTextString(m) }
+func (*PayoutBatchResponse_PayoutTx) ProtoMessage()    {}
+func (*PayoutBatchResponse_PayoutTx) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{78, 1}
+}
+
+func (m *PayoutBatchResponse_PayoutTx) GetTxId() string {
 	if m != nil {
-		return m.Utxo
+		return m.TxId
 	}
-	return nil
+	return ""
 }
 
-func (m *GetBindingHistoryResponse_History) GetFromAddresses() []string {
+func (m *PayoutBatchResponse_PayoutTx) GetStatus() string {
 	if m != nil {
-		return m.FromAddresses
+		return m.Status
 	}
-	return nil
+	return ""
 }
 
-type CreateBindingTransactionRequest struct {
-	Outputs     []*CreateBindingTransactionRequest_Output `protobuf:"bytes,1,rep,name=outputs" json:"outputs,omitempty"`
-	FromAddress string                                    `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	Fee         string                                    `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+func (m *PayoutBatchResponse_PayoutTx) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *CreateBindingTransactionRequest) Reset()         { *m = CreateBindingTransactionRequest{} }
-func (m *CreateBindingTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateBindingTransactionRequest) ProtoMessage()    {}
-func (*CreateBindingTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{58}
+type SignRawTransactionRequest struct {
+	RawTx      string `protobuf:"bytes,1,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	Flags      string `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	WalletId   string `protobuf:"bytes,4,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
-func (m *CreateBindingTransactionRequest) GetOutputs() []*CreateBindingTransactionRequest_Output {
+func (m *SignRawTransactionRequest) Reset()                    { *m = SignRawTransactionRequest{} }
+func (m *SignRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignRawTransactionRequest) ProtoMessage()               {}
+func (*SignRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{79} }
+
+func (m *SignRawTransactionRequest) GetRawTx() string {
 	if m != nil {
-		return m.Outputs
+		return m.RawTx
 	}
-	return nil
+	return ""
 }
 
-func (m *CreateBindingTransactionRequest) GetFromAddress() string {
+func (m *SignRawTransactionRequest) GetFlags() string {
 	if m != nil {
-		return m.FromAddress
+		return m.Flags
 	}
 	return ""
 }
 
-func (m *CreateBindingTransactionRequest) GetFee() string {
+func (m *SignRawTransactionRequest) GetPassphrase() string {
 	if m != nil {
-		return m.Fee
+		return m.Passphrase
 	}
 	return ""
 }
 
-type CreateBindingTransactionRequest_Output struct {
-	HolderAddress  string `protobuf:"bytes,1,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
-	BindingAddress string `protobuf:"bytes,2,opt,name=binding_address,json=bindingAddress,proto3" json:"binding_address,omitempty"`
-	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-}
-
-func (m *CreateBindingTransactionRequest_Output) Reset() {
-	*m = CreateBindingTransactionRequest_Output{}
-}
-func (m *CreateBindingTransactionRequest_Output) String() string { return proto.CompactTextString(m) }
-func (*CreateBindingTransactionRequest_Output) ProtoMessage()    {}
-func (*CreateBindingTransactionRequest_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{58, 0}
-}
-
-func (m *CreateBindingTransactionRequest_Output) GetHolderAddress() string {
+func (m *SignRawTransactionRequest) GetWalletId() string {
 	if m != nil {
-		return m.HolderAddress
+		return m.WalletId
 	}
 	return ""
 }
 
-func (m *CreateBindingTransactionRequest_Output) GetBindingAddress() string {
+type SignRawTransactionResponse struct {
+	Hex      string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+	Complete bool   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+}
+
+func (m *SignRawTransactionResponse) Reset()                    { *m = SignRawTransactionResponse{} }
+func (m *SignRawTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignRawTransactionResponse) ProtoMessage()               {}
+func (*SignRawTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{80} }
+
+func (m *SignRawTransactionResponse) GetHex() string {
 	if m != nil {
-		return m.BindingAddress
+		return m.Hex
 	}
 	return ""
 }
 
-func (m *CreateBindingTransactionRequest_Output) GetAmount() string {
+func (m *SignRawTransactionResponse) GetComplete() bool {
 	if m != nil {
-		return m.Amount
+		return m.Complete
 	}
-	return ""
+	return false
 }
 
-type GetWalletMnemonicRequest struct {
-	WalletId   string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+type CreatePsbtRequest struct {
+	Hex      string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+	WalletId string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
-func (m *GetWalletMnemonicRequest) Reset()                    { *m = GetWalletMnemonicRequest{} }
-func (m *GetWalletMnemonicRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetWalletMnemonicRequest) ProtoMessage()               {}
-func (*GetWalletMnemonicRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{59} }
+func (m *CreatePsbtRequest) Reset()                    { *m = CreatePsbtRequest{} }
+func (m *CreatePsbtRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreatePsbtRequest) ProtoMessage()               {}
+func (*CreatePsbtRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{81} }
 
-func (m *GetWalletMnemonicRequest) GetWalletId() string {
+func (m *CreatePsbtRequest) GetHex() string {
 	if m != nil {
-		return m.WalletId
+		return m.Hex
 	}
 	return ""
 }
 
-func (m *GetWalletMnemonicRequest) GetPassphrase() string {
+func (m *CreatePsbtRequest) GetWalletId() string {
 	if m != nil {
-		return m.Passphrase
+		return m.WalletId
 	}
 	return ""
 }
 
-type GetWalletMnemonicResponse struct {
-	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
-	Version  uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+type PsbtResponse struct {
+	Psbt     string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Complete bool   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
 }
 
-func (m *GetWalletMnemonicResponse) Reset()                    { *m = GetWalletMnemonicResponse{} }
-func (m *GetWalletMnemonicResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetWalletMnemonicResponse) ProtoMessage()               {}
-func (*GetWalletMnemonicResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{60} }
+func (m *PsbtResponse) Reset()                    { *m = PsbtResponse{} }
+func (m *PsbtResponse) String() string            { return proto.CompactTextString(m) }
+func (*PsbtResponse) ProtoMessage()               {}
+func (*PsbtResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{82} }
 
-func (m *GetWalletMnemonicResponse) GetMnemonic() string {
+func (m *PsbtResponse) GetPsbt() string {
 	if m != nil {
-		return m.Mnemonic
+		return m.Psbt
 	}
 	return ""
 }
 
-func (m *GetWalletMnemonicResponse) GetVersion() uint32 {
+func (m *PsbtResponse) GetComplete() bool {
 	if m != nil {
-		return m.Version
+		return m.Complete
 	}
-	return 0
+	return false
 }
 
-type GetBlockByHeightRequest struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+type DecodePsbtRequest struct {
+	Psbt string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
 }
 
-func (m *GetBlockByHeightRequest) Reset()                    { *m = GetBlockByHeightRequest{} }
-func (m *GetBlockByHeightRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetBlockByHeightRequest) ProtoMessage()               {}
-func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{61} }
+func (m *DecodePsbtRequest) Reset()                    { *m = DecodePsbtRequest{} }
+func (m *DecodePsbtRequest) String() string            { return proto.CompactTextString(m) }
+func (*DecodePsbtRequest) ProtoMessage()               {}
+func (*DecodePsbtRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{83} }
 
-func (m *GetBlockByHeightRequest) GetHeight() uint64 {
+func (m *DecodePsbtRequest) GetPsbt() string {
 	if m != nil {
-		return m.Height
+		return m.Psbt
 	}
-	return 0
+	return ""
 }
 
-type GetBlockResponse struct {
-	Hash            string                          `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	ChainId         string                          `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Version         uint64                          `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	Height          uint64                          `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
-	Confirmations   uint64                          `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
-	Time            int64                           `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
-	PreviousHash    string                          `protobuf:"bytes,7,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`
-	NextHash        string                          `protobuf:"bytes,8,opt,name=next_hash,json=nextHash,proto3" json:"next_hash,omitempty"`
-	TransactionRoot string                          `protobuf:"bytes,9,opt,name=transaction_root,json=transactionRoot,proto3" json:"transaction_root,omitempty"`
-	WitnessRoot     string                          `protobuf:"bytes,10,opt,name=witness_root,json=witnessRoot,proto3" json:"witness_root,omitempty"`
-	ProposalRoot    string                          `protobuf:"bytes,11,opt,name=proposal_root,json=proposalRoot,proto3" json:"proposal_root,omitempty"`
-	Target          string                          `protobuf:"bytes,12,opt,name=target,proto3" json:"target,omitempty"`
-	Quality         string                          `protobuf:"bytes,13,opt,name=quality,proto3" json:"quality,omitempty"`
-	Challenge       string                          `protobuf:"bytes,14,opt,name=challenge,proto3" json:"challenge,omitempty"`
-	PublicKey       string                          `protobuf:"bytes,15,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Proof           *GetBlockResponse_Proof         `protobuf:"bytes,16,opt,name=proof" json:"proof,omitempty"`
-	BlockSignature  *GetBlockResponse_PoCSignature  `protobuf:"bytes,17,opt,name=block_signature,json=blockSignature" json:"block_signature,omitempty"`
-	BanList         []string                        `protobuf:"bytes,18,rep,name=ban_list,json=banList" json:"ban_list,omitempty"`
-	ProposalArea    *GetBlockResponse_ProposalArea  `protobuf:"bytes,19,opt,name=proposal_area,json=proposalArea" json:"proposal_area,omitempty"`
-	RawTx           []*GetBlockResponse_TxRawResult `protobuf:"bytes,20,rep,name=raw_tx,json=rawTx" json:"raw_tx,omitempty"`
-	Size           uint32                          `protobuf:"varint,21,opt,name=size,proto3" json:"size,omitempty"`
-	TimeUtc         string                          `protobuf:"bytes,22,opt,name=time_utc,json=timeUtc,proto3" json:"time_utc,omitempty"`
-	TxCount         uint32                          `protobuf:"varint,23,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
-	BindingRoot     string                          `protobuf:"bytes,24,opt,name=binding_root,json=bindingRoot,proto3" json:"binding_root,omitempty"`
+type DecodePsbtResponse struct {
+	Tx       *DecodeRawTransactionResponse `protobuf:"bytes,1,opt,name=tx" json:"tx,omitempty"`
+	Inputs   []*DecodePsbtResponse_Input   `protobuf:"bytes,2,rep,name=inputs" json:"inputs,omitempty"`
+	Fee      string                        `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	Complete bool                          `protobuf:"varint,4,opt,name=complete,proto3" json:"complete,omitempty"`
 }
 
-func (m *GetBlockResponse) Reset()                    { *m = GetBlockResponse{} }
-func (m *GetBlockResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetBlockResponse) ProtoMessage()               {}
-func (*GetBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{62} }
+func (m *DecodePsbtResponse) Reset()                    { *m = DecodePsbtResponse{} }
+func (m *DecodePsbtResponse) String() string            { return proto.CompactTextString(m) }
+func (*DecodePsbtResponse) ProtoMessage()               {}
+func (*DecodePsbtResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{84} }
 
-func (m *GetBlockResponse) GetHash() string {
+func (m *DecodePsbtResponse) GetTx() *DecodeRawTransactionResponse {
 	if m != nil {
-		return m.Hash
+		return m.Tx
 	}
-	return ""
+	return nil
 }
 
-func (m *GetBlockResponse) GetChainId() string {
+func (m *DecodePsbtResponse) GetInputs() []*DecodePsbtResponse_Input {
 	if m != nil {
-		return m.ChainId
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *DecodePsbtResponse) GetFee() string {
+	if m != nil {
+		return m.Fee
 	}
 	return ""
 }
 
-func (m *GetBlockResponse) GetVersion() uint64 {
+func (m *DecodePsbtResponse) GetComplete() bool {
 	if m != nil {
-		return m.Version
+		return m.Complete
 	}
-	return 0
+	return false
 }
 
-func (m *GetBlockResponse) GetHeight() uint64 {
+type DecodePsbtResponse_Derivation struct {
+	PubKey   string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	WalletId string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Branch   uint32 `protobuf:"varint,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	Index    uint32 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *DecodePsbtResponse_Derivation) Reset()         { *m = DecodePsbtResponse_Derivation{} }
+func (m *DecodePsbtResponse_Derivation) String() string { return proto.CompactTextString(m) }
+func (*DecodePsbtResponse_Derivation) ProtoMessage()    {}
+func (*DecodePsbtResponse_Derivation) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{84, 0}
+}
+
+func (m *DecodePsbtResponse_Derivation) GetPubKey() string {
 	if m != nil {
-		return m.Height
+		return m.PubKey
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlockResponse) GetConfirmations() uint64 {
+func (m *DecodePsbtResponse_Derivation) GetWalletId() string {
 	if m != nil {
-		return m.Confirmations
+		return m.WalletId
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlockResponse) GetTime() int64 {
+func (m *DecodePsbtResponse_Derivation) GetBranch() uint32 {
 	if m != nil {
-		return m.Time
+		return m.Branch
 	}
 	return 0
 }
 
-func (m *GetBlockResponse) GetPreviousHash() string {
+func (m *DecodePsbtResponse_Derivation) GetIndex() uint32 {
 	if m != nil {
-		return m.PreviousHash
+		return m.Index
 	}
-	return ""
+	return 0
 }
 
-func (m *GetBlockResponse) GetNextHash() string {
+type DecodePsbtResponse_PartialSig struct {
+	PubKey    string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *DecodePsbtResponse_PartialSig) Reset()         { *m = DecodePsbtResponse_PartialSig{} }
+func (m *DecodePsbtResponse_PartialSig) String() string { return proto.CompactTextString(m) }
+func (*DecodePsbtResponse_PartialSig) ProtoMessage()    {}
+func (*DecodePsbtResponse_PartialSig) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{84, 1}
+}
+
+func (m *DecodePsbtResponse_PartialSig) GetPubKey() string {
 	if m != nil {
-		return m.NextHash
+		return m.PubKey
 	}
 	return ""
 }
 
-func (m *GetBlockResponse) GetTransactionRoot() string {
+func (m *DecodePsbtResponse_PartialSig) GetSignature() string {
 	if m != nil {
-		return m.TransactionRoot
+		return m.Signature
 	}
 	return ""
 }
 
-func (m *GetBlockResponse) GetWitnessRoot() string {
+type DecodePsbtResponse_Input struct {
+	TxId         string                           `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout         uint32                           `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Value        string                           `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	PkScript     string                           `protobuf:"bytes,4,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	RedeemScript string                           `protobuf:"bytes,5,opt,name=redeem_script,json=redeemScript,proto3" json:"redeem_script,omitempty"`
+	Height       uint64                           `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Derivations  []*DecodePsbtResponse_Derivation `protobuf:"bytes,7,rep,name=derivations" json:"derivations,omitempty"`
+	PartialSigs  []*DecodePsbtResponse_PartialSig `protobuf:"bytes,8,rep,name=partial_sigs,json=partialSigs" json:"partial_sigs,omitempty"`
+	Finalized    bool                             `protobuf:"varint,9,opt,name=finalized,proto3" json:"finalized,omitempty"`
+}
+
+func (m *DecodePsbtResponse_Input) Reset()                    { *m = DecodePsbtResponse_Input{} }
+func (m *DecodePsbtResponse_Input) String() string            { return proto.CompactTextString(m) }
+func (*DecodePsbtResponse_Input) ProtoMessage()               {}
+func (*DecodePsbtResponse_Input) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{84, 2} }
+
+func (m *DecodePsbtResponse_Input) GetTxId() string {
 	if m != nil {
-		return m.WitnessRoot
+		return m.TxId
 	}
 	return ""
 }
 
-func (m *GetBlockResponse) GetProposalRoot() string {
+func (m *DecodePsbtResponse_Input) GetVout() uint32 {
 	if m != nil {
-		return m.ProposalRoot
+		return m.Vout
 	}
-	return ""
+	return 0
 }
 
-func (m *GetBlockResponse) GetTarget() string {
+func (m *DecodePsbtResponse_Input) GetValue() string {
 	if m != nil {
-		return m.Target
+		return m.Value
 	}
 	return ""
 }
 
-func (m *GetBlockResponse) GetQuality() string {
+func (m *DecodePsbtResponse_Input) GetPkScript() string {
 	if m != nil {
-		return m.Quality
+		return m.PkScript
 	}
 	return ""
 }
 
-func (m *GetBlockResponse) GetChallenge() string {
+func (m *DecodePsbtResponse_Input) GetRedeemScript() string {
 	if m != nil {
-		return m.Challenge
+		return m.RedeemScript
 	}
 	return ""
 }
 
-func (m *GetBlockResponse) GetPublicKey() string {
+func (m *DecodePsbtResponse_Input) GetHeight() uint64 {
 	if m != nil {
-		return m.PublicKey
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-func (m *GetBlockResponse) GetProof() *GetBlockResponse_Proof {
+func (m *DecodePsbtResponse_Input) GetDerivations() []*DecodePsbtResponse_Derivation {
 	if m != nil {
-		return m.Proof
+		return m.Derivations
 	}
 	return nil
 }
 
-func (m *GetBlockResponse) GetBlockSignature() *GetBlockResponse_PoCSignature {
+func (m *DecodePsbtResponse_Input) GetPartialSigs() []*DecodePsbtResponse_PartialSig {
 	if m != nil {
-		return m.BlockSignature
+		return m.PartialSigs
 	}
 	return nil
 }
 
-func (m *GetBlockResponse) GetBanList() []string {
+func (m *DecodePsbtResponse_Input) GetFinalized() bool {
 	if m != nil {
-		return m.BanList
+		return m.Finalized
 	}
-	return nil
+	return false
 }
 
-func (m *GetBlockResponse) GetProposalArea() *GetBlockResponse_ProposalArea {
-	if m != nil {
-		return m.ProposalArea
-	}
-	return nil
+type SignPsbtRequest struct {
+	Psbt       string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Flags      string `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	WalletId   string `protobuf:"bytes,4,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
-func (m *GetBlockResponse) GetRawTx() []*GetBlockResponse_TxRawResult {
-	if m != nil {
-		return m.RawTx
-	}
-	return nil
-}
+func (m *SignPsbtRequest) Reset()                    { *m = SignPsbtRequest{} }
+func (m *SignPsbtRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignPsbtRequest) ProtoMessage()               {}
+func (*SignPsbtRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{85} }
 
-func (m *GetBlockResponse) GetSize() uint32 {
+func (m *SignPsbtRequest) GetPsbt() string {
 	if m != nil {
-		return m.Size
+		return m.Psbt
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlockResponse) GetTimeUtc() string {
+func (m *SignPsbtRequest) GetFlags() string {
 	if m != nil {
-		return m.TimeUtc
+		return m.Flags
 	}
 	return ""
 }
 
-func (m *GetBlockResponse) GetTxCount() uint32 {
+func (m *SignPsbtRequest) GetPassphrase() string {
 	if m != nil {
-		return m.TxCount
+		return m.Passphrase
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlockResponse) GetBindingRoot() string {
+func (m *SignPsbtRequest) GetWalletId() string {
 	if m != nil {
-		return m.BindingRoot
+		return m.WalletId
 	}
 	return ""
 }
 
-type GetBlockResponse_Proof struct {
-	X         string `protobuf:"bytes,1,opt,name=x,proto3" json:"x,omitempty"`
-	XPrime    string `protobuf:"bytes,2,opt,name=x_prime,json=xPrime,proto3" json:"x_prime,omitempty"`
-	BitLength uint32 `protobuf:"varint,3,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+type SignPsbtResponse struct {
+	Psbt     string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Complete bool   `protobuf:"varint,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	Signed   uint32 `protobuf:"varint,3,opt,name=signed,proto3" json:"signed,omitempty"`
 }
 
-func (m *GetBlockResponse_Proof) Reset()                    { *m = GetBlockResponse_Proof{} }
-func (m *GetBlockResponse_Proof) String() string            { return proto.CompactTextString(m) }
-func (*GetBlockResponse_Proof) ProtoMessage()               {}
-func (*GetBlockResponse_Proof) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{62, 0} }
+func (m *SignPsbtResponse) Reset()                    { *m = SignPsbtResponse{} }
+func (m *SignPsbtResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignPsbtResponse) ProtoMessage()               {}
+func (*SignPsbtResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{86} }
 
-func (m *GetBlockResponse_Proof) GetX() string {
+func (m *SignPsbtResponse) GetPsbt() string {
 	if m != nil {
-		return m.X
+		return m.Psbt
 	}
 	return ""
 }
 
-func (m *GetBlockResponse_Proof) GetXPrime() string {
+func (m *SignPsbtResponse) GetComplete() bool {
 	if m != nil {
-		return m.XPrime
+		return m.Complete
 	}
-	return ""
+	return false
 }
 
-func (m *GetBlockResponse_Proof) GetBitLength() uint32 {
+func (m *SignPsbtResponse) GetSigned() uint32 {
 	if m != nil {
-		return m.BitLength
+		return m.Signed
 	}
 	return 0
 }
 
-type GetBlockResponse_PoCSignature struct {
-	R string `protobuf:"bytes,1,opt,name=r,proto3" json:"r,omitempty"`
-	S string `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
+type CombinePsbtRequest struct {
+	Psbts []string `protobuf:"bytes,1,rep,name=psbts" json:"psbts,omitempty"`
 }
 
-func (m *GetBlockResponse_PoCSignature) Reset()         { *m = GetBlockResponse_PoCSignature{} }
-func (m *GetBlockResponse_PoCSignature) String() string { return proto.CompactTextString(m) }
-func (*GetBlockResponse_PoCSignature) ProtoMessage()    {}
-func (*GetBlockResponse_PoCSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{62, 1}
+func (m *CombinePsbtRequest) Reset()                    { *m = CombinePsbtRequest{} }
+func (m *CombinePsbtRequest) String() string            { return proto.CompactTextString(m) }
+func (*CombinePsbtRequest) ProtoMessage()               {}
+func (*CombinePsbtRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{87} }
+
+func (m *CombinePsbtRequest) GetPsbts() []string {
+	if m != nil {
+		return m.Psbts
+	}
+	return nil
 }
 
-func (m *GetBlockResponse_PoCSignature) GetR() string {
+type FinalizePsbtRequest struct {
+	Psbt string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+}
+
+func (m *FinalizePsbtRequest) Reset()                    { *m = FinalizePsbtRequest{} }
+func (m *FinalizePsbtRequest) String() string            { return proto.CompactTextString(m) }
+func (*FinalizePsbtRequest) ProtoMessage()               {}
+func (*FinalizePsbtRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{88} }
+
+func (m *FinalizePsbtRequest) GetPsbt() string {
 	if m != nil {
-		return m.R
+		return m.Psbt
 	}
 	return ""
 }
 
-func (m *GetBlockResponse_PoCSignature) GetS() string {
+type FinalizePsbtResponse struct {
+	Hex string `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+}
+
+func (m *FinalizePsbtResponse) Reset()                    { *m = FinalizePsbtResponse{} }
+func (m *FinalizePsbtResponse) String() string            { return proto.CompactTextString(m) }
+func (*FinalizePsbtResponse) ProtoMessage()               {}
+func (*FinalizePsbtResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{89} }
+
+func (m *FinalizePsbtResponse) GetHex() string {
 	if m != nil {
-		return m.S
+		return m.Hex
 	}
 	return ""
 }
 
-type GetBlockResponse_ProposalArea struct {
-	PunishmentArea []*GetBlockResponse_ProposalArea_FaultPubKey    `protobuf:"bytes,1,rep,name=punishment_area,json=punishmentArea" json:"punishment_area,omitempty"`
-	OtherArea      []*GetBlockResponse_ProposalArea_NormalProposal `protobuf:"bytes,2,rep,name=other_area,json=otherArea" json:"other_area,omitempty"`
+type GetUtxoRequest struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	WalletId  string   `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
-func (m *GetBlockResponse_ProposalArea) Reset()         { *m = GetBlockResponse_ProposalArea{} }
-func (m *GetBlockResponse_ProposalArea) String() string { return proto.CompactTextString(m) }
-func (*GetBlockResponse_ProposalArea) ProtoMessage()    {}
-func (*GetBlockResponse_ProposalArea) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{62, 2}
-}
+func (m *GetUtxoRequest) Reset()                    { *m = GetUtxoRequest{} }
+func (m *GetUtxoRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetUtxoRequest) ProtoMessage()               {}
+func (*GetUtxoRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{90} }
 
-func (m *GetBlockResponse_ProposalArea) GetPunishmentArea() []*GetBlockResponse_ProposalArea_FaultPubKey {
+func (m *GetUtxoRequest) GetAddresses() []string {
 	if m != nil {
-		return m.PunishmentArea
+		return m.Addresses
 	}
 	return nil
 }
 
-func (m *GetBlockResponse_ProposalArea) GetOtherArea() []*GetBlockResponse_ProposalArea_NormalProposal {
+func (m *GetUtxoRequest) GetWalletId() string {
 	if m != nil {
-		return m.OtherArea
+		return m.WalletId
 	}
-	return nil
+	return ""
 }
 
-type GetBlockResponse_ProposalArea_FaultPubKey struct {
-	Version      uint32                                              `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	ProposalType uint32                                              `protobuf:"varint,2,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty"`
-	PublicKey    string                                              `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Testimony    []*GetBlockResponse_ProposalArea_FaultPubKey_Header `protobuf:"bytes,4,rep,name=testimony" json:"testimony,omitempty"`
+type UTXO struct {
+	TxId           string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout           uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockHeight    uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Maturity       uint32 `protobuf:"varint,5,opt,name=maturity,proto3" json:"maturity,omitempty"`
+	Confirmations  uint32 `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	SpentByUnmined bool   `protobuf:"varint,7,opt,name=spent_by_unmined,json=spentByUnmined,proto3" json:"spent_by_unmined,omitempty"`
+	Note           string `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey) Reset() {
-	*m = GetBlockResponse_ProposalArea_FaultPubKey{}
-}
-func (m *GetBlockResponse_ProposalArea_FaultPubKey) String() string { return proto.CompactTextString(m) }
-func (*GetBlockResponse_ProposalArea_FaultPubKey) ProtoMessage()    {}
-func (*GetBlockResponse_ProposalArea_FaultPubKey) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{62, 2, 0}
-}
+func (m *UTXO) Reset()                    { *m = UTXO{} }
+func (m *UTXO) String() string            { return proto.CompactTextString(m) }
+func (*UTXO) ProtoMessage()               {}
+func (*UTXO) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{91} }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey) GetVersion() uint32 {
+func (m *UTXO) GetTxId() string {
 	if m != nil {
-		return m.Version
+		return m.TxId
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey) GetProposalType() uint32 {
+func (m *UTXO) GetVout() uint32 {
 	if m != nil {
-		return m.ProposalType
+		return m.Vout
 	}
 	return 0
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey) GetPublicKey() string {
+func (m *UTXO) GetAmount() string {
 	if m != nil {
-		return m.PublicKey
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey) GetTestimony() []*GetBlockResponse_ProposalArea_FaultPubKey_Header {
+func (m *UTXO) GetBlockHeight() uint64 {
 	if m != nil {
-		return m.Testimony
+		return m.BlockHeight
 	}
-	return nil
+	return 0
 }
 
-type GetBlockResponse_ProposalArea_FaultPubKey_Header struct {
-	Hash            string                         `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	ChainId         string                         `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Version         uint64                         `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
-	Height          uint64                         `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
-	Time            int64                          `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
-	PreviousHash    string                         `protobuf:"bytes,6,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`
-	TransactionRoot string                         `protobuf:"bytes,7,opt,name=transaction_root,json=transactionRoot,proto3" json:"transaction_root,omitempty"`
-	WitnessRoot     string                         `protobuf:"bytes,8,opt,name=witness_root,json=witnessRoot,proto3" json:"witness_root,omitempty"`
-	ProposalRoot    string                         `protobuf:"bytes,9,opt,name=proposal_root,json=proposalRoot,proto3" json:"proposal_root,omitempty"`
-	Target          string                         `protobuf:"bytes,10,opt,name=target,proto3" json:"target,omitempty"`
-	Challenge       string                         `protobuf:"bytes,11,opt,name=challenge,proto3" json:"challenge,omitempty"`
-	PublicKey       string                         `protobuf:"bytes,12,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Proof           *GetBlockResponse_Proof        `protobuf:"bytes,13,opt,name=proof" json:"proof,omitempty"`
-	BlockSignature  *GetBlockResponse_PoCSignature `protobuf:"bytes,14,opt,name=block_signature,json=blockSignature" json:"block_signature,omitempty"`
-	BanList         []string                       `protobuf:"bytes,15,rep,name=ban_list,json=banList" json:"ban_list,omitempty"`
+func (m *UTXO) GetMaturity() uint32 {
+	if m != nil {
+		return m.Maturity
+	}
+	return 0
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) Reset() {
-	*m = GetBlockResponse_ProposalArea_FaultPubKey_Header{}
-}
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) String() string {
-	return proto.CompactTextString(m)
-}
-func (*GetBlockResponse_ProposalArea_FaultPubKey_Header) ProtoMessage() {}
-func (*GetBlockResponse_ProposalArea_FaultPubKey_Header) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{62, 2, 0, 0}
+func (m *UTXO) GetConfirmations() uint32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetHash() string {
+func (m *UTXO) GetSpentByUnmined() bool {
 	if m != nil {
-		return m.Hash
+		return m.SpentByUnmined
 	}
-	return ""
+	return false
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetChainId() string {
+func (m *UTXO) GetNote() string {
 	if m != nil {
-		return m.ChainId
+		return m.Note
 	}
 	return ""
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetVersion() uint64 {
-	if m != nil {
-		return m.Version
-	}
-	return 0
+type AddressUTXO struct {
+	Address string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Utxos   []*UTXO `protobuf:"bytes,2,rep,name=utxos" json:"utxos,omitempty"`
+	Label   string  `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetHeight() uint64 {
+func (m *AddressUTXO) Reset()                    { *m = AddressUTXO{} }
+func (m *AddressUTXO) String() string            { return proto.CompactTextString(m) }
+func (*AddressUTXO) ProtoMessage()               {}
+func (*AddressUTXO) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{92} }
+
+func (m *AddressUTXO) GetAddress() string {
 	if m != nil {
-		return m.Height
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetTime() int64 {
+func (m *AddressUTXO) GetUtxos() []*UTXO {
 	if m != nil {
-		return m.Time
+		return m.Utxos
 	}
-	return 0
+	return nil
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetPreviousHash() string {
+func (m *AddressUTXO) GetLabel() string {
 	if m != nil {
-		return m.PreviousHash
+		return m.Label
 	}
 	return ""
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetTransactionRoot() string {
+type GetUtxoResponse struct {
+	AddressUtxos []*AddressUTXO `protobuf:"bytes,1,rep,name=address_utxos,json=addressUtxos" json:"address_utxos,omitempty"`
+}
+
+func (m *GetUtxoResponse) Reset()                    { *m = GetUtxoResponse{} }
+func (m *GetUtxoResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetUtxoResponse) ProtoMessage()               {}
+func (*GetUtxoResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{93} }
+
+func (m *GetUtxoResponse) GetAddressUtxos() []*AddressUTXO {
 	if m != nil {
-		return m.TransactionRoot
+		return m.AddressUtxos
 	}
-	return ""
+	return nil
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetWitnessRoot() string {
+type LockUnspentRequest struct {
+	Outpoints []*TransactionInput `protobuf:"bytes,1,rep,name=outpoints" json:"outpoints,omitempty"`
+	Name      string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExpiresIn uint64              `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	WalletId  string              `protobuf:"bytes,4,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *LockUnspentRequest) Reset()                    { *m = LockUnspentRequest{} }
+func (m *LockUnspentRequest) String() string            { return proto.CompactTextString(m) }
+func (*LockUnspentRequest) ProtoMessage()               {}
+func (*LockUnspentRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{94} }
+
+func (m *LockUnspentRequest) GetOutpoints() []*TransactionInput {
 	if m != nil {
-		return m.WitnessRoot
+		return m.Outpoints
 	}
-	return ""
+	return nil
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetProposalRoot() string {
+func (m *LockUnspentRequest) GetName() string {
 	if m != nil {
-		return m.ProposalRoot
+		return m.Name
 	}
 	return ""
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetTarget() string {
+func (m *LockUnspentRequest) GetExpiresIn() uint64 {
 	if m != nil {
-		return m.Target
+		return m.ExpiresIn
 	}
-	return ""
+	return 0
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetChallenge() string {
+func (m *LockUnspentRequest) GetWalletId() string {
 	if m != nil {
-		return m.Challenge
+		return m.WalletId
 	}
 	return ""
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetPublicKey() string {
+type LockedUnspent struct {
+	TxId   string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout   uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Expiry int64  `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (m *LockedUnspent) Reset()                    { *m = LockedUnspent{} }
+func (m *LockedUnspent) String() string            { return proto.CompactTextString(m) }
+func (*LockedUnspent) ProtoMessage()               {}
+func (*LockedUnspent) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{95} }
+
+func (m *LockedUnspent) GetTxId() string {
 	if m != nil {
-		return m.PublicKey
+		return m.TxId
 	}
 	return ""
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetProof() *GetBlockResponse_Proof {
+func (m *LockedUnspent) GetVout() uint32 {
 	if m != nil {
-		return m.Proof
+		return m.Vout
 	}
-	return nil
+	return 0
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetBlockSignature() *GetBlockResponse_PoCSignature {
+func (m *LockedUnspent) GetName() string {
 	if m != nil {
-		return m.BlockSignature
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetBanList() []string {
+func (m *LockedUnspent) GetExpiry() int64 {
 	if m != nil {
-		return m.BanList
+		return m.Expiry
 	}
-	return nil
+	return 0
 }
 
-type GetBlockResponse_ProposalArea_NormalProposal struct {
-	Version      uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	ProposalType uint32 `protobuf:"varint,2,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty"`
-	Data         string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+type LockUnspentResponse struct {
+	Locks []*LockedUnspent `protobuf:"bytes,1,rep,name=locks" json:"locks,omitempty"`
 }
 
-func (m *GetBlockResponse_ProposalArea_NormalProposal) Reset() {
-	*m = GetBlockResponse_ProposalArea_NormalProposal{}
-}
-func (m *GetBlockResponse_ProposalArea_NormalProposal) String() string {
-	return proto.CompactTextString(m)
-}
-func (*GetBlockResponse_ProposalArea_NormalProposal) ProtoMessage() {}
-func (*GetBlockResponse_ProposalArea_NormalProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{62, 2, 1}
-}
+func (m *LockUnspentResponse) Reset()                    { *m = LockUnspentResponse{} }
+func (m *LockUnspentResponse) String() string            { return proto.CompactTextString(m) }
+func (*LockUnspentResponse) ProtoMessage()               {}
+func (*LockUnspentResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{96} }
 
-func (m *GetBlockResponse_ProposalArea_NormalProposal) GetVersion() uint32 {
+func (m *LockUnspentResponse) GetLocks() []*LockedUnspent {
 	if m != nil {
-		return m.Version
+		return m.Locks
 	}
-	return 0
+	return nil
 }
 
-func (m *GetBlockResponse_ProposalArea_NormalProposal) GetProposalType() uint32 {
+type UnlockUnspentRequest struct {
+	Outpoints []*TransactionInput `protobuf:"bytes,1,rep,name=outpoints" json:"outpoints,omitempty"`
+	WalletId  string              `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *UnlockUnspentRequest) Reset()                    { *m = UnlockUnspentRequest{} }
+func (m *UnlockUnspentRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnlockUnspentRequest) ProtoMessage()               {}
+func (*UnlockUnspentRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{97} }
+
+func (m *UnlockUnspentRequest) GetOutpoints() []*TransactionInput {
 	if m != nil {
-		return m.ProposalType
+		return m.Outpoints
 	}
-	return 0
+	return nil
 }
 
-func (m *GetBlockResponse_ProposalArea_NormalProposal) GetData() string {
+func (m *UnlockUnspentRequest) GetWalletId() string {
 	if m != nil {
-		return m.Data
+		return m.WalletId
 	}
 	return ""
 }
 
-type GetBlockResponse_TxRawResult struct {
-	Txid          string  `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	Version       uint32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
-	LockTime      uint64  `protobuf:"varint,3,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
-	Vin           []*Vin  `protobuf:"bytes,4,rep,name=vin" json:"vin,omitempty"`
-	Vout          []*Vout `protobuf:"bytes,5,rep,name=vout" json:"vout,omitempty"`
-	Payload       string  `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
-	Confirmations uint64  `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
-	Size         uint32  `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
-	Fee           string  `protobuf:"bytes,9,opt,name=fee,proto3" json:"fee,omitempty"`
-	Status        int32   `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
-	Type          int32   `protobuf:"varint,11,opt,name=type,proto3" json:"type,omitempty"`
+type UnlockUnspentResponse struct {
+	Unlocked uint32 `protobuf:"varint,1,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
 }
 
-func (m *GetBlockResponse_TxRawResult) Reset()         { *m = GetBlockResponse_TxRawResult{} }
-func (m *GetBlockResponse_TxRawResult) String() string { return proto.CompactTextString(m) }
-func (*GetBlockResponse_TxRawResult) ProtoMessage()    {}
-func (*GetBlockResponse_TxRawResult) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{62, 3}
-}
+func (m *UnlockUnspentResponse) Reset()                    { *m = UnlockUnspentResponse{} }
+func (m *UnlockUnspentResponse) String() string            { return proto.CompactTextString(m) }
+func (*UnlockUnspentResponse) ProtoMessage()               {}
+func (*UnlockUnspentResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{98} }
 
-func (m *GetBlockResponse_TxRawResult) GetTxid() string {
+func (m *UnlockUnspentResponse) GetUnlocked() uint32 {
 	if m != nil {
-		return m.Txid
+		return m.Unlocked
 	}
-	return ""
+	return 0
 }
 
-func (m *GetBlockResponse_TxRawResult) GetVersion() uint32 {
-	if m != nil {
-		return m.Version
-	}
-	return 0
+type ListLockUnspentRequest struct {
+	WalletId string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
-func (m *GetBlockResponse_TxRawResult) GetLockTime() uint64 {
+func (m *ListLockUnspentRequest) Reset()                    { *m = ListLockUnspentRequest{} }
+func (m *ListLockUnspentRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListLockUnspentRequest) ProtoMessage()               {}
+func (*ListLockUnspentRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{99} }
+
+func (m *ListLockUnspentRequest) GetWalletId() string {
 	if m != nil {
-		return m.LockTime
+		return m.WalletId
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlockResponse_TxRawResult) GetVin() []*Vin {
-	if m != nil {
-		return m.Vin
-	}
-	return nil
+type ListLockUnspentResponse struct {
+	Locks []*LockedUnspent `protobuf:"bytes,1,rep,name=locks" json:"locks,omitempty"`
 }
 
-func (m *GetBlockResponse_TxRawResult) GetVout() []*Vout {
+func (m *ListLockUnspentResponse) Reset()                    { *m = ListLockUnspentResponse{} }
+func (m *ListLockUnspentResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListLockUnspentResponse) ProtoMessage()               {}
+func (*ListLockUnspentResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{100} }
+
+func (m *ListLockUnspentResponse) GetLocks() []*LockedUnspent {
 	if m != nil {
-		return m.Vout
+		return m.Locks
 	}
 	return nil
 }
 
-func (m *GetBlockResponse_TxRawResult) GetPayload() string {
+type SetLabelRequest struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TxId     string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Label    string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	WalletId string `protobuf:"bytes,4,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *SetLabelRequest) Reset()                    { *m = SetLabelRequest{} }
+func (m *SetLabelRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetLabelRequest) ProtoMessage()               {}
+func (*SetLabelRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{101} }
+
+func (m *SetLabelRequest) GetAddress() string {
 	if m != nil {
-		return m.Payload
+		return m.Address
 	}
 	return ""
 }
 
-func (m *GetBlockResponse_TxRawResult) GetConfirmations() uint64 {
+func (m *SetLabelRequest) GetTxId() string {
 	if m != nil {
-		return m.Confirmations
+		return m.TxId
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlockResponse_TxRawResult) GetSize() uint32 {
+func (m *SetLabelRequest) GetLabel() string {
 	if m != nil {
-		return m.Size
+		return m.Label
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlockResponse_TxRawResult) GetFee() string {
+func (m *SetLabelRequest) GetWalletId() string {
 	if m != nil {
-		return m.Fee
+		return m.WalletId
 	}
 	return ""
 }
 
-func (m *GetBlockResponse_TxRawResult) GetStatus() int32 {
+type SetLabelResponse struct {
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (m *SetLabelResponse) Reset()                    { *m = SetLabelResponse{} }
+func (m *SetLabelResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetLabelResponse) ProtoMessage()               {}
+func (*SetLabelResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{102} }
+
+func (m *SetLabelResponse) GetOk() bool {
 	if m != nil {
-		return m.Status
+		return m.Ok
 	}
-	return 0
+	return false
 }
 
-func (m *GetBlockResponse_TxRawResult) GetType() int32 {
+type GetLabelsRequest struct {
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	TxIds     []string `protobuf:"bytes,2,rep,name=tx_ids,json=txIds" json:"tx_ids,omitempty"`
+	WalletId  string   `protobuf:"bytes,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *GetLabelsRequest) Reset()                    { *m = GetLabelsRequest{} }
+func (m *GetLabelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetLabelsRequest) ProtoMessage()               {}
+func (*GetLabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{103} }
+
+func (m *GetLabelsRequest) GetAddresses() []string {
 	if m != nil {
-		return m.Type
+		return m.Addresses
 	}
-	return 0
+	return nil
 }
 
-type CreatePoolPkCoinbaseTransactionRequest struct {
-	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
-	Payload     string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+func (m *GetLabelsRequest) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
+	}
+	return nil
 }
 
-func (m *CreatePoolPkCoinbaseTransactionRequest) Reset() {
-	*m = CreatePoolPkCoinbaseTransactionRequest{}
+func (m *GetLabelsRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
 }
-func (m *CreatePoolPkCoinbaseTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreatePoolPkCoinbaseTransactionRequest) ProtoMessage()    {}
-func (*CreatePoolPkCoinbaseTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{63}
+
+type SearchLabelsRequest struct {
+	Query    string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	WalletId string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
-func (m *CreatePoolPkCoinbaseTransactionRequest) GetFromAddress() string {
+func (m *SearchLabelsRequest) Reset()                    { *m = SearchLabelsRequest{} }
+func (m *SearchLabelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SearchLabelsRequest) ProtoMessage()               {}
+func (*SearchLabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{104} }
+
+func (m *SearchLabelsRequest) GetQuery() string {
 	if m != nil {
-		return m.FromAddress
+		return m.Query
 	}
 	return ""
 }
 
-func (m *CreatePoolPkCoinbaseTransactionRequest) GetPayload() string {
+func (m *SearchLabelsRequest) GetWalletId() string {
 	if m != nil {
-		return m.Payload
+		return m.WalletId
 	}
 	return ""
 }
 
-type CheckPoolPkCoinbaseRequest struct {
-	PoolPubkeys []string `protobuf:"bytes,1,rep,name=pool_pubkeys,json=poolPubkeys" json:"pool_pubkeys,omitempty"`
+type LabelsResponse struct {
+	Addresses    map[string]string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Transactions map[string]string `protobuf:"bytes,2,rep,name=transactions" json:"transactions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *CheckPoolPkCoinbaseRequest) Reset()                    { *m = CheckPoolPkCoinbaseRequest{} }
-func (m *CheckPoolPkCoinbaseRequest) String() string            { return proto.CompactTextString(m) }
-func (*CheckPoolPkCoinbaseRequest) ProtoMessage()               {}
-func (*CheckPoolPkCoinbaseRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{64} }
+func (m *LabelsResponse) Reset()                    { *m = LabelsResponse{} }
+func (m *LabelsResponse) String() string            { return proto.CompactTextString(m) }
+func (*LabelsResponse) ProtoMessage()               {}
+func (*LabelsResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{105} }
 
-func (m *CheckPoolPkCoinbaseRequest) GetPoolPubkeys() []string {
+func (m *LabelsResponse) GetAddresses() map[string]string {
 	if m != nil {
-		return m.PoolPubkeys
+		return m.Addresses
 	}
 	return nil
 }
 
-type CheckPoolPkCoinbaseResponse struct {
-	Result map[string]*CheckPoolPkCoinbaseResponse_Info `protobuf:"bytes,1,rep,name=result" json:"result,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-}
-
-func (m *CheckPoolPkCoinbaseResponse) Reset()                    { *m = CheckPoolPkCoinbaseResponse{} }
-func (m *CheckPoolPkCoinbaseResponse) String() string            { return proto.CompactTextString(m) }
-func (*CheckPoolPkCoinbaseResponse) ProtoMessage()               {}
-func (*CheckPoolPkCoinbaseResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{65} }
-
-func (m *CheckPoolPkCoinbaseResponse) GetResult() map[string]*CheckPoolPkCoinbaseResponse_Info {
+func (m *LabelsResponse) GetTransactions() map[string]string {
 	if m != nil {
-		return m.Result
+		return m.Transactions
 	}
 	return nil
 }
 
-type CheckPoolPkCoinbaseResponse_Info struct {
-	Nonce    uint32 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Coinbase string `protobuf:"bytes,2,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+type GetBindingHistoryRequest struct {
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// "all"    - including withdrawn
+	WalletId string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
 }
 
-func (m *CheckPoolPkCoinbaseResponse_Info) Reset()         { *m = CheckPoolPkCoinbaseResponse_Info{} }
-func (m *CheckPoolPkCoinbaseResponse_Info) String() string { return proto.CompactTextString(m) }
-func (*CheckPoolPkCoinbaseResponse_Info) ProtoMessage()    {}
-func (*CheckPoolPkCoinbaseResponse_Info) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{65, 0}
-}
+func (m *GetBindingHistoryRequest) Reset()                    { *m = GetBindingHistoryRequest{} }
+func (m *GetBindingHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBindingHistoryRequest) ProtoMessage()               {}
+func (*GetBindingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{106} }
 
-func (m *CheckPoolPkCoinbaseResponse_Info) GetNonce() uint32 {
+func (m *GetBindingHistoryRequest) GetType() string {
 	if m != nil {
-		return m.Nonce
+		return m.Type
 	}
-	return 0
+	return ""
 }
 
-func (m *CheckPoolPkCoinbaseResponse_Info) GetCoinbase() string {
+func (m *GetBindingHistoryRequest) GetWalletId() string {
 	if m != nil {
-		return m.Coinbase
+		return m.WalletId
 	}
 	return ""
 }
 
-type GetNetworkBindingRequest struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+type GetBindingHistoryResponse struct {
+	Histories []*GetBindingHistoryResponse_History `protobuf:"bytes,1,rep,name=histories" json:"histories,omitempty"`
 }
 
-func (m *GetNetworkBindingRequest) Reset()                    { *m = GetNetworkBindingRequest{} }
-func (m *GetNetworkBindingRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetNetworkBindingRequest) ProtoMessage()               {}
-func (*GetNetworkBindingRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{66} }
+func (m *GetBindingHistoryResponse) Reset()                    { *m = GetBindingHistoryResponse{} }
+func (m *GetBindingHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetBindingHistoryResponse) ProtoMessage()               {}
+func (*GetBindingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{107} }
 
-func (m *GetNetworkBindingRequest) GetHeight() uint64 {
+func (m *GetBindingHistoryResponse) GetHistories() []*GetBindingHistoryResponse_History {
 	if m != nil {
-		return m.Height
+		return m.Histories
 	}
-	return 0
+	return nil
 }
 
-type GetNetworkBindingResponse struct {
-	Height                    uint64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	TotalBinding              string            `protobuf:"bytes,2,opt,name=total_binding,json=totalBinding,proto3" json:"total_binding,omitempty"`
-	BindingPriceMassBitlength map[uint32]string `protobuf:"bytes,3,rep,name=binding_price_mass_bitlength,json=bindingPriceMassBitlength" json:"binding_price_mass_bitlength,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	BindingPriceChiaK         map[uint32]string `protobuf:"bytes,4,rep,name=binding_price_chia_k,json=bindingPriceChiaK" json:"binding_price_chia_k,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+type GetBindingHistoryResponse_BindingUTXO struct {
+	TxId          string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Vout          uint32 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	HolderAddress string `protobuf:"bytes,3,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
+	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	BindingTarget string `protobuf:"bytes,5,opt,name=binding_target,json=bindingTarget,proto3" json:"binding_target,omitempty"`
+	TargetType    string `protobuf:"bytes,6,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetSize    uint32 `protobuf:"varint,7,opt,name=target_size,json=targetSize,proto3" json:"target_size,omitempty"`
+	HolderLabel   string `protobuf:"bytes,8,opt,name=holder_label,json=holderLabel,proto3" json:"holder_label,omitempty"`
 }
 
-func (m *GetNetworkBindingResponse) Reset()                    { *m = GetNetworkBindingResponse{} }
-func (m *GetNetworkBindingResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetNetworkBindingResponse) ProtoMessage()               {}
-func (*GetNetworkBindingResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{67} }
+func (m *GetBindingHistoryResponse_BindingUTXO) Reset()         { *m = GetBindingHistoryResponse_BindingUTXO{} }
+func (m *GetBindingHistoryResponse_BindingUTXO) String() string { return proto.CompactTextString(m) }
+func (*GetBindingHistoryResponse_BindingUTXO) ProtoMessage()    {}
+func (*GetBindingHistoryResponse_BindingUTXO) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{107, 0}
+}
 
-func (m *GetNetworkBindingResponse) GetHeight() uint64 {
+func (m *GetBindingHistoryResponse_BindingUTXO) GetTxId() string {
 	if m != nil {
-		return m.Height
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *GetBindingHistoryResponse_BindingUTXO) GetVout() uint32 {
+	if m != nil {
+		return m.Vout
 	}
 	return 0
 }
 
-func (m *GetNetworkBindingResponse) GetTotalBinding() string {
+func (m *GetBindingHistoryResponse_BindingUTXO) GetHolderAddress() string {
 	if m != nil {
-		return m.TotalBinding
+		return m.HolderAddress
 	}
 	return ""
 }
 
-func (m *GetNetworkBindingResponse) GetBindingPriceMassBitlength() map[uint32]string {
+func (m *GetBindingHistoryResponse_BindingUTXO) GetAmount() string {
 	if m != nil {
-		return m.BindingPriceMassBitlength
+		return m.Amount
 	}
-	return nil
+	return ""
 }
 
-func (m *GetNetworkBindingResponse) GetBindingPriceChiaK() map[uint32]string {
+func (m *GetBindingHistoryResponse_BindingUTXO) GetBindingTarget() string {
 	if m != nil {
-		return m.BindingPriceChiaK
+		return m.BindingTarget
 	}
-	return nil
+	return ""
 }
 
-type CheckTargetBindingRequest struct {
-	Targets []string `protobuf:"bytes,1,rep,name=targets" json:"targets,omitempty"`
+func (m *GetBindingHistoryResponse_BindingUTXO) GetTargetType() string {
+	if m != nil {
+		return m.TargetType
+	}
+	return ""
 }
 
-func (m *CheckTargetBindingRequest) Reset()                    { *m = CheckTargetBindingRequest{} }
-func (m *CheckTargetBindingRequest) String() string            { return proto.CompactTextString(m) }
-func (*CheckTargetBindingRequest) ProtoMessage()               {}
-func (*CheckTargetBindingRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{68} }
-
-func (m *CheckTargetBindingRequest) GetTargets() []string {
+func (m *GetBindingHistoryResponse_BindingUTXO) GetTargetSize() uint32 {
 	if m != nil {
-		return m.Targets
+		return m.TargetSize
 	}
-	return nil
+	return 0
 }
 
-type CheckTargetBindingResponse struct {
-	Result map[string]*CheckTargetBindingResponse_Info `protobuf:"bytes,1,rep,name=result" json:"result,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+func (m *GetBindingHistoryResponse_BindingUTXO) GetHolderLabel() string {
+	if m != nil {
+		return m.HolderLabel
+	}
+	return ""
 }
 
-func (m *CheckTargetBindingResponse) Reset()                    { *m = CheckTargetBindingResponse{} }
-func (m *CheckTargetBindingResponse) String() string            { return proto.CompactTextString(m) }
-func (*CheckTargetBindingResponse) ProtoMessage()               {}
-func (*CheckTargetBindingResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{69} }
+type GetBindingHistoryResponse_History struct {
+	TxId          string                                 `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Status        uint32                                 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	BlockHeight   uint64                                 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Utxo          *GetBindingHistoryResponse_BindingUTXO `protobuf:"bytes,4,opt,name=utxo" json:"utxo,omitempty"`
+	FromAddresses []string                               `protobuf:"bytes,5,rep,name=from_addresses,json=fromAddresses" json:"from_addresses,omitempty"`
+	Note          string                                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+}
 
-func (m *CheckTargetBindingResponse) GetResult() map[string]*CheckTargetBindingResponse_Info {
+func (m *GetBindingHistoryResponse_History) Reset()         { *m = GetBindingHistoryResponse_History{} }
+func (m *GetBindingHistoryResponse_History) String() string { return proto.CompactTextString(m) }
+func (*GetBindingHistoryResponse_History) ProtoMessage()    {}
+func (*GetBindingHistoryResponse_History) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{107, 1}
+}
+
+func (m *GetBindingHistoryResponse_History) GetTxId() string {
 	if m != nil {
-		return m.Result
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *GetBindingHistoryResponse_History) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetBindingHistoryResponse_History) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GetBindingHistoryResponse_History) GetUtxo() *GetBindingHistoryResponse_BindingUTXO {
+	if m != nil {
+		return m.Utxo
 	}
 	return nil
 }
 
-type CheckTargetBindingResponse_Info struct {
-	TargetType string `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
-	TargetSize uint32 `protobuf:"varint,2,opt,name=target_size,json=targetSize,proto3" json:"target_size,omitempty"`
-	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+func (m *GetBindingHistoryResponse_History) GetFromAddresses() []string {
+	if m != nil {
+		return m.FromAddresses
+	}
+	return nil
 }
 
-func (m *CheckTargetBindingResponse_Info) Reset()         { *m = CheckTargetBindingResponse_Info{} }
-func (m *CheckTargetBindingResponse_Info) String() string { return proto.CompactTextString(m) }
-func (*CheckTargetBindingResponse_Info) ProtoMessage()    {}
-func (*CheckTargetBindingResponse_Info) Descriptor() ([]byte, []int) {
-	return fileDescriptorApi, []int{69, 0}
+func (m *GetBindingHistoryResponse_History) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
 }
 
-func (m *CheckTargetBindingResponse_Info) GetTargetType() string {
+type CreateBindingTransactionRequest struct {
+	Outputs     []*CreateBindingTransactionRequest_Output `protobuf:"bytes,1,rep,name=outputs" json:"outputs,omitempty"`
+	FromAddress string                                    `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Fee         string                                    `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	WalletId    string                                    `protobuf:"bytes,4,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	FeeMode     string                                    `protobuf:"bytes,5,opt,name=fee_mode,json=feeMode,proto3" json:"fee_mode,omitempty"`
+}
+
+func (m *CreateBindingTransactionRequest) Reset()         { *m = CreateBindingTransactionRequest{} }
+func (m *CreateBindingTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateBindingTransactionRequest) ProtoMessage()    {}
+func (*CreateBindingTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{108}
+}
+
+func (m *CreateBindingTransactionRequest) GetOutputs() []*CreateBindingTransactionRequest_Output {
 	if m != nil {
-		return m.TargetType
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *CreateBindingTransactionRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
 	return ""
 }
 
-func (m *CheckTargetBindingResponse_Info) GetTargetSize() uint32 {
+func (m *CreateBindingTransactionRequest) GetFee() string {
 	if m != nil {
-		return m.TargetSize
+		return m.Fee
 	}
-	return 0
+	return ""
 }
 
-func (m *CheckTargetBindingResponse_Info) GetAmount() string {
+func (m *CreateBindingTransactionRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *CreateBindingTransactionRequest) GetFeeMode() string {
+	if m != nil {
+		return m.FeeMode
+	}
+	return ""
+}
+
+type CreateBindingTransactionRequest_Output struct {
+	HolderAddress  string `protobuf:"bytes,1,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
+	BindingAddress string `protobuf:"bytes,2,opt,name=binding_address,json=bindingAddress,proto3" json:"binding_address,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *CreateBindingTransactionRequest_Output) Reset() {
+	*m = CreateBindingTransactionRequest_Output{}
+}
+func (m *CreateBindingTransactionRequest_Output) String() string { return proto.CompactTextString(m) }
+func (*CreateBindingTransactionRequest_Output) ProtoMessage()    {}
+func (*CreateBindingTransactionRequest_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{108, 0}
+}
+
+func (m *CreateBindingTransactionRequest_Output) GetHolderAddress() string {
+	if m != nil {
+		return m.HolderAddress
+	}
+	return ""
+}
+
+func (m *CreateBindingTransactionRequest_Output) GetBindingAddress() string {
+	if m != nil {
+		return m.BindingAddress
+	}
+	return ""
+}
+
+func (m *CreateBindingTransactionRequest_Output) GetAmount() string {
 	if m != nil {
 		return m.Amount
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*GetClientStatusResponse)(nil), "rpcprotobuf.GetClientStatusResponse")
-	proto.RegisterType((*GetClientStatusResponsePeerCountInfo)(nil), "rpcprotobuf.GetClientStatusResponse.peerCountInfo")
-	proto.RegisterType((*GetClientStatusResponsePeerInfo)(nil), "rpcprotobuf.GetClientStatusResponse.peerInfo")
-	proto.RegisterType((*GetClientStatusResponsePeerList)(nil), "rpcprotobuf.GetClientStatusResponse.peerList")
-	proto.RegisterType((*QuitClientResponse)(nil), "rpcprotobuf.QuitClientResponse")
-	proto.RegisterType((*WalletsResponse)(nil), "rpcprotobuf.WalletsResponse")
-	proto.RegisterType((*WalletsResponse_WalletSummary)(nil), "rpcprotobuf.WalletsResponse.WalletSummary")
-	proto.RegisterType((*UseWalletRequest)(nil), "rpcprotobuf.UseWalletRequest")
-	proto.RegisterType((*UseWalletResponse)(nil), "rpcprotobuf.UseWalletResponse")
-	proto.RegisterType((*CreateWalletRequest)(nil), "rpcprotobuf.CreateWalletRequest")
-	proto.RegisterType((*CreateWalletResponse)(nil), "rpcprotobuf.CreateWalletResponse")
-	proto.RegisterType((*ImportWalletRequest)(nil), "rpcprotobuf.ImportWalletRequest")
-	proto.RegisterType((*ImportWalletResponse)(nil), "rpcprotobuf.ImportWalletResponse")
-	proto.RegisterType((*ImportMnemonicRequest)(nil), "rpcprotobuf.ImportMnemonicRequest")
-	proto.RegisterType((*ExportWalletRequest)(nil), "rpcprotobuf.ExportWalletRequest")
-	proto.RegisterType((*ExportWalletResponse)(nil), "rpcprotobuf.ExportWalletResponse")
-	proto.RegisterType((*RemoveWalletRequest)(nil), "rpcprotobuf.RemoveWalletRequest")
-	proto.RegisterType((*RemoveWalletResponse)(nil), "rpcprotobuf.RemoveWalletResponse")
-	proto.RegisterType((*GetAddressBalanceRequest)(nil), "rpcprotobuf.GetAddressBalanceRequest")
-	proto.RegisterType((*AddressAndBalance)(nil), "rpcprotobuf.AddressAndBalance")
-	proto.RegisterType((*GetAddressBalanceResponse)(nil), "rpcprotobuf.GetAddressBalanceResponse")
-	proto.RegisterType((*ValidateAddressRequest)(nil), "rpcprotobuf.ValidateAddressRequest")
-	proto.RegisterType((*ValidateAddressResponse)(nil), "rpcprotobuf.ValidateAddressResponse")
-	proto.RegisterType((*CreateAddressRequest)(nil), "rpcprotobuf.CreateAddressRequest")
-	proto.RegisterType((*CreateAddressResponse)(nil), "rpcprotobuf.CreateAddressResponse")
-	proto.RegisterType((*GetAddressesRequest)(nil), "rpcprotobuf.GetAddressesRequest")
-	proto.RegisterType((*GetAddressesResponse)(nil), "rpcprotobuf.GetAddressesResponse")
-	proto.RegisterType((*GetAddressesResponse_AddressDetail)(nil), "rpcprotobuf.GetAddressesResponse.AddressDetail")
-	proto.RegisterType((*GetWalletBalanceRequest)(nil), "rpcprotobuf.GetWalletBalanceRequest")
-	proto.RegisterType((*GetWalletBalanceResponse)(nil), "rpcprotobuf.GetWalletBalanceResponse")
-	proto.RegisterType((*GetWalletBalanceResponse_Detail)(nil), "rpcprotobuf.GetWalletBalanceResponse.Detail")
-	proto.RegisterType((*TxHistoryDetails)(nil), "rpcprotobuf.TxHistoryDetails")
-	proto.RegisterType((*TxHistoryDetails_Input)(nil), "rpcprotobuf.TxHistoryDetails.Input")
-	proto.RegisterType((*TxHistoryDetails_Output)(nil), "rpcprotobuf.TxHistoryDetails.Output")
-	proto.RegisterType((*TxHistoryResponse)(nil), "rpcprotobuf.TxHistoryResponse")
-	proto.RegisterType((*TxHistoryRequest)(nil), "rpcprotobuf.TxHistoryRequest")
-	proto.RegisterType((*TransactionInput)(nil), "rpcprotobuf.TransactionInput")
-	proto.RegisterType((*DecodeRawTransactionRequest)(nil), "rpcprotobuf.DecodeRawTransactionRequest")
-	proto.RegisterType((*DecodeRawTransactionResponse)(nil), "rpcprotobuf.DecodeRawTransactionResponse")
-	proto.RegisterType((*DecodeRawTransactionResponse_Vin)(nil), "rpcprotobuf.DecodeRawTransactionResponse.Vin")
-	proto.RegisterType((*DecodeRawTransactionResponse_Vout)(nil), "rpcprotobuf.DecodeRawTransactionResponse.Vout")
-	proto.RegisterType((*CreateRawTransactionRequest)(nil), "rpcprotobuf.CreateRawTransactionRequest")
-	proto.RegisterType((*AutoCreateTransactionRequest)(nil), "rpcprotobuf.AutoCreateTransactionRequest")
-	proto.RegisterType((*CreateRawTransactionResponse)(nil), "rpcprotobuf.CreateRawTransactionResponse")
-	proto.RegisterType((*CreateStakingTransactionRequest)(nil), "rpcprotobuf.CreateStakingTransactionRequest")
-	proto.RegisterType((*GetBlockStakingRewardRequest)(nil), "rpcprotobuf.GetBlockStakingRewardRequest")
-	proto.RegisterType((*GetBlockStakingRewardResponse)(nil), "rpcprotobuf.GetBlockStakingRewardResponse")
-	proto.RegisterType((*GetBlockStakingRewardResponse_RewardDetail)(nil), "rpcprotobuf.GetBlockStakingRewardResponse.RewardDetail")
-	proto.RegisterType((*GetStakingHistoryRequest)(nil), "rpcprotobuf.GetStakingHistoryRequest")
-	proto.RegisterType((*GetStakingHistoryResponse)(nil), "rpcprotobuf.GetStakingHistoryResponse")
-	proto.RegisterType((*GetStakingHistoryResponse_StakingUTXO)(nil), "rpcprotobuf.GetStakingHistoryResponse.StakingUTXO")
-	proto.RegisterType((*GetStakingHistoryResponse_Tx)(nil), "rpcprotobuf.GetStakingHistoryResponse.Tx")
-	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcprotobuf.SendRawTransactionRequest")
-	proto.RegisterType((*SendRawTransactionResponse)(nil), "rpcprotobuf.SendRawTransactionResponse")
-	proto.RegisterType((*GetTransactionFeeRequest)(nil), "rpcprotobuf.GetTransactionFeeRequest")
-	proto.RegisterType((*GetTransactionFeeResponse)(nil), "rpcprotobuf.GetTransactionFeeResponse")
-	proto.RegisterType((*BlockInfoForTx)(nil), "rpcprotobuf.BlockInfoForTx")
-	proto.RegisterType((*Vin)(nil), "rpcprotobuf.Vin")
-	proto.RegisterType((*Vin_RedeemDetail)(nil), "rpcprotobuf.Vin.RedeemDetail")
-	proto.RegisterType((*Vout)(nil), "rpcprotobuf.Vout")
-	proto.RegisterType((*Vout_ScriptDetail)(nil), "rpcprotobuf.Vout.ScriptDetail")
-	proto.RegisterType((*GetRawTransactionRequest)(nil), "rpcprotobuf.GetRawTransactionRequest")
-	proto.RegisterType((*GetRawTransactionResponse)(nil), "rpcprotobuf.GetRawTransactionResponse")
-	proto.RegisterType((*GetTxStatusRequest)(nil), "rpcprotobuf.GetTxStatusRequest")
-	proto.RegisterType((*GetTxStatusResponse)(nil), "rpcprotobuf.GetTxStatusResponse")
-	proto.RegisterType((*SignRawTransactionRequest)(nil), "rpcprotobuf.SignRawTransactionRequest")
-	proto.RegisterType((*SignRawTransactionResponse)(nil), "rpcprotobuf.SignRawTransactionResponse")
-	proto.RegisterType((*GetUtxoRequest)(nil), "rpcprotobuf.GetUtxoRequest")
-	proto.RegisterType((*UTXO)(nil), "rpcprotobuf.UTXO")
-	proto.RegisterType((*AddressUTXO)(nil), "rpcprotobuf.AddressUTXO")
-	proto.RegisterType((*GetUtxoResponse)(nil), "rpcprotobuf.GetUtxoResponse")
-	proto.RegisterType((*GetBindingHistoryRequest)(nil), "rpcprotobuf.GetBindingHistoryRequest")
-	proto.RegisterType((*GetBindingHistoryResponse)(nil), "rpcprotobuf.GetBindingHistoryResponse")
-	proto.RegisterType((*GetBindingHistoryResponse_BindingUTXO)(nil), "rpcprotobuf.GetBindingHistoryResponse.BindingUTXO")
-	proto.RegisterType((*GetBindingHistoryResponse_History)(nil), "rpcprotobuf.GetBindingHistoryResponse.History")
-	proto.RegisterType((*CreateBindingTransactionRequest)(nil), "rpcprotobuf.CreateBindingTransactionRequest")
-	proto.RegisterType((*CreateBindingTransactionRequest_Output)(nil), "rpcprotobuf.CreateBindingTransactionRequest.Output")
-	proto.RegisterType((*GetWalletMnemonicRequest)(nil), "rpcprotobuf.GetWalletMnemonicRequest")
-	proto.RegisterType((*GetWalletMnemonicResponse)(nil), "rpcprotobuf.GetWalletMnemonicResponse")
-	proto.RegisterType((*GetBlockByHeightRequest)(nil), "rpcprotobuf.GetBlockByHeightRequest")
-	proto.RegisterType((*GetBlockResponse)(nil), "rpcprotobuf.GetBlockResponse")
-	proto.RegisterType((*GetBlockResponse_Proof)(nil), "rpcprotobuf.GetBlockResponse.Proof")
-	proto.RegisterType((*GetBlockResponse_PoCSignature)(nil), "rpcprotobuf.GetBlockResponse.PoCSignature")
-	proto.RegisterType((*GetBlockResponse_ProposalArea)(nil), "rpcprotobuf.GetBlockResponse.ProposalArea")
-	proto.RegisterType((*GetBlockResponse_ProposalArea_FaultPubKey)(nil), "rpcprotobuf.GetBlockResponse.ProposalArea.FaultPubKey")
-	proto.RegisterType((*GetBlockResponse_ProposalArea_FaultPubKey_Header)(nil), "rpcprotobuf.GetBlockResponse.ProposalArea.FaultPubKey.Header")
-	proto.RegisterType((*GetBlockResponse_ProposalArea_NormalProposal)(nil), "rpcprotobuf.GetBlockResponse.ProposalArea.NormalProposal")
-	proto.RegisterType((*GetBlockResponse_TxRawResult)(nil), "rpcprotobuf.GetBlockResponse.TxRawResult")
-	proto.RegisterType((*CreatePoolPkCoinbaseTransactionRequest)(nil), "rpcprotobuf.CreatePoolPkCoinbaseTransactionRequest")
-	proto.RegisterType((*CheckPoolPkCoinbaseRequest)(nil), "rpcprotobuf.CheckPoolPkCoinbaseRequest")
-	proto.RegisterType((*CheckPoolPkCoinbaseResponse)(nil), "rpcprotobuf.CheckPoolPkCoinbaseResponse")
-	proto.RegisterType((*CheckPoolPkCoinbaseResponse_Info)(nil), "rpcprotobuf.CheckPoolPkCoinbaseResponse.Info")
-	proto.RegisterType((*GetNetworkBindingRequest)(nil), "rpcprotobuf.GetNetworkBindingRequest")
-	proto.RegisterType((*GetNetworkBindingResponse)(nil), "rpcprotobuf.GetNetworkBindingResponse")
-	proto.RegisterType((*CheckTargetBindingRequest)(nil), "rpcprotobuf.CheckTargetBindingRequest")
-	proto.RegisterType((*CheckTargetBindingResponse)(nil), "rpcprotobuf.CheckTargetBindingResponse")
-	proto.RegisterType((*CheckTargetBindingResponse_Info)(nil), "rpcprotobuf.CheckTargetBindingResponse.Info")
+type CreateWithdrawTransactionRequest struct {
+	Inputs    []*TransactionInput `protobuf:"bytes,1,rep,name=inputs" json:"inputs,omitempty"`
+	ToAddress string              `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Fee       string              `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	WalletId  string              `protobuf:"bytes,4,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *CreateWithdrawTransactionRequest) Reset()         { *m = CreateWithdrawTransactionRequest{} }
+func (m *CreateWithdrawTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateWithdrawTransactionRequest) ProtoMessage()    {}
+func (*CreateWithdrawTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{109}
+}
+
+func (m *CreateWithdrawTransactionRequest) GetInputs() []*TransactionInput {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *CreateWithdrawTransactionRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *CreateWithdrawTransactionRequest) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *CreateWithdrawTransactionRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+type GetWalletMnemonicRequest struct {
+	WalletId   string `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *GetWalletMnemonicRequest) Reset()                    { *m = GetWalletMnemonicRequest{} }
+func (m *GetWalletMnemonicRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetWalletMnemonicRequest) ProtoMessage()               {}
+func (*GetWalletMnemonicRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{110} }
+
+func (m *GetWalletMnemonicRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *GetWalletMnemonicRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type GetWalletMnemonicResponse struct {
+	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Version  uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *GetWalletMnemonicResponse) Reset()                    { *m = GetWalletMnemonicResponse{} }
+func (m *GetWalletMnemonicResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetWalletMnemonicResponse) ProtoMessage()               {}
+func (*GetWalletMnemonicResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{111} }
+
+func (m *GetWalletMnemonicResponse) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *GetWalletMnemonicResponse) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type GetBlockByHeightRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetBlockByHeightRequest) Reset()                    { *m = GetBlockByHeightRequest{} }
+func (m *GetBlockByHeightRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockByHeightRequest) ProtoMessage()               {}
+func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{112} }
+
+func (m *GetBlockByHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type GetBlockResponse struct {
+	Hash            string                          `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ChainId         string                          `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Version         uint64                          `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Height          uint64                          `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Confirmations   uint64                          `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Time            int64                           `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	PreviousHash    string                          `protobuf:"bytes,7,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`
+	NextHash        string                          `protobuf:"bytes,8,opt,name=next_hash,json=nextHash,proto3" json:"next_hash,omitempty"`
+	TransactionRoot string                          `protobuf:"bytes,9,opt,name=transaction_root,json=transactionRoot,proto3" json:"transaction_root,omitempty"`
+	WitnessRoot     string                          `protobuf:"bytes,10,opt,name=witness_root,json=witnessRoot,proto3" json:"witness_root,omitempty"`
+	ProposalRoot    string                          `protobuf:"bytes,11,opt,name=proposal_root,json=proposalRoot,proto3" json:"proposal_root,omitempty"`
+	Target          string                          `protobuf:"bytes,12,opt,name=target,proto3" json:"target,omitempty"`
+	Quality         string                          `protobuf:"bytes,13,opt,name=quality,proto3" json:"quality,omitempty"`
+	Challenge       string                          `protobuf:"bytes,14,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	PublicKey       string                          `protobuf:"bytes,15,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Proof           *GetBlockResponse_Proof         `protobuf:"bytes,16,opt,name=proof" json:"proof,omitempty"`
+	BlockSignature  *GetBlockResponse_PoCSignature  `protobuf:"bytes,17,opt,name=block_signature,json=blockSignature" json:"block_signature,omitempty"`
+	BanList         []string                        `protobuf:"bytes,18,rep,name=ban_list,json=banList" json:"ban_list,omitempty"`
+	ProposalArea    *GetBlockResponse_ProposalArea  `protobuf:"bytes,19,opt,name=proposal_area,json=proposalArea" json:"proposal_area,omitempty"`
+	RawTx           []*GetBlockResponse_TxRawResult `protobuf:"bytes,20,rep,name=raw_tx,json=rawTx" json:"raw_tx,omitempty"`
+	Size           uint32                          `protobuf:"varint,21,opt,name=size,proto3" json:"size,omitempty"`
+	TimeUtc         string                          `protobuf:"bytes,22,opt,name=time_utc,json=timeUtc,proto3" json:"time_utc,omitempty"`
+	TxCount         uint32                          `protobuf:"varint,23,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	BindingRoot     string                          `protobuf:"bytes,24,opt,name=binding_root,json=bindingRoot,proto3" json:"binding_root,omitempty"`
+}
+
+func (m *GetBlockResponse) Reset()                    { *m = GetBlockResponse{} }
+func (m *GetBlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockResponse) ProtoMessage()               {}
+func (*GetBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{113} }
+
+func (m *GetBlockResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetBlockResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockResponse) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *GetBlockResponse) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *GetBlockResponse) GetPreviousHash() string {
+	if m != nil {
+		return m.PreviousHash
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetNextHash() string {
+	if m != nil {
+		return m.NextHash
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetTransactionRoot() string {
+	if m != nil {
+		return m.TransactionRoot
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetWitnessRoot() string {
+	if m != nil {
+		return m.WitnessRoot
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetProposalRoot() string {
+	if m != nil {
+		return m.ProposalRoot
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetQuality() string {
+	if m != nil {
+		return m.Quality
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetProof() *GetBlockResponse_Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *GetBlockResponse) GetBlockSignature() *GetBlockResponse_PoCSignature {
+	if m != nil {
+		return m.BlockSignature
+	}
+	return nil
+}
+
+func (m *GetBlockResponse) GetBanList() []string {
+	if m != nil {
+		return m.BanList
+	}
+	return nil
+}
+
+func (m *GetBlockResponse) GetProposalArea() *GetBlockResponse_ProposalArea {
+	if m != nil {
+		return m.ProposalArea
+	}
+	return nil
+}
+
+func (m *GetBlockResponse) GetRawTx() []*GetBlockResponse_TxRawResult {
+	if m != nil {
+		return m.RawTx
+	}
+	return nil
+}
+
+func (m *GetBlockResponse) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *GetBlockResponse) GetTimeUtc() string {
+	if m != nil {
+		return m.TimeUtc
+	}
+	return ""
+}
+
+func (m *GetBlockResponse) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *GetBlockResponse) GetBindingRoot() string {
+	if m != nil {
+		return m.BindingRoot
+	}
+	return ""
+}
+
+type GetBlockResponse_Proof struct {
+	X         string `protobuf:"bytes,1,opt,name=x,proto3" json:"x,omitempty"`
+	XPrime    string `protobuf:"bytes,2,opt,name=x_prime,json=xPrime,proto3" json:"x_prime,omitempty"`
+	BitLength uint32 `protobuf:"varint,3,opt,name=bit_length,json=bitLength,proto3" json:"bit_length,omitempty"`
+}
+
+func (m *GetBlockResponse_Proof) Reset()                    { *m = GetBlockResponse_Proof{} }
+func (m *GetBlockResponse_Proof) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockResponse_Proof) ProtoMessage()               {}
+func (*GetBlockResponse_Proof) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{113, 0} }
+
+func (m *GetBlockResponse_Proof) GetX() string {
+	if m != nil {
+		return m.X
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_Proof) GetXPrime() string {
+	if m != nil {
+		return m.XPrime
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_Proof) GetBitLength() uint32 {
+	if m != nil {
+		return m.BitLength
+	}
+	return 0
+}
+
+type GetBlockResponse_PoCSignature struct {
+	R string `protobuf:"bytes,1,opt,name=r,proto3" json:"r,omitempty"`
+	S string `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (m *GetBlockResponse_PoCSignature) Reset()         { *m = GetBlockResponse_PoCSignature{} }
+func (m *GetBlockResponse_PoCSignature) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResponse_PoCSignature) ProtoMessage()    {}
+func (*GetBlockResponse_PoCSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{113, 1}
+}
+
+func (m *GetBlockResponse_PoCSignature) GetR() string {
+	if m != nil {
+		return m.R
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_PoCSignature) GetS() string {
+	if m != nil {
+		return m.S
+	}
+	return ""
+}
+
+type GetBlockResponse_ProposalArea struct {
+	PunishmentArea []*GetBlockResponse_ProposalArea_FaultPubKey    `protobuf:"bytes,1,rep,name=punishment_area,json=punishmentArea" json:"punishment_area,omitempty"`
+	OtherArea      []*GetBlockResponse_ProposalArea_NormalProposal `protobuf:"bytes,2,rep,name=other_area,json=otherArea" json:"other_area,omitempty"`
+}
+
+func (m *GetBlockResponse_ProposalArea) Reset()         { *m = GetBlockResponse_ProposalArea{} }
+func (m *GetBlockResponse_ProposalArea) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResponse_ProposalArea) ProtoMessage()    {}
+func (*GetBlockResponse_ProposalArea) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{113, 2}
+}
+
+func (m *GetBlockResponse_ProposalArea) GetPunishmentArea() []*GetBlockResponse_ProposalArea_FaultPubKey {
+	if m != nil {
+		return m.PunishmentArea
+	}
+	return nil
+}
+
+func (m *GetBlockResponse_ProposalArea) GetOtherArea() []*GetBlockResponse_ProposalArea_NormalProposal {
+	if m != nil {
+		return m.OtherArea
+	}
+	return nil
+}
+
+type GetBlockResponse_ProposalArea_FaultPubKey struct {
+	Version      uint32                                              `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ProposalType uint32                                              `protobuf:"varint,2,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty"`
+	PublicKey    string                                              `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Testimony    []*GetBlockResponse_ProposalArea_FaultPubKey_Header `protobuf:"bytes,4,rep,name=testimony" json:"testimony,omitempty"`
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey) Reset() {
+	*m = GetBlockResponse_ProposalArea_FaultPubKey{}
+}
+func (m *GetBlockResponse_ProposalArea_FaultPubKey) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResponse_ProposalArea_FaultPubKey) ProtoMessage()    {}
+func (*GetBlockResponse_ProposalArea_FaultPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{113, 2, 0}
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey) GetProposalType() uint32 {
+	if m != nil {
+		return m.ProposalType
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey) GetTestimony() []*GetBlockResponse_ProposalArea_FaultPubKey_Header {
+	if m != nil {
+		return m.Testimony
+	}
+	return nil
+}
+
+type GetBlockResponse_ProposalArea_FaultPubKey_Header struct {
+	Hash            string                         `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ChainId         string                         `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Version         uint64                         `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Height          uint64                         `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time            int64                          `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	PreviousHash    string                         `protobuf:"bytes,6,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"`
+	TransactionRoot string                         `protobuf:"bytes,7,opt,name=transaction_root,json=transactionRoot,proto3" json:"transaction_root,omitempty"`
+	WitnessRoot     string                         `protobuf:"bytes,8,opt,name=witness_root,json=witnessRoot,proto3" json:"witness_root,omitempty"`
+	ProposalRoot    string                         `protobuf:"bytes,9,opt,name=proposal_root,json=proposalRoot,proto3" json:"proposal_root,omitempty"`
+	Target          string                         `protobuf:"bytes,10,opt,name=target,proto3" json:"target,omitempty"`
+	Challenge       string                         `protobuf:"bytes,11,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	PublicKey       string                         `protobuf:"bytes,12,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Proof           *GetBlockResponse_Proof        `protobuf:"bytes,13,opt,name=proof" json:"proof,omitempty"`
+	BlockSignature  *GetBlockResponse_PoCSignature `protobuf:"bytes,14,opt,name=block_signature,json=blockSignature" json:"block_signature,omitempty"`
+	BanList         []string                       `protobuf:"bytes,15,rep,name=ban_list,json=banList" json:"ban_list,omitempty"`
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) Reset() {
+	*m = GetBlockResponse_ProposalArea_FaultPubKey_Header{}
+}
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GetBlockResponse_ProposalArea_FaultPubKey_Header) ProtoMessage() {}
+func (*GetBlockResponse_ProposalArea_FaultPubKey_Header) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{113, 2, 0, 0}
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetPreviousHash() string {
+	if m != nil {
+		return m.PreviousHash
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetTransactionRoot() string {
+	if m != nil {
+		return m.TransactionRoot
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetWitnessRoot() string {
+	if m != nil {
+		return m.WitnessRoot
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetProposalRoot() string {
+	if m != nil {
+		return m.ProposalRoot
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetProof() *GetBlockResponse_Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetBlockSignature() *GetBlockResponse_PoCSignature {
+	if m != nil {
+		return m.BlockSignature
+	}
+	return nil
+}
+
+func (m *GetBlockResponse_ProposalArea_FaultPubKey_Header) GetBanList() []string {
+	if m != nil {
+		return m.BanList
+	}
+	return nil
+}
+
+type GetBlockResponse_ProposalArea_NormalProposal struct {
+	Version      uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ProposalType uint32 `protobuf:"varint,2,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty"`
+	Data         string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *GetBlockResponse_ProposalArea_NormalProposal) Reset() {
+	*m = GetBlockResponse_ProposalArea_NormalProposal{}
+}
+func (m *GetBlockResponse_ProposalArea_NormalProposal) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GetBlockResponse_ProposalArea_NormalProposal) ProtoMessage() {}
+func (*GetBlockResponse_ProposalArea_NormalProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{113, 2, 1}
+}
+
+func (m *GetBlockResponse_ProposalArea_NormalProposal) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_ProposalArea_NormalProposal) GetProposalType() uint32 {
+	if m != nil {
+		return m.ProposalType
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_ProposalArea_NormalProposal) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type GetBlockResponse_TxRawResult struct {
+	Txid          string  `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Version       uint32  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	LockTime      uint64  `protobuf:"varint,3,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	Vin           []*Vin  `protobuf:"bytes,4,rep,name=vin" json:"vin,omitempty"`
+	Vout          []*Vout `protobuf:"bytes,5,rep,name=vout" json:"vout,omitempty"`
+	Payload       string  `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	Confirmations uint64  `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Size         uint32  `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	Fee           string  `protobuf:"bytes,9,opt,name=fee,proto3" json:"fee,omitempty"`
+	Status        int32   `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	Type          int32   `protobuf:"varint,11,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *GetBlockResponse_TxRawResult) Reset()         { *m = GetBlockResponse_TxRawResult{} }
+func (m *GetBlockResponse_TxRawResult) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResponse_TxRawResult) ProtoMessage()    {}
+func (*GetBlockResponse_TxRawResult) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{113, 3}
+}
+
+func (m *GetBlockResponse_TxRawResult) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_TxRawResult) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_TxRawResult) GetLockTime() uint64 {
+	if m != nil {
+		return m.LockTime
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_TxRawResult) GetVin() []*Vin {
+	if m != nil {
+		return m.Vin
+	}
+	return nil
+}
+
+func (m *GetBlockResponse_TxRawResult) GetVout() []*Vout {
+	if m != nil {
+		return m.Vout
+	}
+	return nil
+}
+
+func (m *GetBlockResponse_TxRawResult) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_TxRawResult) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_TxRawResult) GetSize() uint32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_TxRawResult) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *GetBlockResponse_TxRawResult) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetBlockResponse_TxRawResult) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+type CreatePoolPkCoinbaseTransactionRequest struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	Payload     string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	WalletId    string `protobuf:"bytes,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+}
+
+func (m *CreatePoolPkCoinbaseTransactionRequest) Reset() {
+	*m = CreatePoolPkCoinbaseTransactionRequest{}
+}
+func (m *CreatePoolPkCoinbaseTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePoolPkCoinbaseTransactionRequest) ProtoMessage()    {}
+func (*CreatePoolPkCoinbaseTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{114}
+}
+
+func (m *CreatePoolPkCoinbaseTransactionRequest) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *CreatePoolPkCoinbaseTransactionRequest) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *CreatePoolPkCoinbaseTransactionRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+type CheckPoolPkCoinbaseRequest struct {
+	PoolPubkeys []string `protobuf:"bytes,1,rep,name=pool_pubkeys,json=poolPubkeys" json:"pool_pubkeys,omitempty"`
+}
+
+func (m *CheckPoolPkCoinbaseRequest) Reset()                    { *m = CheckPoolPkCoinbaseRequest{} }
+func (m *CheckPoolPkCoinbaseRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckPoolPkCoinbaseRequest) ProtoMessage()               {}
+func (*CheckPoolPkCoinbaseRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{115} }
+
+func (m *CheckPoolPkCoinbaseRequest) GetPoolPubkeys() []string {
+	if m != nil {
+		return m.PoolPubkeys
+	}
+	return nil
+}
+
+type CheckPoolPkCoinbaseResponse struct {
+	Result map[string]*CheckPoolPkCoinbaseResponse_Info `protobuf:"bytes,1,rep,name=result" json:"result,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *CheckPoolPkCoinbaseResponse) Reset()                    { *m = CheckPoolPkCoinbaseResponse{} }
+func (m *CheckPoolPkCoinbaseResponse) String() string            { return proto.CompactTextString(m) }
+func (*CheckPoolPkCoinbaseResponse) ProtoMessage()               {}
+func (*CheckPoolPkCoinbaseResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{116} }
+
+func (m *CheckPoolPkCoinbaseResponse) GetResult() map[string]*CheckPoolPkCoinbaseResponse_Info {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type CheckPoolPkCoinbaseResponse_Info struct {
+	Nonce    uint32 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Coinbase string `protobuf:"bytes,2,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+}
+
+func (m *CheckPoolPkCoinbaseResponse_Info) Reset()         { *m = CheckPoolPkCoinbaseResponse_Info{} }
+func (m *CheckPoolPkCoinbaseResponse_Info) String() string { return proto.CompactTextString(m) }
+func (*CheckPoolPkCoinbaseResponse_Info) ProtoMessage()    {}
+func (*CheckPoolPkCoinbaseResponse_Info) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{116, 0}
+}
+
+func (m *CheckPoolPkCoinbaseResponse_Info) GetNonce() uint32 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *CheckPoolPkCoinbaseResponse_Info) GetCoinbase() string {
+	if m != nil {
+		return m.Coinbase
+	}
+	return ""
+}
+
+type GetNetworkBindingRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetNetworkBindingRequest) Reset()                    { *m = GetNetworkBindingRequest{} }
+func (m *GetNetworkBindingRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetNetworkBindingRequest) ProtoMessage()               {}
+func (*GetNetworkBindingRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{117} }
+
+func (m *GetNetworkBindingRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type GetNetworkBindingResponse struct {
+	Height                    uint64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	TotalBinding              string            `protobuf:"bytes,2,opt,name=total_binding,json=totalBinding,proto3" json:"total_binding,omitempty"`
+	BindingPriceMassBitlength map[uint32]string `protobuf:"bytes,3,rep,name=binding_price_mass_bitlength,json=bindingPriceMassBitlength" json:"binding_price_mass_bitlength,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BindingPriceChiaK         map[uint32]string `protobuf:"bytes,4,rep,name=binding_price_chia_k,json=bindingPriceChiaK" json:"binding_price_chia_k,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *GetNetworkBindingResponse) Reset()                    { *m = GetNetworkBindingResponse{} }
+func (m *GetNetworkBindingResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetNetworkBindingResponse) ProtoMessage()               {}
+func (*GetNetworkBindingResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{118} }
+
+func (m *GetNetworkBindingResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetNetworkBindingResponse) GetTotalBinding() string {
+	if m != nil {
+		return m.TotalBinding
+	}
+	return ""
+}
+
+func (m *GetNetworkBindingResponse) GetBindingPriceMassBitlength() map[uint32]string {
+	if m != nil {
+		return m.BindingPriceMassBitlength
+	}
+	return nil
+}
+
+func (m *GetNetworkBindingResponse) GetBindingPriceChiaK() map[uint32]string {
+	if m != nil {
+		return m.BindingPriceChiaK
+	}
+	return nil
+}
+
+type CheckTargetBindingRequest struct {
+	Targets []string `protobuf:"bytes,1,rep,name=targets" json:"targets,omitempty"`
+}
+
+func (m *CheckTargetBindingRequest) Reset()                    { *m = CheckTargetBindingRequest{} }
+func (m *CheckTargetBindingRequest) String() string            { return proto.CompactTextString(m) }
+func (*CheckTargetBindingRequest) ProtoMessage()               {}
+func (*CheckTargetBindingRequest) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{119} }
+
+func (m *CheckTargetBindingRequest) GetTargets() []string {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+type CheckTargetBindingResponse struct {
+	Result map[string]*CheckTargetBindingResponse_Info `protobuf:"bytes,1,rep,name=result" json:"result,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *CheckTargetBindingResponse) Reset()                    { *m = CheckTargetBindingResponse{} }
+func (m *CheckTargetBindingResponse) String() string            { return proto.CompactTextString(m) }
+func (*CheckTargetBindingResponse) ProtoMessage()               {}
+func (*CheckTargetBindingResponse) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{120} }
+
+func (m *CheckTargetBindingResponse) GetResult() map[string]*CheckTargetBindingResponse_Info {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type CheckTargetBindingResponse_Info struct {
+	TargetType string `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetSize uint32 `protobuf:"varint,2,opt,name=target_size,json=targetSize,proto3" json:"target_size,omitempty"`
+	Amount     string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *CheckTargetBindingResponse_Info) Reset()         { *m = CheckTargetBindingResponse_Info{} }
+func (m *CheckTargetBindingResponse_Info) String() string { return proto.CompactTextString(m) }
+func (*CheckTargetBindingResponse_Info) ProtoMessage()    {}
+func (*CheckTargetBindingResponse_Info) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{120, 0}
+}
+
+func (m *CheckTargetBindingResponse_Info) GetTargetType() string {
+	if m != nil {
+		return m.TargetType
+	}
+	return ""
+}
+
+func (m *CheckTargetBindingResponse_Info) GetTargetSize() uint32 {
+	if m != nil {
+		return m.TargetSize
+	}
+	return 0
+}
+
+func (m *CheckTargetBindingResponse_Info) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type EventCursor struct {
+	Height   uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *EventCursor) Reset()                    { *m = EventCursor{} }
+func (m *EventCursor) String() string            { return proto.CompactTextString(m) }
+func (*EventCursor) ProtoMessage()               {}
+func (*EventCursor) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{121} }
+
+func (m *EventCursor) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventCursor) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type SubscribeWalletEventsRequest struct {
+	WalletId string       `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Cursor   *EventCursor `protobuf:"bytes,2,opt,name=cursor" json:"cursor,omitempty"`
+}
+
+func (m *SubscribeWalletEventsRequest) Reset()         { *m = SubscribeWalletEventsRequest{} }
+func (m *SubscribeWalletEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeWalletEventsRequest) ProtoMessage()    {}
+func (*SubscribeWalletEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorApi, []int{122}
+}
+
+func (m *SubscribeWalletEventsRequest) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *SubscribeWalletEventsRequest) GetCursor() *EventCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+type WalletEvent struct {
+	Cursor *EventCursor `protobuf:"bytes,1,opt,name=cursor" json:"cursor,omitempty"`
+	Type   string       `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// "staking_changed", "binding_changed", "reorg_rollback", "sync_progress"
+	WalletId   string `protobuf:"bytes,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	TxId       string `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BlockHash  string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height     uint64 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Balance    string `protobuf:"bytes,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	BestHeight uint64 `protobuf:"varint,8,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+}
+
+func (m *WalletEvent) Reset()                    { *m = WalletEvent{} }
+func (m *WalletEvent) String() string            { return proto.CompactTextString(m) }
+func (*WalletEvent) ProtoMessage()               {}
+func (*WalletEvent) Descriptor() ([]byte, []int) { return fileDescriptorApi, []int{123} }
+
+func (m *WalletEvent) GetCursor() *EventCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *WalletEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WalletEvent) GetWalletId() string {
+	if m != nil {
+		return m.WalletId
+	}
+	return ""
+}
+
+func (m *WalletEvent) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *WalletEvent) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *WalletEvent) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *WalletEvent) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *WalletEvent) GetBestHeight() uint64 {
+	if m != nil {
+		return m.BestHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GetClientStatusResponse)(nil), "rpcprotobuf.GetClientStatusResponse")
+	proto.RegisterType((*GetClientStatusResponsePeerCountInfo)(nil), "rpcprotobuf.GetClientStatusResponse.peerCountInfo")
+	proto.RegisterType((*GetClientStatusResponsePeerInfo)(nil), "rpcprotobuf.GetClientStatusResponse.peerInfo")
+	proto.RegisterType((*GetClientStatusResponsePeerList)(nil), "rpcprotobuf.GetClientStatusResponse.peerList")
+	proto.RegisterType((*QuitClientResponse)(nil), "rpcprotobuf.QuitClientResponse")
+	proto.RegisterType((*WalletsResponse)(nil), "rpcprotobuf.WalletsResponse")
+	proto.RegisterType((*WalletsResponse_WalletSummary)(nil), "rpcprotobuf.WalletsResponse.WalletSummary")
+	proto.RegisterType((*UseWalletRequest)(nil), "rpcprotobuf.UseWalletRequest")
+	proto.RegisterType((*UseWalletResponse)(nil), "rpcprotobuf.UseWalletResponse")
+	proto.RegisterType((*CreateWalletRequest)(nil), "rpcprotobuf.CreateWalletRequest")
+	proto.RegisterType((*CreateWalletResponse)(nil), "rpcprotobuf.CreateWalletResponse")
+	proto.RegisterType((*ImportWalletRequest)(nil), "rpcprotobuf.ImportWalletRequest")
+	proto.RegisterType((*ImportWalletResponse)(nil), "rpcprotobuf.ImportWalletResponse")
+	proto.RegisterType((*ImportMnemonicRequest)(nil), "rpcprotobuf.ImportMnemonicRequest")
+	proto.RegisterType((*ImportWatchOnlyRequest)(nil), "rpcprotobuf.ImportWatchOnlyRequest")
+	proto.RegisterType((*ImportMultisigRequest)(nil), "rpcprotobuf.ImportMultisigRequest")
+	proto.RegisterType((*ExportWatchOnlyRequest)(nil), "rpcprotobuf.ExportWatchOnlyRequest")
+	proto.RegisterType((*ExportWatchOnlyResponse)(nil), "rpcprotobuf.ExportWatchOnlyResponse")
+	proto.RegisterType((*ExportWalletRequest)(nil), "rpcprotobuf.ExportWalletRequest")
+	proto.RegisterType((*ExportWalletResponse)(nil), "rpcprotobuf.ExportWalletResponse")
+	proto.RegisterType((*RemoveWalletRequest)(nil), "rpcprotobuf.RemoveWalletRequest")
+	proto.RegisterType((*RemoveWalletResponse)(nil), "rpcprotobuf.RemoveWalletResponse")
+	proto.RegisterType((*RescanWalletRequest)(nil), "rpcprotobuf.RescanWalletRequest")
+	proto.RegisterType((*RescanWalletResponse)(nil), "rpcprotobuf.RescanWalletResponse")
+	proto.RegisterType((*BackupWalletRequest)(nil), "rpcprotobuf.BackupWalletRequest")
+	proto.RegisterType((*BackupWalletResponse)(nil), "rpcprotobuf.BackupWalletResponse")
+	proto.RegisterType((*UnlockWalletRequest)(nil), "rpcprotobuf.UnlockWalletRequest")
+	proto.RegisterType((*UnlockWalletResponse)(nil), "rpcprotobuf.UnlockWalletResponse")
+	proto.RegisterType((*LockWalletRequest)(nil), "rpcprotobuf.LockWalletRequest")
+	proto.RegisterType((*LockWalletResponse)(nil), "rpcprotobuf.LockWalletResponse")
+	proto.RegisterType((*GetAddressBalanceRequest)(nil), "rpcprotobuf.GetAddressBalanceRequest")
+	proto.RegisterType((*AddressAndBalance)(nil), "rpcprotobuf.AddressAndBalance")
+	proto.RegisterType((*GetAddressBalanceResponse)(nil), "rpcprotobuf.GetAddressBalanceResponse")
+	proto.RegisterType((*ValidateAddressRequest)(nil), "rpcprotobuf.ValidateAddressRequest")
+	proto.RegisterType((*ValidateAddressResponse)(nil), "rpcprotobuf.ValidateAddressResponse")
+	proto.RegisterType((*SignMessageRequest)(nil), "rpcprotobuf.SignMessageRequest")
+	proto.RegisterType((*SignMessageResponse)(nil), "rpcprotobuf.SignMessageResponse")
+	proto.RegisterType((*VerifyMessageRequest)(nil), "rpcprotobuf.VerifyMessageRequest")
+	proto.RegisterType((*VerifyMessageResponse)(nil), "rpcprotobuf.VerifyMessageResponse")
+	proto.RegisterType((*CreateAddressRequest)(nil), "rpcprotobuf.CreateAddressRequest")
+	proto.RegisterType((*CreateAddressResponse)(nil), "rpcprotobuf.CreateAddressResponse")
+	proto.RegisterType((*GetAddressesRequest)(nil), "rpcprotobuf.GetAddressesRequest")
+	proto.RegisterType((*GetAddressesResponse)(nil), "rpcprotobuf.GetAddressesResponse")
+	proto.RegisterType((*GetAddressesResponse_AddressDetail)(nil), "rpcprotobuf.GetAddressesResponse.AddressDetail")
+	proto.RegisterType((*GetWalletBalanceRequest)(nil), "rpcprotobuf.GetWalletBalanceRequest")
+	proto.RegisterType((*GetWalletBalanceResponse)(nil), "rpcprotobuf.GetWalletBalanceResponse")
+	proto.RegisterType((*GetWalletBalanceResponse_Detail)(nil), "rpcprotobuf.GetWalletBalanceResponse.Detail")
+	proto.RegisterType((*TxHistoryDetails)(nil), "rpcprotobuf.TxHistoryDetails")
+	proto.RegisterType((*TxHistoryDetails_Input)(nil), "rpcprotobuf.TxHistoryDetails.Input")
+	proto.RegisterType((*TxHistoryDetails_Output)(nil), "rpcprotobuf.TxHistoryDetails.Output")
+	proto.RegisterType((*TxHistoryResponse)(nil), "rpcprotobuf.TxHistoryResponse")
+	proto.RegisterType((*TxHistoryRequest)(nil), "rpcprotobuf.TxHistoryRequest")
+	proto.RegisterType((*ListTxHistoryRequest)(nil), "rpcprotobuf.ListTxHistoryRequest")
+	proto.RegisterType((*ListTxHistoryResponse)(nil), "rpcprotobuf.ListTxHistoryResponse")
+	proto.RegisterType((*ListTxHistoryResponse_History)(nil), "rpcprotobuf.ListTxHistoryResponse.History")
+	proto.RegisterType((*ExportHistoryRequest)(nil), "rpcprotobuf.ExportHistoryRequest")
+	proto.RegisterType((*ExportHistoryResponse)(nil), "rpcprotobuf.ExportHistoryResponse")
+	proto.RegisterType((*TransactionInput)(nil), "rpcprotobuf.TransactionInput")
+	proto.RegisterType((*DecodeRawTransactionRequest)(nil), "rpcprotobuf.DecodeRawTransactionRequest")
+	proto.RegisterType((*DecodeRawTransactionResponse)(nil), "rpcprotobuf.DecodeRawTransactionResponse")
+	proto.RegisterType((*DecodeRawTransactionResponse_Vin)(nil), "rpcprotobuf.DecodeRawTransactionResponse.Vin")
+	proto.RegisterType((*DecodeRawTransactionResponse_Vout)(nil), "rpcprotobuf.DecodeRawTransactionResponse.Vout")
+	proto.RegisterType((*CreateRawTransactionRequest)(nil), "rpcprotobuf.CreateRawTransactionRequest")
+	proto.RegisterType((*AutoCreateTransactionRequest)(nil), "rpcprotobuf.AutoCreateTransactionRequest")
+	proto.RegisterType((*CreateRawTransactionResponse)(nil), "rpcprotobuf.CreateRawTransactionResponse")
+	proto.RegisterType((*CreateStakingTransactionRequest)(nil), "rpcprotobuf.CreateStakingTransactionRequest")
+	proto.RegisterType((*GetBlockStakingRewardRequest)(nil), "rpcprotobuf.GetBlockStakingRewardRequest")
+	proto.RegisterType((*GetBlockStakingRewardResponse)(nil), "rpcprotobuf.GetBlockStakingRewardResponse")
+	proto.RegisterType((*GetBlockStakingRewardResponse_RewardDetail)(nil), "rpcprotobuf.GetBlockStakingRewardResponse.RewardDetail")
+	proto.RegisterType((*GetStakingHistoryRequest)(nil), "rpcprotobuf.GetStakingHistoryRequest")
+	proto.RegisterType((*GetStakingHistoryResponse)(nil), "rpcprotobuf.GetStakingHistoryResponse")
+	proto.RegisterType((*GetStakingHistoryResponse_StakingUTXO)(nil), "rpcprotobuf.GetStakingHistoryResponse.StakingUTXO")
+	proto.RegisterType((*GetStakingHistoryResponse_Tx)(nil), "rpcprotobuf.GetStakingHistoryResponse.Tx")
+	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcprotobuf.SendRawTransactionRequest")
+	proto.RegisterType((*SendRawTransactionResponse)(nil), "rpcprotobuf.SendRawTransactionResponse")
+	proto.RegisterType((*GetTransactionFeeRequest)(nil), "rpcprotobuf.GetTransactionFeeRequest")
+	proto.RegisterType((*GetTransactionFeeResponse)(nil), "rpcprotobuf.GetTransactionFeeResponse")
+	proto.RegisterType((*EstimateFeeRateRequest)(nil), "rpcprotobuf.EstimateFeeRateRequest")
+	proto.RegisterType((*EstimateFeeRateResponse)(nil), "rpcprotobuf.EstimateFeeRateResponse")
+	proto.RegisterType((*BlockInfoForTx)(nil), "rpcprotobuf.BlockInfoForTx")
+	proto.RegisterType((*Vin)(nil), "rpcprotobuf.Vin")
+	proto.RegisterType((*Vin_RedeemDetail)(nil), "rpcprotobuf.Vin.RedeemDetail")
+	proto.RegisterType((*Vout)(nil), "rpcprotobuf.Vout")
+	proto.RegisterType((*Vout_ScriptDetail)(nil), "rpcprotobuf.Vout.ScriptDetail")
+	proto.RegisterType((*GetRawTransactionRequest)(nil), "rpcprotobuf.GetRawTransactionRequest")
+	proto.RegisterType((*GetRawTransactionResponse)(nil), "rpcprotobuf.GetRawTransactionResponse")
+	proto.RegisterType((*GetTxStatusRequest)(nil), "rpcprotobuf.GetTxStatusRequest")
+	proto.RegisterType((*GetTxStatusResponse)(nil), "rpcprotobuf.GetTxStatusResponse")
+	proto.RegisterType((*ListUnconfirmedRequest)(nil), "rpcprotobuf.ListUnconfirmedRequest")
+	proto.RegisterType((*ListUnconfirmedResponse)(nil), "rpcprotobuf.ListUnconfirmedResponse")
+	proto.RegisterType((*ListUnconfirmedResponse_UnconfirmedTx)(nil), "rpcprotobuf.ListUnconfirmedResponse.UnconfirmedTx")
+	proto.RegisterType((*AbandonTransactionRequest)(nil), "rpcprotobuf.AbandonTransactionRequest")
+	proto.RegisterType((*AbandonTransactionResponse)(nil), "rpcprotobuf.AbandonTransactionResponse")
+	proto.RegisterType((*CreatePayoutBatchRequest)(nil), "rpcprotobuf.CreatePayoutBatchRequest")
+	proto.RegisterType((*CreatePayoutBatchRequest_Payout)(nil), "rpcprotobuf.CreatePayoutBatchRequest.Payout")
+	proto.RegisterType((*GetPayoutBatchRequest)(nil), "rpcprotobuf.GetPayoutBatchRequest")
+	proto.RegisterType((*PayoutBatchResponse)(nil), "rpcprotobuf.PayoutBatchResponse")
+	proto.RegisterType((*PayoutBatchResponse_Payout)(nil), "rpcprotobuf.PayoutBatchResponse.Payout")
+	proto.RegisterType((*PayoutBatchResponse_PayoutTx)(nil), "rpcprotobuf.PayoutBatchResponse.PayoutTx")
+	proto.RegisterType((*SignRawTransactionRequest)(nil), "rpcprotobuf.SignRawTransactionRequest")
+	proto.RegisterType((*SignRawTransactionResponse)(nil), "rpcprotobuf.SignRawTransactionResponse")
+	proto.RegisterType((*CreatePsbtRequest)(nil), "rpcprotobuf.CreatePsbtRequest")
+	proto.RegisterType((*PsbtResponse)(nil), "rpcprotobuf.PsbtResponse")
+	proto.RegisterType((*DecodePsbtRequest)(nil), "rpcprotobuf.DecodePsbtRequest")
+	proto.RegisterType((*DecodePsbtResponse)(nil), "rpcprotobuf.DecodePsbtResponse")
+	proto.RegisterType((*DecodePsbtResponse_Derivation)(nil), "rpcprotobuf.DecodePsbtResponse.Derivation")
+	proto.RegisterType((*DecodePsbtResponse_PartialSig)(nil), "rpcprotobuf.DecodePsbtResponse.PartialSig")
+	proto.RegisterType((*DecodePsbtResponse_Input)(nil), "rpcprotobuf.DecodePsbtResponse.Input")
+	proto.RegisterType((*SignPsbtRequest)(nil), "rpcprotobuf.SignPsbtRequest")
+	proto.RegisterType((*SignPsbtResponse)(nil), "rpcprotobuf.SignPsbtResponse")
+	proto.RegisterType((*CombinePsbtRequest)(nil), "rpcprotobuf.CombinePsbtRequest")
+	proto.RegisterType((*FinalizePsbtRequest)(nil), "rpcprotobuf.FinalizePsbtRequest")
+	proto.RegisterType((*FinalizePsbtResponse)(nil), "rpcprotobuf.FinalizePsbtResponse")
+	proto.RegisterType((*GetUtxoRequest)(nil), "rpcprotobuf.GetUtxoRequest")
+	proto.RegisterType((*UTXO)(nil), "rpcprotobuf.UTXO")
+	proto.RegisterType((*AddressUTXO)(nil), "rpcprotobuf.AddressUTXO")
+	proto.RegisterType((*GetUtxoResponse)(nil), "rpcprotobuf.GetUtxoResponse")
+	proto.RegisterType((*LockUnspentRequest)(nil), "rpcprotobuf.LockUnspentRequest")
+	proto.RegisterType((*LockedUnspent)(nil), "rpcprotobuf.LockedUnspent")
+	proto.RegisterType((*LockUnspentResponse)(nil), "rpcprotobuf.LockUnspentResponse")
+	proto.RegisterType((*UnlockUnspentRequest)(nil), "rpcprotobuf.UnlockUnspentRequest")
+	proto.RegisterType((*UnlockUnspentResponse)(nil), "rpcprotobuf.UnlockUnspentResponse")
+	proto.RegisterType((*ListLockUnspentRequest)(nil), "rpcprotobuf.ListLockUnspentRequest")
+	proto.RegisterType((*ListLockUnspentResponse)(nil), "rpcprotobuf.ListLockUnspentResponse")
+	proto.RegisterType((*SetLabelRequest)(nil), "rpcprotobuf.SetLabelRequest")
+	proto.RegisterType((*SetLabelResponse)(nil), "rpcprotobuf.SetLabelResponse")
+	proto.RegisterType((*GetLabelsRequest)(nil), "rpcprotobuf.GetLabelsRequest")
+	proto.RegisterType((*SearchLabelsRequest)(nil), "rpcprotobuf.SearchLabelsRequest")
+	proto.RegisterType((*LabelsResponse)(nil), "rpcprotobuf.LabelsResponse")
+	proto.RegisterType((*GetBindingHistoryRequest)(nil), "rpcprotobuf.GetBindingHistoryRequest")
+	proto.RegisterType((*GetBindingHistoryResponse)(nil), "rpcprotobuf.GetBindingHistoryResponse")
+	proto.RegisterType((*GetBindingHistoryResponse_BindingUTXO)(nil), "rpcprotobuf.GetBindingHistoryResponse.BindingUTXO")
+	proto.RegisterType((*GetBindingHistoryResponse_History)(nil), "rpcprotobuf.GetBindingHistoryResponse.History")
+	proto.RegisterType((*CreateBindingTransactionRequest)(nil), "rpcprotobuf.CreateBindingTransactionRequest")
+	proto.RegisterType((*CreateBindingTransactionRequest_Output)(nil), "rpcprotobuf.CreateBindingTransactionRequest.Output")
+	proto.RegisterType((*CreateWithdrawTransactionRequest)(nil), "rpcprotobuf.CreateWithdrawTransactionRequest")
+	proto.RegisterType((*GetWalletMnemonicRequest)(nil), "rpcprotobuf.GetWalletMnemonicRequest")
+	proto.RegisterType((*GetWalletMnemonicResponse)(nil), "rpcprotobuf.GetWalletMnemonicResponse")
+	proto.RegisterType((*GetBlockByHeightRequest)(nil), "rpcprotobuf.GetBlockByHeightRequest")
+	proto.RegisterType((*GetBlockResponse)(nil), "rpcprotobuf.GetBlockResponse")
+	proto.RegisterType((*GetBlockResponse_Proof)(nil), "rpcprotobuf.GetBlockResponse.Proof")
+	proto.RegisterType((*GetBlockResponse_PoCSignature)(nil), "rpcprotobuf.GetBlockResponse.PoCSignature")
+	proto.RegisterType((*GetBlockResponse_ProposalArea)(nil), "rpcprotobuf.GetBlockResponse.ProposalArea")
+	proto.RegisterType((*GetBlockResponse_ProposalArea_FaultPubKey)(nil), "rpcprotobuf.GetBlockResponse.ProposalArea.FaultPubKey")
+	proto.RegisterType((*GetBlockResponse_ProposalArea_FaultPubKey_Header)(nil), "rpcprotobuf.GetBlockResponse.ProposalArea.FaultPubKey.Header")
+	proto.RegisterType((*GetBlockResponse_ProposalArea_NormalProposal)(nil), "rpcprotobuf.GetBlockResponse.ProposalArea.NormalProposal")
+	proto.RegisterType((*GetBlockResponse_TxRawResult)(nil), "rpcprotobuf.GetBlockResponse.TxRawResult")
+	proto.RegisterType((*CreatePoolPkCoinbaseTransactionRequest)(nil), "rpcprotobuf.CreatePoolPkCoinbaseTransactionRequest")
+	proto.RegisterType((*CheckPoolPkCoinbaseRequest)(nil), "rpcprotobuf.CheckPoolPkCoinbaseRequest")
+	proto.RegisterType((*CheckPoolPkCoinbaseResponse)(nil), "rpcprotobuf.CheckPoolPkCoinbaseResponse")
+	proto.RegisterType((*CheckPoolPkCoinbaseResponse_Info)(nil), "rpcprotobuf.CheckPoolPkCoinbaseResponse.Info")
+	proto.RegisterType((*GetNetworkBindingRequest)(nil), "rpcprotobuf.GetNetworkBindingRequest")
+	proto.RegisterType((*GetNetworkBindingResponse)(nil), "rpcprotobuf.GetNetworkBindingResponse")
+	proto.RegisterType((*CheckTargetBindingRequest)(nil), "rpcprotobuf.CheckTargetBindingRequest")
+	proto.RegisterType((*CheckTargetBindingResponse)(nil), "rpcprotobuf.CheckTargetBindingResponse")
+	proto.RegisterType((*CheckTargetBindingResponse_Info)(nil), "rpcprotobuf.CheckTargetBindingResponse.Info")
+	proto.RegisterType((*EventCursor)(nil), "rpcprotobuf.EventCursor")
+	proto.RegisterType((*SubscribeWalletEventsRequest)(nil), "rpcprotobuf.SubscribeWalletEventsRequest")
+	proto.RegisterType((*WalletEvent)(nil), "rpcprotobuf.WalletEvent")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApiService service
+
+type ApiServiceClient interface {
+	GetBestBlock(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetBlockResponse, error)
+	GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
+	GetBlockStakingReward(ctx context.Context, in *GetBlockStakingRewardRequest, opts ...grpc.CallOption) (*GetBlockStakingRewardResponse, error)
+	GetClientStatus(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetClientStatusResponse, error)
+	QuitClient(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*QuitClientResponse, error)
+	// commands act on a wallet
+	Wallets(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*WalletsResponse, error)
+	// just create non-poc wallet
+	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
+	UseWallet(ctx context.Context, in *UseWalletRequest, opts ...grpc.CallOption) (*UseWalletResponse, error)
+	ImportWallet(ctx context.Context, in *ImportWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
+	ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
+	ImportWatchOnly(ctx context.Context, in *ImportWatchOnlyRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
+	ImportMultisig(ctx context.Context, in *ImportMultisigRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
+	ExportWatchOnly(ctx context.Context, in *ExportWatchOnlyRequest, opts ...grpc.CallOption) (*ExportWatchOnlyResponse, error)
+	ExportWallet(ctx context.Context, in *ExportWalletRequest, opts ...grpc.CallOption) (*ExportWalletResponse, error)
+	RemoveWallet(ctx context.Context, in *RemoveWalletRequest, opts ...grpc.CallOption) (*RemoveWalletResponse, error)
+	RescanWallet(ctx context.Context, in *RescanWalletRequest, opts ...grpc.CallOption) (*RescanWalletResponse, error)
+	BackupWallet(ctx context.Context, in *BackupWalletRequest, opts ...grpc.CallOption) (*BackupWalletResponse, error)
+	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
+	LockWallet(ctx context.Context, in *LockWalletRequest, opts ...grpc.CallOption) (*LockWalletResponse, error)
+	GetWalletMnemonic(ctx context.Context, in *GetWalletMnemonicRequest, opts ...grpc.CallOption) (*GetWalletMnemonicResponse, error)
+	GetWalletBalance(ctx context.Context, in *GetWalletBalanceRequest, opts ...grpc.CallOption) (*GetWalletBalanceResponse, error)
+	CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*CreateAddressResponse, error)
+	GetAddresses(ctx context.Context, in *GetAddressesRequest, opts ...grpc.CallOption) (*GetAddressesResponse, error)
+	// if addresses not provided, return balances of all addresses
+	GetAddressBalance(ctx context.Context, in *GetAddressBalanceRequest, opts ...grpc.CallOption) (*GetAddressBalanceResponse, error)
+	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
+	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
+	VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error)
+	// if addresses not provided, return utxos of all addresses
+	GetUtxo(ctx context.Context, in *GetUtxoRequest, opts ...grpc.CallOption) (*GetUtxoResponse, error)
+	LockUnspent(ctx context.Context, in *LockUnspentRequest, opts ...grpc.CallOption) (*LockUnspentResponse, error)
+	UnlockUnspent(ctx context.Context, in *UnlockUnspentRequest, opts ...grpc.CallOption) (*UnlockUnspentResponse, error)
+	ListLockUnspent(ctx context.Context, in *ListLockUnspentRequest, opts ...grpc.CallOption) (*ListLockUnspentResponse, error)
+	// label an address or note a transaction of the wallet
+	SetLabel(ctx context.Context, in *SetLabelRequest, opts ...grpc.CallOption) (*SetLabelResponse, error)
+	GetLabels(ctx context.Context, in *GetLabelsRequest, opts ...grpc.CallOption) (*LabelsResponse, error)
+	SearchLabels(ctx context.Context, in *SearchLabelsRequest, opts ...grpc.CallOption) (*LabelsResponse, error)
+	DecodeRawTransaction(ctx context.Context, in *DecodeRawTransactionRequest, opts ...grpc.CallOption) (*DecodeRawTransactionResponse, error)
+	CreateRawTransaction(ctx context.Context, in *CreateRawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	AutoCreateTransaction(ctx context.Context, in *AutoCreateTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	SignRawTransaction(ctx context.Context, in *SignRawTransactionRequest, opts ...grpc.CallOption) (*SignRawTransactionResponse, error)
+	CreatePsbt(ctx context.Context, in *CreatePsbtRequest, opts ...grpc.CallOption) (*PsbtResponse, error)
+	DecodePsbt(ctx context.Context, in *DecodePsbtRequest, opts ...grpc.CallOption) (*DecodePsbtResponse, error)
+	SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error)
+	CombinePsbt(ctx context.Context, in *CombinePsbtRequest, opts ...grpc.CallOption) (*PsbtResponse, error)
+	FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error)
+	GetTransactionFee(ctx context.Context, in *GetTransactionFeeRequest, opts ...grpc.CallOption) (*GetTransactionFeeResponse, error)
+	EstimateFeeRate(ctx context.Context, in *EstimateFeeRateRequest, opts ...grpc.CallOption) (*EstimateFeeRateResponse, error)
+	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error)
+	// get tx from chaindb
+	GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
+	GetTxStatus(ctx context.Context, in *GetTxStatusRequest, opts ...grpc.CallOption) (*GetTxStatusResponse, error)
+	// unmined transactions of the wallet
+	ListUnconfirmed(ctx context.Context, in *ListUnconfirmedRequest, opts ...grpc.CallOption) (*ListUnconfirmedResponse, error)
+	// remove an unmined transaction dropped by the mempool and release its inputs
+	AbandonTransaction(ctx context.Context, in *AbandonTransactionRequest, opts ...grpc.CallOption) (*AbandonTransactionResponse, error)
+	// pay a list of payouts, a repeated request of the same payout keys returns the stored batch
+	CreatePayoutBatch(ctx context.Context, in *CreatePayoutBatchRequest, opts ...grpc.CallOption) (*PayoutBatchResponse, error)
+	GetPayoutBatch(ctx context.Context, in *GetPayoutBatchRequest, opts ...grpc.CallOption) (*PayoutBatchResponse, error)
+	CreateStakingTransaction(ctx context.Context, in *CreateStakingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	TxHistory(ctx context.Context, in *TxHistoryRequest, opts ...grpc.CallOption) (*TxHistoryResponse, error)
+	ListTxHistory(ctx context.Context, in *ListTxHistoryRequest, opts ...grpc.CallOption) (*ListTxHistoryResponse, error)
+	ExportHistory(ctx context.Context, in *ExportHistoryRequest, opts ...grpc.CallOption) (ApiService_ExportHistoryClient, error)
+	GetStakingHistory(ctx context.Context, in *GetStakingHistoryRequest, opts ...grpc.CallOption) (*GetStakingHistoryResponse, error)
+	GetBindingHistory(ctx context.Context, in *GetBindingHistoryRequest, opts ...grpc.CallOption) (*GetBindingHistoryResponse, error)
+	CreateBindingTransaction(ctx context.Context, in *CreateBindingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	CreateWithdrawStakingTransaction(ctx context.Context, in *CreateWithdrawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	CreateWithdrawBindingTransaction(ctx context.Context, in *CreateWithdrawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	CreatePoolPkCoinbaseTransaction(ctx context.Context, in *CreatePoolPkCoinbaseTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
+	GetNetworkBinding(ctx context.Context, in *GetNetworkBindingRequest, opts ...grpc.CallOption) (*GetNetworkBindingResponse, error)
+	CheckPoolPkCoinbase(ctx context.Context, in *CheckPoolPkCoinbaseRequest, opts ...grpc.CallOption) (*CheckPoolPkCoinbaseResponse, error)
+	CheckTargetBinding(ctx context.Context, in *CheckTargetBindingRequest, opts ...grpc.CallOption) (*CheckTargetBindingResponse, error)
+	SubscribeWalletEvents(ctx context.Context, in *SubscribeWalletEventsRequest, opts ...grpc.CallOption) (ApiService_SubscribeWalletEventsClient, error)
+}
+
+type apiServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
+	return &apiServiceClient{cc}
+}
+
+func (c *apiServiceClient) GetBestBlock(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetBlockResponse, error) {
+	out := new(GetBlockResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBestBlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
+	out := new(GetBlockResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBlockByHeight", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockStakingReward(ctx context.Context, in *GetBlockStakingRewardRequest, opts ...grpc.CallOption) (*GetBlockStakingRewardResponse, error) {
+	out := new(GetBlockStakingRewardResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBlockStakingReward", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetClientStatus(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetClientStatusResponse, error) {
+	out := new(GetClientStatusResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetClientStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) QuitClient(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*QuitClientResponse, error) {
+	out := new(QuitClientResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/QuitClient", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Wallets(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*WalletsResponse, error) {
+	out := new(WalletsResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/Wallets", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
+	out := new(CreateWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) UseWallet(ctx context.Context, in *UseWalletRequest, opts ...grpc.CallOption) (*UseWalletResponse, error) {
+	out := new(UseWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/UseWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ImportWallet(ctx context.Context, in *ImportWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
+	out := new(ImportWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
+	out := new(ImportWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportMnemonic", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ImportWatchOnly(ctx context.Context, in *ImportWatchOnlyRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
+	out := new(ImportWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportWatchOnly", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ImportMultisig(ctx context.Context, in *ImportMultisigRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
+	out := new(ImportWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportMultisig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ExportWatchOnly(ctx context.Context, in *ExportWatchOnlyRequest, opts ...grpc.CallOption) (*ExportWatchOnlyResponse, error) {
+	out := new(ExportWatchOnlyResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ExportWatchOnly", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ExportWallet(ctx context.Context, in *ExportWalletRequest, opts ...grpc.CallOption) (*ExportWalletResponse, error) {
+	out := new(ExportWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ExportWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) RemoveWallet(ctx context.Context, in *RemoveWalletRequest, opts ...grpc.CallOption) (*RemoveWalletResponse, error) {
+	out := new(RemoveWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/RemoveWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) RescanWallet(ctx context.Context, in *RescanWalletRequest, opts ...grpc.CallOption) (*RescanWalletResponse, error) {
+	out := new(RescanWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/RescanWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) BackupWallet(ctx context.Context, in *BackupWalletRequest, opts ...grpc.CallOption) (*BackupWalletResponse, error) {
+	out := new(BackupWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/BackupWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
+	out := new(UnlockWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/UnlockWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) LockWallet(ctx context.Context, in *LockWalletRequest, opts ...grpc.CallOption) (*LockWalletResponse, error) {
+	out := new(LockWalletResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/LockWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetWalletMnemonic(ctx context.Context, in *GetWalletMnemonicRequest, opts ...grpc.CallOption) (*GetWalletMnemonicResponse, error) {
+	out := new(GetWalletMnemonicResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetWalletMnemonic", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetWalletBalance(ctx context.Context, in *GetWalletBalanceRequest, opts ...grpc.CallOption) (*GetWalletBalanceResponse, error) {
+	out := new(GetWalletBalanceResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetWalletBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*CreateAddressResponse, error) {
+	out := new(CreateAddressResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAddresses(ctx context.Context, in *GetAddressesRequest, opts ...grpc.CallOption) (*GetAddressesResponse, error) {
+	out := new(GetAddressesResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddresses", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAddressBalance(ctx context.Context, in *GetAddressBalanceRequest, opts ...grpc.CallOption) (*GetAddressBalanceResponse, error) {
+	out := new(GetAddressBalanceResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddressBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error) {
+	out := new(ValidateAddressResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ValidateAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
+	out := new(SignMessageResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SignMessage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error) {
+	out := new(VerifyMessageResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/VerifyMessage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetUtxo(ctx context.Context, in *GetUtxoRequest, opts ...grpc.CallOption) (*GetUtxoResponse, error) {
+	out := new(GetUtxoResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetUtxo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) LockUnspent(ctx context.Context, in *LockUnspentRequest, opts ...grpc.CallOption) (*LockUnspentResponse, error) {
+	out := new(LockUnspentResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/LockUnspent", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) UnlockUnspent(ctx context.Context, in *UnlockUnspentRequest, opts ...grpc.CallOption) (*UnlockUnspentResponse, error) {
+	out := new(UnlockUnspentResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/UnlockUnspent", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) ListLockUnspent(ctx context.Context, in *ListLockUnspentRequest, opts ...grpc.CallOption) (*ListLockUnspentResponse, error) {
+	out := new(ListLockUnspentResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ListLockUnspent", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SetLabel(ctx context.Context, in *SetLabelRequest, opts ...grpc.CallOption) (*SetLabelResponse, error) {
+	out := new(SetLabelResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SetLabel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetLabels(ctx context.Context, in *GetLabelsRequest, opts ...grpc.CallOption) (*LabelsResponse, error) {
+	out := new(LabelsResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetLabels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SearchLabels(ctx context.Context, in *SearchLabelsRequest, opts ...grpc.CallOption) (*LabelsResponse, error) {
+	out := new(LabelsResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SearchLabels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) DecodeRawTransaction(ctx context.Context, in *DecodeRawTransactionRequest, opts ...grpc.CallOption) (*DecodeRawTransactionResponse, error) {
+	out := new(DecodeRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/DecodeRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreateRawTransaction(ctx context.Context, in *CreateRawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) AutoCreateTransaction(ctx context.Context, in *AutoCreateTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/AutoCreateTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SignRawTransaction(ctx context.Context, in *SignRawTransactionRequest, opts ...grpc.CallOption) (*SignRawTransactionResponse, error) {
+	out := new(SignRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SignRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CreatePsbt(ctx context.Context, in *CreatePsbtRequest, opts ...grpc.CallOption) (*PsbtResponse, error) {
+	out := new(PsbtResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreatePsbt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) DecodePsbt(ctx context.Context, in *DecodePsbtRequest, opts ...grpc.CallOption) (*DecodePsbtResponse, error) {
+	out := new(DecodePsbtResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/DecodePsbt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SignPsbt(ctx context.Context, in *SignPsbtRequest, opts ...grpc.CallOption) (*SignPsbtResponse, error) {
+	out := new(SignPsbtResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SignPsbt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CombinePsbt(ctx context.Context, in *CombinePsbtRequest, opts ...grpc.CallOption) (*PsbtResponse, error) {
+	out := new(PsbtResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CombinePsbt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) FinalizePsbt(ctx context.Context, in *FinalizePsbtRequest, opts ...grpc.CallOption) (*FinalizePsbtResponse, error) {
+	out := new(FinalizePsbtResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/FinalizePsbt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTransactionFee(ctx context.Context, in *GetTransactionFeeRequest, opts ...grpc.CallOption) (*GetTransactionFeeResponse, error) {
+	out := new(GetTransactionFeeResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetTransactionFee", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) EstimateFeeRate(ctx context.Context, in *EstimateFeeRateRequest, opts ...grpc.CallOption) (*EstimateFeeRateResponse, error) {
+	out := new(EstimateFeeRateResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/EstimateFeeRate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error) {
+	out := new(SendRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SendRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (c *apiServiceClient) GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
+	out := new(GetRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (c *apiServiceClient) GetTxStatus(ctx context.Context, in *GetTxStatusRequest, opts ...grpc.CallOption) (*GetTxStatusResponse, error) {
+	out := new(GetTxStatusResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetTxStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// Client API for ApiService service
+func (c *apiServiceClient) ListUnconfirmed(ctx context.Context, in *ListUnconfirmedRequest, opts ...grpc.CallOption) (*ListUnconfirmedResponse, error) {
+	out := new(ListUnconfirmedResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ListUnconfirmed", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-type ApiServiceClient interface {
-	GetBestBlock(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetBlockResponse, error)
-	GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
-	GetBlockStakingReward(ctx context.Context, in *GetBlockStakingRewardRequest, opts ...grpc.CallOption) (*GetBlockStakingRewardResponse, error)
-	GetClientStatus(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetClientStatusResponse, error)
-	QuitClient(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*QuitClientResponse, error)
-	// commands act on a wallet
-	Wallets(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*WalletsResponse, error)
-	// just create non-poc wallet
-	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
-	UseWallet(ctx context.Context, in *UseWalletRequest, opts ...grpc.CallOption) (*UseWalletResponse, error)
-	ImportWallet(ctx context.Context, in *ImportWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
-	ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error)
-	ExportWallet(ctx context.Context, in *ExportWalletRequest, opts ...grpc.CallOption) (*ExportWalletResponse, error)
-	RemoveWallet(ctx context.Context, in *RemoveWalletRequest, opts ...grpc.CallOption) (*RemoveWalletResponse, error)
-	GetWalletMnemonic(ctx context.Context, in *GetWalletMnemonicRequest, opts ...grpc.CallOption) (*GetWalletMnemonicResponse, error)
-	GetWalletBalance(ctx context.Context, in *GetWalletBalanceRequest, opts ...grpc.CallOption) (*GetWalletBalanceResponse, error)
-	CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*CreateAddressResponse, error)
-	GetAddresses(ctx context.Context, in *GetAddressesRequest, opts ...grpc.CallOption) (*GetAddressesResponse, error)
-	// if addresses not provided, return balances of all addresses
-	GetAddressBalance(ctx context.Context, in *GetAddressBalanceRequest, opts ...grpc.CallOption) (*GetAddressBalanceResponse, error)
-	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
-	// if addresses not provided, return utxos of all addresses
-	GetUtxo(ctx context.Context, in *GetUtxoRequest, opts ...grpc.CallOption) (*GetUtxoResponse, error)
-	DecodeRawTransaction(ctx context.Context, in *DecodeRawTransactionRequest, opts ...grpc.CallOption) (*DecodeRawTransactionResponse, error)
-	CreateRawTransaction(ctx context.Context, in *CreateRawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
-	AutoCreateTransaction(ctx context.Context, in *AutoCreateTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
-	SignRawTransaction(ctx context.Context, in *SignRawTransactionRequest, opts ...grpc.CallOption) (*SignRawTransactionResponse, error)
-	GetTransactionFee(ctx context.Context, in *GetTransactionFeeRequest, opts ...grpc.CallOption) (*GetTransactionFeeResponse, error)
-	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error)
-	// get tx from chaindb
-	GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error)
-	GetTxStatus(ctx context.Context, in *GetTxStatusRequest, opts ...grpc.CallOption) (*GetTxStatusResponse, error)
-	CreateStakingTransaction(ctx context.Context, in *CreateStakingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
-	TxHistory(ctx context.Context, in *TxHistoryRequest, opts ...grpc.CallOption) (*TxHistoryResponse, error)
-	GetStakingHistory(ctx context.Context, in *GetStakingHistoryRequest, opts ...grpc.CallOption) (*GetStakingHistoryResponse, error)
-	GetBindingHistory(ctx context.Context, in *GetBindingHistoryRequest, opts ...grpc.CallOption) (*GetBindingHistoryResponse, error)
-	CreateBindingTransaction(ctx context.Context, in *CreateBindingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
-	CreatePoolPkCoinbaseTransaction(ctx context.Context, in *CreatePoolPkCoinbaseTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error)
-	GetNetworkBinding(ctx context.Context, in *GetNetworkBindingRequest, opts ...grpc.CallOption) (*GetNetworkBindingResponse, error)
-	CheckPoolPkCoinbase(ctx context.Context, in *CheckPoolPkCoinbaseRequest, opts ...grpc.CallOption) (*CheckPoolPkCoinbaseResponse, error)
-	CheckTargetBinding(ctx context.Context, in *CheckTargetBindingRequest, opts ...grpc.CallOption) (*CheckTargetBindingResponse, error)
+func (c *apiServiceClient) AbandonTransaction(ctx context.Context, in *AbandonTransactionRequest, opts ...grpc.CallOption) (*AbandonTransactionResponse, error) {
+	out := new(AbandonTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/AbandonTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type apiServiceClient struct {
-	cc *grpc.ClientConn
+func (c *apiServiceClient) CreatePayoutBatch(ctx context.Context, in *CreatePayoutBatchRequest, opts ...grpc.CallOption) (*PayoutBatchResponse, error) {
+	out := new(PayoutBatchResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreatePayoutBatch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
-	return &apiServiceClient{cc}
+func (c *apiServiceClient) GetPayoutBatch(ctx context.Context, in *GetPayoutBatchRequest, opts ...grpc.CallOption) (*PayoutBatchResponse, error) {
+	out := new(PayoutBatchResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetPayoutBatch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *apiServiceClient) GetBestBlock(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetBlockResponse, error) {
-	out := new(GetBlockResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBestBlock", in, out, c.cc, opts...)
+func (c *apiServiceClient) CreateStakingTransaction(ctx context.Context, in *CreateStakingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateStakingTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
-	out := new(GetBlockResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBlockByHeight", in, out, c.cc, opts...)
+func (c *apiServiceClient) TxHistory(ctx context.Context, in *TxHistoryRequest, opts ...grpc.CallOption) (*TxHistoryResponse, error) {
+	out := new(TxHistoryResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/TxHistory", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetBlockStakingReward(ctx context.Context, in *GetBlockStakingRewardRequest, opts ...grpc.CallOption) (*GetBlockStakingRewardResponse, error) {
-	out := new(GetBlockStakingRewardResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBlockStakingReward", in, out, c.cc, opts...)
+func (c *apiServiceClient) ListTxHistory(ctx context.Context, in *ListTxHistoryRequest, opts ...grpc.CallOption) (*ListTxHistoryResponse, error) {
+	out := new(ListTxHistoryResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ListTxHistory", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetClientStatus(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*GetClientStatusResponse, error) {
-	out := new(GetClientStatusResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetClientStatus", in, out, c.cc, opts...)
+func (c *apiServiceClient) ExportHistory(ctx context.Context, in *ExportHistoryRequest, opts ...grpc.CallOption) (ApiService_ExportHistoryClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[0], c.cc, "/rpcprotobuf.ApiService/ExportHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceExportHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_ExportHistoryClient interface {
+	Recv() (*ExportHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceExportHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceExportHistoryClient) Recv() (*ExportHistoryResponse, error) {
+	m := new(ExportHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetStakingHistory(ctx context.Context, in *GetStakingHistoryRequest, opts ...grpc.CallOption) (*GetStakingHistoryResponse, error) {
+	out := new(GetStakingHistoryResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetStakingHistory", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) QuitClient(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*QuitClientResponse, error) {
-	out := new(QuitClientResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/QuitClient", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetBindingHistory(ctx context.Context, in *GetBindingHistoryRequest, opts ...grpc.CallOption) (*GetBindingHistoryResponse, error) {
+	out := new(GetBindingHistoryResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBindingHistory", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) Wallets(ctx context.Context, in *google_protobuf2.Empty, opts ...grpc.CallOption) (*WalletsResponse, error) {
-	out := new(WalletsResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/Wallets", in, out, c.cc, opts...)
+func (c *apiServiceClient) CreateBindingTransaction(ctx context.Context, in *CreateBindingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateBindingTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
-	out := new(CreateWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateWallet", in, out, c.cc, opts...)
+func (c *apiServiceClient) CreateWithdrawStakingTransaction(ctx context.Context, in *CreateWithdrawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateWithdrawStakingTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) UseWallet(ctx context.Context, in *UseWalletRequest, opts ...grpc.CallOption) (*UseWalletResponse, error) {
-	out := new(UseWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/UseWallet", in, out, c.cc, opts...)
+func (c *apiServiceClient) CreateWithdrawBindingTransaction(ctx context.Context, in *CreateWithdrawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateWithdrawBindingTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) ImportWallet(ctx context.Context, in *ImportWalletRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
-	out := new(ImportWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportWallet", in, out, c.cc, opts...)
+func (c *apiServiceClient) CreatePoolPkCoinbaseTransaction(ctx context.Context, in *CreatePoolPkCoinbaseTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
+	out := new(CreateRawTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreatePoolPkCoinbaseTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest, opts ...grpc.CallOption) (*ImportWalletResponse, error) {
-	out := new(ImportWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ImportMnemonic", in, out, c.cc, opts...)
+func (c *apiServiceClient) GetNetworkBinding(ctx context.Context, in *GetNetworkBindingRequest, opts ...grpc.CallOption) (*GetNetworkBindingResponse, error) {
+	out := new(GetNetworkBindingResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetNetworkBinding", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) ExportWallet(ctx context.Context, in *ExportWalletRequest, opts ...grpc.CallOption) (*ExportWalletResponse, error) {
-	out := new(ExportWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ExportWallet", in, out, c.cc, opts...)
+func (c *apiServiceClient) CheckPoolPkCoinbase(ctx context.Context, in *CheckPoolPkCoinbaseRequest, opts ...grpc.CallOption) (*CheckPoolPkCoinbaseResponse, error) {
+	out := new(CheckPoolPkCoinbaseResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CheckPoolPkCoinbase", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) RemoveWallet(ctx context.Context, in *RemoveWalletRequest, opts ...grpc.CallOption) (*RemoveWalletResponse, error) {
-	out := new(RemoveWalletResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/RemoveWallet", in, out, c.cc, opts...)
+func (c *apiServiceClient) CheckTargetBinding(ctx context.Context, in *CheckTargetBindingRequest, opts ...grpc.CallOption) (*CheckTargetBindingResponse, error) {
+	out := new(CheckTargetBindingResponse)
+	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CheckTargetBinding", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetWalletMnemonic(ctx context.Context, in *GetWalletMnemonicRequest, opts ...grpc.CallOption) (*GetWalletMnemonicResponse, error) {
-	out := new(GetWalletMnemonicResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetWalletMnemonic", in, out, c.cc, opts...)
+func (c *apiServiceClient) SubscribeWalletEvents(ctx context.Context, in *SubscribeWalletEventsRequest, opts ...grpc.CallOption) (ApiService_SubscribeWalletEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[1], c.cc, "/rpcprotobuf.ApiService/SubscribeWalletEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &apiServiceSubscribeWalletEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeWalletEventsClient interface {
+	Recv() (*WalletEvent, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeWalletEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeWalletEventsClient) Recv() (*WalletEvent, error) {
+	m := new(WalletEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for ApiService service
+
+type ApiServiceServer interface {
+	GetBestBlock(context.Context, *google_protobuf2.Empty) (*GetBlockResponse, error)
+	GetBlockByHeight(context.Context, *GetBlockByHeightRequest) (*GetBlockResponse, error)
+	GetBlockStakingReward(context.Context, *GetBlockStakingRewardRequest) (*GetBlockStakingRewardResponse, error)
+	GetClientStatus(context.Context, *google_protobuf2.Empty) (*GetClientStatusResponse, error)
+	QuitClient(context.Context, *google_protobuf2.Empty) (*QuitClientResponse, error)
+	// commands act on a wallet
+	Wallets(context.Context, *google_protobuf2.Empty) (*WalletsResponse, error)
+	// just create non-poc wallet
+	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
+	UseWallet(context.Context, *UseWalletRequest) (*UseWalletResponse, error)
+	ImportWallet(context.Context, *ImportWalletRequest) (*ImportWalletResponse, error)
+	ImportMnemonic(context.Context, *ImportMnemonicRequest) (*ImportWalletResponse, error)
+	ImportWatchOnly(context.Context, *ImportWatchOnlyRequest) (*ImportWalletResponse, error)
+	ImportMultisig(context.Context, *ImportMultisigRequest) (*ImportWalletResponse, error)
+	ExportWatchOnly(context.Context, *ExportWatchOnlyRequest) (*ExportWatchOnlyResponse, error)
+	ExportWallet(context.Context, *ExportWalletRequest) (*ExportWalletResponse, error)
+	RemoveWallet(context.Context, *RemoveWalletRequest) (*RemoveWalletResponse, error)
+	RescanWallet(context.Context, *RescanWalletRequest) (*RescanWalletResponse, error)
+	BackupWallet(context.Context, *BackupWalletRequest) (*BackupWalletResponse, error)
+	UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error)
+	LockWallet(context.Context, *LockWalletRequest) (*LockWalletResponse, error)
+	GetWalletMnemonic(context.Context, *GetWalletMnemonicRequest) (*GetWalletMnemonicResponse, error)
+	GetWalletBalance(context.Context, *GetWalletBalanceRequest) (*GetWalletBalanceResponse, error)
+	CreateAddress(context.Context, *CreateAddressRequest) (*CreateAddressResponse, error)
+	GetAddresses(context.Context, *GetAddressesRequest) (*GetAddressesResponse, error)
+	// if addresses not provided, return balances of all addresses
+	GetAddressBalance(context.Context, *GetAddressBalanceRequest) (*GetAddressBalanceResponse, error)
+	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
+	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
+	VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error)
+	// if addresses not provided, return utxos of all addresses
+	GetUtxo(context.Context, *GetUtxoRequest) (*GetUtxoResponse, error)
+	LockUnspent(context.Context, *LockUnspentRequest) (*LockUnspentResponse, error)
+	UnlockUnspent(context.Context, *UnlockUnspentRequest) (*UnlockUnspentResponse, error)
+	ListLockUnspent(context.Context, *ListLockUnspentRequest) (*ListLockUnspentResponse, error)
+	// label an address or note a transaction of the wallet
+	SetLabel(context.Context, *SetLabelRequest) (*SetLabelResponse, error)
+	GetLabels(context.Context, *GetLabelsRequest) (*LabelsResponse, error)
+	SearchLabels(context.Context, *SearchLabelsRequest) (*LabelsResponse, error)
+	DecodeRawTransaction(context.Context, *DecodeRawTransactionRequest) (*DecodeRawTransactionResponse, error)
+	CreateRawTransaction(context.Context, *CreateRawTransactionRequest) (*CreateRawTransactionResponse, error)
+	AutoCreateTransaction(context.Context, *AutoCreateTransactionRequest) (*CreateRawTransactionResponse, error)
+	SignRawTransaction(context.Context, *SignRawTransactionRequest) (*SignRawTransactionResponse, error)
+	CreatePsbt(context.Context, *CreatePsbtRequest) (*PsbtResponse, error)
+	DecodePsbt(context.Context, *DecodePsbtRequest) (*DecodePsbtResponse, error)
+	SignPsbt(context.Context, *SignPsbtRequest) (*SignPsbtResponse, error)
+	CombinePsbt(context.Context, *CombinePsbtRequest) (*PsbtResponse, error)
+	FinalizePsbt(context.Context, *FinalizePsbtRequest) (*FinalizePsbtResponse, error)
+	GetTransactionFee(context.Context, *GetTransactionFeeRequest) (*GetTransactionFeeResponse, error)
+	EstimateFeeRate(context.Context, *EstimateFeeRateRequest) (*EstimateFeeRateResponse, error)
+	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendRawTransactionResponse, error)
+	// get tx from chaindb
+	GetRawTransaction(context.Context, *GetRawTransactionRequest) (*GetRawTransactionResponse, error)
+	GetTxStatus(context.Context, *GetTxStatusRequest) (*GetTxStatusResponse, error)
+	// unmined transactions of the wallet
+	ListUnconfirmed(context.Context, *ListUnconfirmedRequest) (*ListUnconfirmedResponse, error)
+	// remove an unmined transaction dropped by the mempool and release its inputs
+	AbandonTransaction(context.Context, *AbandonTransactionRequest) (*AbandonTransactionResponse, error)
+	// pay a list of payouts, a repeated request of the same payout keys returns the stored batch
+	CreatePayoutBatch(context.Context, *CreatePayoutBatchRequest) (*PayoutBatchResponse, error)
+	GetPayoutBatch(context.Context, *GetPayoutBatchRequest) (*PayoutBatchResponse, error)
+	CreateStakingTransaction(context.Context, *CreateStakingTransactionRequest) (*CreateRawTransactionResponse, error)
+	TxHistory(context.Context, *TxHistoryRequest) (*TxHistoryResponse, error)
+	ListTxHistory(context.Context, *ListTxHistoryRequest) (*ListTxHistoryResponse, error)
+	ExportHistory(*ExportHistoryRequest, ApiService_ExportHistoryServer) error
+	GetStakingHistory(context.Context, *GetStakingHistoryRequest) (*GetStakingHistoryResponse, error)
+	GetBindingHistory(context.Context, *GetBindingHistoryRequest) (*GetBindingHistoryResponse, error)
+	CreateBindingTransaction(context.Context, *CreateBindingTransactionRequest) (*CreateRawTransactionResponse, error)
+	CreateWithdrawStakingTransaction(context.Context, *CreateWithdrawTransactionRequest) (*CreateRawTransactionResponse, error)
+	CreateWithdrawBindingTransaction(context.Context, *CreateWithdrawTransactionRequest) (*CreateRawTransactionResponse, error)
+	CreatePoolPkCoinbaseTransaction(context.Context, *CreatePoolPkCoinbaseTransactionRequest) (*CreateRawTransactionResponse, error)
+	GetNetworkBinding(context.Context, *GetNetworkBindingRequest) (*GetNetworkBindingResponse, error)
+	CheckPoolPkCoinbase(context.Context, *CheckPoolPkCoinbaseRequest) (*CheckPoolPkCoinbaseResponse, error)
+	CheckTargetBinding(context.Context, *CheckTargetBindingRequest) (*CheckTargetBindingResponse, error)
+	SubscribeWalletEvents(*SubscribeWalletEventsRequest, ApiService_SubscribeWalletEventsServer) error
+}
+
+func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
+	s.RegisterService(&_ApiService_serviceDesc, srv)
+}
+
+func _ApiService_GetBestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBestBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBestBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBestBlock(ctx, req.(*google_protobuf2.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetWalletBalance(ctx context.Context, in *GetWalletBalanceRequest, opts ...grpc.CallOption) (*GetWalletBalanceResponse, error) {
-	out := new(GetWalletBalanceResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetWalletBalance", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHeightRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *apiServiceClient) CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*CreateAddressResponse, error) {
-	out := new(CreateAddressResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateAddress", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockByHeight(ctx, in)
 	}
-	return out, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockByHeight(ctx, req.(*GetBlockByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetAddresses(ctx context.Context, in *GetAddressesRequest, opts ...grpc.CallOption) (*GetAddressesResponse, error) {
-	out := new(GetAddressesResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddresses", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetBlockStakingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockStakingRewardRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockStakingReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetBlockStakingReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockStakingReward(ctx, req.(*GetBlockStakingRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetAddressBalance(ctx context.Context, in *GetAddressBalanceRequest, opts ...grpc.CallOption) (*GetAddressBalanceResponse, error) {
-	out := new(GetAddressBalanceResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetAddressBalance", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetClientStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetClientStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetClientStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetClientStatus(ctx, req.(*google_protobuf2.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error) {
-	out := new(ValidateAddressResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/ValidateAddress", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_QuitClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).QuitClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/QuitClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).QuitClient(ctx, req.(*google_protobuf2.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetUtxo(ctx context.Context, in *GetUtxoRequest, opts ...grpc.CallOption) (*GetUtxoResponse, error) {
-	out := new(GetUtxoResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetUtxo", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_Wallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(google_protobuf2.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).Wallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/Wallets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).Wallets(ctx, req.(*google_protobuf2.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) DecodeRawTransaction(ctx context.Context, in *DecodeRawTransactionRequest, opts ...grpc.CallOption) (*DecodeRawTransactionResponse, error) {
-	out := new(DecodeRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/DecodeRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).CreateWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/CreateWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).CreateWallet(ctx, req.(*CreateWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) CreateRawTransaction(ctx context.Context, in *CreateRawTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
-	out := new(CreateRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_UseWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UseWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UseWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/UseWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UseWallet(ctx, req.(*UseWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) AutoCreateTransaction(ctx context.Context, in *AutoCreateTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
-	out := new(CreateRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/AutoCreateTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ImportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ImportWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ImportWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ImportWallet(ctx, req.(*ImportWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) SignRawTransaction(ctx context.Context, in *SignRawTransactionRequest, opts ...grpc.CallOption) (*SignRawTransactionResponse, error) {
-	out := new(SignRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SignRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ImportMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMnemonicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ImportMnemonic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ImportMnemonic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ImportMnemonic(ctx, req.(*ImportMnemonicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetTransactionFee(ctx context.Context, in *GetTransactionFeeRequest, opts ...grpc.CallOption) (*GetTransactionFeeResponse, error) {
-	out := new(GetTransactionFeeResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetTransactionFee", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ImportWatchOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWatchOnlyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ImportWatchOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ImportWatchOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ImportWatchOnly(ctx, req.(*ImportWatchOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendRawTransactionResponse, error) {
-	out := new(SendRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/SendRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ImportMultisig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMultisigRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ImportMultisig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ImportMultisig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ImportMultisig(ctx, req.(*ImportMultisigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetRawTransaction(ctx context.Context, in *GetRawTransactionRequest, opts ...grpc.CallOption) (*GetRawTransactionResponse, error) {
-	out := new(GetRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetRawTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ExportWatchOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWatchOnlyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ExportWatchOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ExportWatchOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ExportWatchOnly(ctx, req.(*ExportWatchOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetTxStatus(ctx context.Context, in *GetTxStatusRequest, opts ...grpc.CallOption) (*GetTxStatusResponse, error) {
-	out := new(GetTxStatusResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetTxStatus", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_ExportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ExportWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ExportWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ExportWallet(ctx, req.(*ExportWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) CreateStakingTransaction(ctx context.Context, in *CreateStakingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
-	out := new(CreateRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateStakingTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_RemoveWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).RemoveWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/RemoveWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).RemoveWallet(ctx, req.(*RemoveWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) TxHistory(ctx context.Context, in *TxHistoryRequest, opts ...grpc.CallOption) (*TxHistoryResponse, error) {
-	out := new(TxHistoryResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/TxHistory", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_RescanWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescanWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).RescanWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/RescanWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).RescanWallet(ctx, req.(*RescanWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetStakingHistory(ctx context.Context, in *GetStakingHistoryRequest, opts ...grpc.CallOption) (*GetStakingHistoryResponse, error) {
-	out := new(GetStakingHistoryResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetStakingHistory", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_BackupWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BackupWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/BackupWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BackupWallet(ctx, req.(*BackupWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetBindingHistory(ctx context.Context, in *GetBindingHistoryRequest, opts ...grpc.CallOption) (*GetBindingHistoryResponse, error) {
-	out := new(GetBindingHistoryResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetBindingHistory", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_UnlockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).UnlockWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/UnlockWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).UnlockWallet(ctx, req.(*UnlockWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) CreateBindingTransaction(ctx context.Context, in *CreateBindingTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
-	out := new(CreateRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreateBindingTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_LockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).LockWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/LockWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).LockWallet(ctx, req.(*LockWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) CreatePoolPkCoinbaseTransaction(ctx context.Context, in *CreatePoolPkCoinbaseTransactionRequest, opts ...grpc.CallOption) (*CreateRawTransactionResponse, error) {
-	out := new(CreateRawTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CreatePoolPkCoinbaseTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetWalletMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletMnemonicRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetWalletMnemonic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetWalletMnemonic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetWalletMnemonic(ctx, req.(*GetWalletMnemonicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) GetNetworkBinding(ctx context.Context, in *GetNetworkBindingRequest, opts ...grpc.CallOption) (*GetNetworkBindingResponse, error) {
-	out := new(GetNetworkBindingResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/GetNetworkBinding", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetWalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletBalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetWalletBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetWalletBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetWalletBalance(ctx, req.(*GetWalletBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) CheckPoolPkCoinbase(ctx context.Context, in *CheckPoolPkCoinbaseRequest, opts ...grpc.CallOption) (*CheckPoolPkCoinbaseResponse, error) {
-	out := new(CheckPoolPkCoinbaseResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CheckPoolPkCoinbase", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_CreateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).CreateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/CreateAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).CreateAddress(ctx, req.(*CreateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *apiServiceClient) CheckTargetBinding(ctx context.Context, in *CheckTargetBindingRequest, opts ...grpc.CallOption) (*CheckTargetBindingResponse, error) {
-	out := new(CheckTargetBindingResponse)
-	err := grpc.Invoke(ctx, "/rpcprotobuf.ApiService/CheckTargetBinding", in, out, c.cc, opts...)
-	if err != nil {
+func _ApiService_GetAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAddresses(ctx, req.(*GetAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// Server API for ApiService service
-
-type ApiServiceServer interface {
-	GetBestBlock(context.Context, *google_protobuf2.Empty) (*GetBlockResponse, error)
-	GetBlockByHeight(context.Context, *GetBlockByHeightRequest) (*GetBlockResponse, error)
-	GetBlockStakingReward(context.Context, *GetBlockStakingRewardRequest) (*GetBlockStakingRewardResponse, error)
-	GetClientStatus(context.Context, *google_protobuf2.Empty) (*GetClientStatusResponse, error)
-	QuitClient(context.Context, *google_protobuf2.Empty) (*QuitClientResponse, error)
-	// commands act on a wallet
-	Wallets(context.Context, *google_protobuf2.Empty) (*WalletsResponse, error)
-	// just create non-poc wallet
-	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
-	UseWallet(context.Context, *UseWalletRequest) (*UseWalletResponse, error)
-	ImportWallet(context.Context, *ImportWalletRequest) (*ImportWalletResponse, error)
-	ImportMnemonic(context.Context, *ImportMnemonicRequest) (*ImportWalletResponse, error)
-	ExportWallet(context.Context, *ExportWalletRequest) (*ExportWalletResponse, error)
-	RemoveWallet(context.Context, *RemoveWalletRequest) (*RemoveWalletResponse, error)
-	GetWalletMnemonic(context.Context, *GetWalletMnemonicRequest) (*GetWalletMnemonicResponse, error)
-	GetWalletBalance(context.Context, *GetWalletBalanceRequest) (*GetWalletBalanceResponse, error)
-	CreateAddress(context.Context, *CreateAddressRequest) (*CreateAddressResponse, error)
-	GetAddresses(context.Context, *GetAddressesRequest) (*GetAddressesResponse, error)
-	// if addresses not provided, return balances of all addresses
-	GetAddressBalance(context.Context, *GetAddressBalanceRequest) (*GetAddressBalanceResponse, error)
-	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
-	// if addresses not provided, return utxos of all addresses
-	GetUtxo(context.Context, *GetUtxoRequest) (*GetUtxoResponse, error)
-	DecodeRawTransaction(context.Context, *DecodeRawTransactionRequest) (*DecodeRawTransactionResponse, error)
-	CreateRawTransaction(context.Context, *CreateRawTransactionRequest) (*CreateRawTransactionResponse, error)
-	AutoCreateTransaction(context.Context, *AutoCreateTransactionRequest) (*CreateRawTransactionResponse, error)
-	SignRawTransaction(context.Context, *SignRawTransactionRequest) (*SignRawTransactionResponse, error)
-	GetTransactionFee(context.Context, *GetTransactionFeeRequest) (*GetTransactionFeeResponse, error)
-	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendRawTransactionResponse, error)
-	// get tx from chaindb
-	GetRawTransaction(context.Context, *GetRawTransactionRequest) (*GetRawTransactionResponse, error)
-	GetTxStatus(context.Context, *GetTxStatusRequest) (*GetTxStatusResponse, error)
-	CreateStakingTransaction(context.Context, *CreateStakingTransactionRequest) (*CreateRawTransactionResponse, error)
-	TxHistory(context.Context, *TxHistoryRequest) (*TxHistoryResponse, error)
-	GetStakingHistory(context.Context, *GetStakingHistoryRequest) (*GetStakingHistoryResponse, error)
-	GetBindingHistory(context.Context, *GetBindingHistoryRequest) (*GetBindingHistoryResponse, error)
-	CreateBindingTransaction(context.Context, *CreateBindingTransactionRequest) (*CreateRawTransactionResponse, error)
-	CreatePoolPkCoinbaseTransaction(context.Context, *CreatePoolPkCoinbaseTransactionRequest) (*CreateRawTransactionResponse, error)
-	GetNetworkBinding(context.Context, *GetNetworkBindingRequest) (*GetNetworkBindingResponse, error)
-	CheckPoolPkCoinbase(context.Context, *CheckPoolPkCoinbaseRequest) (*CheckPoolPkCoinbaseResponse, error)
-	CheckTargetBinding(context.Context, *CheckTargetBindingRequest) (*CheckTargetBindingResponse, error)
+func _ApiService_GetAddressBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAddressBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/GetAddressBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAddressBalance(ctx, req.(*GetAddressBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
-	s.RegisterService(&_ApiService_serviceDesc, srv)
+func _ApiService_ValidateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ValidateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ValidateAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ValidateAddress(ctx, req.(*ValidateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf2.Empty)
+func _ApiService_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBestBlock(ctx, in)
+		return srv.(ApiServiceServer).SignMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBestBlock",
+		FullMethod: "/rpcprotobuf.ApiService/SignMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBestBlock(ctx, req.(*google_protobuf2.Empty))
+		return srv.(ApiServiceServer).SignMessage(ctx, req.(*SignMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockByHeightRequest)
+func _ApiService_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockByHeight(ctx, in)
+		return srv.(ApiServiceServer).VerifyMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBlockByHeight",
+		FullMethod: "/rpcprotobuf.ApiService/VerifyMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockByHeight(ctx, req.(*GetBlockByHeightRequest))
+		return srv.(ApiServiceServer).VerifyMessage(ctx, req.(*VerifyMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockStakingReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockStakingRewardRequest)
+func _ApiService_GetUtxo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUtxoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockStakingReward(ctx, in)
+		return srv.(ApiServiceServer).GetUtxo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetBlockStakingReward",
+		FullMethod: "/rpcprotobuf.ApiService/GetUtxo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockStakingReward(ctx, req.(*GetBlockStakingRewardRequest))
+		return srv.(ApiServiceServer).GetUtxo(ctx, req.(*GetUtxoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetClientStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf2.Empty)
+func _ApiService_LockUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockUnspentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetClientStatus(ctx, in)
+		return srv.(ApiServiceServer).LockUnspent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetClientStatus",
+		FullMethod: "/rpcprotobuf.ApiService/LockUnspent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetClientStatus(ctx, req.(*google_protobuf2.Empty))
+		return srv.(ApiServiceServer).LockUnspent(ctx, req.(*LockUnspentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_QuitClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf2.Empty)
+func _ApiService_UnlockUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUnspentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).QuitClient(ctx, in)
+		return srv.(ApiServiceServer).UnlockUnspent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/QuitClient",
+		FullMethod: "/rpcprotobuf.ApiService/UnlockUnspent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).QuitClient(ctx, req.(*google_protobuf2.Empty))
+		return srv.(ApiServiceServer).UnlockUnspent(ctx, req.(*UnlockUnspentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_Wallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf2.Empty)
+func _ApiService_ListLockUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLockUnspentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).Wallets(ctx, in)
+		return srv.(ApiServiceServer).ListLockUnspent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/Wallets",
+		FullMethod: "/rpcprotobuf.ApiService/ListLockUnspent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).Wallets(ctx, req.(*google_protobuf2.Empty))
+		return srv.(ApiServiceServer).ListLockUnspent(ctx, req.(*ListLockUnspentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateWalletRequest)
+func _ApiService_SetLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).CreateWallet(ctx, in)
+		return srv.(ApiServiceServer).SetLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/CreateWallet",
+		FullMethod: "/rpcprotobuf.ApiService/SetLabel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).CreateWallet(ctx, req.(*CreateWalletRequest))
+		return srv.(ApiServiceServer).SetLabel(ctx, req.(*SetLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_UseWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UseWalletRequest)
+func _ApiService_GetLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLabelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).UseWallet(ctx, in)
+		return srv.(ApiServiceServer).GetLabels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/UseWallet",
+		FullMethod: "/rpcprotobuf.ApiService/GetLabels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).UseWallet(ctx, req.(*UseWalletRequest))
+		return srv.(ApiServiceServer).GetLabels(ctx, req.(*GetLabelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_ImportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportWalletRequest)
+func _ApiService_SearchLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLabelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).ImportWallet(ctx, in)
+		return srv.(ApiServiceServer).SearchLabels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/ImportWallet",
+		FullMethod: "/rpcprotobuf.ApiService/SearchLabels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).ImportWallet(ctx, req.(*ImportWalletRequest))
+		return srv.(ApiServiceServer).SearchLabels(ctx, req.(*SearchLabelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_ImportMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportMnemonicRequest)
+func _ApiService_DecodeRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeRawTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).ImportMnemonic(ctx, in)
+		return srv.(ApiServiceServer).DecodeRawTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/ImportMnemonic",
+		FullMethod: "/rpcprotobuf.ApiService/DecodeRawTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).ImportMnemonic(ctx, req.(*ImportMnemonicRequest))
+		return srv.(ApiServiceServer).DecodeRawTransaction(ctx, req.(*DecodeRawTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_ExportWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportWalletRequest)
+func _ApiService_CreateRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRawTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).ExportWallet(ctx, in)
+		return srv.(ApiServiceServer).CreateRawTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/ExportWallet",
+		FullMethod: "/rpcprotobuf.ApiService/CreateRawTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).ExportWallet(ctx, req.(*ExportWalletRequest))
+		return srv.(ApiServiceServer).CreateRawTransaction(ctx, req.(*CreateRawTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_RemoveWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveWalletRequest)
+func _ApiService_AutoCreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoCreateTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).RemoveWallet(ctx, in)
+		return srv.(ApiServiceServer).AutoCreateTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/RemoveWallet",
+		FullMethod: "/rpcprotobuf.ApiService/AutoCreateTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).RemoveWallet(ctx, req.(*RemoveWalletRequest))
+		return srv.(ApiServiceServer).AutoCreateTransaction(ctx, req.(*AutoCreateTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetWalletMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWalletMnemonicRequest)
+func _ApiService_SignRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRawTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetWalletMnemonic(ctx, in)
+		return srv.(ApiServiceServer).SignRawTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetWalletMnemonic",
+		FullMethod: "/rpcprotobuf.ApiService/SignRawTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetWalletMnemonic(ctx, req.(*GetWalletMnemonicRequest))
+		return srv.(ApiServiceServer).SignRawTransaction(ctx, req.(*SignRawTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetWalletBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWalletBalanceRequest)
+func _ApiService_CreatePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePsbtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetWalletBalance(ctx, in)
+		return srv.(ApiServiceServer).CreatePsbt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetWalletBalance",
+		FullMethod: "/rpcprotobuf.ApiService/CreatePsbt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetWalletBalance(ctx, req.(*GetWalletBalanceRequest))
+		return srv.(ApiServiceServer).CreatePsbt(ctx, req.(*CreatePsbtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_CreateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAddressRequest)
+func _ApiService_DecodePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodePsbtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).CreateAddress(ctx, in)
+		return srv.(ApiServiceServer).DecodePsbt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/CreateAddress",
+		FullMethod: "/rpcprotobuf.ApiService/DecodePsbt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).CreateAddress(ctx, req.(*CreateAddressRequest))
+		return srv.(ApiServiceServer).DecodePsbt(ctx, req.(*DecodePsbtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAddressesRequest)
+func _ApiService_SignPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPsbtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetAddresses(ctx, in)
+		return srv.(ApiServiceServer).SignPsbt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetAddresses",
+		FullMethod: "/rpcprotobuf.ApiService/SignPsbt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetAddresses(ctx, req.(*GetAddressesRequest))
+		return srv.(ApiServiceServer).SignPsbt(ctx, req.(*SignPsbtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetAddressBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAddressBalanceRequest)
+func _ApiService_CombinePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CombinePsbtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetAddressBalance(ctx, in)
+		return srv.(ApiServiceServer).CombinePsbt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetAddressBalance",
+		FullMethod: "/rpcprotobuf.ApiService/CombinePsbt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetAddressBalance(ctx, req.(*GetAddressBalanceRequest))
+		return srv.(ApiServiceServer).CombinePsbt(ctx, req.(*CombinePsbtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_ValidateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidateAddressRequest)
+func _ApiService_FinalizePsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizePsbtRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).ValidateAddress(ctx, in)
+		return srv.(ApiServiceServer).FinalizePsbt(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/ValidateAddress",
+		FullMethod: "/rpcprotobuf.ApiService/FinalizePsbt",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).ValidateAddress(ctx, req.(*ValidateAddressRequest))
+		return srv.(ApiServiceServer).FinalizePsbt(ctx, req.(*FinalizePsbtRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetUtxo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUtxoRequest)
+func _ApiService_GetTransactionFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionFeeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetUtxo(ctx, in)
+		return srv.(ApiServiceServer).GetTransactionFee(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetUtxo",
+		FullMethod: "/rpcprotobuf.ApiService/GetTransactionFee",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetUtxo(ctx, req.(*GetUtxoRequest))
+		return srv.(ApiServiceServer).GetTransactionFee(ctx, req.(*GetTransactionFeeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_DecodeRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DecodeRawTransactionRequest)
+func _ApiService_EstimateFeeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).DecodeRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).EstimateFeeRate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/DecodeRawTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/EstimateFeeRate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).DecodeRawTransaction(ctx, req.(*DecodeRawTransactionRequest))
+		return srv.(ApiServiceServer).EstimateFeeRate(ctx, req.(*EstimateFeeRateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_CreateRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRawTransactionRequest)
+func _ApiService_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRawTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).CreateRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/CreateRawTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/SendRawTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).CreateRawTransaction(ctx, req.(*CreateRawTransactionRequest))
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_AutoCreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AutoCreateTransactionRequest)
+func _ApiService_GetRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRawTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).AutoCreateTransaction(ctx, in)
+		return srv.(ApiServiceServer).GetRawTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/AutoCreateTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/GetRawTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).AutoCreateTransaction(ctx, req.(*AutoCreateTransactionRequest))
+		return srv.(ApiServiceServer).GetRawTransaction(ctx, req.(*GetRawTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_SignRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignRawTransactionRequest)
+func _ApiService_GetTxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).SignRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).GetTxStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/SignRawTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/GetTxStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).SignRawTransaction(ctx, req.(*SignRawTransactionRequest))
+		return srv.(ApiServiceServer).GetTxStatus(ctx, req.(*GetTxStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetTransactionFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionFeeRequest)
+func _ApiService_ListUnconfirmed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnconfirmedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTransactionFee(ctx, in)
+		return srv.(ApiServiceServer).ListUnconfirmed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetTransactionFee",
+		FullMethod: "/rpcprotobuf.ApiService/ListUnconfirmed",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTransactionFee(ctx, req.(*GetTransactionFeeRequest))
+		return srv.(ApiServiceServer).ListUnconfirmed(ctx, req.(*ListUnconfirmedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendRawTransactionRequest)
+func _ApiService_AbandonTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbandonTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).SendRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).AbandonTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/SendRawTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/AbandonTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
+		return srv.(ApiServiceServer).AbandonTransaction(ctx, req.(*AbandonTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRawTransactionRequest)
+func _ApiService_CreatePayoutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayoutBatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetRawTransaction(ctx, in)
+		return srv.(ApiServiceServer).CreatePayoutBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetRawTransaction",
+		FullMethod: "/rpcprotobuf.ApiService/CreatePayoutBatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetRawTransaction(ctx, req.(*GetRawTransactionRequest))
+		return srv.(ApiServiceServer).CreatePayoutBatch(ctx, req.(*CreatePayoutBatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetTxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTxStatusRequest)
+func _ApiService_GetPayoutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoutBatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTxStatus(ctx, in)
+		return srv.(ApiServiceServer).GetPayoutBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcprotobuf.ApiService/GetTxStatus",
+		FullMethod: "/rpcprotobuf.ApiService/GetPayoutBatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTxStatus(ctx, req.(*GetTxStatusRequest))
+		return srv.(ApiServiceServer).GetPayoutBatch(ctx, req.(*GetPayoutBatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4622,6 +7780,45 @@ func _ApiService_TxHistory_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_ListTxHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTxHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).ListTxHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/ListTxHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).ListTxHistory(ctx, req.(*ListTxHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_ExportHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).ExportHistory(m, &apiServiceExportHistoryServer{stream})
+}
+
+type ApiService_ExportHistoryServer interface {
+	Send(*ExportHistoryResponse) error
+	grpc.ServerStream
+}
+
+type apiServiceExportHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceExportHistoryServer) Send(m *ExportHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _ApiService_GetStakingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetStakingHistoryRequest)
 	if err := dec(in); err != nil {
@@ -4676,6 +7873,42 @@ func _ApiService_CreateBindingTransaction_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_CreateWithdrawStakingTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWithdrawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).CreateWithdrawStakingTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/CreateWithdrawStakingTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).CreateWithdrawStakingTransaction(ctx, req.(*CreateWithdrawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_CreateWithdrawBindingTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWithdrawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).CreateWithdrawBindingTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcprotobuf.ApiService/CreateWithdrawBindingTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).CreateWithdrawBindingTransaction(ctx, req.(*CreateWithdrawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_CreatePoolPkCoinbaseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePoolPkCoinbaseTransactionRequest)
 	if err := dec(in); err != nil {
@@ -4748,6 +7981,27 @@ func _ApiService_CheckTargetBinding_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_SubscribeWalletEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeWalletEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeWalletEvents(m, &apiServiceSubscribeWalletEventsServer{stream})
+}
+
+type ApiService_SubscribeWalletEventsServer interface {
+	Send(*WalletEvent) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeWalletEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeWalletEventsServer) Send(m *WalletEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _ApiService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcprotobuf.ApiService",
 	HandlerType: (*ApiServiceServer)(nil),
@@ -4792,6 +8046,18 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ImportMnemonic",
 			Handler:    _ApiService_ImportMnemonic_Handler,
 		},
+		{
+			MethodName: "ImportWatchOnly",
+			Handler:    _ApiService_ImportWatchOnly_Handler,
+		},
+		{
+			MethodName: "ImportMultisig",
+			Handler:    _ApiService_ImportMultisig_Handler,
+		},
+		{
+			MethodName: "ExportWatchOnly",
+			Handler:    _ApiService_ExportWatchOnly_Handler,
+		},
 		{
 			MethodName: "ExportWallet",
 			Handler:    _ApiService_ExportWallet_Handler,
@@ -4800,6 +8066,22 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveWallet",
 			Handler:    _ApiService_RemoveWallet_Handler,
 		},
+		{
+			MethodName: "RescanWallet",
+			Handler:    _ApiService_RescanWallet_Handler,
+		},
+		{
+			MethodName: "BackupWallet",
+			Handler:    _ApiService_BackupWallet_Handler,
+		},
+		{
+			MethodName: "UnlockWallet",
+			Handler:    _ApiService_UnlockWallet_Handler,
+		},
+		{
+			MethodName: "LockWallet",
+			Handler:    _ApiService_LockWallet_Handler,
+		},
 		{
 			MethodName: "GetWalletMnemonic",
 			Handler:    _ApiService_GetWalletMnemonic_Handler,
@@ -4824,10 +8106,42 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateAddress",
 			Handler:    _ApiService_ValidateAddress_Handler,
 		},
+		{
+			MethodName: "SignMessage",
+			Handler:    _ApiService_SignMessage_Handler,
+		},
+		{
+			MethodName: "VerifyMessage",
+			Handler:    _ApiService_VerifyMessage_Handler,
+		},
 		{
 			MethodName: "GetUtxo",
 			Handler:    _ApiService_GetUtxo_Handler,
 		},
+		{
+			MethodName: "LockUnspent",
+			Handler:    _ApiService_LockUnspent_Handler,
+		},
+		{
+			MethodName: "UnlockUnspent",
+			Handler:    _ApiService_UnlockUnspent_Handler,
+		},
+		{
+			MethodName: "ListLockUnspent",
+			Handler:    _ApiService_ListLockUnspent_Handler,
+		},
+		{
+			MethodName: "SetLabel",
+			Handler:    _ApiService_SetLabel_Handler,
+		},
+		{
+			MethodName: "GetLabels",
+			Handler:    _ApiService_GetLabels_Handler,
+		},
+		{
+			MethodName: "SearchLabels",
+			Handler:    _ApiService_SearchLabels_Handler,
+		},
 		{
 			MethodName: "DecodeRawTransaction",
 			Handler:    _ApiService_DecodeRawTransaction_Handler,
@@ -4844,10 +8158,34 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SignRawTransaction",
 			Handler:    _ApiService_SignRawTransaction_Handler,
 		},
+		{
+			MethodName: "CreatePsbt",
+			Handler:    _ApiService_CreatePsbt_Handler,
+		},
+		{
+			MethodName: "DecodePsbt",
+			Handler:    _ApiService_DecodePsbt_Handler,
+		},
+		{
+			MethodName: "SignPsbt",
+			Handler:    _ApiService_SignPsbt_Handler,
+		},
+		{
+			MethodName: "CombinePsbt",
+			Handler:    _ApiService_CombinePsbt_Handler,
+		},
+		{
+			MethodName: "FinalizePsbt",
+			Handler:    _ApiService_FinalizePsbt_Handler,
+		},
 		{
 			MethodName: "GetTransactionFee",
 			Handler:    _ApiService_GetTransactionFee_Handler,
 		},
+		{
+			MethodName: "EstimateFeeRate",
+			Handler:    _ApiService_EstimateFeeRate_Handler,
+		},
 		{
 			MethodName: "SendRawTransaction",
 			Handler:    _ApiService_SendRawTransaction_Handler,
@@ -4860,6 +8198,22 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxStatus",
 			Handler:    _ApiService_GetTxStatus_Handler,
 		},
+		{
+			MethodName: "ListUnconfirmed",
+			Handler:    _ApiService_ListUnconfirmed_Handler,
+		},
+		{
+			MethodName: "AbandonTransaction",
+			Handler:    _ApiService_AbandonTransaction_Handler,
+		},
+		{
+			MethodName: "CreatePayoutBatch",
+			Handler:    _ApiService_CreatePayoutBatch_Handler,
+		},
+		{
+			MethodName: "GetPayoutBatch",
+			Handler:    _ApiService_GetPayoutBatch_Handler,
+		},
 		{
 			MethodName: "CreateStakingTransaction",
 			Handler:    _ApiService_CreateStakingTransaction_Handler,
@@ -4868,6 +8222,10 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TxHistory",
 			Handler:    _ApiService_TxHistory_Handler,
 		},
+		{
+			MethodName: "ListTxHistory",
+			Handler:    _ApiService_ListTxHistory_Handler,
+		},
 		{
 			MethodName: "GetStakingHistory",
 			Handler:    _ApiService_GetStakingHistory_Handler,
@@ -4880,6 +8238,14 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBindingTransaction",
 			Handler:    _ApiService_CreateBindingTransaction_Handler,
 		},
+		{
+			MethodName: "CreateWithdrawStakingTransaction",
+			Handler:    _ApiService_CreateWithdrawStakingTransaction_Handler,
+		},
+		{
+			MethodName: "CreateWithdrawBindingTransaction",
+			Handler:    _ApiService_CreateWithdrawBindingTransaction_Handler,
+		},
 		{
 			MethodName: "CreatePoolPkCoinbaseTransaction",
 			Handler:    _ApiService_CreatePoolPkCoinbaseTransaction_Handler,
@@ -4897,338 +8263,510 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _ApiService_CheckTargetBinding_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportHistory",
+			Handler:       _ApiService_ExportHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeWalletEvents",
+			Handler:       _ApiService_SubscribeWalletEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api.proto",
 }
 
 func init() { proto.RegisterFile("api.proto", fileDescriptorApi) }
 
 var fileDescriptorApi = []byte{
-	// 5209 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3c, 0x4b, 0x6f, 0x23, 0xc9,
-	0x79, 0xe9, 0xe6, 0xfb, 0x93, 0xa8, 0x47, 0x49, 0xa3, 0xa1, 0x5a, 0x9a, 0x1d, 0x6d, 0xcf, 0x7b,
-	0x32, 0x43, 0xee, 0x68, 0xbd, 0x8e, 0x3d, 0x86, 0x63, 0x4b, 0x9a, 0x7d, 0x28, 0xb3, 0xb3, 0xab,
-	0x6d, 0x69, 0x76, 0x0d, 0x1b, 0x08, 0xd3, 0x24, 0x4b, 0x62, 0xaf, 0xc8, 0x6e, 0x4e, 0x77, 0x71,
-	0x44, 0xee, 0x62, 0x11, 0x24, 0x71, 0x9c, 0x43, 0x6c, 0x18, 0xeb, 0xc4, 0x79, 0x21, 0x97, 0x04,
-	0xf0, 0x25, 0x81, 0x91, 0x4b, 0x82, 0x1c, 0x92, 0x5b, 0x0e, 0x09, 0x72, 0x71, 0x80, 0x00, 0xb9,
-	0x04, 0x30, 0x0c, 0xc4, 0xf9, 0x11, 0x01, 0x72, 0x08, 0xea, 0xd5, 0xec, 0x47, 0x35, 0x45, 0xed,
-	0x8e, 0x73, 0x12, 0xab, 0xfa, 0xab, 0xaf, 0xbe, 0xfa, 0xea, 0x7b, 0x57, 0x95, 0xa0, 0x62, 0x0f,
-	0x9c, 0xfa, 0xc0, 0xf7, 0x88, 0x87, 0xe6, 0xfc, 0x41, 0x9b, 0xfd, 0x6a, 0x0d, 0x8f, 0x8d, 0xcd,
-	0x13, 0xcf, 0x3b, 0xe9, 0xe1, 0x86, 0x3d, 0x70, 0x1a, 0xb6, 0xeb, 0x7a, 0xc4, 0x26, 0x8e, 0xe7,
-	0x06, 0x1c, 0xd4, 0xb8, 0xc7, 0xfe, 0xb4, 0xef, 0x9f, 0x60, 0xf7, 0x7e, 0x70, 0x66, 0x9f, 0x9c,
-	0x60, 0xbf, 0xe1, 0x0d, 0x18, 0x84, 0x02, 0x7a, 0x43, 0xe0, 0x92, 0xc8, 0x1b, 0xb8, 0x3f, 0x20,
-	0x63, 0xfe, 0xd1, 0xfc, 0xab, 0x22, 0x5c, 0x7e, 0x13, 0x93, 0xbd, 0x9e, 0x83, 0x5d, 0x72, 0x48,
-	0x6c, 0x32, 0x0c, 0x2c, 0x1c, 0x0c, 0x3c, 0x37, 0xc0, 0xe8, 0x06, 0x2c, 0x0c, 0x30, 0xf6, 0x9b,
-	0x3d, 0x27, 0x20, 0xd8, 0x75, 0xdc, 0x93, 0x9a, 0xb6, 0xa5, 0xdd, 0x2e, 0x5b, 0x55, 0xda, 0xfb,
-	0xb6, 0xec, 0x44, 0x35, 0x28, 0x05, 0x63, 0xb7, 0x4d, 0xbf, 0xeb, 0xec, 0xbb, 0x6c, 0xa2, 0x75,
-	0x28, 0xb7, 0xbb, 0xb6, 0xe3, 0x36, 0x9d, 0x4e, 0x2d, 0xb7, 0xa5, 0xdd, 0xae, 0x58, 0x25, 0xd6,
-	0xde, 0xef, 0xa0, 0xbb, 0xb0, 0xdc, 0xf3, 0xda, 0x76, 0xaf, 0xd9, 0xc2, 0x01, 0x69, 0x76, 0xb1,
-	0x73, 0xd2, 0x25, 0xb5, 0xfc, 0x96, 0x76, 0x3b, 0x6f, 0x2d, 0xb2, 0x0f, 0xbb, 0x38, 0x20, 0x6f,
-	0xb1, 0x6e, 0x0a, 0x7b, 0xea, 0x7a, 0x67, 0x6e, 0x0c, 0xb6, 0xc0, 0x61, 0xd9, 0x87, 0x08, 0xec,
-	0x3d, 0x40, 0x67, 0x76, 0xaf, 0x87, 0x49, 0x93, 0x12, 0x21, 0x81, 0x8b, 0x0c, 0x78, 0x89, 0x7f,
-	0x39, 0x1c, 0xbb, 0x6d, 0x01, 0xfd, 0x1e, 0x00, 0x5b, 0x61, 0xdb, 0x1b, 0xba, 0xa4, 0x56, 0xda,
-	0xd2, 0x6e, 0xcf, 0x6d, 0x6f, 0xd7, 0x23, 0x1b, 0x51, 0xcf, 0xe0, 0x4d, 0x9d, 0x0e, 0xdb, 0xa3,
-	0xa3, 0xf6, 0xdd, 0x63, 0xcf, 0xaa, 0x84, 0x4d, 0xb4, 0x07, 0x05, 0xda, 0x08, 0x6a, 0x65, 0x86,
-	0xed, 0xfe, 0xcc, 0xd8, 0x28, 0x43, 0x2d, 0x3e, 0xd6, 0xf8, 0x16, 0x54, 0x63, 0x13, 0xa0, 0x55,
-	0x28, 0x10, 0x8f, 0xd8, 0x3d, 0xb6, 0x03, 0x55, 0x8b, 0x37, 0x90, 0x01, 0x65, 0x6f, 0x48, 0x5a,
-	0xde, 0xd0, 0xed, 0x30, 0xd6, 0x57, 0xad, 0xb0, 0x4d, 0x77, 0xc5, 0x71, 0xf9, 0xa7, 0x1c, 0xfb,
-	0x24, 0x9b, 0x86, 0x05, 0x65, 0x8a, 0x9c, 0xe1, 0x5d, 0x00, 0xdd, 0xe9, 0x30, 0xa4, 0x15, 0x4b,
-	0x77, 0xd8, 0x28, 0xbb, 0xd3, 0xf1, 0x71, 0x10, 0x30, 0x84, 0x15, 0x4b, 0x36, 0xd1, 0x26, 0x54,
-	0x3a, 0x8e, 0x8f, 0xdb, 0x54, 0xb2, 0xc4, 0x66, 0x4e, 0x3a, 0x8c, 0xff, 0xd2, 0x38, 0x52, 0xba,
-	0x08, 0xb4, 0x1f, 0x21, 0x4b, 0xdb, 0xca, 0x5d, 0x88, 0x0b, 0x8c, 0x9d, 0x93, 0x55, 0xbc, 0x39,
-	0x59, 0x85, 0xfe, 0x59, 0x30, 0xc9, 0xd1, 0x74, 0x5b, 0x3c, 0xd2, 0xc5, 0x7e, 0x2d, 0xf7, 0x59,
-	0xd0, 0xf0, 0xb1, 0xe6, 0x43, 0x40, 0xef, 0x0d, 0x1d, 0x01, 0x1b, 0xaa, 0x09, 0x82, 0x7c, 0xdb,
-	0xeb, 0x60, 0xc6, 0xc5, 0x9c, 0xc5, 0x7e, 0xa3, 0x25, 0xc8, 0xf5, 0x83, 0x13, 0xc1, 0x43, 0xfa,
-	0xd3, 0xfc, 0x5d, 0x1d, 0x16, 0x3f, 0x60, 0xf2, 0x37, 0x51, 0xb0, 0x47, 0x50, 0xe2, 0x22, 0x19,
-	0x08, 0x3e, 0xdd, 0x8d, 0x91, 0x95, 0x00, 0x17, 0xed, 0xc3, 0x61, 0xbf, 0x6f, 0xfb, 0x63, 0x4b,
-	0x0e, 0x35, 0xfe, 0x5a, 0x83, 0x6a, 0xec, 0x13, 0xda, 0x80, 0x8a, 0x50, 0x82, 0x70, 0x73, 0xcb,
-	0xbc, 0x63, 0xbf, 0x43, 0xc9, 0x25, 0xe3, 0x01, 0x16, 0x02, 0xc3, 0x7e, 0xd3, 0x6d, 0x7f, 0x8e,
-	0xfd, 0x40, 0x6e, 0x6d, 0xd5, 0x92, 0x4d, 0xfa, 0xc5, 0xc7, 0x7d, 0xdb, 0x3f, 0x0d, 0x98, 0x76,
-	0x56, 0x2c, 0xd9, 0x44, 0x6b, 0x50, 0x0c, 0x18, 0xbb, 0x98, 0x2a, 0x56, 0x2d, 0xd1, 0x42, 0x57,
-	0x00, 0xf8, 0xaf, 0x26, 0xe5, 0x40, 0x91, 0x4b, 0x0a, 0xef, 0x79, 0x12, 0x9c, 0x98, 0x0d, 0x58,
-	0x7a, 0x1a, 0x60, 0x4e, 0xaf, 0x85, 0x9f, 0x0d, 0x71, 0x40, 0xa6, 0xd2, 0x6b, 0xfe, 0xa1, 0x0e,
-	0xcb, 0x91, 0x11, 0x82, 0x75, 0x51, 0xd3, 0xa2, 0xc5, 0x4d, 0x4b, 0x0c, 0x9b, 0x9e, 0xb1, 0xfa,
-	0x9c, 0x7a, 0xf5, 0xf9, 0xf8, 0xea, 0xaf, 0x41, 0x95, 0x69, 0x5a, 0xb3, 0x65, 0xf7, 0x6c, 0xb7,
-	0x8d, 0xd9, 0x52, 0x2b, 0xd6, 0x3c, 0xeb, 0xdc, 0xe5, 0x7d, 0xd4, 0xe4, 0xe0, 0x11, 0xc1, 0xbe,
-	0x6b, 0xf7, 0x9a, 0xa7, 0x78, 0x2c, 0x8c, 0x09, 0x5d, 0x78, 0xc1, 0x5a, 0x92, 0x5f, 0x1e, 0xe3,
-	0x31, 0xb7, 0x0f, 0xf7, 0x00, 0x39, 0x6e, 0x0a, 0xba, 0xc4, 0xa1, 0xe5, 0x97, 0x10, 0x3a, 0xc2,
-	0xfe, 0x72, 0x8c, 0xfd, 0xe6, 0x87, 0xb0, 0xb2, 0xe7, 0x63, 0x9b, 0x24, 0x58, 0xf9, 0x12, 0xc0,
-	0xc0, 0x0e, 0x82, 0x41, 0xd7, 0xb7, 0x03, 0x2c, 0x38, 0x13, 0xe9, 0x89, 0x22, 0xd4, 0xe3, 0xfb,
-	0xb9, 0x0e, 0xe5, 0x96, 0x43, 0x9a, 0x81, 0xf3, 0x11, 0xe7, 0x4e, 0xc1, 0x2a, 0xb5, 0x1c, 0x72,
-	0xe8, 0x7c, 0x84, 0x4d, 0x07, 0x56, 0xe3, 0x73, 0x89, 0x4d, 0x98, 0x2a, 0x67, 0x06, 0x94, 0xfb,
-	0x2e, 0xee, 0x7b, 0xae, 0xd3, 0x96, 0xbb, 0x20, 0xdb, 0xd9, 0xf2, 0x66, 0xbe, 0x07, 0x2b, 0xfb,
-	0xfd, 0x81, 0xe7, 0x93, 0xf8, 0xb2, 0x0c, 0x28, 0x9f, 0xe2, 0x71, 0x40, 0x3c, 0x5f, 0x2e, 0x2a,
-	0x6c, 0x27, 0x96, 0xac, 0x27, 0x97, 0x6c, 0xfe, 0xbe, 0x06, 0xab, 0x71, 0x9c, 0x82, 0xfc, 0x05,
-	0xd0, 0xbd, 0x53, 0xe1, 0xd3, 0x74, 0xef, 0xf4, 0x45, 0x0a, 0x4e, 0x84, 0xcd, 0x85, 0xf8, 0xbe,
-	0xfd, 0x83, 0x06, 0x97, 0x38, 0x35, 0x4f, 0x04, 0x37, 0x22, 0x6b, 0x0c, 0x19, 0xa6, 0x25, 0x18,
-	0x76, 0xce, 0x1a, 0xa3, 0xf3, 0xe5, 0xe2, 0xdb, 0x7a, 0x03, 0x16, 0x42, 0xe9, 0x74, 0xdc, 0x0e,
-	0x1e, 0x09, 0x52, 0xab, 0xb2, 0x77, 0x9f, 0x76, 0x52, 0xb0, 0x50, 0x2c, 0x39, 0x18, 0xd7, 0xea,
-	0xaa, 0xec, 0x65, 0x60, 0xa6, 0x05, 0x2b, 0xaf, 0x8f, 0xd2, 0xdb, 0x33, 0x55, 0x10, 0xce, 0xdb,
-	0x9f, 0x6d, 0x58, 0x8d, 0xe3, 0x14, 0xdb, 0x33, 0x65, 0xcf, 0x29, 0x1d, 0x16, 0xee, 0x7b, 0xcf,
-	0xf1, 0x0b, 0xa4, 0xe3, 0x26, 0xac, 0xc6, 0x71, 0xaa, 0xc5, 0xc4, 0xf4, 0xa0, 0xf6, 0x26, 0x26,
-	0x3b, 0xdc, 0x2f, 0x0a, 0x23, 0x20, 0x09, 0x78, 0x0d, 0xd6, 0x7c, 0xfc, 0x6c, 0xe8, 0xf8, 0xb8,
-	0xd3, 0x6c, 0x7b, 0xee, 0xb1, 0xe3, 0xf7, 0x79, 0x2c, 0xc6, 0xc6, 0x17, 0xac, 0x4b, 0xf2, 0xeb,
-	0x5e, 0xf4, 0x23, 0x75, 0xae, 0xc2, 0xcf, 0xe2, 0x80, 0x39, 0xba, 0x8a, 0x35, 0xe9, 0x30, 0xff,
-	0x59, 0x83, 0x65, 0x31, 0xdd, 0x8e, 0xdb, 0x91, 0x66, 0x27, 0xe2, 0xaa, 0xb5, 0xb8, 0xab, 0x0e,
-	0x83, 0x05, 0xbe, 0x46, 0x11, 0x2c, 0x6c, 0x42, 0x25, 0x18, 0x60, 0xb7, 0x63, 0xb7, 0x7a, 0x58,
-	0x3a, 0xf0, 0xb0, 0x03, 0x3d, 0x80, 0xd5, 0x33, 0x87, 0x74, 0x3b, 0xbe, 0x7d, 0x46, 0xdb, 0xcd,
-	0x80, 0xd8, 0xa7, 0x34, 0xa2, 0xe3, 0x46, 0x7f, 0x25, 0xfa, 0xed, 0x90, 0x7f, 0x4a, 0x0d, 0x69,
-	0x39, 0x6e, 0x87, 0x0e, 0x29, 0xa4, 0x87, 0xec, 0xf2, 0x4f, 0xe6, 0x07, 0xb0, 0xae, 0x60, 0x9d,
-	0xe0, 0xf3, 0x43, 0x28, 0x0b, 0x33, 0x2b, 0xdd, 0xe1, 0x4b, 0x31, 0x77, 0x98, 0x62, 0x81, 0x15,
-	0xc2, 0x9b, 0xdb, 0xb0, 0xf6, 0xbe, 0xdd, 0x73, 0x3a, 0x36, 0xc1, 0x02, 0x4c, 0xee, 0x48, 0x26,
-	0x9b, 0xcc, 0xdf, 0xd2, 0xe0, 0x72, 0x6a, 0xd0, 0xc4, 0xbd, 0x38, 0x41, 0xf3, 0x39, 0xfd, 0x2a,
-	0x76, 0xbe, 0xe4, 0x04, 0x0c, 0x18, 0x5d, 0x86, 0x92, 0x13, 0x34, 0xfb, 0x8e, 0x8b, 0x45, 0xb8,
-	0x5b, 0x74, 0x82, 0x27, 0x8e, 0x1b, 0xdb, 0x90, 0x5c, 0x7c, 0x43, 0x12, 0x76, 0xa2, 0x30, 0x31,
-	0x77, 0xaf, 0x48, 0xcb, 0x9a, 0xa6, 0x5a, 0x8e, 0xd0, 0xe2, 0x23, 0x1e, 0xc0, 0xa5, 0xc4, 0x08,
-	0x41, 0x72, 0xf6, 0x42, 0x1b, 0xb0, 0x32, 0xe1, 0x3a, 0x9e, 0x61, 0x8e, 0xff, 0xd4, 0x60, 0x35,
-	0x3e, 0x42, 0xcc, 0xb1, 0x0f, 0xa5, 0x0e, 0x26, 0xb6, 0xd3, 0x93, 0x3b, 0xd4, 0x48, 0xc6, 0x51,
-	0xa9, 0x31, 0x72, 0xdb, 0x1e, 0xb1, 0x71, 0x96, 0x1c, 0x6f, 0x8c, 0xa0, 0x1a, 0xfb, 0x32, 0x45,
-	0x9e, 0x23, 0x84, 0xea, 0x31, 0x42, 0xa9, 0x51, 0x1e, 0x06, 0x98, 0x47, 0xb8, 0x65, 0x8b, 0xfd,
-	0x46, 0x57, 0x61, 0x2e, 0x20, 0x9d, 0xa6, 0xc4, 0xc5, 0x05, 0x18, 0x02, 0xd2, 0x11, 0xd3, 0x99,
-	0x5d, 0x96, 0xf1, 0x70, 0x25, 0x7f, 0x31, 0xea, 0xbb, 0x06, 0x45, 0xbe, 0x2c, 0x29, 0x11, 0xbc,
-	0x65, 0xfe, 0xa5, 0xce, 0x4c, 0x45, 0x62, 0xaa, 0x59, 0x9c, 0xa7, 0x5a, 0x85, 0x1f, 0x85, 0xf3,
-	0xe4, 0x58, 0x72, 0x71, 0x2f, 0xc9, 0x7d, 0xe5, 0x4c, 0x75, 0xc1, 0x7a, 0x31, 0xd6, 0xf8, 0xae,
-	0x06, 0x45, 0xc1, 0xf3, 0x98, 0x4d, 0xd0, 0x66, 0xb5, 0x09, 0xfa, 0xc5, 0x6d, 0x42, 0x2e, 0xdb,
-	0x26, 0xfc, 0x54, 0x87, 0xa5, 0xa3, 0xd1, 0x5b, 0x0e, 0x35, 0xec, 0x63, 0x4e, 0x57, 0x80, 0x56,
-	0xa0, 0x40, 0x46, 0x13, 0xc6, 0xe4, 0xc9, 0x68, 0xbf, 0x83, 0x5e, 0x86, 0xf9, 0x56, 0xcf, 0x6b,
-	0x9f, 0xca, 0xac, 0x4e, 0x67, 0x59, 0xdd, 0x1c, 0xeb, 0x13, 0x09, 0xdd, 0x57, 0xa0, 0xe8, 0xb8,
-	0x83, 0x21, 0x09, 0x44, 0x9c, 0x7f, 0x2d, 0xc6, 0xa1, 0xe4, 0x34, 0xf5, 0x7d, 0x0a, 0x6b, 0x89,
-	0x21, 0xe8, 0x57, 0xa1, 0xe4, 0x0d, 0x09, 0x1b, 0x9d, 0x67, 0xa3, 0xaf, 0x4f, 0x1f, 0xfd, 0x2e,
-	0x03, 0xb6, 0xe4, 0x20, 0xea, 0x43, 0x8f, 0x7d, 0xaf, 0xdf, 0x9c, 0x98, 0xf2, 0x02, 0x33, 0xe5,
-	0x55, 0xda, 0x1b, 0x2a, 0x86, 0xb1, 0x0d, 0x05, 0x36, 0xaf, 0x7a, 0x91, 0xab, 0x50, 0xe0, 0xfe,
-	0x57, 0x67, 0xe9, 0x04, 0x6f, 0x18, 0x0f, 0xa1, 0xc8, 0x67, 0x9b, 0xa2, 0x26, 0x6b, 0x50, 0xb4,
-	0xfb, 0x2c, 0x9a, 0xe4, 0x1b, 0x24, 0x5a, 0xe6, 0x01, 0x2c, 0x87, 0xa4, 0x87, 0xd2, 0xf7, 0x15,
-	0xa8, 0x74, 0x59, 0x97, 0x13, 0x5a, 0xdb, 0x2b, 0x53, 0x57, 0x6b, 0x4d, 0xe0, 0xcd, 0xdd, 0xc8,
-	0x8e, 0x49, 0xd5, 0x59, 0x85, 0x02, 0x0f, 0x65, 0x45, 0x86, 0xda, 0x96, 0xf1, 0xab, 0x3a, 0x9f,
-	0x34, 0xbf, 0x02, 0x4b, 0x47, 0xbe, 0xed, 0x06, 0x36, 0x4b, 0x20, 0xa7, 0x30, 0x04, 0x41, 0xfe,
-	0xb9, 0x37, 0x24, 0x32, 0x5f, 0xa1, 0xbf, 0xcd, 0x06, 0x6c, 0x3c, 0xc2, 0x34, 0xd1, 0xb2, 0xec,
-	0xb3, 0x08, 0x16, 0x49, 0xcb, 0x12, 0xe4, 0xba, 0x78, 0x24, 0xb0, 0xd0, 0x9f, 0xe6, 0x8f, 0x0b,
-	0xb0, 0xa9, 0x1e, 0x21, 0xf8, 0xa1, 0x9c, 0x3a, 0xdb, 0xf0, 0x6c, 0x40, 0x85, 0x49, 0x22, 0x71,
-	0xfa, 0xdc, 0x99, 0xe6, 0xac, 0x32, 0xed, 0x38, 0x72, 0xfa, 0x2c, 0x21, 0x64, 0x51, 0x34, 0xb7,
-	0xf5, 0xec, 0x37, 0xfa, 0x1a, 0xe4, 0x9e, 0x3b, 0x2e, 0x13, 0x88, 0x64, 0xf6, 0x39, 0x8d, 0xae,
-	0xfa, 0xfb, 0x8e, 0x6b, 0xd1, 0x91, 0x68, 0x57, 0xb0, 0xa1, 0xc8, 0x30, 0xd4, 0x2f, 0x80, 0xc1,
-	0x1b, 0x12, 0xce, 0x36, 0x6a, 0x1a, 0x07, 0xf6, 0xb8, 0xe7, 0xd9, 0x9d, 0x26, 0xe5, 0x4f, 0x49,
-	0x86, 0x40, 0xac, 0xeb, 0x2d, 0x1e, 0x05, 0x4a, 0x80, 0x0e, 0xc3, 0x29, 0xb2, 0x8e, 0xaa, 0xe8,
-	0xe5, 0x13, 0x19, 0x1d, 0xc8, 0xbd, 0xef, 0xb8, 0x33, 0x6f, 0x17, 0x8d, 0xe4, 0x02, 0xba, 0x35,
-	0x34, 0x83, 0xca, 0x31, 0xa5, 0x0d, 0xdb, 0x94, 0xc7, 0x67, 0x0e, 0x71, 0xb9, 0xa9, 0xa6, 0xda,
-	0x22, 0x9b, 0xc6, 0xff, 0x68, 0x90, 0xa7, 0xc4, 0x53, 0xd1, 0x7a, 0x6e, 0xf7, 0x86, 0xd2, 0x42,
-	0xf1, 0x06, 0x9a, 0x07, 0xcd, 0x15, 0xb3, 0x68, 0xae, 0x32, 0x3c, 0xa7, 0x99, 0x68, 0xdb, 0x77,
-	0x06, 0xa4, 0x69, 0x07, 0x7d, 0xe1, 0x08, 0x2a, 0xbc, 0x67, 0x27, 0xe8, 0x47, 0x3e, 0x77, 0x45,
-	0xb8, 0x1b, 0x7e, 0xa6, 0xbc, 0xf8, 0x65, 0x58, 0xf6, 0x71, 0xdb, 0x19, 0xd0, 0x5c, 0x3f, 0xf4,
-	0x26, 0x3c, 0x9d, 0x5d, 0x0a, 0x3f, 0x08, 0xad, 0x46, 0xb7, 0x60, 0x51, 0x58, 0xc7, 0x10, 0x94,
-	0x73, 0x77, 0x41, 0x74, 0x4b, 0xc0, 0x1b, 0xb0, 0x20, 0x6c, 0x62, 0x93, 0xd8, 0xfe, 0x09, 0x26,
-	0x92, 0xc3, 0xa2, 0xf7, 0x88, 0x75, 0x9a, 0x3f, 0xd1, 0x61, 0x83, 0xbb, 0x79, 0xb5, 0x84, 0xbf,
-	0x16, 0xda, 0x39, 0xa5, 0xee, 0x26, 0x14, 0x2b, 0xb4, 0x70, 0xef, 0x42, 0x89, 0x1b, 0x85, 0x40,
-	0x94, 0x53, 0x5e, 0x8b, 0x8d, 0x9b, 0x32, 0x63, 0x7d, 0x87, 0x8f, 0x7b, 0xdd, 0x25, 0xfe, 0xd8,
-	0x92, 0x58, 0xd2, 0x7a, 0x90, 0x8f, 0xe8, 0xc1, 0x0d, 0x58, 0x68, 0x77, 0x6d, 0xf7, 0x04, 0x27,
-	0x9c, 0x71, 0x95, 0xf7, 0x4a, 0x96, 0xdc, 0x86, 0xc5, 0x60, 0xd8, 0x22, 0xbe, 0xdd, 0x26, 0xc7,
-	0x18, 0x53, 0x5b, 0x29, 0xec, 0x66, 0xb2, 0xdb, 0x78, 0x08, 0xf3, 0x51, 0x32, 0xa8, 0x9e, 0x9f,
-	0xe2, 0xb1, 0xd4, 0xf3, 0x53, 0x3c, 0x9e, 0x88, 0x8a, 0x1e, 0x11, 0x95, 0x87, 0xfa, 0x97, 0x34,
-	0xf3, 0x47, 0x3a, 0x6c, 0xee, 0x0c, 0x89, 0xc7, 0xd7, 0xa8, 0x60, 0xe9, 0xc1, 0x84, 0x37, 0x9c,
-	0xa7, 0x5f, 0x8c, 0x47, 0x9f, 0x53, 0xc6, 0xce, 0xc2, 0x1c, 0x3d, 0xc1, 0x9c, 0x25, 0xc8, 0x1d,
-	0x63, 0x19, 0x88, 0xd3, 0x9f, 0xd4, 0xbd, 0x45, 0xdd, 0x87, 0x60, 0xd6, 0x5c, 0xc4, 0x79, 0x28,
-	0x38, 0x5a, 0x50, 0x70, 0xf4, 0x73, 0xf1, 0xe9, 0x15, 0xd8, 0x54, 0x8b, 0x81, 0x30, 0x94, 0x69,
-	0xdb, 0xfa, 0x8f, 0x1a, 0x5c, 0xe5, 0x43, 0x44, 0x14, 0xa0, 0x60, 0x6e, 0x72, 0x6d, 0x5a, 0x7a,
-	0x6d, 0x0a, 0x15, 0xd2, 0x95, 0x2a, 0x34, 0xf1, 0x73, 0xb9, 0xa8, 0x9f, 0x43, 0xd7, 0x80, 0x3a,
-	0xda, 0x8f, 0xb0, 0xdb, 0x1c, 0x60, 0xdf, 0xf1, 0x3a, 0x22, 0xd1, 0x9d, 0xe7, 0x9d, 0x07, 0xac,
-	0x4f, 0xb2, 0xbd, 0x10, 0xb2, 0xdd, 0xfc, 0x22, 0x6c, 0xbe, 0x89, 0xc9, 0x2e, 0xdd, 0x18, 0x41,
-	0xbf, 0x85, 0xcf, 0x6c, 0xbf, 0x23, 0x49, 0x5f, 0x83, 0xa2, 0x88, 0x37, 0x34, 0xb6, 0x85, 0xa2,
-	0x65, 0x7e, 0xaa, 0xc3, 0x95, 0x8c, 0x81, 0x82, 0x55, 0xef, 0x25, 0xa3, 0xe5, 0x5f, 0x49, 0xc6,
-	0x6b, 0xd9, 0x83, 0xeb, 0xbc, 0x99, 0x88, 0x9a, 0x23, 0xc4, 0xe8, 0x51, 0x62, 0x8c, 0x6f, 0x6b,
-	0x30, 0x1f, 0x1d, 0x41, 0xed, 0xa1, 0x6f, 0xbb, 0xa7, 0x22, 0x6e, 0x65, 0xbf, 0xb3, 0x02, 0x04,
-	0xda, 0x7f, 0xc6, 0x91, 0x52, 0x86, 0x6a, 0x96, 0x68, 0x45, 0x9d, 0x77, 0x3e, 0x15, 0x6a, 0x0c,
-	0x7c, 0xef, 0xd8, 0x21, 0x82, 0x91, 0xa2, 0x65, 0xd6, 0x59, 0xbc, 0x2b, 0x16, 0x94, 0x08, 0x10,
-	0xa4, 0x85, 0x96, 0xce, 0x62, 0x3c, 0xc0, 0xe6, 0x0f, 0xf2, 0x2c, 0x21, 0x4c, 0x0e, 0x08, 0x63,
-	0x94, 0x1c, 0x19, 0x49, 0xde, 0xdd, 0x49, 0xf2, 0x4e, 0x3d, 0xa8, 0x7e, 0x34, 0xb2, 0xe8, 0x28,
-	0xf4, 0x04, 0x4a, 0x7c, 0x19, 0xd2, 0xd4, 0xbd, 0x3a, 0x23, 0x82, 0x0f, 0xf8, 0x28, 0xa1, 0xcb,
-	0x02, 0x87, 0xf1, 0x3d, 0x0d, 0xe6, 0xc4, 0x80, 0xa7, 0x47, 0xdf, 0x78, 0x77, 0x76, 0xdf, 0x97,
-	0x9d, 0x15, 0x4e, 0xb6, 0x23, 0x3f, 0x5d, 0x8e, 0x0b, 0x69, 0x39, 0x36, 0xfe, 0x5c, 0x03, 0xfd,
-	0x68, 0xa4, 0x26, 0x63, 0x52, 0x99, 0xd5, 0x63, 0x95, 0xd9, 0x64, 0xfc, 0x9c, 0x4b, 0xc7, 0xcf,
-	0x6f, 0x40, 0x7e, 0x48, 0x46, 0x1e, 0xa3, 0x48, 0x71, 0x14, 0x92, 0xc1, 0xb2, 0x08, 0x63, 0x2c,
-	0x36, 0x9e, 0x5a, 0xa0, 0x28, 0x1f, 0xcf, 0xb3, 0x40, 0x5a, 0xd4, 0x02, 0xdd, 0x87, 0xf5, 0x43,
-	0xec, 0x76, 0x66, 0x0d, 0xed, 0x1e, 0x80, 0xa1, 0x02, 0x9f, 0x12, 0xd7, 0x99, 0xff, 0xab, 0x31,
-	0x39, 0x8d, 0xc0, 0xbf, 0x81, 0xc3, 0x1c, 0xf0, 0xed, 0xa4, 0x1f, 0x48, 0x71, 0x41, 0x39, 0x2e,
-	0xc3, 0x07, 0x4c, 0x1c, 0xb5, 0x7e, 0x11, 0x47, 0x7d, 0x15, 0xe6, 0xba, 0x76, 0x10, 0x4b, 0x9f,
-	0xca, 0x16, 0x74, 0xed, 0x40, 0x64, 0x4d, 0x9f, 0xcb, 0xc4, 0xdf, 0x67, 0x4a, 0x97, 0x5c, 0xc5,
-	0xc4, 0xbe, 0x53, 0x03, 0xa9, 0x4d, 0x0c, 0x24, 0x86, 0x05, 0x66, 0xa7, 0xf6, 0xdd, 0x63, 0xef,
-	0x0d, 0xcf, 0x3f, 0x1a, 0x65, 0x99, 0x44, 0x1a, 0x51, 0x09, 0x01, 0xb3, 0x83, 0xae, 0x98, 0xb7,
-	0xc2, 0xc5, 0xcb, 0x0e, 0xba, 0x34, 0xdb, 0xa4, 0xae, 0x30, 0x20, 0x76, 0x7f, 0x20, 0x82, 0xe6,
-	0x49, 0x87, 0xf9, 0x73, 0x9d, 0x47, 0x95, 0x9f, 0x35, 0xda, 0xdb, 0x85, 0xaa, 0x8f, 0x3b, 0x18,
-	0xf7, 0x9b, 0x22, 0x47, 0xe6, 0x32, 0x1c, 0x67, 0xf8, 0xfb, 0x8e, 0x5b, 0xb7, 0x18, 0x94, 0xb0,
-	0xac, 0xf3, 0x7e, 0xa4, 0x65, 0xfc, 0x8c, 0x99, 0xd1, 0x49, 0xc7, 0x2f, 0x38, 0xc4, 0x4d, 0xb9,
-	0xc5, 0xc2, 0x4c, 0x6e, 0xb1, 0x38, 0x63, 0x64, 0x59, 0x52, 0x45, 0x96, 0xff, 0xa6, 0x7f, 0xce,
-	0xa8, 0x7a, 0x0f, 0xaa, 0x22, 0x6c, 0x8e, 0xf1, 0x39, 0x5e, 0xab, 0xa3, 0x33, 0xd4, 0x0f, 0x19,
-	0x98, 0x64, 0x74, 0x10, 0x69, 0x19, 0xff, 0xaa, 0xc1, 0x7c, 0xf4, 0x33, 0x15, 0x3b, 0x1a, 0xa4,
-	0x0b, 0xb1, 0xb3, 0x83, 0xbe, 0xd4, 0x74, 0x3d, 0xd4, 0x74, 0xb4, 0x0e, 0x65, 0x1f, 0x3f, 0x6b,
-	0x06, 0xce, 0x49, 0x20, 0x4f, 0x28, 0x7c, 0xfc, 0xec, 0xd0, 0x39, 0x09, 0xd4, 0xc1, 0x7a, 0x7e,
-	0xf6, 0x60, 0xbd, 0x30, 0x23, 0x4b, 0x8b, 0x2a, 0x96, 0x36, 0x98, 0x35, 0x51, 0xdb, 0x2b, 0xa5,
-	0xfd, 0xf9, 0x34, 0xc7, 0x34, 0x70, 0xd6, 0x08, 0x6b, 0x82, 0x44, 0x57, 0x27, 0xa7, 0xb9, 0x29,
-	0xc9, 0x69, 0x3e, 0x91, 0x9c, 0x3e, 0x80, 0x02, 0xd3, 0x48, 0xb6, 0xe4, 0xb9, 0xed, 0x8d, 0xd8,
-	0xb6, 0xc5, 0xf5, 0xdc, 0xe2, 0x90, 0xc8, 0xe4, 0xb9, 0x2b, 0xcf, 0x3c, 0x97, 0x92, 0xfa, 0xc4,
-	0xd3, 0xd3, 0x1b, 0x42, 0x27, 0x4a, 0x0c, 0x68, 0x39, 0x25, 0x0c, 0x13, 0x6f, 0x28, 0x52, 0x49,
-	0x79, 0x9e, 0x25, 0x9a, 0xe8, 0x3a, 0x54, 0xe3, 0x15, 0xb7, 0x0a, 0xd3, 0xa2, 0x78, 0x67, 0x98,
-	0x5a, 0x43, 0x24, 0xb5, 0x16, 0x16, 0x6b, 0x6e, 0x12, 0x49, 0x4f, 0x1c, 0xe0, 0x3c, 0x83, 0x93,
-	0x0e, 0xd0, 0x80, 0x72, 0xdb, 0x73, 0xdc, 0x96, 0x1d, 0xe0, 0x5a, 0x95, 0x99, 0xd4, 0xb0, 0x6d,
-	0xde, 0x01, 0x44, 0x8d, 0xe2, 0x48, 0x1e, 0x01, 0x4f, 0xd9, 0xbe, 0x1d, 0x56, 0x4f, 0x9d, 0x80,
-	0x2a, 0xce, 0x81, 0x0b, 0xe2, 0x1c, 0x38, 0xee, 0x8a, 0x2b, 0x92, 0x12, 0xb3, 0x0b, 0xeb, 0x87,
-	0xce, 0x89, 0xab, 0x96, 0x99, 0x4b, 0x50, 0xf4, 0xed, 0xb3, 0x26, 0x91, 0x32, 0x50, 0xf0, 0xed,
-	0xb3, 0xa3, 0x11, 0x55, 0xd8, 0xe3, 0x9e, 0x7d, 0x22, 0x51, 0xf1, 0x46, 0xe2, 0x54, 0x23, 0x97,
-	0x3a, 0xd5, 0xf8, 0x35, 0x30, 0x54, 0x33, 0x65, 0xca, 0x1a, 0xe3, 0x51, 0x7f, 0xd0, 0xc3, 0x44,
-	0xd6, 0xb7, 0xc3, 0xb6, 0x59, 0x87, 0x85, 0x37, 0x31, 0x79, 0x4a, 0x46, 0x9e, 0x24, 0x35, 0x76,
-	0x70, 0xa1, 0x25, 0x0f, 0x2e, 0xfe, 0x43, 0x83, 0xfc, 0xc5, 0xa2, 0xa5, 0xac, 0xd8, 0x3e, 0x19,
-	0xba, 0xe4, 0xd3, 0xa1, 0x8b, 0x01, 0xe5, 0xbe, 0x4d, 0x86, 0xbe, 0x43, 0xc6, 0x22, 0x62, 0x0a,
-	0xdb, 0x69, 0xe1, 0x2a, 0xf2, 0xc3, 0xad, 0xb8, 0x70, 0xdd, 0x86, 0xa5, 0x60, 0x40, 0x0d, 0x48,
-	0x6b, 0xdc, 0x1c, 0xba, 0x7d, 0xc7, 0xc5, 0x1d, 0x66, 0x43, 0xcb, 0xd6, 0x02, 0xeb, 0xdf, 0x1d,
-	0x3f, 0xe5, 0xbd, 0xe6, 0x01, 0xcc, 0x09, 0x1b, 0xc1, 0x96, 0x97, 0x5d, 0x93, 0xbb, 0x05, 0x05,
-	0x1a, 0x0f, 0x49, 0xef, 0x1f, 0xd7, 0x0b, 0x16, 0x2f, 0xf1, 0xef, 0xe6, 0x01, 0x2c, 0x86, 0xac,
-	0x15, 0x7b, 0xf3, 0x55, 0xa8, 0x0a, 0x34, 0x4d, 0x8e, 0x83, 0x87, 0x23, 0x35, 0xd5, 0xa1, 0x08,
-	0x43, 0x35, 0x2f, 0xc0, 0x9f, 0x32, 0x8c, 0x3c, 0x16, 0x17, 0xf1, 0xc2, 0x0c, 0xb1, 0xf8, 0x0f,
-	0x79, 0x2c, 0x9e, 0x1c, 0x20, 0x88, 0x79, 0x3b, 0x5d, 0x2f, 0xac, 0xa7, 0xb2, 0x19, 0xe5, 0xd0,
-	0xba, 0x6c, 0x4f, 0x10, 0x18, 0x3f, 0xd5, 0x60, 0x4e, 0x40, 0x5f, 0x4c, 0x3e, 0x6e, 0xc0, 0x42,
-	0xd7, 0xeb, 0x75, 0xb0, 0xdf, 0x8c, 0x07, 0xd5, 0x55, 0xde, 0xbb, 0x73, 0x4e, 0x68, 0x9d, 0x36,
-	0xe8, 0x05, 0x85, 0x41, 0xa7, 0xd1, 0x17, 0xff, 0xdc, 0x64, 0x5c, 0xe2, 0x46, 0x1f, 0x78, 0xd7,
-	0x11, 0xf5, 0x81, 0x13, 0x00, 0x66, 0x8d, 0x4a, 0x8c, 0x42, 0x01, 0x70, 0xe8, 0x7c, 0x84, 0x8d,
-	0x7f, 0xd1, 0xa0, 0x24, 0xd6, 0xfd, 0xff, 0x1d, 0xa3, 0x67, 0xec, 0x42, 0x84, 0xdd, 0x3c, 0x46,
-	0x9f, 0xb1, 0x5c, 0x6d, 0xfe, 0xb1, 0x2e, 0xd3, 0x7b, 0x81, 0x42, 0x61, 0xb1, 0x9e, 0x4c, 0x2a,
-	0xe7, 0x9a, 0x22, 0xd9, 0x3a, 0x67, 0x78, 0xaa, 0x90, 0x9e, 0x0c, 0x8b, 0xf4, 0x74, 0x58, 0x94,
-	0x2a, 0x9f, 0x18, 0x83, 0xb0, 0x44, 0x9e, 0x16, 0x12, 0x4d, 0x25, 0x24, 0xb7, 0x60, 0x51, 0x0a,
-	0x43, 0xa2, 0xe0, 0x20, 0xba, 0xcf, 0x29, 0x38, 0x98, 0x1f, 0x44, 0x4e, 0x77, 0x92, 0x87, 0xf9,
-	0x9f, 0xeb, 0x24, 0xfa, 0x3d, 0xa6, 0x89, 0x49, 0xc4, 0x93, 0x63, 0xf1, 0xcc, 0x6b, 0x02, 0x89,
-	0x82, 0x75, 0x35, 0x7a, 0x6c, 0x78, 0x59, 0xd6, 0x1b, 0x76, 0xc7, 0x5c, 0x90, 0xce, 0x2b, 0x70,
-	0xfc, 0x13, 0x82, 0x25, 0x39, 0x26, 0xea, 0xe4, 0x58, 0x70, 0x2f, 0x64, 0x99, 0xfe, 0x8e, 0xdd,
-	0xc5, 0xd1, 0xe3, 0x77, 0x71, 0x12, 0x41, 0x4a, 0x7e, 0x12, 0xa4, 0x4c, 0x66, 0xcd, 0xc7, 0x72,
-	0x88, 0x94, 0xa9, 0x2e, 0x64, 0xc4, 0x01, 0x2c, 0xba, 0x29, 0xf2, 0x3b, 0x57, 0xf4, 0x37, 0xcd,
-	0x9b, 0x07, 0x3e, 0x7e, 0xee, 0x78, 0xc3, 0x80, 0x27, 0x20, 0x3c, 0xfe, 0x9d, 0x97, 0x9d, 0x2c,
-	0x07, 0xd9, 0x80, 0x8a, 0x8b, 0x47, 0x84, 0x03, 0xf0, 0x10, 0xa4, 0x4c, 0x3b, 0xd8, 0xc7, 0x3b,
-	0xb0, 0x44, 0x26, 0xd2, 0xd9, 0xf4, 0x3d, 0x8f, 0xb0, 0x30, 0xa4, 0x62, 0x2d, 0x46, 0xfa, 0x2d,
-	0xcf, 0x63, 0x0e, 0x49, 0x04, 0xf1, 0x1c, 0x0c, 0xb8, 0x88, 0x8a, 0x3e, 0x06, 0xc2, 0xe8, 0xf1,
-	0x06, 0x5e, 0x60, 0xf7, 0x38, 0xcc, 0x9c, 0xa4, 0x87, 0x77, 0x32, 0xa0, 0x35, 0x28, 0x0a, 0x4b,
-	0x34, 0xcf, 0x65, 0x8b, 0xb7, 0x28, 0xe3, 0x9e, 0x0d, 0xed, 0x1e, 0x75, 0x66, 0x55, 0xce, 0x52,
-	0xd1, 0xa4, 0x2e, 0xb7, 0xdd, 0xa5, 0xa2, 0xe1, 0x9e, 0xe0, 0xda, 0x02, 0xcf, 0xb1, 0xc2, 0x0e,
-	0x9a, 0x82, 0x0d, 0x86, 0xad, 0x9e, 0xd3, 0x6e, 0xd2, 0x74, 0x70, 0x91, 0x7f, 0xe6, 0x3d, 0x8f,
-	0xf1, 0x18, 0x7d, 0x19, 0x0a, 0x03, 0xdf, 0xf3, 0x8e, 0x6b, 0x4b, 0xcc, 0x78, 0x5c, 0x53, 0x16,
-	0xa4, 0x42, 0x9b, 0x71, 0x40, 0x41, 0x2d, 0x3e, 0x02, 0x1d, 0xc2, 0x22, 0xb7, 0x4c, 0x81, 0x73,
-	0xe2, 0x52, 0xc7, 0x8a, 0x6b, 0xcb, 0x0c, 0xc9, 0xdd, 0x73, 0x90, 0x78, 0x7b, 0x87, 0x72, 0x84,
-	0xb5, 0xc0, 0x50, 0x84, 0x6d, 0x76, 0xe9, 0xc8, 0x76, 0xd9, 0x0d, 0xd3, 0x1a, 0xe2, 0xb9, 0x51,
-	0xcb, 0x76, 0xd9, 0x2d, 0xc2, 0x77, 0x23, 0xec, 0xb3, 0x7d, 0x6c, 0xd7, 0x56, 0x66, 0x9a, 0x4d,
-	0x0c, 0xd9, 0xf1, 0xb1, 0x3d, 0x61, 0x35, 0x6d, 0xa1, 0xaf, 0x87, 0x61, 0xd5, 0xaa, 0xba, 0xa2,
-	0x14, 0xc7, 0x74, 0x34, 0xb2, 0xec, 0x33, 0x0b, 0x07, 0xc3, 0x1e, 0x91, 0x11, 0x98, 0x8c, 0x3e,
-	0x2f, 0x71, 0x8f, 0xc4, 0xa2, 0xcf, 0x75, 0x28, 0x53, 0xe9, 0x6b, 0x0e, 0x49, 0xbb, 0xb6, 0xc6,
-	0x77, 0x8a, 0xb6, 0x9f, 0x92, 0x36, 0xfb, 0x34, 0x12, 0x17, 0xbc, 0x2e, 0x73, 0x75, 0x24, 0xa3,
-	0xbd, 0x30, 0x9e, 0x11, 0xb6, 0x87, 0x89, 0x46, 0x8d, 0x8b, 0x8f, 0xe8, 0xa3, 0x92, 0x61, 0x3c,
-	0x81, 0x02, 0xe3, 0x3f, 0x4d, 0xc9, 0x64, 0x84, 0xa6, 0x8d, 0xd0, 0x65, 0x28, 0x8d, 0x9a, 0x03,
-	0x5f, 0x96, 0x94, 0x2b, 0x56, 0x71, 0x74, 0x40, 0x5b, 0x2c, 0xf9, 0x76, 0x48, 0x93, 0x8a, 0x01,
-	0xe9, 0x8a, 0x8c, 0xad, 0xd2, 0x72, 0xc8, 0xdb, 0xac, 0xc3, 0xb8, 0x0b, 0xf3, 0xd1, 0x9d, 0xa0,
-	0x58, 0x7d, 0x89, 0xd5, 0xa7, 0x2d, 0x69, 0xfd, 0xb4, 0xc0, 0xf8, 0xb4, 0x0c, 0xf3, 0x51, 0x46,
-	0xa2, 0x26, 0x2c, 0x0e, 0x86, 0xae, 0x13, 0x74, 0xfb, 0x2c, 0xbf, 0xa2, 0xbb, 0xa1, 0xaa, 0x91,
-	0x4f, 0xdd, 0x8d, 0xfa, 0x1b, 0xf6, 0xb0, 0x47, 0x0e, 0x86, 0xad, 0xc7, 0x78, 0x6c, 0x2d, 0x4c,
-	0xd0, 0xb1, 0x09, 0xbe, 0x01, 0xc0, 0xae, 0x58, 0x72, 0xdc, 0x3c, 0x58, 0xfa, 0xf2, 0x05, 0x70,
-	0xbf, 0xe3, 0xf9, 0x7d, 0xbb, 0x27, 0xbb, 0xac, 0x0a, 0x43, 0x46, 0xbf, 0x18, 0x3f, 0x2b, 0xc0,
-	0x5c, 0x64, 0xe6, 0xe4, 0xad, 0x87, 0xf8, 0x65, 0xbf, 0x50, 0xe0, 0x22, 0x37, 0x24, 0x43, 0x21,
-	0x3a, 0x12, 0x67, 0x4a, 0x11, 0xfd, 0xca, 0x25, 0xf5, 0xeb, 0x5b, 0x50, 0x21, 0x38, 0x20, 0x4e,
-	0xdf, 0x73, 0xc7, 0xe2, 0x10, 0xf9, 0xab, 0x9f, 0x8d, 0x45, 0xf5, 0xb7, 0xb0, 0xdd, 0xc1, 0xbe,
-	0x35, 0xc1, 0x67, 0xfc, 0x30, 0x0f, 0x45, 0xde, 0xfb, 0x8b, 0x37, 0xc3, 0xd2, 0xc0, 0x16, 0xa6,
-	0x19, 0xd8, 0xa2, 0xc2, 0xc0, 0xaa, 0x6c, 0x68, 0x69, 0x36, 0x1b, 0x5a, 0x9e, 0xc1, 0x86, 0x56,
-	0xa6, 0xda, 0x50, 0x88, 0xd9, 0xd0, 0x98, 0xa5, 0x9c, 0x9b, 0x6e, 0x29, 0xe7, 0x33, 0x2d, 0x65,
-	0xf5, 0x45, 0x58, 0xca, 0x85, 0x17, 0x6a, 0x29, 0x17, 0x63, 0x96, 0xd2, 0x68, 0xc3, 0x42, 0x5c,
-	0xfe, 0x3f, 0xaf, 0x90, 0x23, 0xc8, 0x77, 0x6c, 0x62, 0x0b, 0xf1, 0x66, 0xbf, 0x8d, 0xbf, 0xd3,
-	0x61, 0x2e, 0x62, 0x12, 0x99, 0x80, 0x8c, 0xa2, 0x41, 0xad, 0xd3, 0xc9, 0x0e, 0x3f, 0xa6, 0x9f,
-	0x13, 0x8a, 0xfa, 0x42, 0x7e, 0x96, 0xfa, 0x42, 0x61, 0xe6, 0xfa, 0x42, 0xf1, 0x9c, 0xfa, 0x42,
-	0x69, 0x5a, 0x7d, 0xa1, 0x1c, 0xb1, 0xf0, 0x22, 0xd4, 0xac, 0xa8, 0xea, 0x0b, 0x10, 0xab, 0x2f,
-	0xc8, 0xb4, 0x6a, 0x8e, 0x57, 0x00, 0x58, 0x5a, 0x85, 0xe1, 0x26, 0x0f, 0x7f, 0x0f, 0x3c, 0xaf,
-	0x77, 0x70, 0xba, 0x27, 0xea, 0x0d, 0x9f, 0xed, 0x8c, 0x2c, 0xb2, 0x3c, 0x3d, 0xb6, 0x3c, 0xf3,
-	0x6b, 0x60, 0xec, 0x75, 0x71, 0xfb, 0x34, 0x3e, 0x4b, 0x04, 0xf5, 0xc0, 0xf3, 0x7a, 0xcd, 0xc1,
-	0xb0, 0x75, 0x8a, 0xc7, 0x32, 0x53, 0x9f, 0xa3, 0x7d, 0x07, 0xbc, 0xcb, 0xfc, 0xbe, 0x0e, 0x1b,
-	0x4a, 0x0c, 0x61, 0x02, 0x58, 0xf4, 0xd9, 0xce, 0x0b, 0xcb, 0xff, 0x85, 0x78, 0x84, 0x9f, 0x3d,
-	0xb2, 0xce, 0x05, 0x86, 0xd7, 0xc5, 0x05, 0x0e, 0xe3, 0x4b, 0x90, 0x97, 0xef, 0x1a, 0x5c, 0xcf,
-	0x6d, 0x63, 0x79, 0x6b, 0x84, 0x35, 0x62, 0x75, 0x1a, 0x71, 0x0f, 0x57, 0xb6, 0x8d, 0x2e, 0xcc,
-	0x45, 0x10, 0x2a, 0xea, 0xde, 0x7b, 0xd1, 0xba, 0x77, 0xf2, 0xae, 0xc5, 0x34, 0x3a, 0xf9, 0x4d,
-	0xff, 0x49, 0x99, 0x7c, 0x9b, 0x85, 0xf7, 0xef, 0x60, 0x72, 0xe6, 0xf9, 0xa7, 0x22, 0x79, 0x39,
-	0x2f, 0x66, 0xfe, 0x6f, 0x5e, 0xd9, 0x4b, 0x0e, 0x12, 0x3c, 0xcc, 0xaa, 0x9b, 0x4f, 0xae, 0x99,
-	0x8b, 0x7a, 0xbf, 0x1e, 0xbd, 0x66, 0xce, 0xfb, 0xd0, 0x77, 0x34, 0xd8, 0x94, 0x31, 0xc3, 0xc0,
-	0x77, 0xda, 0xb8, 0xd9, 0xb7, 0x83, 0xa0, 0xd9, 0x72, 0x48, 0xe8, 0xf2, 0xe9, 0xbe, 0xbc, 0x9e,
-	0xb4, 0x31, 0x6a, 0x5a, 0x64, 0x3e, 0x78, 0x40, 0x31, 0x3d, 0xb1, 0x83, 0x60, 0x57, 0xe2, 0xe1,
-	0x1b, 0xb5, 0xde, 0xca, 0xfa, 0x8e, 0x5c, 0x58, 0x8d, 0xd3, 0xd1, 0xee, 0x3a, 0x76, 0xf3, 0x34,
-	0xcb, 0xdd, 0xcd, 0x30, 0xff, 0x5e, 0xd7, 0xb1, 0x1f, 0xf3, 0x79, 0x97, 0x5b, 0xc9, 0x7e, 0xe3,
-	0x6d, 0x78, 0x69, 0x3a, 0xb1, 0x51, 0x21, 0xa8, 0x9e, 0x73, 0xf8, 0x61, 0x3c, 0x82, 0x35, 0xf5,
-	0xd4, 0x17, 0xc1, 0x62, 0xbe, 0x06, 0xeb, 0x4c, 0x94, 0x78, 0xc1, 0x20, 0x21, 0x1c, 0x35, 0x28,
-	0x71, 0x0f, 0x24, 0x15, 0x4d, 0x36, 0xcd, 0xbf, 0xd5, 0x85, 0x9a, 0x26, 0xc6, 0x09, 0xf9, 0x78,
-	0x9c, 0xd0, 0xb1, 0x57, 0xd3, 0xb2, 0xab, 0x1c, 0xa8, 0x54, 0xb1, 0xdf, 0x10, 0x2a, 0x96, 0x28,
-	0x66, 0x68, 0xe7, 0x15, 0x33, 0xf4, 0x64, 0x31, 0x23, 0x2b, 0xff, 0x35, 0x4e, 0xce, 0x53, 0xc5,
-	0xdd, 0xb8, 0x2a, 0xde, 0x9b, 0x75, 0x39, 0x09, 0x4d, 0xdc, 0xfe, 0xc9, 0x0d, 0x80, 0x9d, 0x81,
-	0x73, 0x88, 0xfd, 0xe7, 0x4e, 0x1b, 0xa3, 0x5f, 0x87, 0x79, 0xea, 0x3b, 0x71, 0xc0, 0xfd, 0x27,
-	0x5a, 0xab, 0xf3, 0x17, 0x6e, 0xf5, 0x10, 0xf7, 0xeb, 0xfd, 0x01, 0x19, 0x1b, 0x57, 0xa6, 0xba,
-	0x5b, 0xf3, 0xf2, 0x6f, 0xff, 0xfb, 0xcf, 0xff, 0x40, 0x5f, 0x46, 0x8b, 0x8d, 0xe7, 0x0f, 0x1a,
-	0xcc, 0xdb, 0x06, 0x8d, 0x16, 0xdd, 0xbf, 0x8f, 0x27, 0x79, 0xaf, 0xcc, 0x95, 0xd1, 0x75, 0x25,
-	0xae, 0x44, 0x2a, 0x7d, 0xde, 0x8c, 0x26, 0x9b, 0x71, 0x13, 0x19, 0x91, 0x19, 0xb9, 0x09, 0x68,
-	0x7c, 0xcc, 0xff, 0x7e, 0x82, 0xfe, 0x54, 0x83, 0x4b, 0xca, 0x9b, 0x01, 0xe8, 0xce, 0x2c, 0xb7,
-	0x07, 0x38, 0x1d, 0x77, 0x67, 0xbf, 0x68, 0x60, 0xde, 0x61, 0x44, 0x5d, 0x43, 0x2f, 0x47, 0x88,
-	0x92, 0xd4, 0x34, 0xc4, 0x81, 0x88, 0xcf, 0x29, 0xf8, 0x90, 0x15, 0x29, 0xa3, 0x4f, 0xa5, 0x32,
-	0x79, 0x7f, 0x7d, 0x96, 0x07, 0x56, 0xe6, 0x3a, 0x9b, 0x7b, 0x05, 0x2d, 0xd3, 0xb9, 0xdb, 0x0c,
-	0xa2, 0x21, 0x7c, 0xa9, 0x0d, 0x30, 0x79, 0x6b, 0x95, 0x39, 0xcd, 0xd5, 0xd8, 0x34, 0xe9, 0xc7,
-	0x59, 0xa6, 0xc1, 0x66, 0x58, 0x35, 0x17, 0x23, 0x33, 0x3c, 0x1b, 0x3a, 0xe4, 0xa1, 0x76, 0x17,
-	0x1d, 0x41, 0x49, 0x3c, 0xb1, 0xca, 0xc4, 0xbf, 0x39, 0xed, 0x41, 0x96, 0xb9, 0xc2, 0x90, 0x57,
-	0xd1, 0x1c, 0x45, 0x2e, 0x9e, 0x63, 0x21, 0x1f, 0xe6, 0xa3, 0x8f, 0x65, 0xd0, 0x96, 0xa2, 0x14,
-	0x16, 0x7b, 0xb5, 0x60, 0xbc, 0x3c, 0x05, 0x42, 0xcc, 0x74, 0x85, 0xcd, 0x74, 0xd9, 0x44, 0x91,
-	0x99, 0x1a, 0x6d, 0x06, 0x49, 0x57, 0x72, 0x0c, 0x95, 0xf0, 0x89, 0x14, 0x8a, 0x0b, 0x61, 0xf2,
-	0xb1, 0x95, 0xf1, 0x52, 0xd6, 0x67, 0x15, 0xc7, 0xe4, 0x54, 0xc3, 0x80, 0xcd, 0xe3, 0xc3, 0x7c,
-	0xf4, 0x25, 0x4d, 0x62, 0x6d, 0x8a, 0x87, 0x3b, 0x89, 0xb5, 0xa9, 0x9e, 0xe1, 0xa8, 0xd7, 0xe6,
-	0x30, 0x48, 0x3a, 0xe7, 0x6f, 0xc2, 0x42, 0xfc, 0xbd, 0x0c, 0x32, 0x15, 0x38, 0x13, 0xf5, 0xb7,
-	0x59, 0xe6, 0xbd, 0xc9, 0xe6, 0xdd, 0x32, 0x37, 0xd2, 0xf3, 0x36, 0x64, 0x45, 0x4d, 0x2c, 0x3a,
-	0xfa, 0x3e, 0x25, 0xb1, 0x68, 0xc5, 0x73, 0x98, 0xc4, 0xe4, 0xaa, 0xc7, 0x2d, 0xea, 0x45, 0xe3,
-	0x91, 0x5c, 0xb4, 0x0f, 0xf3, 0xd1, 0xb7, 0x28, 0x89, 0x39, 0x15, 0x4f, 0x5f, 0x12, 0x73, 0xaa,
-	0x1e, 0xb2, 0xa8, 0xe7, 0xf4, 0x19, 0x24, 0x9d, 0xf3, 0x77, 0x34, 0x58, 0x4e, 0x95, 0x1d, 0xd1,
-	0x0d, 0xf5, 0x1d, 0xf3, 0x24, 0xbf, 0x6f, 0x9e, 0x07, 0x26, 0x68, 0xb8, 0xca, 0x68, 0x58, 0x37,
-	0x57, 0xa3, 0x34, 0x44, 0xb9, 0xfd, 0x7b, 0x1a, 0xb3, 0xbe, 0xb1, 0x9b, 0xec, 0x69, 0xeb, 0xab,
-	0xba, 0xbd, 0x6f, 0xdc, 0x98, 0xe9, 0x3a, 0xbc, 0x7a, 0xdf, 0xdb, 0x43, 0xdf, 0xa7, 0xb6, 0x41,
-	0xbc, 0x28, 0xa1, 0x94, 0x9c, 0x41, 0x35, 0xf6, 0xd2, 0x02, 0xa9, 0xf4, 0x34, 0xfe, 0x6e, 0xc3,
-	0x30, 0xa7, 0x81, 0xa8, 0x58, 0x10, 0x16, 0xdf, 0x23, 0xda, 0x4c, 0x98, 0x7f, 0x0b, 0x2b, 0xf0,
-	0x89, 0xcd, 0x57, 0x3c, 0xe5, 0x48, 0x6c, 0xbe, 0xea, 0x19, 0x86, 0x9c, 0x15, 0x5d, 0x8e, 0xcf,
-	0xfa, 0xb1, 0xc8, 0xd0, 0x3e, 0x41, 0xdf, 0xe6, 0xdb, 0x1f, 0x7f, 0x9c, 0x93, 0xde, 0x7e, 0xe5,
-	0xbb, 0xa7, 0xf4, 0xf6, 0xab, 0xdf, 0xf8, 0x98, 0x5b, 0x8c, 0x0a, 0xc3, 0xbc, 0x14, 0xa7, 0x22,
-	0xc2, 0xf5, 0xef, 0x68, 0xb0, 0x98, 0x78, 0x95, 0x83, 0xe2, 0xc9, 0xb7, 0xfa, 0xa1, 0x4f, 0xc2,
-	0xe3, 0x64, 0x3c, 0xec, 0x31, 0x6f, 0x33, 0x02, 0x4c, 0xb4, 0x95, 0x60, 0x83, 0xf8, 0xf9, 0x49,
-	0xe3, 0xb9, 0x18, 0x88, 0x3a, 0x50, 0x12, 0x27, 0x72, 0x68, 0x23, 0xb9, 0xba, 0xc8, 0x11, 0x68,
-	0xc2, 0x45, 0x24, 0x0e, 0xf1, 0xcc, 0x97, 0xd8, 0x7c, 0x35, 0x73, 0x25, 0x3e, 0x1f, 0x3b, 0xd0,
-	0xa3, 0xcb, 0xfd, 0xbe, 0x06, 0xab, 0xaa, 0xeb, 0xdb, 0xe8, 0xf6, 0x0c, 0x37, 0xbc, 0x39, 0x01,
-	0x77, 0x66, 0xbe, 0x0b, 0x2e, 0x03, 0x10, 0x93, 0x09, 0x41, 0xa4, 0x1c, 0x13, 0x34, 0xf8, 0x75,
-	0x6f, 0x49, 0x91, 0xea, 0x06, 0x68, 0x82, 0xa2, 0x29, 0x77, 0x85, 0x13, 0x14, 0x4d, 0xbb, 0x4e,
-	0x3a, 0x85, 0xa2, 0x89, 0x3e, 0xfc, 0x91, 0x06, 0x97, 0x94, 0xd7, 0x6f, 0x13, 0x21, 0xd1, 0xb4,
-	0x2b, 0xba, 0x17, 0xa1, 0xe9, 0x16, 0xa3, 0xe9, 0x65, 0x73, 0x33, 0x83, 0xa6, 0x86, 0x3d, 0x24,
-	0x9e, 0xb0, 0x55, 0x28, 0x7d, 0xb8, 0x8e, 0xe2, 0xca, 0x90, 0x79, 0xce, 0x6f, 0xdc, 0x3a, 0x17,
-	0x4e, 0xa5, 0x35, 0x31, 0x82, 0x02, 0xe7, 0xc4, 0x8d, 0xd8, 0xee, 0xf8, 0x9d, 0xae, 0xb4, 0xf2,
-	0x2a, 0x6f, 0xae, 0xa5, 0x95, 0x57, 0x7d, 0x35, 0x2c, 0x6e, 0xb8, 0x62, 0x64, 0x1c, 0x63, 0x1c,
-	0xf2, 0x23, 0x75, 0x17, 0x2f, 0xc9, 0x8f, 0xac, 0xbb, 0x7d, 0x49, 0x7e, 0x64, 0x5e, 0xea, 0x9b,
-	0xc6, 0x0f, 0xec, 0x76, 0x28, 0x25, 0xdf, 0xe5, 0xfc, 0x48, 0x10, 0x92, 0xe2, 0x87, 0x9a, 0x8e,
-	0x9b, 0xe7, 0x81, 0xa9, 0x6c, 0x49, 0x8c, 0x8c, 0x8f, 0xd9, 0x81, 0xef, 0x27, 0x0d, 0x79, 0x6d,
-	0x77, 0x0c, 0x73, 0x91, 0x1b, 0x23, 0xe8, 0x6a, 0x8a, 0xe1, 0xf1, 0x6b, 0x27, 0xc6, 0x56, 0x36,
-	0x40, 0x5c, 0x46, 0xd1, 0xd5, 0xcc, 0xb9, 0x45, 0x1c, 0xfd, 0x27, 0x1a, 0xd4, 0xb2, 0x6e, 0x67,
-	0xa3, 0x7b, 0x0a, 0xa5, 0xc8, 0xbc, 0xc4, 0x7d, 0x11, 0x15, 0xba, 0xc6, 0xc8, 0xbb, 0x62, 0xd6,
-	0xd2, 0x3b, 0xc4, 0xd1, 0xd3, 0x4d, 0xf2, 0xa0, 0x12, 0x3e, 0x23, 0x42, 0x19, 0xaf, 0x8f, 0xd4,
-	0x51, 0x6b, 0xea, 0x3d, 0xd3, 0x94, 0x09, 0xf9, 0xad, 0x83, 0x31, 0x9d, 0xf0, 0xef, 0xb9, 0x54,
-	0xc4, 0x6f, 0xb1, 0xa6, 0xa5, 0x42, 0x79, 0x7f, 0x39, 0x2d, 0x15, 0xea, 0xcb, 0xb0, 0xe6, 0x21,
-	0xa3, 0xe4, 0x09, 0xba, 0x95, 0xb5, 0x74, 0x49, 0x51, 0xe3, 0x63, 0x9a, 0x90, 0x7f, 0xf2, 0x4d,
-	0x95, 0x00, 0x25, 0x40, 0x25, 0xe5, 0xf1, 0xb3, 0xfd, 0x34, 0xe5, 0xca, 0xdb, 0x1e, 0x69, 0xca,
-	0xd5, 0x57, 0x04, 0xa6, 0x50, 0x2e, 0x8a, 0x32, 0xb3, 0x50, 0x9e, 0x00, 0x8d, 0xc8, 0x5f, 0xfa,
-	0xfc, 0x5f, 0x29, 0x7f, 0x99, 0xd7, 0x04, 0x5e, 0x8c, 0xfc, 0x09, 0xfa, 0xa8, 0x38, 0xfc, 0x38,
-	0x7c, 0xb8, 0x90, 0x59, 0x9b, 0x45, 0xaa, 0x8b, 0x0c, 0xe7, 0x55, 0x72, 0x2f, 0x42, 0xe8, 0x5d,
-	0x46, 0xe8, 0x75, 0x33, 0xad, 0xc7, 0x03, 0xcf, 0xeb, 0x0d, 0x4e, 0x65, 0xdd, 0x93, 0xd2, 0xfb,
-	0x37, 0x5c, 0x08, 0xe2, 0x05, 0xb5, 0xb4, 0x10, 0x28, 0x2b, 0x96, 0x69, 0x21, 0x50, 0xd7, 0xe5,
-	0xcc, 0xc7, 0x8c, 0xa0, 0xd7, 0x11, 0x8b, 0x8e, 0x05, 0xb3, 0x82, 0x86, 0xcb, 0x81, 0x45, 0xfb,
-	0x9b, 0x37, 0xd1, 0xf5, 0x29, 0x9f, 0x27, 0xc5, 0x8c, 0xef, 0x69, 0xb0, 0xa2, 0x28, 0xb9, 0xa2,
-	0x5b, 0xe7, 0x17, 0x65, 0x39, 0xd5, 0xb7, 0x67, 0xad, 0xde, 0xc6, 0x77, 0x3c, 0x24, 0x8c, 0x31,
-	0x91, 0x57, 0xb8, 0x45, 0x70, 0x89, 0xd2, 0x75, 0xa7, 0x84, 0x83, 0xca, 0x2c, 0xec, 0x19, 0xb7,
-	0x66, 0x2c, 0x60, 0xc5, 0x3d, 0x65, 0x48, 0x8c, 0xa8, 0x02, 0x3e, 0xd4, 0xee, 0xee, 0xfe, 0x48,
-	0xff, 0xc1, 0xce, 0x5f, 0xe8, 0xe8, 0x10, 0x16, 0x9f, 0xec, 0x1c, 0x1e, 0xde, 0xe7, 0x89, 0xca,
-	0xd6, 0xce, 0xc1, 0xbe, 0xf9, 0x65, 0x98, 0xa7, 0x5d, 0x5b, 0x03, 0xdf, 0xfb, 0x10, 0xb7, 0x09,
-	0x5a, 0xed, 0x12, 0x32, 0x08, 0x1e, 0x36, 0x1a, 0x7d, 0x3b, 0x08, 0x5c, 0x4c, 0xea, 0x9e, 0x7f,
-	0xd2, 0x30, 0x56, 0xda, 0x9e, 0x4b, 0xec, 0x36, 0xf9, 0x7a, 0xa4, 0xf7, 0xee, 0x2f, 0x6d, 0xe7,
-	0x1e, 0xd4, 0x5f, 0xb9, 0xab, 0xe9, 0xdb, 0x4b, 0xf6, 0x60, 0xd0, 0x73, 0xda, 0xec, 0x50, 0xa3,
-	0xf1, 0x61, 0xe0, 0xb9, 0xdb, 0x6b, 0xd1, 0x9e, 0xd1, 0xfd, 0x63, 0xcf, 0xbb, 0xdf, 0x77, 0xfa,
-	0xf8, 0x61, 0x0a, 0xf2, 0x61, 0x06, 0xa4, 0x75, 0x15, 0x72, 0x5f, 0x78, 0xe5, 0x55, 0x54, 0x83,
-	0x85, 0x77, 0xbc, 0xad, 0x01, 0xf6, 0xfb, 0x4e, 0x40, 0x13, 0x87, 0x3a, 0x2a, 0x42, 0xfe, 0xcf,
-	0x74, 0xad, 0x64, 0x6d, 0x50, 0x80, 0x2f, 0xa0, 0x55, 0x80, 0x77, 0x3c, 0xb2, 0x75, 0xec, 0x0d,
-	0xdd, 0x4e, 0xf8, 0xd1, 0x7f, 0x0d, 0xae, 0x24, 0x56, 0xba, 0xf5, 0xc8, 0x6b, 0x0f, 0xfb, 0xd8,
-	0xe5, 0xff, 0xac, 0x4a, 0xbd, 0xce, 0x56, 0x91, 0x71, 0xfb, 0xd5, 0xff, 0x0b, 0x00, 0x00, 0xff,
-	0xff, 0x15, 0xb2, 0x4e, 0x03, 0x28, 0x4b, 0x00, 0x00,
+	// 7783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7c, 0x5d, 0x8c, 0x1c, 0xc7,
+	0x71, 0xf0, 0x37, 0xfb, 0xbf, 0x75, 0xbb, 0xf7, 0x33, 0xf7, 0xc3, 0xe5, 0x90, 0x14, 0x8f, 0x23,
+	0xfe, 0x89, 0x16, 0xef, 0x28, 0xca, 0xf2, 0x67, 0x51, 0x9f, 0x6c, 0x1f, 0x4f, 0x14, 0xc5, 0x8f,
+	0x3c, 0x8b, 0x9a, 0x23, 0x25, 0xc3, 0x06, 0xbc, 0x99, 0xdd, 0xed, 0xbb, 0x1d, 0xdd, 0xee, 0xcc,
+	0x6a, 0x66, 0x96, 0xb7, 0x27, 0x41, 0x49, 0x6c, 0xcb, 0x76, 0x10, 0x38, 0x36, 0x9c, 0x20, 0x3f,
+	0x4e, 0x9e, 0x9c, 0x1f, 0x24, 0x70, 0x62, 0x24, 0x40, 0x12, 0xe4, 0x21, 0x01, 0xf2, 0x90, 0x07,
+	0x03, 0x41, 0x80, 0x24, 0x48, 0x80, 0x3c, 0xc4, 0x0f, 0x06, 0xe2, 0xbc, 0x04, 0x79, 0x32, 0x02,
+	0x04, 0x79, 0x0b, 0xfa, 0x6f, 0xa6, 0x7b, 0xa6, 0x67, 0x76, 0x8f, 0xa2, 0x92, 0xa7, 0xdd, 0xee,
+	0xa9, 0xee, 0xaa, 0xae, 0xee, 0xae, 0xae, 0xaa, 0xae, 0x2e, 0xa8, 0xdb, 0x23, 0x67, 0x63, 0xe4,
+	0x7b, 0xa1, 0xa7, 0xcf, 0xf9, 0xa3, 0x2e, 0xf9, 0xd7, 0x19, 0xef, 0x19, 0xa7, 0xf7, 0x3d, 0x6f,
+	0x7f, 0x80, 0x36, 0xed, 0x91, 0xb3, 0x69, 0xbb, 0xae, 0x17, 0xda, 0xa1, 0xe3, 0xb9, 0x01, 0x05,
+	0x35, 0x9e, 0x25, 0x3f, 0xdd, 0xab, 0xfb, 0xc8, 0xbd, 0x1a, 0x1c, 0xda, 0xfb, 0xfb, 0xc8, 0xdf,
+	0xf4, 0x46, 0x04, 0x42, 0x01, 0x7d, 0x8a, 0xf5, 0xc5, 0x3b, 0xdf, 0x44, 0xc3, 0x51, 0x78, 0x44,
+	0x3f, 0x9a, 0xdf, 0xab, 0xc0, 0x89, 0xdb, 0x28, 0xdc, 0x1e, 0x38, 0xc8, 0x0d, 0x77, 0x43, 0x3b,
+	0x1c, 0x07, 0x16, 0x0a, 0x46, 0x9e, 0x1b, 0x20, 0xfd, 0x02, 0xcc, 0x8f, 0x10, 0xf2, 0xdb, 0x03,
+	0x27, 0x08, 0x91, 0xeb, 0xb8, 0xfb, 0x2d, 0x6d, 0x5d, 0xbb, 0x5c, 0xb3, 0x9a, 0xb8, 0xf6, 0x1e,
+	0xaf, 0xd4, 0x5b, 0x50, 0x0d, 0x8e, 0xdc, 0x2e, 0xfe, 0x5e, 0x20, 0xdf, 0x79, 0x51, 0x3f, 0x09,
+	0xb5, 0x6e, 0xdf, 0x76, 0xdc, 0xb6, 0xd3, 0x6b, 0x15, 0xd7, 0xb5, 0xcb, 0x75, 0xab, 0x4a, 0xca,
+	0x77, 0x7a, 0xfa, 0x15, 0x58, 0x1a, 0x78, 0x5d, 0x7b, 0xd0, 0xee, 0xa0, 0x20, 0x6c, 0xf7, 0x91,
+	0xb3, 0xdf, 0x0f, 0x5b, 0xa5, 0x75, 0xed, 0x72, 0xc9, 0x5a, 0x20, 0x1f, 0x6e, 0xa2, 0x20, 0x7c,
+	0x8d, 0x54, 0x63, 0xd8, 0x03, 0xd7, 0x3b, 0x74, 0x25, 0xd8, 0x32, 0x85, 0x25, 0x1f, 0x04, 0xd8,
+	0x67, 0x41, 0x3f, 0xb4, 0x07, 0x03, 0x14, 0xb6, 0x31, 0x11, 0x1c, 0xb8, 0x42, 0x80, 0x17, 0xe9,
+	0x97, 0xdd, 0x23, 0xb7, 0xcb, 0xa0, 0xdf, 0x00, 0x20, 0x23, 0xec, 0x7a, 0x63, 0x37, 0x6c, 0x55,
+	0xd7, 0xb5, 0xcb, 0x73, 0xd7, 0xaf, 0x6f, 0x08, 0x13, 0xb1, 0x91, 0xc1, 0x9b, 0x0d, 0xdc, 0x6c,
+	0x1b, 0xb7, 0xba, 0xe3, 0xee, 0x79, 0x56, 0x3d, 0x2a, 0xea, 0xdb, 0x50, 0xc6, 0x85, 0xa0, 0x55,
+	0x23, 0xbd, 0x5d, 0x9d, 0xb9, 0x37, 0xcc, 0x50, 0x8b, 0xb6, 0x35, 0xbe, 0x00, 0x4d, 0x09, 0x81,
+	0xbe, 0x02, 0xe5, 0xd0, 0x0b, 0xed, 0x01, 0x99, 0x81, 0xa6, 0x45, 0x0b, 0xba, 0x01, 0x35, 0x6f,
+	0x1c, 0x76, 0xbc, 0xb1, 0xdb, 0x23, 0xac, 0x6f, 0x5a, 0x51, 0x19, 0xcf, 0x8a, 0xe3, 0xd2, 0x4f,
+	0x45, 0xf2, 0x89, 0x17, 0x0d, 0x0b, 0x6a, 0xb8, 0x73, 0xd2, 0xef, 0x3c, 0x14, 0x9c, 0x1e, 0xe9,
+	0xb4, 0x6e, 0x15, 0x1c, 0xd2, 0xca, 0xee, 0xf5, 0x7c, 0x14, 0x04, 0xa4, 0xc3, 0xba, 0xc5, 0x8b,
+	0xfa, 0x69, 0xa8, 0xf7, 0x1c, 0x1f, 0x75, 0xf1, 0xca, 0x62, 0x93, 0x19, 0x57, 0x18, 0xff, 0xa2,
+	0x41, 0x8d, 0x0f, 0x42, 0xbf, 0x23, 0x90, 0xa5, 0xad, 0x17, 0x8f, 0xc5, 0x05, 0xc2, 0xce, 0x78,
+	0x14, 0xb7, 0xe3, 0x51, 0x14, 0x1e, 0xa7, 0x27, 0xde, 0x1a, 0x4f, 0x8b, 0x17, 0xf6, 0x91, 0xdf,
+	0x2a, 0x3e, 0x4e, 0x37, 0xb4, 0xad, 0x79, 0x03, 0xf4, 0x37, 0xc6, 0x0e, 0x83, 0x8d, 0xb6, 0x89,
+	0x0e, 0xa5, 0xae, 0xd7, 0x43, 0x84, 0x8b, 0x45, 0x8b, 0xfc, 0xd7, 0x17, 0xa1, 0x38, 0x0c, 0xf6,
+	0x19, 0x0f, 0xf1, 0x5f, 0xf3, 0xb7, 0x0b, 0xb0, 0xf0, 0x16, 0x59, 0x7f, 0xf1, 0x06, 0x7b, 0x05,
+	0xaa, 0x74, 0x49, 0x06, 0x8c, 0x4f, 0x57, 0x24, 0xb2, 0x12, 0xe0, 0xac, 0xbc, 0x3b, 0x1e, 0x0e,
+	0x6d, 0xff, 0xc8, 0xe2, 0x4d, 0x8d, 0xbf, 0xd6, 0xa0, 0x29, 0x7d, 0xd2, 0x4f, 0x41, 0x9d, 0x6d,
+	0x82, 0x68, 0x72, 0x6b, 0xb4, 0xe2, 0x4e, 0x0f, 0x93, 0x1b, 0x1e, 0x8d, 0x10, 0x5b, 0x30, 0xe4,
+	0x3f, 0x9e, 0xf6, 0x47, 0xc8, 0x0f, 0xf8, 0xd4, 0x36, 0x2d, 0x5e, 0xc4, 0x5f, 0x7c, 0x34, 0xb4,
+	0xfd, 0x83, 0x80, 0xec, 0xce, 0xba, 0xc5, 0x8b, 0xfa, 0x1a, 0x54, 0x02, 0xc2, 0x2e, 0xb2, 0x15,
+	0x9b, 0x16, 0x2b, 0xe9, 0x67, 0x00, 0xe8, 0xbf, 0x36, 0xe6, 0x40, 0x85, 0xae, 0x14, 0x5a, 0xb3,
+	0x13, 0xec, 0xe3, 0xcf, 0x87, 0x76, 0xd8, 0xed, 0xb7, 0x3d, 0x77, 0x70, 0x44, 0xb6, 0x5c, 0xcd,
+	0xaa, 0x93, 0x9a, 0xd7, 0xdd, 0xc1, 0x91, 0xb9, 0x09, 0x8b, 0x0f, 0x03, 0x44, 0x87, 0x63, 0xa1,
+	0x77, 0xc6, 0x28, 0x08, 0x73, 0x87, 0x63, 0xfe, 0x71, 0x01, 0x96, 0x84, 0x16, 0x8c, 0xb3, 0xa2,
+	0xe4, 0xd1, 0x64, 0xc9, 0x23, 0xf5, 0x56, 0xc8, 0x60, 0x4e, 0x51, 0xcd, 0x9c, 0x92, 0xcc, 0x9c,
+	0xa7, 0xa1, 0x49, 0x36, 0x62, 0xbb, 0x63, 0x0f, 0x6c, 0xb7, 0x8b, 0x08, 0x27, 0xea, 0x56, 0x83,
+	0x54, 0xde, 0xa4, 0x75, 0x58, 0x22, 0xa1, 0x49, 0x88, 0x7c, 0xd7, 0x1e, 0xb4, 0x0f, 0xd0, 0x11,
+	0x93, 0x35, 0x98, 0x2f, 0x65, 0x6b, 0x91, 0x7f, 0xb9, 0x8b, 0x8e, 0xa8, 0xf8, 0x78, 0x16, 0x74,
+	0xc7, 0x4d, 0x41, 0x57, 0x29, 0xb4, 0xe3, 0x26, 0xa0, 0x85, 0xd9, 0xa9, 0xc9, 0xb3, 0x23, 0xb3,
+	0xb9, 0x9e, 0x64, 0xf3, 0xdb, 0xb0, 0xbc, 0xed, 0x23, 0x3b, 0x4c, 0x70, 0xfa, 0x29, 0x80, 0x91,
+	0x1d, 0x04, 0xa3, 0xbe, 0x6f, 0x07, 0x88, 0x31, 0x4e, 0xa8, 0x11, 0xf1, 0x15, 0x64, 0x7c, 0x27,
+	0xa1, 0xd6, 0x71, 0xc2, 0x76, 0xe0, 0xbc, 0x4b, 0x99, 0x57, 0xb6, 0xaa, 0x1d, 0x27, 0xdc, 0x75,
+	0xde, 0x45, 0xa6, 0x03, 0x2b, 0x32, 0x2e, 0x36, 0x47, 0xb9, 0xab, 0xd4, 0x80, 0xda, 0xd0, 0x45,
+	0x43, 0xcf, 0x75, 0xba, 0x7c, 0x92, 0x78, 0x39, 0x7b, 0xb5, 0x9a, 0x6f, 0xc0, 0xf2, 0x9d, 0xe1,
+	0xc8, 0xf3, 0x43, 0x79, 0x58, 0x06, 0xd4, 0x0e, 0xd0, 0x51, 0x10, 0x7a, 0x3e, 0x1f, 0x54, 0x54,
+	0x4e, 0x0c, 0xb9, 0x90, 0x1c, 0xb2, 0xf9, 0x3d, 0x0d, 0x56, 0xe4, 0x3e, 0x19, 0xf9, 0xf3, 0x50,
+	0xf0, 0x0e, 0xd8, 0x89, 0x58, 0xf0, 0x0e, 0x9e, 0xe4, 0xba, 0x12, 0xd8, 0x5c, 0xce, 0x9b, 0xd6,
+	0x4a, 0x72, 0x5a, 0xff, 0x5c, 0x83, 0x55, 0x4a, 0xec, 0x0e, 0x63, 0x96, 0xc0, 0x82, 0x88, 0x9f,
+	0x5a, 0x82, 0x9f, 0x53, 0x58, 0x20, 0x92, 0x53, 0x94, 0xc9, 0xb9, 0x00, 0xf3, 0xd1, 0xda, 0x76,
+	0xdc, 0x1e, 0x9a, 0xb0, 0x91, 0x34, 0x79, 0xed, 0x1d, 0x5c, 0x89, 0xc1, 0x1c, 0x57, 0x02, 0xa3,
+	0x22, 0xa3, 0xe9, 0xb8, 0x02, 0x98, 0xf9, 0x7b, 0x1a, 0xac, 0x71, 0x56, 0xb3, 0x11, 0x71, 0xf2,
+	0x2f, 0xc2, 0x82, 0xdd, 0x25, 0x7b, 0xa1, 0x3d, 0x1a, 0x77, 0xf0, 0xce, 0x60, 0xa3, 0x68, 0xb2,
+	0xea, 0xfb, 0xe3, 0xce, 0x5d, 0x74, 0x94, 0xb3, 0x40, 0xd3, 0xa4, 0x16, 0x67, 0x23, 0xb5, 0xa4,
+	0x22, 0xf5, 0x07, 0x31, 0xa3, 0xc7, 0x83, 0xd0, 0x09, 0x9c, 0x7d, 0x4e, 0xe9, 0x69, 0xa8, 0x87,
+	0x7d, 0x1f, 0x05, 0x7d, 0x6f, 0xd0, 0x63, 0xa7, 0x75, 0x5c, 0xa1, 0x5f, 0x86, 0xc5, 0xc4, 0x38,
+	0x02, 0x72, 0xb0, 0xd5, 0xad, 0x79, 0x69, 0x20, 0xc1, 0xff, 0x18, 0xd3, 0x5f, 0x80, 0xb5, 0x5b,
+	0x13, 0x25, 0xcf, 0x73, 0xc5, 0xee, 0x16, 0x9c, 0x48, 0x35, 0x63, 0x1b, 0x63, 0xc6, 0xb9, 0x32,
+	0x2d, 0x58, 0xe6, 0x5d, 0xcc, 0x2a, 0xed, 0xa7, 0xee, 0xd6, 0xeb, 0xb0, 0x22, 0xf7, 0xc9, 0x68,
+	0xca, 0x91, 0x00, 0x98, 0x0e, 0x0b, 0x0d, 0xbd, 0x47, 0xe8, 0x09, 0xd2, 0x71, 0x11, 0x56, 0xe4,
+	0x3e, 0xd5, 0x42, 0xc3, 0x1c, 0x61, 0xdc, 0x41, 0xd7, 0x76, 0x8f, 0x81, 0xfb, 0x2c, 0xcc, 0xed,
+	0xf9, 0xde, 0x90, 0xeb, 0xb6, 0x05, 0xa2, 0xdb, 0x02, 0xae, 0x62, 0x5a, 0xed, 0x29, 0xa8, 0xef,
+	0xdb, 0xa3, 0xf6, 0xc0, 0x19, 0x3a, 0x21, 0x5b, 0xe5, 0xb5, 0x7d, 0x7b, 0x74, 0x0f, 0x97, 0xcd,
+	0xaf, 0x6b, 0xb0, 0x22, 0xa3, 0x9c, 0x45, 0x1c, 0x4f, 0xc5, 0xf9, 0x1c, 0xac, 0xf4, 0x9c, 0xa0,
+	0xeb, 0x3d, 0x42, 0x3e, 0xea, 0xb5, 0x99, 0xd2, 0x88, 0x02, 0x86, 0x7e, 0x39, 0xfe, 0xb6, 0xc5,
+	0x3f, 0x99, 0xcf, 0xc0, 0xf2, 0x4d, 0xbb, 0x7b, 0x30, 0x1e, 0xc9, 0x63, 0xd7, 0xa1, 0x34, 0xb2,
+	0xc3, 0x3e, 0x23, 0x81, 0xfc, 0x37, 0x7f, 0xb5, 0x00, 0x2b, 0x32, 0x6c, 0xac, 0x7b, 0x25, 0x81,
+	0x71, 0x1d, 0x39, 0x86, 0x0a, 0x54, 0x1f, 0xc3, 0xff, 0xf1, 0xfc, 0x77, 0xfb, 0xa8, 0x7b, 0x10,
+	0x8c, 0x87, 0x6c, 0x3b, 0x45, 0x65, 0xbc, 0xd3, 0x90, 0x1b, 0xfa, 0x0e, 0x0a, 0x98, 0x01, 0xc2,
+	0x8b, 0x58, 0xda, 0x76, 0xc9, 0xc9, 0xd5, 0x6b, 0xdb, 0xd4, 0xe2, 0x28, 0x5a, 0x75, 0x56, 0xb3,
+	0x15, 0xe2, 0x1d, 0x16, 0x74, 0xfb, 0x68, 0x68, 0xb7, 0xb9, 0x1c, 0xaf, 0xd0, 0x1d, 0x46, 0x6b,
+	0xdf, 0x8c, 0xb5, 0x04, 0x6c, 0x8b, 0xa0, 0x1e, 0xe7, 0x5e, 0x95, 0x60, 0x69, 0xd0, 0x4a, 0xc6,
+	0xbf, 0x56, 0xac, 0x0a, 0xd6, 0x88, 0x3c, 0xe0, 0x45, 0x2c, 0x50, 0x90, 0xdb, 0xf5, 0x8f, 0x46,
+	0x21, 0xea, 0xf1, 0x83, 0x3c, 0xaa, 0x30, 0x07, 0xb0, 0xfc, 0xd0, 0x1d, 0x78, 0xdd, 0x83, 0x27,
+	0xb7, 0x78, 0x31, 0x2d, 0xa1, 0x33, 0x44, 0xde, 0x98, 0xaf, 0x1e, 0x5e, 0x34, 0xaf, 0xc1, 0x8a,
+	0x8c, 0x8d, 0x4d, 0x03, 0x66, 0xe1, 0x64, 0xe4, 0xf8, 0x28, 0x60, 0x5a, 0x30, 0x2f, 0x9a, 0xd7,
+	0x60, 0xe9, 0xde, 0xb1, 0xa8, 0x33, 0xcf, 0x83, 0x7e, 0x2f, 0x8d, 0x21, 0xb9, 0x71, 0xbe, 0xa1,
+	0x41, 0xeb, 0x36, 0x0a, 0xd9, 0x6a, 0x62, 0xba, 0x16, 0xef, 0xff, 0x05, 0x58, 0xf3, 0xd1, 0x3b,
+	0x63, 0x07, 0x2f, 0xc5, 0xae, 0xe7, 0xee, 0x39, 0xfe, 0x90, 0x5a, 0xc4, 0xa4, 0x83, 0xb2, 0xb5,
+	0xca, 0xbf, 0x6e, 0x8b, 0x1f, 0x31, 0xa7, 0xe3, 0x85, 0x4b, 0xa5, 0x72, 0x5c, 0x21, 0x13, 0x5d,
+	0x4c, 0x10, 0xfd, 0x03, 0x0d, 0x96, 0x18, 0x2d, 0x5b, 0x6e, 0x8f, 0xab, 0x7e, 0x82, 0x35, 0xa5,
+	0xc9, 0xd6, 0x54, 0x64, 0xcf, 0x51, 0xee, 0xd3, 0x02, 0x26, 0x20, 0x18, 0x21, 0xb7, 0x67, 0x77,
+	0x06, 0x88, 0xdb, 0x58, 0x51, 0x05, 0xde, 0x62, 0x87, 0x4e, 0xd8, 0xef, 0xf9, 0xf6, 0x21, 0x2e,
+	0xb7, 0x83, 0xd0, 0x3e, 0xc0, 0x46, 0x37, 0xd5, 0xcb, 0x97, 0xc5, 0x6f, 0xbb, 0xf4, 0x53, 0xaa,
+	0x49, 0xc7, 0x71, 0x7b, 0xb8, 0x49, 0x39, 0xdd, 0xe4, 0x26, 0xfd, 0x64, 0xbe, 0x05, 0x27, 0x15,
+	0x7c, 0x65, 0xb3, 0x70, 0x03, 0x6a, 0x4c, 0xd5, 0xe5, 0x16, 0xcb, 0x53, 0x92, 0xc5, 0x92, 0x62,
+	0x81, 0x15, 0xc1, 0x9b, 0xaf, 0xc3, 0xda, 0x9b, 0xf6, 0xc0, 0xe9, 0xd9, 0x21, 0x62, 0x60, 0x7c,
+	0xba, 0xb2, 0xd9, 0x94, 0xa7, 0x53, 0x99, 0x5f, 0xd2, 0xe0, 0x44, 0xaa, 0xc7, 0x58, 0xff, 0x77,
+	0x82, 0xf6, 0x23, 0xfc, 0x95, 0x2d, 0x9a, 0xaa, 0x13, 0x10, 0x60, 0xfd, 0x04, 0x54, 0x9d, 0xa0,
+	0x3d, 0x74, 0x5c, 0xc4, 0xdc, 0x15, 0x15, 0x27, 0xd8, 0x71, 0x5c, 0x69, 0xb6, 0x8a, 0x32, 0x19,
+	0x09, 0x4d, 0xad, 0x1c, 0x2b, 0x9c, 0x7d, 0xd0, 0x77, 0x9d, 0x7d, 0x77, 0x07, 0x05, 0x81, 0xbd,
+	0x8f, 0xa6, 0x0f, 0xa8, 0x05, 0xd5, 0x21, 0x85, 0xe5, 0xfa, 0x09, 0x2b, 0x26, 0x36, 0x65, 0x31,
+	0x75, 0xa2, 0x3c, 0x0f, 0xcb, 0x12, 0x26, 0x36, 0x50, 0xbc, 0x64, 0x9c, 0x7d, 0xd7, 0x0e, 0xc7,
+	0xd1, 0xc9, 0x16, 0x57, 0x98, 0x7d, 0x58, 0x79, 0x13, 0xf9, 0xce, 0xde, 0xd1, 0xcc, 0x04, 0x4a,
+	0xfd, 0x15, 0x12, 0xfd, 0x89, 0xe4, 0x17, 0x25, 0xf2, 0xcd, 0xab, 0xb0, 0x9a, 0xc0, 0xc4, 0x08,
+	0x5c, 0x81, 0xb2, 0x38, 0x0d, 0xb4, 0x60, 0xee, 0x70, 0x9b, 0x20, 0xbd, 0x14, 0x38, 0xa7, 0x35,
+	0x89, 0xd3, 0xf9, 0x4b, 0xe1, 0x39, 0x58, 0x4d, 0x74, 0x17, 0x0b, 0x26, 0xf5, 0x40, 0xcd, 0x7b,
+	0xb0, 0x1c, 0xaf, 0x73, 0xf4, 0x61, 0x09, 0xf8, 0x89, 0x06, 0x2b, 0x72, 0x77, 0x8c, 0x80, 0x3b,
+	0x50, 0xed, 0xa1, 0xd0, 0x76, 0x06, 0x7c, 0xc3, 0x6c, 0x26, 0x3d, 0x0f, 0xa9, 0x36, 0x7c, 0x17,
+	0xbd, 0x42, 0xda, 0x59, 0xbc, 0xbd, 0xf1, 0x4d, 0x0d, 0x9a, 0xd2, 0xa7, 0xfc, 0x75, 0xc6, 0x87,
+	0x51, 0x90, 0x87, 0xa1, 0x43, 0x69, 0x1c, 0x20, 0x2a, 0xc1, 0x6a, 0x16, 0xf9, 0x8f, 0x4f, 0xf7,
+	0x20, 0x8c, 0x4e, 0x6d, 0x26, 0x50, 0x20, 0x08, 0xf9, 0x61, 0x8d, 0x27, 0x71, 0x60, 0x77, 0xd0,
+	0x80, 0x09, 0x0e, 0x5a, 0x30, 0xbf, 0xaa, 0x11, 0xdf, 0x21, 0x95, 0xd4, 0x4f, 0x46, 0x04, 0xaf,
+	0x41, 0x85, 0x0e, 0x97, 0xef, 0x4d, 0x5a, 0xca, 0x17, 0xbe, 0xbf, 0x59, 0x80, 0x56, 0x9a, 0x8e,
+	0x59, 0xd4, 0x1a, 0xb5, 0x18, 0x7e, 0x25, 0x22, 0xa2, 0x48, 0x7c, 0x78, 0xcf, 0x26, 0xa7, 0x4c,
+	0x89, 0x69, 0x83, 0xcd, 0x17, 0x6b, 0x6b, 0x7c, 0x43, 0x83, 0x0a, 0x9b, 0x27, 0x49, 0xae, 0x6b,
+	0xb3, 0xca, 0xf5, 0xc2, 0xf1, 0xe5, 0x7a, 0x31, 0x5b, 0xae, 0xff, 0xa4, 0x00, 0x8b, 0x0f, 0x26,
+	0xaf, 0x39, 0x41, 0xe8, 0xf9, 0x47, 0x94, 0xae, 0x40, 0x5f, 0x86, 0x72, 0x38, 0x89, 0x19, 0x53,
+	0x0a, 0x27, 0x77, 0x7a, 0xfa, 0x39, 0x68, 0x74, 0xf0, 0x19, 0x2f, 0x2b, 0x7b, 0x73, 0xa4, 0x8e,
+	0x69, 0x2b, 0x2f, 0x41, 0xc5, 0x71, 0x47, 0xe3, 0x30, 0x60, 0xee, 0xb4, 0xa7, 0x25, 0x0e, 0x25,
+	0xd1, 0x6c, 0xdc, 0xc1, 0xb0, 0x16, 0x6b, 0xa2, 0x7f, 0x0a, 0xaa, 0xde, 0x38, 0x24, 0xad, 0x4b,
+	0xa4, 0xf5, 0xf9, 0xfc, 0xd6, 0xaf, 0x13, 0x60, 0x8b, 0x37, 0xc2, 0x6a, 0x17, 0xd1, 0x45, 0xe3,
+	0xb3, 0xba, 0x4c, 0xce, 0xea, 0x26, 0xae, 0x8d, 0x76, 0x13, 0x5e, 0xe8, 0xae, 0x17, 0x22, 0xe6,
+	0x81, 0x22, 0xff, 0x8d, 0xeb, 0x50, 0x26, 0xb4, 0xa8, 0x07, 0xbe, 0x02, 0x65, 0x6a, 0x28, 0x51,
+	0xcd, 0x91, 0x16, 0x8c, 0x1b, 0x50, 0xa1, 0x14, 0xe4, 0x6c, 0xb7, 0x35, 0xa8, 0xd8, 0x43, 0xe2,
+	0xa9, 0xa1, 0x93, 0xc6, 0x4a, 0xe6, 0x7d, 0x58, 0x8a, 0x86, 0x13, 0xad, 0xc8, 0x97, 0xa0, 0xde,
+	0x27, 0x55, 0x4e, 0x74, 0x8a, 0x9e, 0xc9, 0xe5, 0x80, 0x15, 0xc3, 0x9b, 0x6d, 0x61, 0x16, 0xf9,
+	0x5e, 0x5b, 0x81, 0x32, 0x75, 0x13, 0x31, 0xe7, 0x70, 0x97, 0xfb, 0x86, 0x32, 0x5c, 0xb9, 0xb9,
+	0x9b, 0xe9, 0x37, 0x0a, 0xb0, 0x82, 0xbd, 0xb8, 0x29, 0x2c, 0x6b, 0x50, 0xe9, 0x8e, 0xfd, 0xc0,
+	0xf3, 0xd9, 0xe0, 0x59, 0x89, 0xc8, 0x06, 0x62, 0x69, 0x50, 0x87, 0x22, 0x2d, 0xe0, 0x5a, 0xcf,
+	0xef, 0x11, 0x7f, 0x2b, 0xd9, 0x59, 0xa4, 0x90, 0x34, 0x23, 0x4a, 0x2a, 0xd3, 0x25, 0xf4, 0x64,
+	0x17, 0x7f, 0x2d, 0xf4, 0xe2, 0x8f, 0xa4, 0x35, 0xd6, 0x46, 0xc9, 0xb4, 0x16, 0xad, 0x1a, 0xae,
+	0x78, 0xe0, 0x0c, 0x11, 0x3e, 0xd6, 0x43, 0x8f, 0x7e, 0xaa, 0x92, 0x4f, 0x95, 0xd0, 0x23, 0x1f,
+	0x04, 0x3e, 0xd4, 0xd2, 0x4a, 0xd8, 0xd1, 0x08, 0x05, 0xad, 0x3a, 0x59, 0x3f, 0xb4, 0x20, 0x73,
+	0x07, 0x12, 0xdc, 0xf9, 0x61, 0x01, 0x56, 0x13, 0xdc, 0x61, 0xb3, 0xfa, 0x5a, 0x7a, 0x56, 0x65,
+	0x6f, 0xae, 0xb2, 0xd9, 0x06, 0x2f, 0xc7, 0x8d, 0x31, 0x93, 0x5c, 0x34, 0x09, 0xdb, 0x8c, 0xdb,
+	0x4c, 0x3f, 0xc7, 0x55, 0xdb, 0xa4, 0xc6, 0xf8, 0x27, 0x0d, 0xaa, 0xac, 0xdd, 0x63, 0xef, 0xe0,
+	0x33, 0x00, 0x14, 0x84, 0x70, 0xac, 0x48, 0x4d, 0x1b, 0x52, 0x43, 0x98, 0xc6, 0xfd, 0x55, 0x25,
+	0xd6, 0x2b, 0xf6, 0x57, 0x9d, 0x01, 0x70, 0x51, 0xd8, 0x66, 0x0b, 0x9d, 0x9e, 0x04, 0x75, 0x17,
+	0x85, 0x5b, 0xa4, 0x02, 0xbb, 0xbc, 0xf7, 0x10, 0xdf, 0x6e, 0xf8, 0xaf, 0xac, 0x4f, 0x57, 0x93,
+	0xfa, 0x34, 0xdf, 0x9f, 0xb5, 0x78, 0x7f, 0x9a, 0x7f, 0xa9, 0x71, 0xfb, 0x3d, 0xbd, 0xf8, 0xf6,
+	0x3c, 0x7c, 0x4a, 0xf0, 0xc5, 0x47, 0x4b, 0x33, 0xd9, 0xc2, 0xf1, 0x82, 0x2a, 0xe6, 0x2d, 0xa8,
+	0x52, 0xf6, 0x82, 0x2a, 0x4b, 0x0b, 0x4a, 0x5a, 0x20, 0x95, 0xc4, 0x02, 0xf9, 0x18, 0xac, 0x26,
+	0x06, 0x10, 0x5b, 0xaa, 0x3d, 0x3b, 0xb4, 0xf9, 0x3c, 0xe1, 0xff, 0xe6, 0x4b, 0xb0, 0xf8, 0xc0,
+	0xb7, 0xdd, 0xc0, 0x26, 0x97, 0x28, 0x39, 0x92, 0x49, 0x87, 0xd2, 0x23, 0x6f, 0x4c, 0xc7, 0xd7,
+	0xb4, 0xc8, 0x7f, 0x73, 0x13, 0x4e, 0xbd, 0x82, 0xba, 0x5e, 0x0f, 0x59, 0xf6, 0xa1, 0xd0, 0x0b,
+	0xe7, 0xd8, 0x22, 0x14, 0xfb, 0x68, 0xc2, 0x7a, 0xc1, 0x7f, 0xcd, 0xef, 0x97, 0xe1, 0xb4, 0xba,
+	0x05, 0x23, 0x51, 0x89, 0x3a, 0x5b, 0x93, 0x38, 0x05, 0xf5, 0xe4, 0x0a, 0xaa, 0x89, 0x0b, 0x88,
+	0x18, 0xe1, 0x54, 0x5f, 0x26, 0xff, 0xf5, 0x4f, 0x43, 0xf1, 0x91, 0xe3, 0xb6, 0xca, 0x8a, 0x1b,
+	0x98, 0x3c, 0xba, 0x36, 0xde, 0x74, 0x5c, 0x0b, 0xb7, 0xd4, 0x6f, 0x32, 0x36, 0x54, 0x48, 0x0f,
+	0x1b, 0xc7, 0xe8, 0xc1, 0x1b, 0x87, 0x94, 0x6d, 0x78, 0xc5, 0x8c, 0xec, 0xa3, 0x81, 0x67, 0x63,
+	0x73, 0x7c, 0xd2, 0xaa, 0x72, 0x45, 0x9b, 0x54, 0xbd, 0x46, 0xfd, 0x66, 0x1c, 0xa0, 0x47, 0xfa,
+	0x64, 0x2b, 0xb4, 0xc9, 0x6a, 0x29, 0x22, 0xa3, 0x07, 0xc5, 0x37, 0x1d, 0x77, 0xe6, 0xe9, 0xc2,
+	0x1e, 0x88, 0x00, 0x4f, 0x8d, 0xdb, 0xa5, 0xcc, 0x2a, 0x59, 0x51, 0x99, 0x18, 0xff, 0x4e, 0xe8,
+	0x52, 0xdd, 0x8b, 0x1a, 0xff, 0xb4, 0x68, 0xfc, 0x97, 0x06, 0x25, 0x4c, 0x3c, 0x53, 0xa3, 0xc7,
+	0x5c, 0x7d, 0xa0, 0x05, 0xbd, 0x01, 0x9a, 0xcb, 0xb0, 0x68, 0xae, 0xd2, 0xc9, 0x8c, 0x6f, 0x63,
+	0xba, 0xbe, 0x33, 0x0a, 0xdb, 0x76, 0x30, 0x64, 0xdb, 0xb9, 0x4e, 0x6b, 0xb6, 0x82, 0xa1, 0xf0,
+	0xb9, 0xcf, 0x1c, 0x84, 0xd1, 0x67, 0xcc, 0x8b, 0x8f, 0xc1, 0x92, 0x8f, 0xba, 0xce, 0xc8, 0x41,
+	0x6e, 0x18, 0xa9, 0x87, 0x74, 0xc9, 0x2f, 0x46, 0x1f, 0xb8, 0x92, 0x78, 0x09, 0x16, 0x98, 0xea,
+	0x12, 0x81, 0x52, 0xee, 0xce, 0xb3, 0x6a, 0x0e, 0x78, 0x01, 0xe6, 0x99, 0xc2, 0xd2, 0x0e, 0x6d,
+	0x7f, 0x1f, 0x85, 0x9c, 0xc3, 0xac, 0xf6, 0x01, 0xa9, 0x34, 0xff, 0xbd, 0x00, 0xa7, 0xa8, 0x56,
+	0xaf, 0x5e, 0xe1, 0x2f, 0x44, 0x4a, 0x88, 0xf2, 0x10, 0x4d, 0x6c, 0xac, 0x48, 0xfd, 0x78, 0x1d,
+	0xaa, 0x54, 0x84, 0x05, 0xec, 0x4a, 0xf1, 0x05, 0xa9, 0x5d, 0x0e, 0xc6, 0x0d, 0x2a, 0xe9, 0x82,
+	0x5b, 0x6e, 0x88, 0xef, 0xdf, 0x58, 0x2f, 0xe9, 0x7d, 0x50, 0x12, 0xf6, 0xc1, 0x05, 0x98, 0xef,
+	0xf6, 0x6d, 0x77, 0x1f, 0x25, 0xb4, 0xeb, 0x26, 0xad, 0xe5, 0x2c, 0xb9, 0x0c, 0x0b, 0xc1, 0xb8,
+	0x13, 0xfa, 0x76, 0x37, 0xdc, 0x43, 0x08, 0xcb, 0x20, 0xa6, 0xd4, 0x24, 0xab, 0x73, 0xa5, 0x8f,
+	0x71, 0x03, 0x1a, 0x22, 0x8d, 0x58, 0x08, 0xc4, 0xee, 0x57, 0xfc, 0x37, 0x5e, 0x47, 0x05, 0x61,
+	0x1d, 0xdd, 0x28, 0x7c, 0x52, 0x33, 0x3f, 0x28, 0xc2, 0xe9, 0xad, 0x71, 0xe8, 0x51, 0x06, 0x28,
+	0xf8, 0x7d, 0x3f, 0x66, 0x1c, 0x65, 0xf8, 0x27, 0x64, 0xdb, 0x3f, 0xa7, 0xed, 0x2c, 0x9c, 0x2b,
+	0x24, 0x38, 0xc7, 0xce, 0x93, 0x62, 0x7c, 0x9e, 0x9c, 0x83, 0x86, 0xa8, 0xf8, 0x31, 0x4e, 0xce,
+	0x09, 0x6a, 0x9f, 0x82, 0xdd, 0x65, 0x15, 0xbb, 0xf3, 0x98, 0x48, 0xfa, 0xf0, 0x1c, 0xb7, 0x1d,
+	0xa0, 0x01, 0xbb, 0xee, 0xae, 0xb2, 0x3e, 0x3c, 0xc7, 0xdd, 0xe5, 0x95, 0xd8, 0xc5, 0xb0, 0x87,
+	0x50, 0x7b, 0x18, 0x4b, 0x88, 0xea, 0x1e, 0x42, 0x3b, 0x58, 0x36, 0x7c, 0x98, 0x69, 0xb8, 0x06,
+	0xa7, 0xd5, 0x4b, 0x90, 0x09, 0xe9, 0xb4, 0x5c, 0xff, 0x0f, 0x0d, 0xce, 0xd2, 0x26, 0xcc, 0x3c,
+	0x50, 0xcc, 0x5d, 0x92, 0x75, 0x5a, 0x9a, 0x75, 0x8a, 0xed, 0x5b, 0x50, 0x6e, 0xdf, 0x58, 0xd9,
+	0x2d, 0x8a, 0xca, 0x2e, 0xf6, 0x73, 0xee, 0xf9, 0xde, 0xbb, 0xc8, 0x6d, 0x8f, 0x90, 0xef, 0x78,
+	0x3d, 0x76, 0x2d, 0xd1, 0xa0, 0x95, 0xf7, 0x49, 0x1d, 0x9f, 0xd5, 0x72, 0x3c, 0xab, 0xb9, 0x73,
+	0x21, 0x32, 0xb9, 0x2a, 0x31, 0xd9, 0xfc, 0x04, 0x9c, 0xbe, 0x8d, 0xc2, 0x9b, 0x78, 0xbd, 0xb0,
+	0x71, 0x5b, 0xe8, 0xd0, 0xf6, 0x7b, 0x82, 0xca, 0xc0, 0x8e, 0x7d, 0x8d, 0xac, 0x2c, 0x56, 0x32,
+	0xbf, 0x5d, 0x80, 0x33, 0x19, 0x0d, 0x19, 0x8b, 0xdf, 0x48, 0xda, 0xec, 0xff, 0x37, 0x69, 0x00,
+	0x66, 0x37, 0xde, 0xa0, 0xc5, 0x84, 0xed, 0x2e, 0x10, 0x53, 0x10, 0x89, 0x31, 0x3e, 0xd0, 0xa0,
+	0x21, 0xb6, 0xc0, 0x32, 0xdc, 0xb7, 0xdd, 0x03, 0x66, 0x25, 0x93, 0xff, 0x59, 0xd6, 0x05, 0xae,
+	0x3f, 0x8c, 0x15, 0x1b, 0xcd, 0x62, 0x25, 0x51, 0xe3, 0x2d, 0xa5, 0xec, 0x94, 0x91, 0xef, 0xed,
+	0x39, 0x5c, 0x7d, 0x63, 0x25, 0xf3, 0x2e, 0x31, 0xa0, 0xd9, 0x80, 0x12, 0xaa, 0x17, 0x3f, 0x55,
+	0x34, 0x41, 0x15, 0xcc, 0xf5, 0x85, 0xfc, 0x45, 0x09, 0x4e, 0x2a, 0x7a, 0x8b, 0xac, 0x9f, 0x62,
+	0x38, 0xe1, 0x8c, 0x7d, 0x26, 0xc9, 0x58, 0x75, 0xa3, 0x8d, 0x07, 0x13, 0x0b, 0xb7, 0xd2, 0x77,
+	0xa0, 0x4a, 0xc7, 0xc8, 0x65, 0xf7, 0xf3, 0x33, 0x76, 0xf0, 0x16, 0x6d, 0xc5, 0xe4, 0x0f, 0xeb,
+	0xc3, 0xf8, 0x2d, 0x0d, 0xe6, 0x58, 0x83, 0x87, 0x0f, 0x3e, 0xf7, 0xfa, 0xec, 0x87, 0x79, 0xb6,
+	0xab, 0x30, 0x9e, 0xab, 0x52, 0xfe, 0xe6, 0x28, 0x2b, 0x36, 0x47, 0xe4, 0x66, 0xa9, 0x08, 0x6e,
+	0x16, 0xe3, 0x8f, 0x34, 0x28, 0x3c, 0x98, 0xa8, 0x89, 0x8b, 0x83, 0x30, 0x0a, 0x52, 0x10, 0x46,
+	0xd2, 0x02, 0x28, 0xa6, 0x2d, 0x80, 0x57, 0xa1, 0x34, 0x0e, 0x27, 0x5e, 0xab, 0xa4, 0x8e, 0x7a,
+	0xca, 0x60, 0xa4, 0xc0, 0x2e, 0x8b, 0xb4, 0x8f, 0xf4, 0xf8, 0xb2, 0x60, 0x67, 0xdf, 0x80, 0x86,
+	0xc8, 0xf1, 0x69, 0x02, 0x50, 0x13, 0x05, 0xe0, 0x55, 0x38, 0xb9, 0x8b, 0xdc, 0xde, 0xac, 0x5a,
+	0xed, 0x73, 0x60, 0xa8, 0xc0, 0x73, 0x54, 0x5a, 0xf3, 0x3b, 0xd4, 0x5f, 0x24, 0xc0, 0xbf, 0x8a,
+	0x22, 0xc7, 0xd5, 0xbd, 0xe4, 0x29, 0x97, 0xe2, 0x8c, 0xb2, 0x5d, 0xc6, 0x09, 0x17, 0xeb, 0x28,
+	0x85, 0xe3, 0xe8, 0x28, 0x67, 0x61, 0xae, 0x6f, 0x07, 0x92, 0x5b, 0xa7, 0x66, 0x41, 0xdf, 0x0e,
+	0x98, 0x37, 0x47, 0xde, 0x80, 0xa5, 0x27, 0xa8, 0x05, 0x5c, 0x25, 0x7b, 0x37, 0x39, 0xc4, 0xf8,
+	0xec, 0xc1, 0xc2, 0x5b, 0x8b, 0x84, 0xb7, 0xf9, 0x32, 0xac, 0xdd, 0x0a, 0x42, 0x67, 0x68, 0x87,
+	0x08, 0x03, 0xda, 0x21, 0xe7, 0x07, 0x5e, 0xf0, 0x54, 0xb9, 0x6b, 0x93, 0x45, 0x17, 0x30, 0xe7,
+	0x44, 0x83, 0x56, 0x12, 0x01, 0x1a, 0x98, 0x87, 0x70, 0x22, 0xd5, 0x9c, 0xe1, 0x9a, 0xa5, 0x3d,
+	0x3f, 0x1e, 0x7c, 0x3b, 0x8c, 0xfc, 0xe9, 0x7b, 0xb4, 0x1f, 0x72, 0x6d, 0xc6, 0xba, 0xe6, 0xce,
+	0xce, 0xb8, 0xc2, 0x44, 0x30, 0x4f, 0xba, 0xc0, 0xd1, 0x5d, 0xaf, 0x7a, 0xfe, 0x83, 0x49, 0xd6,
+	0x71, 0x11, 0x1b, 0xca, 0x7d, 0x3b, 0xe8, 0x33, 0x24, 0xd4, 0x50, 0x7e, 0xcd, 0x0e, 0xfa, 0x18,
+	0x0d, 0xd6, 0x5e, 0x82, 0xd0, 0x1e, 0x8e, 0xb8, 0x19, 0x1d, 0x55, 0x98, 0x3f, 0x2e, 0x50, 0x2b,
+	0xe1, 0x71, 0xb5, 0xf7, 0x9b, 0xd0, 0xf4, 0x51, 0x0f, 0xa1, 0x61, 0x9b, 0x39, 0x24, 0xe9, 0x66,
+	0x95, 0x57, 0xd1, 0x9b, 0x8e, 0xbb, 0x61, 0x11, 0x28, 0x76, 0xea, 0x34, 0x7c, 0xa1, 0x64, 0xfc,
+	0x88, 0x1c, 0x31, 0x71, 0xc5, 0x47, 0x6c, 0xb2, 0xa4, 0x54, 0x8d, 0xf2, 0x4c, 0xaa, 0x46, 0x65,
+	0x46, 0x4b, 0xa1, 0xaa, 0xb2, 0x14, 0xfe, 0xae, 0xf0, 0x21, 0xad, 0xa4, 0x6d, 0x68, 0x32, 0x33,
+	0x48, 0xe2, 0xb3, 0x7c, 0xb9, 0x85, 0x31, 0x6c, 0xec, 0x12, 0x30, 0xce, 0xe8, 0x40, 0x28, 0xe1,
+	0x38, 0xbc, 0x86, 0xf8, 0x19, 0x6f, 0x17, 0x6c, 0x74, 0xb1, 0xed, 0x62, 0x07, 0x43, 0x2e, 0xbe,
+	0x0a, 0x91, 0xf8, 0xc2, 0x2b, 0xd8, 0x47, 0xef, 0xb4, 0x03, 0x67, 0x3f, 0xe0, 0x71, 0x53, 0x3e,
+	0x7a, 0x67, 0xd7, 0xd9, 0x0f, 0xd4, 0xc6, 0x57, 0x69, 0x76, 0xe3, 0xab, 0x3c, 0x23, 0x4b, 0x2b,
+	0x2a, 0x96, 0x6e, 0x12, 0x11, 0xa9, 0x16, 0xc2, 0x4a, 0xa1, 0xfa, 0xed, 0x22, 0x9c, 0x54, 0xb4,
+	0xc8, 0xd2, 0x5a, 0xe3, 0x4e, 0x0a, 0x6a, 0x67, 0x43, 0x31, 0xc7, 0xd9, 0x50, 0x4a, 0x38, 0x1b,
+	0x9e, 0x83, 0x32, 0xd9, 0x91, 0x64, 0xc8, 0x73, 0xd7, 0x4f, 0x49, 0xd3, 0x26, 0xef, 0x73, 0x8b,
+	0x42, 0xea, 0x26, 0xf5, 0x45, 0x50, 0x4f, 0xc2, 0x62, 0x72, 0x3f, 0x51, 0x77, 0xc3, 0x05, 0xb6,
+	0x27, 0xaa, 0x04, 0x68, 0x29, 0xb5, 0x18, 0x62, 0x65, 0x80, 0xb9, 0x06, 0xb8, 0x1d, 0xc0, 0x8a,
+	0xfa, 0x79, 0x68, 0xca, 0x77, 0x1f, 0x75, 0xb2, 0x8b, 0xe4, 0xca, 0xc8, 0x55, 0x02, 0x82, 0xab,
+	0x84, 0x49, 0xda, 0xb9, 0x58, 0x4d, 0x8e, 0x4f, 0xfa, 0x06, 0x81, 0x63, 0x25, 0x12, 0xd9, 0xe0,
+	0x39, 0x6e, 0x07, 0xdf, 0x1a, 0x36, 0x89, 0x98, 0x8b, 0xca, 0xe6, 0x33, 0xa0, 0x63, 0x61, 0x3e,
+	0xe1, 0x61, 0xad, 0x39, 0xd3, 0xb7, 0x05, 0xcb, 0x12, 0xa8, 0x22, 0xb6, 0xb5, 0xcc, 0x62, 0x5b,
+	0x65, 0x9d, 0xa3, 0xce, 0x29, 0xc1, 0x91, 0x44, 0xd8, 0xc7, 0xf9, 0xd0, 0x65, 0xc3, 0x43, 0xbd,
+	0x99, 0xee, 0xfb, 0xff, 0x51, 0x83, 0x13, 0xa9, 0x76, 0x51, 0x80, 0xac, 0xa0, 0x2c, 0x5e, 0x4f,
+	0xb9, 0x53, 0x15, 0x4d, 0x36, 0x84, 0x3a, 0xa6, 0x35, 0x1a, 0x01, 0x34, 0xa5, 0x5a, 0xb5, 0x04,
+	0x34, 0xf0, 0x4e, 0xec, 0x22, 0xe7, 0x11, 0xea, 0xb1, 0x0b, 0x80, 0xa8, 0x1c, 0x4d, 0x51, 0x51,
+	0x08, 0x29, 0x39, 0x03, 0xe0, 0xb8, 0xed, 0x21, 0x1a, 0x8e, 0x3c, 0x8f, 0x0a, 0x8c, 0x9a, 0x55,
+	0x77, 0xdc, 0x1d, 0x5a, 0x61, 0xee, 0xc0, 0xc9, 0xad, 0x8e, 0xed, 0xf6, 0x3c, 0x77, 0xc6, 0x1d,
+	0x94, 0xaf, 0x54, 0x3f, 0x0f, 0x86, 0xaa, 0x3b, 0xc6, 0xa7, 0x55, 0xa8, 0x90, 0xfe, 0x28, 0xab,
+	0xb0, 0x2b, 0x7b, 0x72, 0xa7, 0x17, 0x98, 0x3f, 0x57, 0x80, 0x16, 0xb5, 0x0c, 0xef, 0xdb, 0x47,
+	0xde, 0x38, 0xbc, 0x89, 0x63, 0xb5, 0x38, 0x0d, 0xaf, 0x92, 0x65, 0xeb, 0xc5, 0xfe, 0x93, 0x67,
+	0x15, 0x7e, 0x90, 0x74, 0xbb, 0x0d, 0x5a, 0x65, 0xf1, 0xc6, 0x53, 0xa3, 0x49, 0xf2, 0x6e, 0x1b,
+	0x24, 0xfb, 0xae, 0x24, 0x1b, 0xd1, 0xf7, 0xa0, 0x42, 0x51, 0x29, 0xf4, 0x97, 0xec, 0xbb, 0x8d,
+	0x0c, 0xe3, 0xd4, 0x74, 0x61, 0xf5, 0x36, 0x0a, 0x15, 0x6c, 0xc0, 0x81, 0xab, 0xb8, 0x2c, 0x44,
+	0x0a, 0x93, 0xf2, 0x9d, 0x1e, 0x9e, 0x61, 0x3a, 0x48, 0x12, 0xc3, 0xc6, 0x8e, 0x7e, 0x5a, 0x83,
+	0x63, 0x0d, 0x73, 0xaf, 0x51, 0x7e, 0xbf, 0x08, 0xcb, 0x12, 0xb6, 0x38, 0x30, 0x21, 0x0b, 0x5d,
+	0x0b, 0xaa, 0x2c, 0xb6, 0x88, 0xad, 0x3f, 0x5e, 0xd4, 0xb7, 0xe2, 0xa9, 0xa2, 0xf7, 0x6d, 0x97,
+	0xa4, 0xa9, 0x52, 0xe0, 0x49, 0xcd, 0x12, 0x33, 0xbb, 0x4a, 0x0a, 0xb3, 0x2b, 0xbb, 0x39, 0xdf,
+	0x40, 0xdf, 0xd2, 0x9e, 0xe4, 0x5c, 0xc4, 0xab, 0xbf, 0xa4, 0xb4, 0x64, 0xca, 0xa2, 0x54, 0xc1,
+	0x47, 0x3a, 0xf2, 0x7d, 0xcf, 0xe7, 0x36, 0x11, 0x29, 0x18, 0x3b, 0x50, 0xe3, 0x24, 0xce, 0x62,
+	0x18, 0x29, 0xba, 0x2b, 0x0a, 0xdd, 0xe1, 0x9b, 0xec, 0x93, 0x38, 0xba, 0x42, 0x7d, 0xde, 0xad,
+	0x42, 0xc5, 0xb7, 0x0f, 0xdb, 0x21, 0x3f, 0xbf, 0xca, 0xbe, 0x7d, 0xf8, 0x60, 0x82, 0xbb, 0xda,
+	0x1b, 0xd8, 0xfb, 0x1c, 0x03, 0x2d, 0x4c, 0x8b, 0xe3, 0xc8, 0xd5, 0xdc, 0xcd, 0xff, 0x0f, 0x86,
+	0x8a, 0x8c, 0xcc, 0x43, 0x94, 0x08, 0xff, 0xe1, 0x68, 0x80, 0x42, 0x1e, 0xcc, 0x12, 0x95, 0xcd,
+	0x9b, 0xb0, 0xc4, 0xf6, 0x70, 0xd0, 0x09, 0x33, 0xed, 0xa7, 0x7c, 0xa9, 0xf3, 0x29, 0x68, 0xd0,
+	0xd6, 0x42, 0xb8, 0x5d, 0xd0, 0xe1, 0x97, 0x30, 0xe4, 0x7f, 0x2e, 0x0d, 0x97, 0x60, 0x89, 0xba,
+	0xcb, 0x45, 0x1a, 0x14, 0x9d, 0x98, 0x7f, 0x5f, 0x06, 0x5d, 0x84, 0x64, 0xf8, 0x5e, 0x84, 0x02,
+	0xe3, 0x7a, 0x72, 0xd1, 0xe6, 0xb9, 0xfb, 0xad, 0x42, 0x38, 0xd1, 0x5f, 0x4e, 0x58, 0x5e, 0x17,
+	0x14, 0xcd, 0x45, 0x5c, 0x89, 0x4b, 0xea, 0xb4, 0xf7, 0x51, 0x1c, 0x67, 0x49, 0x1e, 0xa7, 0x31,
+	0x02, 0x78, 0x05, 0xf9, 0xce, 0x23, 0x72, 0xa2, 0xe3, 0x9b, 0x23, 0x39, 0xf0, 0xb5, 0x32, 0xa2,
+	0xd1, 0xc9, 0x79, 0xbc, 0xc6, 0x2b, 0xb6, 0xe3, 0xdb, 0x6e, 0xb7, 0xcf, 0x34, 0x53, 0x56, 0x8a,
+	0x6f, 0xa5, 0xa9, 0x3b, 0x8d, 0x16, 0x8c, 0x6d, 0x80, 0xfb, 0xb6, 0x1f, 0x3a, 0xf6, 0x60, 0xd7,
+	0xd9, 0xcf, 0xc6, 0x98, 0x1b, 0xce, 0x63, 0xfc, 0x73, 0x21, 0xf7, 0x3e, 0x5c, 0x65, 0x13, 0x44,
+	0x1a, 0x76, 0x51, 0xd4, 0xb0, 0x4f, 0x41, 0x7d, 0x74, 0xd0, 0xa6, 0xda, 0x30, 0x5f, 0xd4, 0xa3,
+	0x03, 0xaa, 0x0c, 0x63, 0x4b, 0x8e, 0x19, 0x31, 0x0c, 0x80, 0xbd, 0x92, 0xa0, 0x95, 0x0c, 0x28,
+	0x36, 0xbf, 0x2a, 0x92, 0xf9, 0x75, 0x0f, 0xe6, 0x7a, 0x11, 0x67, 0x83, 0x56, 0x55, 0x71, 0xb1,
+	0xaa, 0x98, 0xcb, 0x78, 0x32, 0x2c, 0xb1, 0xb9, 0xbe, 0x03, 0x8d, 0x11, 0xe5, 0x1a, 0xd5, 0xb8,
+	0x6b, 0xb3, 0x75, 0x17, 0x73, 0xda, 0x9a, 0x1b, 0x45, 0xff, 0x49, 0xb0, 0xd4, 0x9e, 0xe3, 0xda,
+	0x03, 0xe7, 0xdd, 0x38, 0x34, 0x33, 0xaa, 0x30, 0x27, 0xb0, 0x80, 0x37, 0xf3, 0x94, 0xa5, 0xff,
+	0x51, 0x88, 0x91, 0xcf, 0xc3, 0x62, 0x8c, 0xf9, 0xf1, 0xb6, 0x2e, 0x11, 0xa0, 0xce, 0xbe, 0x8b,
+	0xf8, 0xf3, 0x31, 0x56, 0x32, 0xaf, 0x80, 0xbe, 0xed, 0x0d, 0x3b, 0x8e, 0x2b, 0xed, 0xe9, 0x15,
+	0x28, 0xe3, 0x1e, 0x23, 0xfd, 0x83, 0x14, 0x70, 0x84, 0xef, 0xab, 0x8c, 0x1d, 0xd3, 0x04, 0xc0,
+	0x65, 0x58, 0x91, 0x41, 0x33, 0xdd, 0xdd, 0x77, 0x61, 0xfe, 0x36, 0x0a, 0x1f, 0x86, 0x13, 0x4f,
+	0x08, 0xb9, 0x8f, 0xef, 0x99, 0xb5, 0xdc, 0xb8, 0xcd, 0xa4, 0x80, 0xfb, 0x37, 0x0d, 0x4a, 0xc7,
+	0x73, 0xfd, 0x65, 0x1d, 0x6a, 0x49, 0x8f, 0x5b, 0x29, 0xed, 0x71, 0xc3, 0x6f, 0x30, 0xf0, 0xc6,
+	0x73, 0xc2, 0x23, 0xe6, 0xfe, 0x8b, 0xca, 0x69, 0x53, 0x81, 0x85, 0x12, 0x4b, 0x95, 0xf8, 0xf9,
+	0x40, 0x30, 0xc2, 0xe6, 0x60, 0xe7, 0xa8, 0x3d, 0x76, 0x71, 0x0c, 0x63, 0x8f, 0x3d, 0xa1, 0x9a,
+	0x27, 0xf5, 0x37, 0x8f, 0x1e, 0xd2, 0x5a, 0xe5, 0xed, 0xfa, 0x1e, 0xcc, 0x31, 0x2b, 0x90, 0x0c,
+	0x39, 0x3b, 0x9c, 0xe5, 0x12, 0x94, 0xb1, 0x6b, 0x8f, 0x8b, 0x4e, 0xd9, 0xf2, 0xc1, 0x6d, 0x2d,
+	0xfa, 0x3d, 0x76, 0x58, 0x16, 0xc5, 0xb8, 0xb0, 0xfb, 0xb0, 0x10, 0xcd, 0x10, 0x9b, 0xc6, 0x97,
+	0xa1, 0xc9, 0x3a, 0x6f, 0xd3, 0x9e, 0xa9, 0xca, 0xd9, 0x52, 0x45, 0x8f, 0x12, 0x04, 0x0d, 0x06,
+	0x8e, 0x7b, 0x09, 0xcc, 0xef, 0x6a, 0x34, 0x28, 0xf8, 0xa1, 0x4b, 0x86, 0xc9, 0x27, 0xfe, 0x25,
+	0xa8, 0x7b, 0xe3, 0x70, 0xe4, 0x39, 0xee, 0xac, 0x97, 0x80, 0x31, 0x3c, 0xe1, 0x90, 0x3d, 0xe4,
+	0x52, 0x91, 0xfc, 0xc7, 0x1a, 0x1f, 0x0b, 0x5c, 0x6e, 0x3b, 0x2e, 0xf3, 0x79, 0xd4, 0x59, 0xcd,
+	0x1d, 0x37, 0x7f, 0xd3, 0xf5, 0xa0, 0x89, 0x49, 0x44, 0x3d, 0x46, 0xe4, 0xec, 0x4b, 0x8a, 0x53,
+	0x52, 0x14, 0x28, 0x59, 0x83, 0x0a, 0xc1, 0x7b, 0xc4, 0x8c, 0x5d, 0x56, 0x32, 0x6f, 0xc3, 0xb2,
+	0xc4, 0x08, 0xc6, 0xdf, 0x6b, 0x50, 0xe6, 0x5e, 0x32, 0xcc, 0x05, 0x43, 0x36, 0x95, 0x44, 0xb2,
+	0x2c, 0x0a, 0x68, 0x8e, 0x78, 0x28, 0xf7, 0x93, 0xe4, 0xe9, 0x14, 0x13, 0x66, 0x35, 0x81, 0x31,
+	0x7e, 0x9c, 0x31, 0x26, 0x1f, 0x10, 0x7f, 0x31, 0x13, 0x95, 0xb9, 0x51, 0xa9, 0x98, 0xfc, 0x5c,
+	0xa3, 0xf2, 0x2e, 0x9c, 0x48, 0x35, 0x7b, 0x6c, 0x56, 0x05, 0xb0, 0xb0, 0x8b, 0xc2, 0x7b, 0x78,
+	0x6d, 0x4f, 0x0f, 0xa0, 0x55, 0xfa, 0x35, 0x94, 0xfb, 0x24, 0x7f, 0x39, 0x99, 0xb0, 0x18, 0x23,
+	0xcd, 0x08, 0x82, 0xef, 0xc1, 0xe2, 0x6d, 0x06, 0x13, 0xcc, 0x26, 0x0c, 0x63, 0x43, 0xb1, 0x20,
+	0x18, 0x8a, 0xf9, 0xa6, 0xcc, 0x6b, 0xb0, 0xbc, 0x8b, 0x6c, 0xbf, 0xdb, 0x97, 0x11, 0xad, 0x40,
+	0xf9, 0x9d, 0x31, 0xf2, 0xb9, 0xc6, 0x41, 0x0b, 0xf9, 0x2b, 0xe0, 0x0f, 0x0a, 0x30, 0xcf, 0x3b,
+	0x89, 0xc3, 0xa6, 0x64, 0x72, 0x53, 0x61, 0x53, 0x12, 0xfc, 0x46, 0x14, 0xdf, 0x47, 0x1d, 0xed,
+	0xc2, 0xd0, 0xde, 0x80, 0x46, 0x18, 0x2f, 0xcd, 0x40, 0xf9, 0x5e, 0x38, 0xd1, 0x99, 0xb0, 0x94,
+	0x59, 0x7f, 0x52, 0x17, 0xc6, 0xff, 0x83, 0x79, 0x19, 0xdf, 0x71, 0x5c, 0xe9, 0xc6, 0xa7, 0x61,
+	0x29, 0x85, 0xe0, 0x58, 0xbe, 0x78, 0x7a, 0x2b, 0xc7, 0x5c, 0xfe, 0x1f, 0xf6, 0x56, 0xee, 0x6f,
+	0xe8, 0xad, 0x5c, 0xb2, 0x37, 0x36, 0x0d, 0xf7, 0xd2, 0xd1, 0x6b, 0x1b, 0xa9, 0x4b, 0x4f, 0x65,
+	0x53, 0x45, 0x04, 0x9b, 0xf1, 0xa5, 0x02, 0xcc, 0x31, 0xe8, 0xe3, 0x1d, 0xae, 0x17, 0x60, 0x1e,
+	0x3f, 0x93, 0x43, 0x7e, 0x5b, 0xbe, 0x5e, 0x6b, 0xd2, 0xda, 0xad, 0x29, 0x97, 0x6c, 0x69, 0xdf,
+	0x66, 0x59, 0xe1, 0xdb, 0xc4, 0xb7, 0x2b, 0xf4, 0x73, 0x9b, 0xb0, 0x90, 0x1a, 0x96, 0x40, 0xab,
+	0x1e, 0x60, 0x46, 0xc6, 0x00, 0xc4, 0xeb, 0x53, 0x25, 0x14, 0x32, 0x00, 0xfc, 0xa4, 0x15, 0x1f,
+	0xf6, 0x8c, 0x4e, 0xba, 0xad, 0xe9, 0x29, 0x3b, 0x47, 0xeb, 0xc8, 0x22, 0x33, 0x7e, 0x38, 0x2d,
+	0x48, 0xef, 0xa3, 0xbb, 0xba, 0xcb, 0x98, 0x28, 0x61, 0x46, 0xd8, 0xd5, 0xdd, 0xe3, 0x47, 0xd2,
+	0x9a, 0x7f, 0x5b, 0xe0, 0x41, 0x07, 0xac, 0x5b, 0x85, 0xdd, 0xbc, 0x13, 0x07, 0xfa, 0x6a, 0x8a,
+	0xdb, 0xda, 0x29, 0xcd, 0x53, 0x71, 0xbf, 0xc9, 0x8b, 0x85, 0x42, 0xfa, 0x62, 0x21, 0x6d, 0xb5,
+	0xe5, 0xc9, 0x58, 0xc9, 0xfb, 0x54, 0x96, 0xbd, 0x4f, 0xa3, 0x28, 0xea, 0x37, 0xbd, 0x26, 0x35,
+	0xd5, 0x9a, 0xbc, 0x04, 0x0b, 0x7c, 0xed, 0x25, 0xc2, 0x27, 0x58, 0xf5, 0x94, 0xf0, 0x09, 0xf3,
+	0x77, 0x35, 0x58, 0x67, 0xef, 0xa4, 0x59, 0xf8, 0xf6, 0x93, 0x8b, 0x79, 0x3a, 0x03, 0x10, 0x7a,
+	0x09, 0xba, 0xea, 0xa1, 0xf7, 0x78, 0x6c, 0x33, 0xdf, 0x12, 0xc2, 0xed, 0x93, 0xef, 0x8c, 0x3f,
+	0xd4, 0xab, 0xc9, 0x37, 0xe0, 0xa4, 0xa2, 0xe3, 0x58, 0x4b, 0xc8, 0x7c, 0xc1, 0x9c, 0x08, 0x52,
+	0x14, 0x5e, 0x84, 0x3f, 0x47, 0x9e, 0x28, 0x90, 0x3b, 0x80, 0x9b, 0x47, 0x74, 0xfb, 0x4c, 0x0b,
+	0x10, 0xf9, 0x2b, 0x1d, 0x16, 0x79, 0x1b, 0xd1, 0x7c, 0x22, 0x17, 0x80, 0x6c, 0x07, 0xe3, 0xff,
+	0x52, 0x92, 0x81, 0x82, 0x9c, 0x64, 0x20, 0x71, 0x91, 0x51, 0x8a, 0x08, 0x12, 0xb0, 0x96, 0x44,
+	0xac, 0x69, 0x03, 0xa0, 0x9c, 0x71, 0x57, 0x20, 0x44, 0x3f, 0x93, 0xff, 0xd8, 0xbe, 0x1e, 0xf9,
+	0xe8, 0x91, 0xe3, 0x8d, 0x03, 0x7a, 0x49, 0x49, 0xef, 0xc8, 0x1a, 0xbc, 0x92, 0xdc, 0x53, 0x9e,
+	0x82, 0x3a, 0x09, 0x2a, 0x26, 0x00, 0x54, 0x5c, 0xd5, 0x70, 0x05, 0xf9, 0xf8, 0x0c, 0x2c, 0x0a,
+	0xe7, 0x5e, 0xdb, 0xf7, 0xbc, 0x90, 0x98, 0xb3, 0x75, 0x6b, 0x41, 0xa8, 0xb7, 0x3c, 0x8f, 0x98,
+	0x39, 0xec, 0xa2, 0x8f, 0x82, 0xd1, 0x00, 0xe9, 0x39, 0x56, 0x47, 0x40, 0x08, 0x3d, 0xde, 0xc8,
+	0x0b, 0xec, 0x01, 0x85, 0x99, 0xe3, 0xf4, 0xd0, 0x4a, 0x02, 0xb4, 0x06, 0x15, 0x26, 0xa2, 0x1b,
+	0x74, 0x17, 0xd0, 0x12, 0x66, 0xdc, 0x3b, 0x63, 0x7b, 0x80, 0x4d, 0xa4, 0x26, 0x65, 0x29, 0x2b,
+	0x62, 0xc5, 0xa6, 0xdb, 0xc7, 0x4b, 0xc3, 0xdd, 0x47, 0xad, 0x79, 0xba, 0x84, 0xa3, 0x0a, 0xe2,
+	0xab, 0x1d, 0x77, 0x06, 0x4e, 0x97, 0x38, 0x41, 0x16, 0xe8, 0x67, 0x5a, 0x83, 0xfd, 0x20, 0x2f,
+	0x42, 0x79, 0xe4, 0x7b, 0xde, 0x5e, 0x6b, 0x71, 0x5d, 0x4b, 0xbd, 0x57, 0x48, 0x4e, 0xf6, 0xc6,
+	0x7d, 0x0c, 0x6a, 0xd1, 0x16, 0xfa, 0x2e, 0x2c, 0x50, 0x79, 0x1c, 0x3b, 0x52, 0x96, 0xd6, 0xb5,
+	0x94, 0x9e, 0x92, 0xee, 0xc4, 0xdb, 0xde, 0xe5, 0x2d, 0xac, 0x79, 0xd2, 0x45, 0x54, 0xa6, 0x6e,
+	0x60, 0x97, 0x64, 0xd6, 0x69, 0xe9, 0xf4, 0xfe, 0xb4, 0x63, 0xbb, 0x24, 0x7b, 0xca, 0xeb, 0x02,
+	0xfb, 0x6c, 0x1f, 0xd9, 0xad, 0xe5, 0x99, 0xb0, 0xb1, 0x26, 0x5b, 0x3e, 0xb2, 0x63, 0x56, 0xe3,
+	0x92, 0xfe, 0x99, 0xc8, 0x7d, 0xb9, 0xa2, 0x0e, 0xba, 0x91, 0x7b, 0x7a, 0x30, 0xb1, 0xec, 0x43,
+	0x0b, 0x05, 0xe3, 0x41, 0xc8, 0x3d, 0x9d, 0xfc, 0xfa, 0x63, 0x95, 0x1e, 0xd5, 0xf8, 0x3f, 0x1e,
+	0x01, 0x5e, 0x7d, 0xed, 0x71, 0xd8, 0x6d, 0xad, 0xd1, 0x99, 0xc2, 0xe5, 0x87, 0x61, 0x97, 0x7c,
+	0x9a, 0xb0, 0xcc, 0x15, 0x27, 0xe8, 0x76, 0x0c, 0x27, 0xdb, 0x91, 0x95, 0xcc, 0xa4, 0x24, 0x59,
+	0x1a, 0x2d, 0xba, 0x7c, 0x58, 0x1d, 0x5e, 0x19, 0xc6, 0x0e, 0x94, 0x09, 0xff, 0xf1, 0xb5, 0x2d,
+	0x37, 0xfc, 0xb5, 0x09, 0x76, 0x71, 0x4d, 0xda, 0x23, 0xdf, 0x89, 0x2c, 0xb6, 0xca, 0xe4, 0x3e,
+	0x2e, 0x91, 0x0b, 0x7a, 0x27, 0x6c, 0xe3, 0x65, 0x10, 0x72, 0xdf, 0x59, 0xbd, 0xe3, 0x84, 0xf7,
+	0x48, 0x85, 0x71, 0x05, 0x1a, 0xe2, 0x4c, 0xe0, 0x5e, 0xf9, 0x0b, 0x06, 0xcd, 0xc7, 0x25, 0x2e,
+	0x0f, 0xb5, 0xc0, 0xf8, 0x76, 0x0d, 0x1a, 0x22, 0x23, 0xf5, 0x36, 0x2c, 0x8c, 0xc6, 0xae, 0x13,
+	0xf4, 0x87, 0xe4, 0x0e, 0x16, 0xcf, 0x86, 0x2a, 0xf4, 0x31, 0x77, 0x36, 0x36, 0x5e, 0xb5, 0xc7,
+	0x03, 0xf6, 0xe6, 0xdd, 0x9a, 0x8f, 0xbb, 0x23, 0x08, 0x3e, 0x07, 0x40, 0x52, 0xcb, 0xd0, 0xbe,
+	0xa9, 0xca, 0xfa, 0xe2, 0x31, 0xfa, 0xfe, 0x2c, 0x0e, 0x83, 0x1f, 0xf0, 0x2a, 0xab, 0x4e, 0x3a,
+	0xc3, 0x5f, 0x8c, 0x1f, 0x95, 0x61, 0x4e, 0xc0, 0x9c, 0x7c, 0xd8, 0x26, 0x67, 0x31, 0x89, 0x16,
+	0x9c, 0x90, 0x19, 0x26, 0x5a, 0x44, 0x0f, 0x58, 0x1c, 0xb1, 0xb0, 0xbf, 0x8a, 0xc9, 0xfd, 0xf5,
+	0x05, 0xa8, 0x87, 0x24, 0xba, 0xc2, 0x73, 0x8f, 0xd8, 0x25, 0xc3, 0xcb, 0x8f, 0xc7, 0xa2, 0x8d,
+	0xd7, 0x90, 0xdd, 0x43, 0xbe, 0x15, 0xf7, 0x67, 0xfc, 0x72, 0x09, 0x2a, 0xb4, 0xf6, 0xa3, 0x17,
+	0xc3, 0x5c, 0xc0, 0x96, 0xf3, 0x04, 0x6c, 0x45, 0x21, 0x60, 0x55, 0x32, 0xb4, 0x3a, 0x9b, 0x0c,
+	0xad, 0xcd, 0x20, 0x43, 0xeb, 0xb9, 0x32, 0x14, 0x24, 0x19, 0x2a, 0x49, 0xca, 0xb9, 0x7c, 0x49,
+	0xd9, 0xc8, 0x94, 0x94, 0xcd, 0x27, 0x21, 0x29, 0xe7, 0x9f, 0xa8, 0xa4, 0x5c, 0x90, 0x24, 0xa5,
+	0xd1, 0x85, 0x79, 0x79, 0xfd, 0x7f, 0xd8, 0x45, 0xce, 0xdf, 0x68, 0x14, 0xe3, 0x37, 0x1a, 0xc6,
+	0x9f, 0x16, 0x60, 0x4e, 0x10, 0x89, 0x18, 0x26, 0x9c, 0x88, 0xaa, 0xbc, 0xd3, 0xcb, 0x56, 0x3f,
+	0xf2, 0x63, 0xc3, 0x59, 0x0c, 0x42, 0x69, 0x96, 0x18, 0x84, 0xf2, 0xcc, 0x31, 0x08, 0x95, 0x29,
+	0x31, 0x08, 0xd5, 0xbc, 0x18, 0x84, 0x9a, 0x20, 0xe1, 0x99, 0x56, 0x58, 0x57, 0xc5, 0x20, 0x80,
+	0x14, 0x83, 0xc0, 0x8d, 0xd1, 0x39, 0x52, 0x4b, 0xfe, 0x9b, 0x5f, 0xd6, 0xe0, 0x22, 0xbb, 0x7f,
+	0xf2, 0xbc, 0xc1, 0xfd, 0x83, 0x6d, 0x16, 0x94, 0xf0, 0x78, 0xc1, 0xc9, 0xc2, 0xf8, 0x0a, 0xf2,
+	0xf8, 0x72, 0x5d, 0x17, 0x9f, 0x06, 0x63, 0x1b, 0xa7, 0x79, 0x90, 0x49, 0x10, 0xf0, 0x8e, 0x3c,
+	0x6f, 0x80, 0xb3, 0x94, 0x90, 0x44, 0x2c, 0xd4, 0x5b, 0x32, 0x87, 0xeb, 0xee, 0xd3, 0x2a, 0xf3,
+	0x5b, 0xf8, 0x0d, 0x82, 0xaa, 0x87, 0xc8, 0x6e, 0xae, 0xf8, 0x64, 0x5d, 0xb0, 0x73, 0xe1, 0xe3,
+	0xb2, 0x85, 0x93, 0xdd, 0x72, 0x83, 0x2e, 0x27, 0xea, 0x75, 0x60, 0x7d, 0x18, 0x9f, 0x84, 0x12,
+	0xcf, 0xf6, 0xe6, 0x7a, 0x38, 0xea, 0x8a, 0x3d, 0xe8, 0x23, 0x05, 0x29, 0xd2, 0x83, 0x59, 0xf7,
+	0xbc, 0x6c, 0xf4, 0x61, 0x4e, 0xe8, 0x50, 0xe1, 0x65, 0xd8, 0x16, 0xbd, 0x0c, 0x49, 0xb7, 0x48,
+	0x1e, 0x9d, 0x34, 0xff, 0x59, 0xec, 0x94, 0xb8, 0x4e, 0x94, 0xff, 0xcf, 0xa2, 0xf0, 0xd0, 0xf3,
+	0x0f, 0x98, 0xf1, 0x36, 0x4d, 0xa3, 0xfe, 0x57, 0x1a, 0x1b, 0x94, 0x6c, 0xc4, 0x78, 0x98, 0xd1,
+	0x4a, 0xc8, 0xae, 0x45, 0x1b, 0xb4, 0x0a, 0x62, 0x76, 0x2d, 0x5a, 0xa7, 0x7f, 0x4d, 0x83, 0xd3,
+	0x5c, 0xa3, 0x18, 0xf9, 0x4e, 0x17, 0xb5, 0x87, 0x76, 0x80, 0x23, 0x27, 0xc3, 0x48, 0x21, 0xc0,
+	0xf3, 0x72, 0x2b, 0x29, 0x81, 0xd4, 0xb4, 0x70, 0x1b, 0xf9, 0x3e, 0xee, 0x69, 0xc7, 0x0e, 0x82,
+	0x9b, 0xbc, 0x1f, 0x3a, 0x51, 0x27, 0x3b, 0x59, 0xdf, 0x75, 0x17, 0x56, 0x64, 0x3a, 0xba, 0x7d,
+	0xc7, 0x6e, 0x1f, 0x64, 0x1d, 0x86, 0x33, 0xe0, 0xdf, 0xee, 0x3b, 0xf6, 0x5d, 0x8a, 0x77, 0xa9,
+	0x93, 0xac, 0x37, 0xee, 0xc1, 0x53, 0xf9, 0xc4, 0x8a, 0x8b, 0xa0, 0x39, 0xcd, 0x57, 0xf5, 0x0a,
+	0xac, 0xa9, 0x51, 0x1f, 0xa7, 0x17, 0xf3, 0x05, 0x38, 0x49, 0x96, 0x12, 0xf5, 0xb3, 0x24, 0x16,
+	0x07, 0xce, 0x2a, 0x42, 0xea, 0xf9, 0x46, 0xe3, 0x45, 0xf3, 0x4f, 0x0a, 0x60, 0xa8, 0xda, 0xb1,
+	0xf5, 0x71, 0x37, 0xb1, 0xc7, 0x9e, 0x4f, 0xaf, 0x5d, 0x65, 0x43, 0xe5, 0x16, 0xfb, 0x29, 0xb6,
+	0xc5, 0x12, 0x3e, 0x20, 0x6d, 0x9a, 0x0f, 0xa8, 0x90, 0xf2, 0x01, 0x65, 0xd8, 0xf1, 0xc6, 0xfe,
+	0xb4, 0xad, 0x78, 0x53, 0xde, 0x8a, 0xcf, 0xce, 0x3a, 0x9c, 0xe4, 0x4e, 0xdc, 0x82, 0xb9, 0x5b,
+	0x8f, 0x90, 0xcb, 0x5e, 0x85, 0x66, 0x6e, 0x23, 0x31, 0x8a, 0xb3, 0x20, 0x47, 0x71, 0x9a, 0x43,
+	0x38, 0xbd, 0x3b, 0xee, 0xe0, 0x6b, 0xd9, 0x0e, 0xcb, 0x54, 0x44, 0x7a, 0x0c, 0x66, 0xb2, 0xe6,
+	0xaf, 0x45, 0x0f, 0x82, 0xe9, 0x40, 0xe4, 0xcb, 0x1c, 0x81, 0x34, 0xfe, 0x54, 0xd8, 0xfc, 0x4f,
+	0x0d, 0xe6, 0x04, 0x34, 0x42, 0x0f, 0xda, 0x6c, 0x3d, 0x48, 0xc9, 0x0b, 0x95, 0x6e, 0xcf, 0x64,
+	0x80, 0x91, 0x32, 0xd6, 0x44, 0x8e, 0xe9, 0x2d, 0x27, 0x63, 0x7a, 0xb3, 0xee, 0xa2, 0x5b, 0x50,
+	0xe5, 0x89, 0xfe, 0xaa, 0x3c, 0x74, 0x87, 0x14, 0xf1, 0x62, 0x11, 0x73, 0x93, 0xd6, 0x48, 0x33,
+	0xe8, 0x44, 0x69, 0x49, 0xaf, 0x7f, 0x77, 0x1b, 0x60, 0x6b, 0xe4, 0xec, 0x22, 0xff, 0x91, 0xd3,
+	0x45, 0xfa, 0x17, 0xa1, 0x81, 0xb5, 0x20, 0x14, 0x50, 0x4d, 0x48, 0x5f, 0xdb, 0xa0, 0x39, 0x5a,
+	0x37, 0xe2, 0xc1, 0xe3, 0x1c, 0xad, 0xc6, 0x99, 0x5c, 0xc5, 0xc9, 0x3c, 0xf1, 0xe5, 0x7f, 0xf8,
+	0xf1, 0x2f, 0x15, 0x96, 0xf4, 0x85, 0xcd, 0x47, 0xcf, 0x6d, 0x12, 0xfa, 0x83, 0x4d, 0x8c, 0x54,
+	0x7f, 0x0f, 0x16, 0x93, 0x5e, 0x0f, 0xfd, 0xbc, 0xb2, 0xaf, 0x84, 0x53, 0x64, 0x1a, 0x46, 0x93,
+	0x60, 0x3c, 0xad, 0x1b, 0x02, 0x46, 0x3a, 0xe8, 0xcd, 0xf7, 0xe8, 0xef, 0xfb, 0xfa, 0x77, 0x34,
+	0x58, 0xe5, 0x0d, 0xa5, 0x37, 0x32, 0xfa, 0x33, 0xb3, 0xbc, 0xa3, 0xa1, 0x74, 0x5c, 0x99, 0xfd,
+	0xc9, 0x8d, 0xf9, 0x0c, 0x21, 0xea, 0x69, 0xfd, 0x9c, 0x40, 0x14, 0xa7, 0x66, 0x93, 0x85, 0xbf,
+	0xfa, 0x94, 0x82, 0xb7, 0xc9, 0xd5, 0xa4, 0x98, 0xec, 0x33, 0x93, 0xf7, 0xe7, 0x67, 0x49, 0x11,
+	0x6a, 0x9e, 0x24, 0xb8, 0x97, 0xf5, 0x25, 0x8c, 0xbb, 0x4b, 0x20, 0x36, 0x99, 0x56, 0x64, 0x03,
+	0xc4, 0xd9, 0x42, 0x33, 0xd1, 0x9c, 0x95, 0xd0, 0xa4, 0xd3, 0x8b, 0x9a, 0x06, 0xc1, 0xb0, 0x62,
+	0x2e, 0x08, 0x18, 0xde, 0x19, 0x3b, 0xe1, 0x0d, 0xed, 0x8a, 0xfe, 0x00, 0xaa, 0x6f, 0xb1, 0x34,
+	0x51, 0x59, 0xfd, 0x9f, 0xce, 0x4b, 0x29, 0x6a, 0x2e, 0x93, 0xce, 0x9b, 0xfa, 0x1c, 0xee, 0x9c,
+	0x67, 0x9c, 0xf2, 0xa1, 0x21, 0x26, 0x6c, 0xd4, 0xd7, 0x15, 0x6e, 0x5b, 0x29, 0xa1, 0x93, 0x71,
+	0x2e, 0x07, 0x82, 0x61, 0x3a, 0x43, 0x30, 0x9d, 0x30, 0x75, 0x01, 0xd3, 0x26, 0x0d, 0x70, 0xc3,
+	0x23, 0xd9, 0x83, 0x7a, 0x94, 0xc5, 0x53, 0x97, 0x17, 0x61, 0x32, 0x1f, 0xa8, 0xf1, 0x54, 0xd6,
+	0x67, 0x15, 0xc7, 0x38, 0xaa, 0x71, 0x40, 0xf0, 0xf8, 0xd0, 0x10, 0xb3, 0x39, 0x26, 0xc6, 0xa6,
+	0x48, 0x1e, 0x69, 0x9c, 0xcb, 0x81, 0xc8, 0x1b, 0x9b, 0x43, 0x20, 0x31, 0xce, 0x9f, 0x81, 0x79,
+	0x39, 0x29, 0xa3, 0x6e, 0x2a, 0xfa, 0x4c, 0x78, 0x52, 0x67, 0xc1, 0x7b, 0x91, 0xe0, 0x5d, 0x37,
+	0x4f, 0xa5, 0xf1, 0x6e, 0x72, 0xdf, 0x28, 0x26, 0xe0, 0xcb, 0x1a, 0x2c, 0x24, 0x12, 0x2b, 0xea,
+	0x4f, 0x2b, 0xbb, 0x97, 0x53, 0x00, 0xce, 0x42, 0xc3, 0x25, 0x42, 0xc3, 0x39, 0xf3, 0xb4, 0x82,
+	0x06, 0x92, 0x98, 0x12, 0x67, 0xaa, 0x94, 0xb9, 0xc0, 0x32, 0x26, 0xaa, 0xb9, 0x20, 0xa7, 0x53,
+	0xfc, 0xd0, 0x5c, 0x60, 0xdd, 0x61, 0x02, 0xbe, 0xaa, 0xc1, 0xc2, 0xad, 0x49, 0x1e, 0x17, 0xd4,
+	0x89, 0x10, 0x8d, 0xf3, 0xf9, 0x40, 0x79, 0x8c, 0x40, 0x93, 0x34, 0x23, 0x7c, 0x68, 0xdc, 0x9a,
+	0x64, 0x2e, 0x41, 0x45, 0x4a, 0x44, 0xe3, 0x5c, 0x0e, 0x44, 0xde, 0x12, 0xa4, 0xd8, 0x19, 0x4e,
+	0x31, 0x1f, 0x61, 0x02, 0xa7, 0x22, 0xfd, 0xa1, 0x71, 0x2e, 0x07, 0x22, 0x0f, 0xa7, 0x4f, 0x20,
+	0x23, 0x9c, 0x71, 0xa2, 0xc1, 0x14, 0xce, 0x54, 0xda, 0x43, 0xe3, 0x5c, 0x0e, 0x44, 0x3e, 0x4e,
+	0x0c, 0xc9, 0x70, 0x8a, 0x79, 0x02, 0x13, 0x38, 0x15, 0xe9, 0x06, 0x8d, 0x73, 0x39, 0x10, 0x79,
+	0x38, 0x3b, 0x04, 0x92, 0xe1, 0x14, 0x93, 0xe2, 0x25, 0x70, 0x2a, 0xb2, 0xf3, 0x19, 0xe7, 0x72,
+	0x20, 0xf2, 0x70, 0xd2, 0xa8, 0x08, 0x8c, 0xf3, 0x6d, 0x80, 0x38, 0x49, 0x9e, 0xfe, 0x54, 0x2a,
+	0x86, 0x41, 0xc6, 0x77, 0x36, 0xf3, 0x3b, 0xc3, 0x76, 0x8a, 0x60, 0x5b, 0x35, 0x17, 0x45, 0x6c,
+	0x1c, 0xd7, 0x57, 0x34, 0x58, 0x4a, 0x5d, 0xcb, 0xe8, 0x17, 0xd4, 0x49, 0x91, 0x92, 0x52, 0xec,
+	0xe2, 0x34, 0x30, 0x46, 0xc1, 0x59, 0x42, 0xc1, 0x49, 0x73, 0x45, 0xa4, 0x40, 0x94, 0x61, 0x5f,
+	0xd7, 0x60, 0x31, 0x6a, 0xce, 0x13, 0xec, 0x9d, 0x9f, 0x92, 0x99, 0x89, 0xd2, 0x70, 0x61, 0xa6,
+	0xfc, 0x4d, 0x6a, 0x39, 0xd2, 0x1d, 0xfb, 0x3e, 0x3e, 0x71, 0x99, 0xa6, 0x87, 0x29, 0x39, 0x84,
+	0xa6, 0x94, 0x6c, 0x4c, 0x57, 0x9d, 0x7e, 0x72, 0x5e, 0x33, 0xc3, 0xcc, 0x03, 0x51, 0xb1, 0x20,
+	0xba, 0x92, 0x15, 0xce, 0xc8, 0x90, 0x68, 0x8d, 0xf1, 0xbd, 0xec, 0x7a, 0x4e, 0x2a, 0x31, 0xd5,
+	0x42, 0x53, 0x25, 0x1b, 0xe3, 0x58, 0xf5, 0x13, 0x32, 0xd6, 0xf7, 0x98, 0x07, 0xeb, 0x7d, 0xfd,
+	0x03, 0x3a, 0xfd, 0x72, 0x46, 0xc0, 0xf4, 0xf4, 0x2b, 0x33, 0x31, 0x1a, 0x17, 0xa7, 0x81, 0x31,
+	0x2a, 0xd6, 0x09, 0x15, 0x86, 0xb9, 0x2a, 0x53, 0x21, 0x70, 0xfd, 0x6b, 0x1a, 0x2c, 0x24, 0xb2,
+	0xfd, 0x25, 0xa4, 0xb7, 0x3a, 0xbb, 0xa0, 0x71, 0x3e, 0x1f, 0x88, 0x11, 0x70, 0x99, 0x10, 0x60,
+	0xea, 0xeb, 0x09, 0x36, 0xb0, 0xbf, 0xef, 0x6f, 0x3e, 0x62, 0x0d, 0xf5, 0x43, 0x98, 0x13, 0x12,
+	0xf1, 0xe9, 0xf2, 0xde, 0x4a, 0x27, 0x03, 0x34, 0xd6, 0xb3, 0x01, 0x18, 0xee, 0x0b, 0x04, 0xf7,
+	0x59, 0xd3, 0x90, 0x71, 0xb3, 0xd4, 0x7a, 0x9b, 0xd8, 0x9b, 0x4a, 0x0f, 0xd0, 0xa6, 0x94, 0x62,
+	0x2f, 0xb1, 0xee, 0x54, 0x89, 0xfe, 0x0c, 0x33, 0x0f, 0x44, 0x75, 0x70, 0xa5, 0xd1, 0x3f, 0x22,
+	0x8d, 0x30, 0x01, 0x3d, 0xa8, 0xb2, 0xb8, 0x3e, 0xfd, 0x54, 0x72, 0x5e, 0x85, 0x78, 0x4c, 0xe3,
+	0xb4, 0xfa, 0x23, 0x43, 0xf7, 0x14, 0x41, 0xd7, 0x32, 0x97, 0x65, 0x74, 0x24, 0x2c, 0x10, 0x63,
+	0x19, 0xc3, 0x9c, 0x10, 0xb6, 0xa5, 0xa7, 0x65, 0x97, 0x1c, 0x07, 0x66, 0xac, 0x67, 0x03, 0x30,
+	0x8c, 0x4f, 0x13, 0x8c, 0x67, 0xcc, 0x96, 0x02, 0x63, 0x24, 0xe5, 0xde, 0xc7, 0x8f, 0x84, 0x84,
+	0xe8, 0x34, 0x5d, 0x25, 0xa4, 0x13, 0xa8, 0xcd, 0x3c, 0x90, 0xfc, 0xc9, 0xa5, 0xc8, 0x63, 0x81,
+	0xfe, 0x15, 0x0d, 0x16, 0x12, 0x11, 0x6b, 0x89, 0xe5, 0xad, 0x0e, 0x83, 0x33, 0xce, 0xe7, 0x03,
+	0xcd, 0x42, 0x05, 0x0d, 0xb5, 0xc3, 0x54, 0x74, 0xa0, 0xc6, 0x83, 0xce, 0x74, 0x79, 0x16, 0x13,
+	0x01, 0x70, 0xc6, 0x99, 0x8c, 0xaf, 0xb2, 0x59, 0x64, 0xce, 0x63, 0x7c, 0x24, 0x46, 0x26, 0xd8,
+	0x0c, 0x10, 0x51, 0x45, 0xbe, 0x08, 0xf5, 0x28, 0x68, 0x4d, 0x4f, 0x99, 0x9b, 0x52, 0x8c, 0x99,
+	0x71, 0x2a, 0x27, 0x7a, 0xcb, 0x5c, 0x25, 0x38, 0x16, 0x4c, 0x88, 0x71, 0xe0, 0xfe, 0x0f, 0xa0,
+	0x21, 0x86, 0xab, 0x25, 0xa4, 0xa4, 0x22, 0x92, 0x2d, 0x1f, 0xcb, 0x69, 0x82, 0x65, 0xcd, 0x5c,
+	0x92, 0x46, 0x82, 0x3b, 0xc1, 0xc8, 0xbe, 0xa5, 0xc1, 0x8a, 0xea, 0x29, 0x82, 0x7e, 0x79, 0x86,
+	0xd7, 0x0a, 0x14, 0xfb, 0xec, 0xef, 0x1a, 0xb8, 0xf5, 0x6d, 0x12, 0x59, 0x2d, 0x46, 0xaa, 0x6d,
+	0xd2, 0x4c, 0x45, 0x9c, 0x22, 0x55, 0x02, 0x91, 0x04, 0x45, 0x39, 0x69, 0x6e, 0x8c, 0x67, 0x66,
+	0x80, 0x9c, 0x4a, 0x51, 0x7c, 0x6c, 0xfd, 0x8a, 0x06, 0xab, 0xca, 0xe4, 0x30, 0x09, 0x7f, 0x40,
+	0x5e, 0x02, 0x99, 0xe3, 0xd0, 0x24, 0xc9, 0x33, 0x05, 0x4d, 0x9b, 0xf6, 0x38, 0xf4, 0x98, 0x4a,
+	0xa1, 0xa7, 0x9f, 0xdb, 0xe8, 0x17, 0x53, 0x02, 0x5b, 0xcd, 0xa6, 0x4b, 0x53, 0xe1, 0x54, 0x87,
+	0x9b, 0x44, 0x10, 0x17, 0xed, 0x23, 0x80, 0xf8, 0xad, 0x4e, 0x42, 0x9d, 0x4b, 0x3d, 0xe2, 0x31,
+	0x4e, 0x4a, 0xdf, 0xc5, 0x70, 0xf9, 0x9c, 0xb1, 0x8f, 0x82, 0x4e, 0x28, 0x4c, 0xca, 0x23, 0xfc,
+	0x62, 0x85, 0x3f, 0x74, 0x48, 0x60, 0x4c, 0x3d, 0xd9, 0x31, 0xce, 0x66, 0x7e, 0x9f, 0x0d, 0x6f,
+	0xbc, 0x3c, 0x5d, 0xa8, 0xf1, 0xa7, 0x09, 0x49, 0x09, 0x23, 0xbf, 0x95, 0x30, 0xce, 0x64, 0x7c,
+	0x55, 0x49, 0xb4, 0x34, 0x46, 0xce, 0xd9, 0x00, 0xe6, 0x84, 0xe7, 0x0a, 0x89, 0xd3, 0x24, 0xfd,
+	0x90, 0x21, 0x8f, 0xb7, 0x4c, 0x45, 0x30, 0xcf, 0x64, 0xf0, 0x96, 0x76, 0x86, 0x91, 0xfe, 0x34,
+	0x34, 0xc4, 0xc7, 0x0c, 0x09, 0x11, 0xa4, 0x78, 0x12, 0x61, 0x9c, 0xcb, 0x81, 0x90, 0xbd, 0x5c,
+	0xe6, 0x53, 0x6a, 0xf4, 0xfc, 0xdd, 0x89, 0xa0, 0xb1, 0xcb, 0x59, 0x1c, 0xd2, 0x2a, 0x9b, 0x32,
+	0x91, 0x85, 0x71, 0x71, 0x1a, 0x98, 0x4a, 0x5d, 0x95, 0xe8, 0xd9, 0x43, 0x84, 0x8a, 0x6f, 0x62,
+	0x7b, 0x5b, 0xce, 0xee, 0x90, 0xb4, 0xb7, 0x95, 0xa9, 0x23, 0x8c, 0xf3, 0xf9, 0x40, 0x0c, 0xff,
+	0x35, 0x82, 0xff, 0x8a, 0x7e, 0x59, 0x85, 0xdf, 0xc7, 0xfb, 0xfc, 0x3d, 0x29, 0x81, 0xc4, 0xfb,
+	0x74, 0xbf, 0xa7, 0x72, 0x85, 0x24, 0xf7, 0x7b, 0x56, 0xee, 0x11, 0xe3, 0xd2, 0x54, 0xb8, 0xe9,
+	0xfb, 0x1d, 0xb9, 0xe4, 0x9c, 0xfd, 0x06, 0x9d, 0xa0, 0x04, 0x21, 0xa9, 0x09, 0x52, 0xd3, 0x71,
+	0x71, 0x1a, 0x98, 0x4a, 0xa5, 0x95, 0xc8, 0x78, 0x8f, 0xb8, 0xc4, 0xdf, 0xdf, 0xe4, 0xd9, 0x89,
+	0x8e, 0x60, 0x4e, 0x78, 0xfc, 0x9d, 0xd8, 0x24, 0xe9, 0x17, 0xe4, 0xc6, 0x7a, 0x36, 0x80, 0x2c,
+	0x0f, 0xf4, 0xb3, 0x99, 0xb8, 0x99, 0x93, 0xf4, 0xab, 0x4c, 0xef, 0x11, 0x1e, 0x68, 0x2b, 0xf4,
+	0x9e, 0xf4, 0x9b, 0x72, 0xe3, 0x7c, 0x3e, 0xd0, 0x54, 0xb9, 0x34, 0x8e, 0xa1, 0xf1, 0x8c, 0xfc,
+	0xbc, 0x06, 0x7a, 0xfa, 0x81, 0x75, 0x62, 0x6d, 0x64, 0x3e, 0xe8, 0x36, 0x2e, 0x4d, 0x85, 0x53,
+	0xe9, 0xa2, 0x12, 0x41, 0x36, 0x6d, 0x84, 0x89, 0xf9, 0x92, 0x16, 0xbd, 0xdd, 0x8c, 0xdf, 0xe6,
+	0x26, 0x96, 0x47, 0xd6, 0xfb, 0xec, 0xc4, 0xe4, 0x28, 0x1e, 0xf7, 0xe6, 0xd0, 0xc0, 0x5e, 0x0b,
+	0x33, 0x1a, 0xe6, 0xe5, 0x17, 0xd3, 0x09, 0x7f, 0x9d, 0xf2, 0x39, 0xf5, 0x0c, 0xd8, 0x73, 0xe4,
+	0x18, 0xc5, 0xbe, 0x49, 0x1e, 0x44, 0x63, 0x1a, 0x7e, 0x4d, 0xe3, 0xef, 0xd7, 0xd3, 0x99, 0xcd,
+	0x74, 0xd5, 0x73, 0xf5, 0xcc, 0x04, 0x68, 0xc7, 0xd1, 0x1f, 0xb2, 0xd9, 0xc3, 0xee, 0x12, 0x30,
+	0x69, 0x1e, 0xd4, 0xa3, 0x64, 0xae, 0x7a, 0x46, 0xfa, 0x5e, 0xb5, 0xbf, 0x3a, 0x95, 0x03, 0x36,
+	0x07, 0x21, 0x0d, 0xa9, 0x27, 0xc6, 0xd7, 0xcf, 0x6a, 0xd0, 0x94, 0x52, 0xc8, 0x26, 0x0c, 0x14,
+	0x55, 0xce, 0x5e, 0xc3, 0xcc, 0x03, 0x99, 0x7a, 0xac, 0x31, 0xec, 0x9b, 0x03, 0x27, 0x20, 0x9a,
+	0xfb, 0x07, 0x1a, 0x34, 0xa5, 0xe4, 0xa6, 0xba, 0xca, 0x31, 0x99, 0x4b, 0x82, 0x32, 0x37, 0xaa,
+	0x79, 0x85, 0x90, 0x70, 0xde, 0x3c, 0x9b, 0x49, 0x42, 0xe4, 0xc9, 0xbc, 0xa6, 0xe9, 0x7f, 0x46,
+	0x85, 0xa7, 0x9c, 0xa0, 0x2a, 0x2d, 0x3c, 0x95, 0xd9, 0xcc, 0x8c, 0x8b, 0xd3, 0xc0, 0x18, 0x49,
+	0xbb, 0x84, 0xa4, 0x1d, 0xfd, 0x52, 0xd6, 0x22, 0x88, 0x48, 0x7b, 0x0f, 0x5f, 0x42, 0xbe, 0xff,
+	0x79, 0x95, 0x9c, 0x4d, 0x80, 0x72, 0xca, 0xe5, 0xf8, 0xfc, 0x34, 0xe5, 0xca, 0x17, 0x1f, 0xc6,
+	0xc5, 0x69, 0x60, 0x53, 0x29, 0x67, 0x41, 0x04, 0xb3, 0x50, 0x9e, 0x00, 0x15, 0x76, 0x62, 0x3a,
+	0x5e, 0x5f, 0xb9, 0x13, 0x33, 0xc3, 0xfa, 0x9f, 0xcc, 0x4e, 0x64, 0xf4, 0xe1, 0x55, 0xf9, 0xbd,
+	0x54, 0xdc, 0xbc, 0x42, 0x58, 0x5c, 0x55, 0x5d, 0x50, 0x65, 0x86, 0xd9, 0x1f, 0x87, 0xc6, 0x67,
+	0x09, 0x8d, 0x17, 0xcd, 0x73, 0x99, 0xb3, 0xcf, 0xb3, 0xb1, 0xab, 0x89, 0x55, 0xf0, 0xf3, 0x7f,
+	0x83, 0x58, 0x3e, 0xe1, 0x22, 0xb1, 0xdf, 0x8f, 0x12, 0x4b, 0x66, 0x86, 0x70, 0xe9, 0xaa, 0x27,
+	0x1d, 0xd3, 0x02, 0xbe, 0x8e, 0x43, 0x71, 0xb6, 0x68, 0x18, 0x79, 0xde, 0x60, 0x74, 0xc0, 0x23,
+	0xa0, 0x30, 0xbd, 0x7f, 0x48, 0xb7, 0x97, 0x1c, 0x5a, 0x93, 0xde, 0x5e, 0xca, 0xd8, 0x25, 0xe3,
+	0xe2, 0x34, 0x30, 0x46, 0xd0, 0x5d, 0x42, 0xd0, 0x2d, 0x9d, 0x78, 0x89, 0x19, 0xd7, 0x82, 0x4d,
+	0x97, 0x02, 0xb3, 0xf2, 0xe7, 0x2f, 0xea, 0xe7, 0x73, 0x3e, 0xc7, 0x57, 0xe5, 0xbf, 0xa0, 0xc1,
+	0xb2, 0x22, 0xf8, 0x4a, 0xbf, 0x34, 0x3d, 0x3c, 0x8b, 0x52, 0x7d, 0x79, 0xd6, 0x38, 0x2e, 0x79,
+	0x2f, 0x45, 0x84, 0x11, 0x26, 0xd2, 0x58, 0x37, 0xe6, 0x64, 0xd5, 0xd3, 0x11, 0x28, 0x09, 0x2d,
+	0x28, 0x33, 0xc4, 0xc7, 0xb8, 0x34, 0x63, 0x28, 0x8b, 0x6c, 0x3b, 0x44, 0xc4, 0xb0, 0x78, 0x20,
+	0x7a, 0xa7, 0xb2, 0xaa, 0x0c, 0x4c, 0x49, 0xb8, 0x0c, 0xf2, 0x82, 0x57, 0x8c, 0x96, 0xe2, 0xe6,
+	0x9b, 0x40, 0x98, 0x3a, 0x41, 0xdf, 0xd0, 0x89, 0xe7, 0x08, 0x91, 0x46, 0xd7, 0xb4, 0x9b, 0xbf,
+	0x53, 0xf8, 0xc5, 0xad, 0xef, 0x16, 0x70, 0x14, 0xeb, 0xce, 0xd6, 0xee, 0xee, 0x55, 0xda, 0x60,
+	0x7d, 0xeb, 0xfe, 0x1d, 0xf3, 0x45, 0x68, 0xe0, 0xaa, 0xf5, 0x91, 0xef, 0xbd, 0x8d, 0xba, 0xa1,
+	0xbe, 0xd2, 0x0f, 0xc3, 0x51, 0x70, 0x63, 0x73, 0x13, 0xc7, 0x9a, 0xb9, 0x28, 0xdc, 0xf0, 0xfc,
+	0xfd, 0x4d, 0x63, 0xb9, 0xeb, 0xb9, 0xa1, 0xdd, 0x0d, 0x3f, 0x23, 0xd4, 0x5e, 0xf9, 0x3f, 0xd7,
+	0x8b, 0xcf, 0x6d, 0x5c, 0xbb, 0xa2, 0x15, 0xae, 0x2f, 0xda, 0xa3, 0xd1, 0xc0, 0xe9, 0x92, 0x80,
+	0xcb, 0xcd, 0xb7, 0x03, 0xcf, 0xbd, 0xbe, 0x26, 0xd6, 0x4c, 0xae, 0xee, 0x79, 0xde, 0xd5, 0xa1,
+	0x33, 0x44, 0x37, 0x52, 0x90, 0x37, 0x32, 0x20, 0xad, 0xb3, 0x50, 0xfc, 0xf8, 0xb5, 0xe7, 0xf5,
+	0x16, 0x0e, 0x84, 0x5d, 0x1f, 0x21, 0x7f, 0xe8, 0x04, 0x81, 0xe3, 0xb9, 0x1b, 0x7a, 0x05, 0x4a,
+	0xbf, 0x5e, 0xd0, 0xaa, 0xd6, 0x29, 0x0c, 0xf0, 0x71, 0x7d, 0x05, 0xe0, 0xb3, 0x5e, 0xb8, 0xbe,
+	0xe7, 0x8d, 0xdd, 0x5e, 0xf4, 0xd1, 0x7f, 0x01, 0xce, 0x24, 0x46, 0xba, 0xfe, 0x8a, 0xd7, 0x1d,
+	0xe3, 0xe0, 0x74, 0x82, 0x49, 0x3d, 0xce, 0x4e, 0x85, 0xf0, 0xf4, 0xf9, 0xff, 0x1e, 0x00, 0x29,
+	0x8b, 0x28, 0x92, 0xbc, 0x78, 0x00, 0x00,
 }
@@ -0,0 +1,245 @@
+package api
+
+import (
+	"encoding/hex"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/status"
+
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/wire"
+
+	pb "massnet.org/mass-wallet/api/proto"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/psbt"
+)
+
+func (s *APIServer) CreatePsbt(ctx context.Context, in *pb.CreatePsbtRequest) (*pb.PsbtResponse, error) {
+	logging.CPrint(logging.INFO, "api: CreatePsbt", logging.LogFormat{})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
+	serializedTx, err := decodeHexStr(in.Hex)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "decodeHexStr error", logging.LogFormat{
+			"err": err,
+		})
+		return nil, status.New(ErrAPIInvalidTxHex, ErrCode[ErrAPIInvalidTxHex]).Err()
+	}
+	var tx wire.MsgTx
+	if err = tx.SetBytes(serializedTx, wire.Packet); err != nil {
+		logging.CPrint(logging.ERROR, "deserialize tx error", logging.LogFormat{"err": err.Error()})
+		return nil, status.New(ErrAPIInvalidTxHex, ErrCode[ErrAPIInvalidTxHex]).Err()
+	}
+
+	p, err := s.massWallet.CreatePsbt(in.WalletId, &tx)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "CreatePsbt failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	resp, err := buildPsbtResponse(p)
+	if err != nil {
+		return nil, err
+	}
+	logging.CPrint(logging.INFO, "api: CreatePsbt completed", logging.LogFormat{})
+	return resp, nil
+}
+
+func (s *APIServer) DecodePsbt(ctx context.Context, in *pb.DecodePsbtRequest) (*pb.DecodePsbtResponse, error) {
+	logging.CPrint(logging.INFO, "api: DecodePsbt", logging.LogFormat{})
+
+	p, err := decodePsbt(in.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	txResp, err := s.buildDecodeRawTxResponse(p.Tx)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "buildDecodeRawTxResponse error", logging.LogFormat{
+			"txid": p.Tx.TxHash(),
+			"err":  err,
+		})
+		return nil, status.New(ErrAPIInvalidPsbt, ErrCode[ErrAPIInvalidPsbt]).Err()
+	}
+	fee, err := AmountToString(p.Fee())
+	if err != nil {
+		logging.CPrint(logging.ERROR, "Failed to transfer amount to string", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIInvalidPsbt, ErrCode[ErrAPIInvalidPsbt]).Err()
+	}
+
+	resp := &pb.DecodePsbtResponse{
+		Tx:       txResp,
+		Inputs:   make([]*pb.DecodePsbtResponse_Input, 0, len(p.Inputs)),
+		Fee:      fee,
+		Complete: p.IsComplete(config.ChainParams),
+	}
+	for i, in := range p.Inputs {
+		val, err := AmountToString(in.Value)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "Failed to transfer amount to string", logging.LogFormat{"err": err})
+			return nil, status.New(ErrAPIInvalidPsbt, ErrCode[ErrAPIInvalidPsbt]).Err()
+		}
+		input := &pb.DecodePsbtResponse_Input{
+			TxId:         p.Tx.TxIn[i].PreviousOutPoint.Hash.String(),
+			Vout:         p.Tx.TxIn[i].PreviousOutPoint.Index,
+			Value:        val,
+			PkScript:     hex.EncodeToString(in.PkScript),
+			RedeemScript: hex.EncodeToString(in.RedeemScript),
+			Height:       in.Height,
+			Derivations:  make([]*pb.DecodePsbtResponse_Derivation, 0, len(in.Derivations)),
+			PartialSigs:  make([]*pb.DecodePsbtResponse_PartialSig, 0, len(in.PartialSigs)),
+			Finalized:    p.IsFinalized(i),
+		}
+		for _, d := range in.Derivations {
+			input.Derivations = append(input.Derivations, &pb.DecodePsbtResponse_Derivation{
+				PubKey:   hex.EncodeToString(d.PubKey),
+				WalletId: d.WalletId,
+				Branch:   d.Branch,
+				Index:    d.Index,
+			})
+		}
+		for _, sig := range in.PartialSigs {
+			input.PartialSigs = append(input.PartialSigs, &pb.DecodePsbtResponse_PartialSig{
+				PubKey:    hex.EncodeToString(sig.PubKey),
+				Signature: hex.EncodeToString(sig.Signature),
+			})
+		}
+		resp.Inputs = append(resp.Inputs, input)
+	}
+
+	logging.CPrint(logging.INFO, "api: DecodePsbt completed", logging.LogFormat{})
+	return resp, nil
+}
+
+func (s *APIServer) SignPsbt(ctx context.Context, in *pb.SignPsbtRequest) (*pb.SignPsbtResponse, error) {
+	logging.CPrint(logging.INFO, "api: SignPsbt", logging.LogFormat{})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	p, err := decodePsbt(in.Psbt)
+	if err != nil {
+		return nil, err
+	}
+	// the passphrase is omitted if the wallet is unlocked by UnlockWallet
+	if len(in.Passphrase) > 0 {
+		if err = checkPassLen(in.Passphrase); err != nil {
+			return nil, err
+		}
+	}
+
+	flag := in.Flags
+	if len(flag) == 0 {
+		flag = "ALL"
+	}
+
+	signed, err := s.massWallet.SignPsbt(in.WalletId, []byte(in.Passphrase), flag, p)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "SignPsbt failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPISignRawTx, ErrCode[ErrAPISignRawTx]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	encoded, err := p.Encode()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to encode psbt", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+	}
+	logging.CPrint(logging.INFO, "api: SignPsbt completed", logging.LogFormat{"signed": signed})
+	return &pb.SignPsbtResponse{
+		Psbt:     encoded,
+		Complete: p.IsComplete(config.ChainParams),
+		Signed:   uint32(signed),
+	}, nil
+}
+
+func (s *APIServer) CombinePsbt(ctx context.Context, in *pb.CombinePsbtRequest) (*pb.PsbtResponse, error) {
+	logging.CPrint(logging.INFO, "api: CombinePsbt", logging.LogFormat{})
+
+	if err := checkNotEmpty(in.Psbts); err != nil {
+		return nil, err
+	}
+	packets := make([]*psbt.Packet, 0, len(in.Psbts))
+	for _, encoded := range in.Psbts {
+		p, err := decodePsbt(encoded)
+		if err != nil {
+			return nil, err
+		}
+		packets = append(packets, p)
+	}
+
+	combined, err := psbt.Combine(packets...)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "CombinePsbt failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIInvalidPsbt, ErrCode[ErrAPIInvalidPsbt]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	resp, err := buildPsbtResponse(combined)
+	if err != nil {
+		return nil, err
+	}
+	logging.CPrint(logging.INFO, "api: CombinePsbt completed", logging.LogFormat{})
+	return resp, nil
+}
+
+func (s *APIServer) FinalizePsbt(ctx context.Context, in *pb.FinalizePsbtRequest) (*pb.FinalizePsbtResponse, error) {
+	logging.CPrint(logging.INFO, "api: FinalizePsbt", logging.LogFormat{})
+
+	p, err := decodePsbt(in.Psbt)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := p.Finalize(config.ChainParams)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "FinalizePsbt failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIInvalidPsbt, ErrCode[ErrAPIInvalidPsbt]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	txHex, err := messageToHex(tx)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to encode tx", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+	}
+	logging.CPrint(logging.INFO, "api: FinalizePsbt completed", logging.LogFormat{})
+	return &pb.FinalizePsbtResponse{Hex: txHex}, nil
+}
+
+func decodePsbt(encoded string) (*psbt.Packet, error) {
+	p, err := psbt.Decode(encoded)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to decode psbt", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIInvalidPsbt, ErrCode[ErrAPIInvalidPsbt]).Err()
+	}
+	return p, nil
+}
+
+func buildPsbtResponse(p *psbt.Packet) (*pb.PsbtResponse, error) {
+	encoded, err := p.Encode()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to encode psbt", logging.LogFormat{"err": err})
+		return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+	}
+	return &pb.PsbtResponse{
+		Psbt:     encoded,
+		Complete: p.IsComplete(config.ChainParams),
+	}, nil
+}
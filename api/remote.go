@@ -0,0 +1,105 @@
+package api
+
+import (
+	"strings"
+
+	"github.com/massnetorg/mass-core/wire"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RemoteNode is the chain access left to the api when the wallet follows a
+// remote full node instead of embedding the chain.
+type RemoteNode interface {
+	ChainID() string
+	BestBlockHeight() uint64
+	SubmitTx(tx *wire.MsgTx) error
+	// HaveTransaction returns whether the tx is in the mempool of the node.
+	HaveTransaction(hash *wire.Hash) bool
+	// FetchTxAndHeightBySha returns the latest tx of hash on the best chain
+	// and the height of its block, the tx is nil if not found.
+	FetchTxAndHeightBySha(hash *wire.Hash) (*wire.MsgTx, uint64, error)
+}
+
+// embeddedChainMethods are the rpcs querying the chain beyond what a remote
+// node serves to the wallet, they're unavailable when following a remote node.
+var embeddedChainMethods = map[string]struct{}{
+	"GetBestBlock":          {},
+	"GetBlockByHeight":      {},
+	"GetBlockStakingReward": {},
+	"GetRawTransaction":     {},
+	"GetNetworkBinding":     {},
+	"CheckPoolPkCoinbase":   {},
+	"CheckTargetBinding":    {},
+}
+
+func embeddedChainInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	name := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	if _, ok := embeddedChainMethods[name]; ok {
+		return nil, status.New(ErrAPIChainUnavailable, ErrCode[ErrAPIChainUnavailable]).Err()
+	}
+	return handler(ctx, req)
+}
+
+// chainUnaryInterceptors runs the interceptors in order, the grpc server takes
+// a single one.
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, h := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, h)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// chainStreamInterceptors runs the stream interceptors in order, the grpc
+// server takes a single one.
+func chainStreamInterceptors(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, h := interceptors[i], next
+			next = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, h)
+			}
+		}
+		return next(srv, ss)
+	}
+}
+
+func (s *APIServer) bestBlockHeight() uint64 {
+	if s.remote != nil {
+		return s.remote.BestBlockHeight()
+	}
+	return s.node.Blockchain().BestBlockHeight()
+}
+
+func (s *APIServer) haveMempoolTx(hash *wire.Hash) bool {
+	if s.remote != nil {
+		return s.remote.HaveTransaction(hash)
+	}
+	_, err := s.node.TxMemPool().FetchTransaction(hash)
+	return err == nil
+}
+
+// fetchChainTx returns the latest tx of hash on the best chain and the height
+// of its block, the tx is nil if not found.
+func (s *APIServer) fetchChainTx(hash *wire.Hash) (*wire.MsgTx, uint64, error) {
+	if s.remote != nil {
+		return s.remote.FetchTxAndHeightBySha(hash)
+	}
+	txList, err := s.node.Blockchain().GetTransactionInDB(hash)
+	if err != nil || len(txList) == 0 {
+		return nil, 0, err
+	}
+	last := txList[len(txList)-1]
+	return last.Tx, last.Height, nil
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"math"
+	"testing"
+
+	"github.com/massnetorg/mass-core/consensus"
+	"github.com/massnetorg/mass-core/wire"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	pb "massnet.org/mass-wallet/api/proto"
+)
+
+type mockRemoteNode struct {
+	best    uint64
+	mempool map[wire.Hash]*wire.MsgTx
+	chain   map[wire.Hash]uint64
+	txs     map[wire.Hash]*wire.MsgTx
+}
+
+func (n *mockRemoteNode) ChainID() string                   { return "" }
+func (n *mockRemoteNode) BestBlockHeight() uint64           { return n.best }
+func (n *mockRemoteNode) SubmitTx(tx *wire.MsgTx) error     { return nil }
+func (n *mockRemoteNode) HaveTransaction(h *wire.Hash) bool { return n.mempool[*h] != nil }
+
+func (n *mockRemoteNode) FetchTxAndHeightBySha(h *wire.Hash) (*wire.MsgTx, uint64, error) {
+	return n.txs[*h], n.chain[*h], nil
+}
+
+func TestGetTxStatus_Remote(t *testing.T) {
+	newTx := func(hash wire.Hash, index uint32) *wire.MsgTx {
+		tx := wire.NewMsgTx()
+		tx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Hash: hash, Index: index}})
+		return tx
+	}
+	pending, confirmed, missing := newTx(wire.Hash{1}, 0), newTx(wire.Hash{2}, 0), newTx(wire.Hash{3}, 0)
+	// a coinbase is confirming until it matures
+	confirming := newTx(wire.Hash{}, math.MaxUint32)
+	best := uint64(100)
+	node := &mockRemoteNode{
+		best:    best,
+		mempool: map[wire.Hash]*wire.MsgTx{pending.TxHash(): pending},
+		chain: map[wire.Hash]uint64{
+			confirming.TxHash(): best + 2 - consensus.CoinbaseMaturity,
+			confirmed.TxHash():  best + 1 - consensus.TransactionMaturity,
+		},
+		txs: map[wire.Hash]*wire.MsgTx{
+			confirming.TxHash(): confirming,
+			confirmed.TxHash():  confirmed,
+		},
+	}
+	s := &APIServer{remote: node}
+	for _, test := range []struct {
+		tx   *wire.MsgTx
+		code int32
+	}{
+		{pending, txStatusPacking},
+		{confirming, txStatusConfirming},
+		{confirmed, txStatusConfirmed},
+		{missing, txStatusMissing},
+	} {
+		resp, err := s.GetTxStatus(context.Background(), &pb.GetTxStatusRequest{TxId: test.tx.TxHash().String()})
+		assert.Nil(t, err)
+		assert.Equal(t, test.code, resp.Code)
+		assert.Equal(t, txStatusDesc[test.code], resp.Status)
+	}
+}
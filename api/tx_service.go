@@ -8,6 +8,7 @@ import (
 	"github.com/massnetorg/mass-core/blockchain"
 	"github.com/massnetorg/mass-core/consensus"
 	"github.com/massnetorg/mass-core/consensus/forks"
+	"github.com/massnetorg/mass-core/database"
 	"github.com/massnetorg/mass-core/logging"
 	"github.com/massnetorg/mass-core/massutil"
 	"github.com/massnetorg/mass-core/poc"
@@ -16,6 +17,7 @@ import (
 	pb "massnet.org/mass-wallet/api/proto"
 	"massnet.org/mass-wallet/config"
 	"massnet.org/mass-wallet/masswallet"
+	"massnet.org/mass-wallet/masswallet/txmgr"
 	"massnet.org/mass-wallet/masswallet/utils"
 
 	"golang.org/x/crypto/ripemd160"
@@ -307,16 +309,197 @@ func (s *APIServer) GetTxStatus(ctx context.Context, in *pb.GetTxStatusRequest)
 	return resp, nil
 }
 
+func (s *APIServer) ListUnconfirmed(ctx context.Context, in *pb.ListUnconfirmedRequest) (*pb.ListUnconfirmedResponse, error) {
+	logging.CPrint(logging.INFO, "api: ListUnconfirmed", logging.LogFormat{})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
+	txs, err := s.massWallet.ListUnconfirmed(in.WalletId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ListUnconfirmed failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	resp := &pb.ListUnconfirmedResponse{
+		Txs: make([]*pb.ListUnconfirmedResponse_UnconfirmedTx, 0, len(txs)),
+	}
+	for _, tx := range txs {
+		resp.Txs = append(resp.Txs, &pb.ListUnconfirmedResponse_UnconfirmedTx{
+			TxId:      tx.TxId,
+			Received:  tx.Received.Unix(),
+			Size:      int64(tx.Size),
+			InMempool: tx.InMempool,
+		})
+	}
+	logging.CPrint(logging.INFO, "api: ListUnconfirmed completed", logging.LogFormat{"count": len(txs)})
+	return resp, nil
+}
+
+func (s *APIServer) AbandonTransaction(ctx context.Context, in *pb.AbandonTransactionRequest) (*pb.AbandonTransactionResponse, error) {
+	logging.CPrint(logging.INFO, "api: AbandonTransaction", logging.LogFormat{"txid": in.TxId})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	if err := checkTransactionIdLen(in.TxId); err != nil {
+		return nil, err
+	}
+
+	txIds, err := s.massWallet.AbandonTransaction(in.WalletId, in.TxId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "AbandonTransaction failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: AbandonTransaction completed", logging.LogFormat{"removed": txIds})
+	return &pb.AbandonTransactionResponse{TxIds: txIds}, nil
+}
+
+func (s *APIServer) CreatePayoutBatch(ctx context.Context, in *pb.CreatePayoutBatchRequest) (*pb.PayoutBatchResponse, error) {
+	logging.CPrint(logging.INFO, "api: CreatePayoutBatch", logging.LogFormat{"payouts": len(in.Payouts)})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	if err := checkNotEmpty(in.Payouts); err != nil {
+		return nil, err
+	}
+	// the passphrase is omitted if the wallet is unlocked by UnlockWallet
+	if len(in.Passphrase) > 0 {
+		if err := checkPassLen(in.Passphrase); err != nil {
+			return nil, err
+		}
+	}
+
+	payouts := make([]*masswallet.PayoutRequest, 0, len(in.Payouts))
+	for _, payout := range in.Payouts {
+		addr := strings.TrimSpace(payout.Address)
+		if err := checkAddressLen(addr); err != nil {
+			return nil, err
+		}
+		amount, err := checkParseAmount(strings.TrimSpace(payout.Amount))
+		if err != nil {
+			return nil, err
+		}
+		payouts = append(payouts, &masswallet.PayoutRequest{
+			Key:     payout.Key,
+			Address: addr,
+			Amount:  amount,
+		})
+	}
+	feeMode, err := masswallet.ParseFeeMode(strings.TrimSpace(in.FeeMode))
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+
+	batch, err := s.massWallet.CreatePayoutBatch(in.WalletId, payouts, []byte(in.Passphrase), feeMode, txFeeLimit(s.config))
+	if err != nil {
+		logging.CPrint(logging.ERROR, "CreatePayoutBatch failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	resp, err := payoutBatchResponse(batch)
+	if err != nil {
+		return nil, err
+	}
+	logging.CPrint(logging.INFO, "api: CreatePayoutBatch completed", logging.LogFormat{"batch": batch.Id})
+	return resp, nil
+}
+
+func (s *APIServer) GetPayoutBatch(ctx context.Context, in *pb.GetPayoutBatchRequest) (*pb.PayoutBatchResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetPayoutBatch", logging.LogFormat{"params": in})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	if len(in.BatchId) == 0 && len(in.PayoutKey) == 0 {
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+
+	batch, err := s.massWallet.GetPayoutBatch(in.WalletId, in.BatchId, in.PayoutKey)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "GetPayoutBatch failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	resp, err := payoutBatchResponse(batch)
+	if err != nil {
+		return nil, err
+	}
+	logging.CPrint(logging.INFO, "api: GetPayoutBatch completed", logging.LogFormat{"batch": batch.Id})
+	return resp, nil
+}
+
+var payoutTxStatusDesc = map[txmgr.PayoutTxStatus]string{
+	txmgr.PayoutTxPending:   "pending",
+	txmgr.PayoutTxSubmitted: "submitted",
+	txmgr.PayoutTxFailed:    "failed",
+	txmgr.PayoutTxMined:     "mined",
+}
+
+func payoutBatchResponse(batch *txmgr.PayoutBatch) (*pb.PayoutBatchResponse, error) {
+	resp := &pb.PayoutBatchResponse{
+		BatchId: batch.Id,
+		Created: batch.Created.Unix(),
+		Payouts: make([]*pb.PayoutBatchResponse_Payout, 0, len(batch.Payouts)),
+		Txs:     make([]*pb.PayoutBatchResponse_PayoutTx, 0, len(batch.Txs)),
+	}
+	for _, ptx := range batch.Txs {
+		resp.Txs = append(resp.Txs, &pb.PayoutBatchResponse_PayoutTx{
+			TxId:   ptx.Hash.String(),
+			Status: payoutTxStatusDesc[ptx.Status],
+			Error:  ptx.Error,
+		})
+	}
+	for _, payout := range batch.Payouts {
+		amount, err := checkFormatAmount(payout.Amount)
+		if err != nil {
+			return nil, err
+		}
+		p := &pb.PayoutBatchResponse_Payout{
+			Key:     payout.Key,
+			Address: payout.Address,
+			Amount:  amount,
+			Status:  payoutTxStatusDesc[txmgr.PayoutTxFailed],
+			Error:   payout.Error,
+		}
+		if payout.TxIndex >= 0 {
+			p.TxId = resp.Txs[payout.TxIndex].TxId
+			p.Status = resp.Txs[payout.TxIndex].Status
+			p.Error = resp.Txs[payout.TxIndex].Error
+		}
+		resp.Payouts = append(resp.Payouts, p)
+	}
+	return resp, nil
+}
+
 func (s *APIServer) getStatus(txHash *wire.Hash) (code int32, err error) {
 
-	_, err = s.node.TxMemPool().FetchTransaction(txHash)
-	if err == nil {
+	if s.haveMempoolTx(txHash) {
 		code = txStatusPacking
 	} else {
 		code = txStatusMissing
 	}
 
-	txList, err := s.node.Blockchain().GetTransactionInDB(txHash)
+	lastTx, txHeight, err := s.fetchChainTx(txHash)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "FetchTxBySha error",
 			logging.LogFormat{
@@ -325,15 +508,13 @@ func (s *APIServer) getStatus(txHash *wire.Hash) (code int32, err error) {
 			})
 		return txStatusUndefined, err
 	}
-	if len(txList) == 0 {
+	if lastTx == nil {
 		return code, nil
 	}
 
-	lastTx := txList[len(txList)-1]
-	txHeight := lastTx.Height
-	bestHeight := s.node.Blockchain().BestBlockHeight()
+	bestHeight := s.bestBlockHeight()
 	confirmations := 1 + bestHeight - txHeight
-	if blockchain.IsCoinBaseTx(lastTx.Tx) {
+	if blockchain.IsCoinBaseTx(lastTx) {
 		if confirmations >= consensus.CoinbaseMaturity {
 			return txStatusConfirmed, nil
 		} else {
@@ -453,6 +634,10 @@ func (s *APIServer) DecodeRawTransaction(ctx context.Context, in *pb.DecodeRawTr
 func (s *APIServer) CreateRawTransaction(ctx context.Context, in *pb.CreateRawTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
 	logging.CPrint(logging.INFO, "api: CreateRawTransaction", logging.LogFormat{"params": in})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	err := checkLocktime(in.LockTime)
 	if err != nil {
 		return nil, err
@@ -514,7 +699,7 @@ func (s *APIServer) CreateRawTransaction(ctx context.Context, in *pb.CreateRawTr
 		subtractfeefrom[subfrom] = struct{}{}
 	}
 
-	mtxHex, fee, err := s.massWallet.CreateRawTransaction(inputs, amounts, in.LockTime, changeAddr, subtractfeefrom)
+	mtxHex, fee, err := s.massWallet.CreateRawTransaction(in.WalletId, inputs, amounts, in.LockTime, changeAddr, subtractfeefrom)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "CreateRawTransaction failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -536,6 +721,10 @@ func (s *APIServer) CreateRawTransaction(ctx context.Context, in *pb.CreateRawTr
 func (s *APIServer) CreateStakingTransaction(ctx context.Context, in *pb.CreateStakingTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
 	logging.CPrint(logging.INFO, "api: CreateStakingTransaction", logging.LogFormat{"params": in})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	val, err := checkParseAmount(in.Amount)
 	if err != nil {
 		return nil, err
@@ -562,6 +751,11 @@ func (s *APIServer) CreateStakingTransaction(ctx context.Context, in *pb.CreateS
 		return nil, err
 	}
 
+	feeMode, err := masswallet.ParseFeeMode(strings.TrimSpace(in.FeeMode))
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+
 	outputs := make([]*masswallet.StakingTxOut, 0)
 	output := &masswallet.StakingTxOut{
 		Address:      in.StakingAddress,
@@ -570,7 +764,7 @@ func (s *APIServer) CreateStakingTransaction(ctx context.Context, in *pb.CreateS
 	}
 	outputs = append(outputs, output)
 
-	mtxHex, fee, err := s.massWallet.CreateStakingTransaction(in.FromAddress, outputs, uint64(0), valFee)
+	mtxHex, fee, err := s.massWallet.CreateStakingTransaction(in.WalletId, in.FromAddress, outputs, uint64(0), valFee, feeMode)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "AutoCreateStakingTransaction failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -593,6 +787,10 @@ func (s *APIServer) CreateStakingTransaction(ctx context.Context, in *pb.CreateS
 func (s *APIServer) CreateBindingTransaction(ctx context.Context, in *pb.CreateBindingTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
 	logging.CPrint(logging.INFO, "api: CreateBindingTransaction", logging.LogFormat{"params": in})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	err := checkNotEmpty(in.Outputs)
 	if err != nil {
 		return nil, err
@@ -644,8 +842,12 @@ func (s *APIServer) CreateBindingTransaction(ctx context.Context, in *pb.CreateB
 			Amount:        val,
 		})
 	}
+	feeMode, err := masswallet.ParseFeeMode(strings.TrimSpace(in.FeeMode))
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
 	//construct binding transaction
-	mtxHex, fee, err := s.massWallet.CreateBindingTransaction(in.FromAddress, txFee, bindings)
+	mtxHex, fee, err := s.massWallet.CreateBindingTransaction(in.WalletId, in.FromAddress, txFee, bindings, feeMode)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "CreateBindingTransaction failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -664,7 +866,95 @@ func (s *APIServer) CreateBindingTransaction(ctx context.Context, in *pb.CreateB
 	return &pb.CreateRawTransactionResponse{Hex: mtxHex}, nil
 }
 
+// Creates a transaction withdrawing mature staking utxos.
+func (s *APIServer) CreateWithdrawStakingTransaction(ctx context.Context, in *pb.CreateWithdrawTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
+	logging.CPrint(logging.INFO, "api: CreateWithdrawStakingTransaction", logging.LogFormat{"params": in})
+
+	inputs, txFee, err := checkWithdrawRequest(in)
+	if err != nil {
+		return nil, err
+	}
+	mtxHex, fee, err := s.massWallet.CreateWithdrawStakingTransaction(in.WalletId, inputs, in.ToAddress, txFee)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "CreateWithdrawStakingTransaction failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	err = checkTxFeeLimit(s.config, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: CreateWithdrawStakingTransaction completed", logging.LogFormat{})
+	return &pb.CreateRawTransactionResponse{Hex: mtxHex}, nil
+}
+
+// Creates a transaction withdrawing mature binding utxos.
+func (s *APIServer) CreateWithdrawBindingTransaction(ctx context.Context, in *pb.CreateWithdrawTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
+	logging.CPrint(logging.INFO, "api: CreateWithdrawBindingTransaction", logging.LogFormat{"params": in})
+
+	inputs, txFee, err := checkWithdrawRequest(in)
+	if err != nil {
+		return nil, err
+	}
+	mtxHex, fee, err := s.massWallet.CreateWithdrawBindingTransaction(in.WalletId, inputs, in.ToAddress, txFee)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "CreateWithdrawBindingTransaction failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	err = checkTxFeeLimit(s.config, fee)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.CPrint(logging.INFO, "api: CreateWithdrawBindingTransaction completed", logging.LogFormat{})
+	return &pb.CreateRawTransactionResponse{Hex: mtxHex}, nil
+}
+
+func checkWithdrawRequest(in *pb.CreateWithdrawTransactionRequest) ([]*masswallet.TxIn, massutil.Amount, error) {
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+
+	inputs := make([]*masswallet.TxIn, 0, len(in.Inputs))
+	for _, txInput := range in.Inputs {
+		txid := strings.TrimSpace(txInput.TxId)
+		if err := checkTransactionIdLen(txid); err != nil {
+			return nil, massutil.ZeroAmount(), err
+		}
+		inputs = append(inputs, &masswallet.TxIn{
+			TxId: txid,
+			Vout: txInput.Vout,
+		})
+	}
+
+	if len(in.ToAddress) > 0 {
+		if _, err := checkWitnessAddress(in.ToAddress, false, config.ChainParams); err != nil {
+			return nil, massutil.ZeroAmount(), err
+		}
+	}
+
+	txFee, err := checkParseAmount(in.Fee)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), status.New(ErrAPIUserTxFee, ErrCode[ErrAPIUserTxFee]).Err()
+	}
+	return inputs, txFee, nil
+}
+
 func (s *APIServer) CreatePoolPkCoinbaseTransaction(ctx context.Context, in *pb.CreatePoolPkCoinbaseTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	from, err := checkWitnessAddress(strings.TrimSpace(in.FromAddress), false, config.ChainParams)
 	if err != nil {
 		return nil, err
@@ -686,7 +976,7 @@ func (s *APIServer) CreatePoolPkCoinbaseTransaction(ctx context.Context, in *pb.
 
 	requiredCost, _ := massutil.NewAmountFromInt(int64(consensus.MASSIP0002SetPoolPkCoinbaseFee))
 
-	mtxHex, fee, err := s.massWallet.AutoCreateRawTransaction(outputs, 0, requiredCost, from.EncodeAddress(), from.EncodeAddress(), raw)
+	mtxHex, fee, err := s.massWallet.AutoCreateRawTransaction(in.WalletId, outputs, 0, requiredCost, from.EncodeAddress(), from.EncodeAddress(), raw, nil, masswallet.FeeModeMinimum)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "CreateSetPoolPkCoinbaseTransaction failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -712,6 +1002,10 @@ func (s *APIServer) CreatePoolPkCoinbaseTransaction(ctx context.Context, in *pb.
 func (s *APIServer) AutoCreateTransaction(ctx context.Context, in *pb.AutoCreateTransactionRequest) (*pb.CreateRawTransactionResponse, error) {
 	logging.CPrint(logging.INFO, "api: AutoCreateTransaction", logging.LogFormat{"params": in})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	if err := checkLocktime(in.LockTime); err != nil {
 		return nil, err
 	}
@@ -754,7 +1048,16 @@ func (s *APIServer) AutoCreateTransaction(ctx context.Context, in *pb.AutoCreate
 		}
 	}
 
-	mtxHex, fee, err := s.massWallet.AutoCreateRawTransaction(amounts, in.LockTime, txFee, fromAddr, changeAddr, nil)
+	selector, err := masswallet.NewCoinSelector(strings.TrimSpace(in.CoinSelection))
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+	feeMode, err := masswallet.ParseFeeMode(strings.TrimSpace(in.FeeMode))
+	if err != nil {
+		return nil, convertResponseError(err)
+	}
+
+	mtxHex, fee, err := s.massWallet.AutoCreateRawTransaction(in.WalletId, amounts, in.LockTime, txFee, fromAddr, changeAddr, nil, selector, feeMode)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "AutoCreateRawTransaction failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -776,12 +1079,16 @@ func (s *APIServer) AutoCreateTransaction(ctx context.Context, in *pb.AutoCreate
 func (s *APIServer) GetTransactionFee(ctx context.Context, in *pb.GetTransactionFeeRequest) (*pb.GetTransactionFeeResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetTransactionFee", logging.LogFormat{"params": in})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	err := checkNotEmpty(in.Amounts)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(s.massWallet.CurrentWallet()) == 0 {
+	if len(in.WalletId) == 0 && len(s.massWallet.CurrentWallet()) == 0 {
 		return nil, convertResponseError(masswallet.ErrNoWalletInUse)
 	}
 
@@ -806,7 +1113,7 @@ func (s *APIServer) GetTransactionFee(ctx context.Context, in *pb.GetTransaction
 					Amount:        val,
 				})
 			}
-			_, txFee, err = s.massWallet.EstimateBindingTxFee(bindings, 0, txFee, "", "")
+			_, txFee, err = s.massWallet.EstimateBindingTxFee(in.WalletId, bindings, 0, txFee, "", "", masswallet.FeeModeMinimum)
 			if err != nil {
 				logging.CPrint(logging.ERROR, "EstimateBindingTxFee failed", logging.LogFormat{"err": err})
 				cvtErr := convertResponseError(err)
@@ -832,7 +1139,7 @@ func (s *APIServer) GetTransactionFee(ctx context.Context, in *pb.GetTransaction
 				outputs = append(outputs, output)
 			}
 
-			_, txFee, err = s.massWallet.EstimateStakingTxFee(outputs, 0, massutil.ZeroAmount(), "", "")
+			_, txFee, err = s.massWallet.EstimateStakingTxFee(in.WalletId, outputs, 0, massutil.ZeroAmount(), "", "", masswallet.FeeModeMinimum)
 			if err != nil {
 				logging.CPrint(logging.ERROR, "EstimateStakingTxFee failed", logging.LogFormat{"err": err})
 				cvtErr := convertResponseError(err)
@@ -856,7 +1163,7 @@ func (s *APIServer) GetTransactionFee(ctx context.Context, in *pb.GetTransaction
 			inputs = append(inputs, input)
 		}
 
-		txFee, err = s.massWallet.EstimateManualTxFee(inputs, len(in.Amounts))
+		txFee, err = s.massWallet.EstimateManualTxFee(in.WalletId, inputs, len(in.Amounts))
 		if err != nil {
 			logging.CPrint(logging.ERROR, "EstimateManualTxFee failed", logging.LogFormat{"err": err})
 			cvtErr := convertResponseError(err)
@@ -878,6 +1185,35 @@ func (s *APIServer) GetTransactionFee(ctx context.Context, in *pb.GetTransaction
 	return &pb.GetTransactionFeeResponse{Fee: fee}, nil
 }
 
+// Returns the fee rate per kB expected to get a transaction confirmed within target blocks.
+func (s *APIServer) EstimateFeeRate(ctx context.Context, in *pb.EstimateFeeRateRequest) (*pb.EstimateFeeRateResponse, error) {
+	logging.CPrint(logging.INFO, "api: EstimateFeeRate", logging.LogFormat{"params": in})
+
+	feeRate, estimated, err := s.massWallet.EstimateFeeRate(in.TargetBlocks)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "EstimateFeeRate failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+	rate, err := AmountToString(feeRate.IntValue())
+	if err != nil {
+		return nil, status.New(ErrAPIUnknownErr, ErrCode[ErrAPIUnknownErr]).Err()
+	}
+
+	logging.CPrint(logging.INFO, "api: EstimateFeeRate completed", logging.LogFormat{
+		"feeRate":   rate,
+		"estimated": estimated,
+	})
+	return &pb.EstimateFeeRateResponse{
+		TargetBlocks: in.TargetBlocks,
+		FeeRate:      rate,
+		Estimated:    estimated,
+	}, nil
+}
+
 // Returns new binding
 func mockBindingTarget() massutil.Address {
 	var h [ripemd160.Size + 2]byte
@@ -898,6 +1234,10 @@ func (s *APIServer) TxHistory(ctx context.Context, in *pb.TxHistoryRequest) (*pb
 		"address": in.Address,
 	})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	if len(in.Address) > 0 {
 		err := checkAddressLen(in.Address)
 		if err != nil {
@@ -908,7 +1248,7 @@ func (s *APIServer) TxHistory(ctx context.Context, in *pb.TxHistoryRequest) (*pb
 		return nil, status.New(ErrAPIInvalidTxHistoryCount, ErrCode[ErrAPIInvalidTxHistoryCount]).Err()
 	}
 
-	histories, err := s.massWallet.GetTxHistory(int(in.Count), in.Address)
+	histories, err := s.massWallet.GetTxHistory(in.WalletId, int(in.Count), in.Address)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "GetTxHistory failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -917,6 +1257,13 @@ func (s *APIServer) TxHistory(ctx context.Context, in *pb.TxHistoryRequest) (*pb
 		}
 		return nil, cvtErr
 	}
+	labels, err := s.walletLabels(in.WalletId)
+	if err != nil {
+		return nil, err
+	}
+	for _, history := range histories {
+		history.Note = labels.Transactions[history.TxId]
+	}
 	sort.Slice(histories, func(i, j int) bool {
 		return histories[i].BlockHeight > histories[j].BlockHeight
 	})
@@ -928,22 +1275,172 @@ func (s *APIServer) TxHistory(ctx context.Context, in *pb.TxHistoryRequest) (*pb
 	return reps, nil
 }
 
-func (s *APIServer) GetStakingHistory(ctx context.Context, in *pb.GetStakingHistoryRequest) (*pb.GetStakingHistoryResponse, error) {
-	logging.CPrint(logging.INFO, "api: GetStakingHistory", logging.LogFormat{})
-	newestHeight := s.node.Blockchain().BestBlockHeight()
-	rewards, err := s.node.Blockchain().GetUnexpiredStakingRank(newestHeight)
+const defaultTxHistoryLimit = 50
+
+var historyTxTypes = map[string]txmgr.HistoryTxType{
+	"transfer": txmgr.HistoryTransfer,
+	"staking":  txmgr.HistoryStaking,
+	"binding":  txmgr.HistoryBinding,
+	"coinbase": txmgr.HistoryCoinbase,
+}
+
+func (s *APIServer) ListTxHistory(ctx context.Context, in *pb.ListTxHistoryRequest) (*pb.ListTxHistoryResponse, error) {
+	logging.CPrint(logging.INFO, "api: ListTxHistory", logging.LogFormat{
+		"cursor":  in.Cursor,
+		"limit":   in.Limit,
+		"order":   in.Order,
+		"address": in.Address,
+		"types":   in.Types,
+	})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	if len(in.Address) > 0 {
+		if err := checkAddressLen(in.Address); err != nil {
+			return nil, err
+		}
+	}
+	if in.Limit > 1000 {
+		return nil, status.New(ErrAPIInvalidTxHistoryCount, ErrCode[ErrAPIInvalidTxHistoryCount]).Err()
+	}
+	query := &txmgr.TxHistoryQuery{
+		FromHeight: in.FromHeight,
+		ToHeight:   in.ToHeight,
+		FromTime:   in.FromTime,
+		ToTime:     in.ToTime,
+		Limit:      int(in.Limit),
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultTxHistoryLimit
+	}
+	switch strings.ToLower(strings.TrimSpace(in.Order)) {
+	case "", "desc":
+		query.Descending = true
+	case "asc":
+	default:
+		logging.CPrint(logging.ERROR, "invalid order", logging.LogFormat{"order": in.Order})
+		return nil, status.New(ErrAPIInvalidParameter, "order should be either asc or desc").Err()
+	}
+	if len(in.Cursor) > 0 {
+		cursor, err := hex.DecodeString(in.Cursor)
+		if err != nil {
+			logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidHistoryCursor], logging.LogFormat{"err": err})
+			return nil, status.New(ErrAPIInvalidHistoryCursor, ErrCode[ErrAPIInvalidHistoryCursor]).Err()
+		}
+		query.Cursor = cursor
+	}
+	for _, name := range in.Types {
+		typ, ok := historyTxTypes[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			logging.CPrint(logging.ERROR, "invalid history type", logging.LogFormat{"type": name})
+			return nil, status.New(ErrAPIInvalidParameter, "unknown transaction type "+name).Err()
+		}
+		query.Types = append(query.Types, typ)
+	}
+
+	entries, cursor, err := s.massWallet.ListTxHistory(in.WalletId, in.Address, query)
 	if err != nil {
-		logging.CPrint(logging.ERROR, "Failed to FetchAllLockTx from chainDB", logging.LogFormat{
-			"err": err,
+		logging.CPrint(logging.ERROR, "ListTxHistory failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+	labels, err := s.walletLabels(in.WalletId)
+	if err != nil {
+		return nil, err
+	}
+
+	typeNames := make(map[txmgr.HistoryTxType]string, len(historyTxTypes))
+	for name, typ := range historyTxTypes {
+		typeNames[typ] = name
+	}
+	histories := make([]*pb.ListTxHistoryResponse_History, 0, len(entries))
+	for _, entry := range entries {
+		net, err := netAmountToString(entry.Received, entry.Sent)
+		if err != nil {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		fee, err := AmountToString(entry.Fee.IntValue())
+		if err != nil {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		addresses := make([]string, 0, len(entry.ScriptHashes))
+		for _, scriptHash := range entry.ScriptHashes {
+			addr, err := massutil.NewAddressWitnessScriptHash(scriptHash, config.ChainParams)
+			if err != nil {
+				return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+			}
+			addresses = append(addresses, addr.EncodeAddress())
+		}
+		txId := entry.TxHash.String()
+		histories = append(histories, &pb.ListTxHistoryResponse_History{
+			TxId:        txId,
+			BlockHeight: entry.BlockHeight,
+			BlockTime:   entry.Timestamp.Unix(),
+			Type:        typeNames[entry.Type],
+			NetAmount:   net,
+			Fee:         fee,
+			Addresses:   addresses,
+			Note:        labels.Transactions[txId],
 		})
-		st := status.New(ErrAPIGetStakingTxDetail, ErrCode[ErrAPIGetStakingTxDetail])
-		return nil, st.Err()
+	}
+
+	logging.CPrint(logging.INFO, "api: ListTxHistory completed", logging.LogFormat{"num": len(histories)})
+	return &pb.ListTxHistoryResponse{
+		Histories:  histories,
+		NextCursor: hex.EncodeToString(cursor),
+	}, nil
+}
+
+// netAmountToString returns received minus sent in MASS, negative for spending.
+func netAmountToString(received, sent massutil.Amount) (string, error) {
+	if received.Cmp(sent) >= 0 {
+		net, err := received.Sub(sent)
+		if err != nil {
+			return "", err
+		}
+		return AmountToString(net.IntValue())
+	}
+	net, err := sent.Sub(received)
+	if err != nil {
+		return "", err
+	}
+	str, err := AmountToString(net.IntValue())
+	if err != nil {
+		return "", err
+	}
+	return "-" + str, nil
+}
+
+func (s *APIServer) GetStakingHistory(ctx context.Context, in *pb.GetStakingHistoryRequest) (*pb.GetStakingHistoryResponse, error) {
+	logging.CPrint(logging.INFO, "api: GetStakingHistory", logging.LogFormat{})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
+	newestHeight := s.bestBlockHeight()
+	// the staking rank is not served by remote nodes, weights are left empty
+	var rewards []database.Rank
+	if s.remote == nil {
+		var err error
+		rewards, err = s.node.Blockchain().GetUnexpiredStakingRank(newestHeight)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "Failed to FetchAllLockTx from chainDB", logging.LogFormat{
+				"err": err,
+			})
+			st := status.New(ErrAPIGetStakingTxDetail, ErrCode[ErrAPIGetStakingTxDetail])
+			return nil, st.Err()
+		}
 	}
 	excludeWithdrawn := true
 	if in.Type == "all" {
 		excludeWithdrawn = false
 	}
-	stakingTxs, err := s.massWallet.GetStakingHistory(excludeWithdrawn)
+	stakingTxs, err := s.massWallet.GetStakingHistory(in.WalletId, excludeWithdrawn)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "Failed to GetStakingHistory from walletDB", logging.LogFormat{
 			"err": err,
@@ -951,6 +1448,10 @@ func (s *APIServer) GetStakingHistory(ctx context.Context, in *pb.GetStakingHist
 		st := status.New(ErrAPIGetStakingTxDetail, ErrCode[ErrAPIGetStakingTxDetail])
 		return nil, st.Err()
 	}
+	labels, err := s.walletLabels(in.WalletId)
+	if err != nil {
+		return nil, err
+	}
 
 	weights := make(map[string]float64)
 	txs := make([]*pb.GetStakingHistoryResponse_Tx, 0)
@@ -973,7 +1474,9 @@ func (s *APIServer) GetStakingHistory(ctx context.Context, in *pb.GetStakingHist
 				Address:      lTx.Utxo.Address,
 				Amount:       amt,
 				FrozenPeriod: lTx.Utxo.FrozenPeriod,
+				Label:        labels.Addresses[lTx.Utxo.Address],
 			},
+			Note: labels.Transactions[lTx.TxHash.String()],
 		}
 		if tx.BlockHeight == 0 {
 			tx.Status = stakingStatusPending
@@ -1035,11 +1538,15 @@ func (s *APIServer) GetStakingHistory(ctx context.Context, in *pb.GetStakingHist
 func (s *APIServer) GetBindingHistory(ctx context.Context, in *pb.GetBindingHistoryRequest) (*pb.GetBindingHistoryResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetBindingHistory", logging.LogFormat{})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	excludeWithdrawn := true
 	if in.Type == "all" {
 		excludeWithdrawn = false
 	}
-	details, err := s.massWallet.GetBindingHistory(excludeWithdrawn)
+	details, err := s.massWallet.GetBindingHistory(in.WalletId, excludeWithdrawn)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "GetBindingHistory failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -1048,6 +1555,10 @@ func (s *APIServer) GetBindingHistory(ctx context.Context, in *pb.GetBindingHist
 		}
 		return nil, cvtErr
 	}
+	labels, err := s.walletLabels(in.WalletId)
+	if err != nil {
+		return nil, err
+	}
 	histories := make([]*pb.GetBindingHistoryResponse_History, 0)
 	for _, detail := range details {
 		amt, err := checkFormatAmount(detail.Utxo.Amount)
@@ -1067,15 +1578,14 @@ func (s *APIServer) GetBindingHistory(ctx context.Context, in *pb.GetBindingHist
 				} else {
 					fromSet := make(map[string]struct{}, 0)
 					for _, txIn := range detail.MsgTx.TxIn {
-						list, err := s.node.Blockchain().GetTransactionInDB(&txIn.PreviousOutPoint.Hash)
-						if err != nil || len(list) == 0 {
+						prevMtx, _, err := s.fetchChainTx(&txIn.PreviousOutPoint.Hash)
+						if err != nil || prevMtx == nil {
 							logging.CPrint(logging.ERROR, "transaction not found", logging.LogFormat{
 								"tx":  txIn.PreviousOutPoint.Hash.String(),
 								"err": err,
 							})
 							return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
 						} else {
-							prevMtx := list[len(list)-1].Tx
 							ps, err := utils.ParsePkScript(prevMtx.TxOut[txIn.PreviousOutPoint.Index].PkScript, config.ChainParams)
 							if err != nil {
 								logging.CPrint(logging.ERROR, "ParsePkScript failed", logging.LogFormat{
@@ -1102,8 +1612,10 @@ func (s *APIServer) GetBindingHistory(ctx context.Context, in *pb.GetBindingHist
 				HolderAddress: detail.Utxo.Holder.EncodeAddress(),
 				BindingTarget: detail.Utxo.BindingTarget.EncodeAddress(),
 				Amount:        amt,
+				HolderLabel:   labels.Addresses[detail.Utxo.Holder.EncodeAddress()],
 			},
 			FromAddresses: froms,
+			Note:          labels.Transactions[detail.TxHash.String()],
 		}
 		{
 			history.Utxo.TargetType = "MASS"
@@ -1158,7 +1670,7 @@ func (s *APIServer) SendRawTransaction(ctx context.Context, in *pb.SendRawTransa
 		return nil, st.Err()
 	}
 
-	if !forks.EnforceMASSIP0002WarmUp(s.node.Blockchain().BestBlockHeight()) {
+	if !forks.EnforceMASSIP0002WarmUp(s.bestBlockHeight()) {
 		if payload := blockchain.DecodePayload(msgtx.Payload); payload != nil && payload.Method == blockchain.BindPoolCoinbase {
 			return nil, status.New(ErrAPIUnacceptable, "cannot yet set coinbase for pool pk").Err()
 		}
@@ -1176,7 +1688,11 @@ func (s *APIServer) SendRawTransaction(ctx context.Context, in *pb.SendRawTransa
 	}
 
 	tx := massutil.NewTx(msgtx)
-	_, err = s.node.Blockchain().ProcessTx(tx)
+	if s.remote != nil {
+		err = s.remote.SubmitTx(msgtx)
+	} else {
+		_, err = s.node.Blockchain().ProcessTx(tx)
+	}
 	if err != nil {
 		logging.CPrint(logging.ERROR, "ProcessTx failed", logging.LogFormat{"err": err})
 		s.massWallet.ClearUsedUTXOMark(msgtx)
@@ -38,6 +38,7 @@ const (
 	walletStatusReady uint32 = iota
 	walletStatusImporting
 	walletStatusRemoving
+	walletStatusRescanning
 )
 
 var (
@@ -51,8 +52,9 @@ var (
 
 var (
 	walletStatusMsg = map[uint32]string{
-		walletStatusReady:    "ready",
-		walletStatusRemoving: "removing",
+		walletStatusReady:      "ready",
+		walletStatusRemoving:   "removing",
+		walletStatusRescanning: "rescanning",
 		// walletStatusImporting: "{synced_height}",
 	}
 )
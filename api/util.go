@@ -18,7 +18,10 @@ import (
 	"massnet.org/mass-wallet/config"
 	"massnet.org/mass-wallet/errors"
 	"massnet.org/mass-wallet/masswallet"
+	"massnet.org/mass-wallet/masswallet/backup"
 	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/masswallet/txmgr"
 )
 
 const (
@@ -31,6 +34,11 @@ const (
 	// estimated value
 	LenMnemonicMax = 256
 	LenMnemonicMin = 38
+	// base58 encoded extended key
+	LenAccountPubKeyMax = 112
+	LenMessageMax       = 64 * 1024
+	// address gap limit of RescanWallet
+	RescanGapLimitMax = 10000
 )
 
 var (
@@ -220,7 +228,8 @@ func parseBindingTarget(address string, net *config.Params) (massutil.Address, e
 	return target, nil
 }
 
-func checkTxFeeLimit(cfg *config.Config, fee massutil.Amount) error {
+// txFeeLimit returns max_tx_fee of cfg, or the default if invalid.
+func txFeeLimit(cfg *config.Config) massutil.Amount {
 	max, err := checkParseAmount(cfg.Wallet.Settings.MaxTxFee)
 	if err != nil {
 		logging.CPrint(logging.WARN, "invalid max_tx_fee", logging.LogFormat{
@@ -228,6 +237,11 @@ func checkTxFeeLimit(cfg *config.Config, fee massutil.Amount) error {
 		})
 		max, _ = checkParseAmount(config.DefaultMaxTxFee)
 	}
+	return max
+}
+
+func checkTxFeeLimit(cfg *config.Config, fee massutil.Amount) error {
+	max := txFeeLimit(cfg)
 	if max.Cmp(fee) < 0 {
 		logging.CPrint(logging.ERROR, "big transaction fee", logging.LogFormat{
 			"fee": fee,
@@ -271,6 +285,46 @@ func convertResponseError(err error) error {
 			"err": err,
 		})
 		return status.New(ErrAPIOverfullInputs, ErrCode[ErrAPIOverfullInputs]).Err()
+	case masswallet.ErrUnknownCoinSelection:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIUnknownCoinSelection], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIUnknownCoinSelection, ErrCode[ErrAPIUnknownCoinSelection]).Err()
+	case masswallet.ErrUnknownFeeMode:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIUnknownFeeMode], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIUnknownFeeMode, ErrCode[ErrAPIUnknownFeeMode]).Err()
+	case masswallet.ErrUnconfirmedNotFound:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPINoUnconfirmedTx], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPINoUnconfirmedTx, ErrCode[ErrAPINoUnconfirmedTx]).Err()
+	case masswallet.ErrTxInMempool:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPITxInMempool], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPITxInMempool, ErrCode[ErrAPITxInMempool]).Err()
+	case masswallet.ErrPayoutKeyConflict:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIPayoutKeyConflict], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIPayoutKeyConflict, ErrCode[ErrAPIPayoutKeyConflict]).Err()
+	case masswallet.ErrPayoutBatchNotFound:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPINoPayoutBatch], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPINoPayoutBatch, ErrCode[ErrAPINoPayoutBatch]).Err()
+	case txmgr.ErrInvalidHistoryCursor:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidHistoryCursor], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidHistoryCursor, ErrCode[ErrAPIInvalidHistoryCursor]).Err()
+	case masswallet.ErrInvalidSignature:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidSignature], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidSignature, ErrCode[ErrAPIInvalidSignature]).Err()
 	case masswallet.ErrInvalidFlag:
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidFlag], logging.LogFormat{
 			"err": err,
@@ -288,6 +342,26 @@ func convertResponseError(err error) error {
 			"err": err,
 		})
 		return status.New(ErrAPISignRawTx, ErrCode[ErrAPISignRawTx]).Err()
+	case masswallet.ErrWalletNotFound:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidWalletId], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidWalletId, ErrCode[ErrAPIInvalidWalletId]).Err()
+	case backup.ErrFileExists:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIBackupExists], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIBackupExists, ErrCode[ErrAPIBackupExists]).Err()
+	case masswallet.ErrEventCursorExpired:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIEventCursorExpired], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIEventCursorExpired, ErrCode[ErrAPIEventCursorExpired]).Err()
+	case masswallet.ErrEventSubscriberLagged:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIEventSubscriberLagged], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIEventSubscriberLagged, ErrCode[ErrAPIEventSubscriberLagged]).Err()
 	case masswallet.ErrWalletUnready:
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIWalletUnready], logging.LogFormat{
 			"err": err,
@@ -330,6 +404,11 @@ func convertResponseError(err error) error {
 			"err": err,
 		})
 		return status.New(ErrAPIInvalidPassphrase, ErrCode[ErrAPIInvalidPassphrase]).Err()
+	case keystore.ErrLocked:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIWalletLocked], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIWalletLocked, ErrCode[ErrAPIWalletLocked]).Err()
 	case keystore.ErrAddressNotFound,
 		keystore.ErrAccountNotFound,
 		keystore.ErrAddressVersion,
@@ -404,6 +483,39 @@ func convertResponseError(err error) error {
 			"err": err,
 		})
 		return status.New(ErrAPIInvalidBitSize, ErrCode[ErrAPIInvalidBitSize]).Err()
+	case keystore.ErrWatchOnly:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIWatchOnlyWallet], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIWatchOnlyWallet, ErrCode[ErrAPIWatchOnlyWallet]).Err()
+	case keystore.ErrInvalidAccountPubKey:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidAccountPubKey], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidAccountPubKey, ErrCode[ErrAPIInvalidAccountPubKey]).Err()
+	case keystore.ErrMultisig:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIMultisigWallet], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIMultisigWallet, ErrCode[ErrAPIMultisigWallet]).Err()
+	case keystore.ErrInvalidMultisigParams:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidMultisigParams], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidMultisigParams, ErrCode[ErrAPIInvalidMultisigParams]).Err()
+	case psbt.ErrInvalidPacket,
+		psbt.ErrUnknownVersion,
+		psbt.ErrMismatchedScript,
+		psbt.ErrMismatchedPackets:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidPsbt], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIInvalidPsbt, ErrCode[ErrAPIInvalidPsbt]).Err()
+	case psbt.ErrIncomplete:
+		logging.CPrint(logging.ERROR, ErrCode[ErrAPIIncompletePsbt], logging.LogFormat{
+			"err": err,
+		})
+		return status.New(ErrAPIIncompletePsbt, ErrCode[ErrAPIIncompletePsbt]).Err()
 	case keystore.ErrGapLimit:
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIGapLimit], logging.LogFormat{
 			"err": err,
@@ -471,6 +583,15 @@ func checkWalletIdLen(walletId string) error {
 	return nil
 }
 
+// checkOptionalWalletId checks walletId only if it is provided,
+// an empty walletId refers to the wallet selected by UseWallet.
+func checkOptionalWalletId(walletId string) error {
+	if len(walletId) == 0 {
+		return nil
+	}
+	return checkWalletIdLen(walletId)
+}
+
 func checkTransactionIdLen(txId string) error {
 	if len(txId) != LenTxId {
 		logging.CPrint(logging.ERROR, "", logging.LogFormat{
@@ -509,6 +630,18 @@ func checkPassLen(pass string) error {
 	return nil
 }
 
+func checkAccountPubKeyLen(key string) error {
+	if len(key) == 0 || len(key) > LenAccountPubKeyMax {
+		logging.CPrint(logging.ERROR, "The length of the account public key is out of range", logging.LogFormat{
+			"length": len(key),
+			"max":    LenAccountPubKeyMax,
+		})
+		st := status.New(ErrAPIInvalidAccountPubKey, ErrCode[ErrAPIInvalidAccountPubKey])
+		return st.Err()
+	}
+	return nil
+}
+
 func checkRemarksLen(remarks string) string {
 	r := []rune(remarks)
 	if len(r) > LenRemarksMax {
@@ -2,7 +2,11 @@ package api
 
 import (
 	"encoding/hex"
+	"fmt"
+	"math"
+	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"golang.org/x/net/context"
@@ -14,6 +18,7 @@ import (
 
 	pb "massnet.org/mass-wallet/api/proto"
 	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/txmgr"
 )
 
 // no error for return
@@ -25,6 +30,24 @@ func (s *APIServer) GetClientStatus(ctx context.Context, in *empty.Empty) (*pb.G
 		return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
 	}
 
+	if s.remote != nil {
+		// the peers are those of the remote node, only its best block is known
+		bestHeight := s.remote.BestBlockHeight()
+		logging.CPrint(logging.INFO, "api: GetClientStatus completed", logging.LogFormat{})
+		return &pb.GetClientStatusResponse{
+			LocalBestHeight:  bestHeight,
+			KnownBestHeight:  bestHeight,
+			WalletSyncHeight: height,
+			ChainId:          s.remote.ChainID(),
+			PeerCount:        &pb.GetClientStatusResponsePeerCountInfo{},
+			Peers: &pb.GetClientStatusResponsePeerList{
+				Outbound: make([]*pb.GetClientStatusResponsePeerInfo, 0),
+				Inbound:  make([]*pb.GetClientStatusResponsePeerInfo, 0),
+				Other:    make([]*pb.GetClientStatusResponsePeerInfo, 0),
+			},
+		}, nil
+	}
+
 	resp := &pb.GetClientStatusResponse{
 		PeerListening:    s.node.SyncManager().Switch().IsListening(),
 		Syncing:          !s.node.SyncManager().IsCaughtUp(),
@@ -81,6 +104,10 @@ func (s *APIServer) QuitClient(ctx context.Context, in *empty.Empty) (*pb.QuitCl
 func (s *APIServer) SignRawTransaction(ctx context.Context, in *pb.SignRawTransactionRequest) (*pb.SignRawTransactionResponse, error) {
 	logging.CPrint(logging.INFO, "api: SignRawTransaction", logging.LogFormat{})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	if len(in.RawTx) == 0 {
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidTxHex], logging.LogFormat{
 			"err": in.RawTx})
@@ -103,10 +130,13 @@ func (s *APIServer) SignRawTransaction(ctx context.Context, in *pb.SignRawTransa
 		return nil, st.Err()
 	}
 
-	err = checkPassLen(in.Passphrase)
-	if err != nil {
-		s.massWallet.ClearUsedUTXOMark(&tx)
-		return nil, err
+	// the passphrase is omitted if the wallet is unlocked by UnlockWallet
+	if len(in.Passphrase) > 0 {
+		err = checkPassLen(in.Passphrase)
+		if err != nil {
+			s.massWallet.ClearUsedUTXOMark(&tx)
+			return nil, err
+		}
 	}
 
 	//check in.Flags
@@ -119,7 +149,7 @@ func (s *APIServer) SignRawTransaction(ctx context.Context, in *pb.SignRawTransa
 
 	logging.CPrint(logging.INFO, "get tx", logging.LogFormat{"tx input count": len(tx.TxIn), "tx output count": len(tx.TxOut)})
 
-	bufBytes, err := s.massWallet.SignRawTx([]byte(in.Passphrase), flag, &tx)
+	bufBytes, err := s.massWallet.SignRawTx(in.WalletId, []byte(in.Passphrase), flag, &tx)
 	if err != nil {
 		s.massWallet.ClearUsedUTXOMark(&tx)
 		return nil, convertResponseError(err)
@@ -148,6 +178,10 @@ func decodeHexStr(hexStr string) ([]byte, error) {
 func (s *APIServer) CreateAddress(ctx context.Context, in *pb.CreateAddressRequest) (*pb.CreateAddressResponse, error) {
 	logging.CPrint(logging.INFO, "api: CreateAddress", logging.LogFormat{"version": in.Version})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	addressClass := uint16(in.Version)
 	if !massutil.IsValidAddressClass(addressClass) {
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidVersion], logging.LogFormat{
@@ -157,7 +191,7 @@ func (s *APIServer) CreateAddress(ctx context.Context, in *pb.CreateAddressReque
 		return nil, st.Err()
 	}
 
-	ads, err := s.massWallet.GetAddresses(addressClass)
+	ads, err := s.massWallet.GetAddresses(in.WalletId, addressClass)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "GetAddresses failed", logging.LogFormat{"err": err})
 		return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
@@ -178,7 +212,7 @@ func (s *APIServer) CreateAddress(ctx context.Context, in *pb.CreateAddressReque
 		return nil, status.New(ErrAPIUnusedAddressLimit, ErrCode[ErrAPIUnusedAddressLimit]).Err()
 	}
 
-	address, err := s.massWallet.NewAddress(addressClass)
+	address, err := s.massWallet.NewAddress(in.WalletId, addressClass)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "new address error", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -194,6 +228,11 @@ func (s *APIServer) CreateAddress(ctx context.Context, in *pb.CreateAddressReque
 
 func (s *APIServer) GetAddresses(ctx context.Context, in *pb.GetAddressesRequest) (*pb.GetAddressesResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetAddresses", logging.LogFormat{"version": in.Version})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	addressClass := uint16(in.Version)
 	if !massutil.IsValidAddressClass(addressClass) {
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidVersion], logging.LogFormat{
@@ -202,7 +241,7 @@ func (s *APIServer) GetAddresses(ctx context.Context, in *pb.GetAddressesRequest
 		return nil, status.New(ErrAPIInvalidVersion, ErrCode[ErrAPIInvalidVersion]).Err()
 	}
 
-	ads, err := s.massWallet.GetAddresses(addressClass)
+	ads, err := s.massWallet.GetAddresses(in.WalletId, addressClass)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "GetAddresses failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -212,6 +251,11 @@ func (s *APIServer) GetAddresses(ctx context.Context, in *pb.GetAddressesRequest
 		return nil, cvtErr
 	}
 
+	labels, err := s.walletLabels(in.WalletId)
+	if err != nil {
+		return nil, err
+	}
+
 	details := make([]*pb.GetAddressesResponse_AddressDetail, 0)
 	for _, ad := range ads {
 		pbAd := &pb.GetAddressesResponse_AddressDetail{
@@ -219,6 +263,7 @@ func (s *APIServer) GetAddresses(ctx context.Context, in *pb.GetAddressesRequest
 			Version:    int32(ad.AddressClass),
 			Used:       ad.Used,
 			StdAddress: ad.StdAddress,
+			Label:      labels.Addresses[ad.Address],
 		}
 		details = append(details, pbAd)
 	}
@@ -234,12 +279,17 @@ func (s *APIServer) GetAddresses(ctx context.Context, in *pb.GetAddressesRequest
 
 func (s *APIServer) ValidateAddress(ctx context.Context, in *pb.ValidateAddressRequest) (*pb.ValidateAddressResponse, error) {
 	logging.CPrint(logging.INFO, "api: ValidateAddress", logging.LogFormat{"address": in.Address})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	err := checkAddressLen(in.Address)
 	if err != nil {
 		return nil, err
 	}
 
-	witAddr, isMine, err := s.massWallet.IsAddressInCurrent(in.Address)
+	witAddr, isMine, err := s.massWallet.IsAddressInWallet(in.WalletId, in.Address)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to check validate address", logging.LogFormat{
 			"err":     err,
@@ -272,6 +322,10 @@ func (s *APIServer) ValidateAddress(ctx context.Context, in *pb.ValidateAddressR
 func (s *APIServer) GetWalletBalance(ctx context.Context, in *pb.GetWalletBalanceRequest) (*pb.GetWalletBalanceResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetWalletBalance", logging.LogFormat{"params": in})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	// uint32 is the set of all unsigned 32-bit integers.
 	// Range: 0 through 4294967295. (in.RequiredConfirmations int32)
 	// int32 is the set of all signed 32-bit integers.
@@ -283,7 +337,7 @@ func (s *APIServer) GetWalletBalance(ctx context.Context, in *pb.GetWalletBalanc
 		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
 	}
 
-	bal, err := s.massWallet.WalletBalance(uint32(in.RequiredConfirmations), in.Detail)
+	bal, err := s.massWallet.WalletBalance(in.WalletId, uint32(in.RequiredConfirmations), in.Detail)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "WalletBalance failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -324,6 +378,10 @@ func (s *APIServer) GetWalletBalance(ctx context.Context, in *pb.GetWalletBalanc
 func (s *APIServer) GetAddressBalance(ctx context.Context, in *pb.GetAddressBalanceRequest) (*pb.GetAddressBalanceResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetAddressBalance", logging.LogFormat{"params": in})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	if in.RequiredConfirmations < 0 {
 		logging.CPrint(logging.ERROR, ErrCode[ErrAPIInvalidParameter], logging.LogFormat{
 			"confs": in.RequiredConfirmations,
@@ -338,7 +396,7 @@ func (s *APIServer) GetAddressBalance(ctx context.Context, in *pb.GetAddressBala
 		}
 	}
 
-	bals, err := s.massWallet.AddressBalance(uint32(in.RequiredConfirmations), in.Addresses)
+	bals, err := s.massWallet.AddressBalance(in.WalletId, uint32(in.RequiredConfirmations), in.Addresses)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "AddressBalance failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -412,6 +470,7 @@ func (s *APIServer) UseWallet(ctx context.Context, in *pb.UseWalletRequest) (*pb
 		ExternalKeyCount: info.ExternalKeyCount,
 		InternalKeyCount: info.InternalKeyCount,
 		Remarks:          info.Remarks,
+		WatchOnly:        info.WatchOnly,
 	}, nil
 }
 
@@ -430,15 +489,19 @@ func (s *APIServer) Wallets(ctx context.Context, in *empty.Empty) (*pb.WalletsRe
 	}
 	for _, summary := range summaries {
 		ws := &pb.WalletsResponse_WalletSummary{
-			WalletId: summary.WalletID,
-			Type:     summary.Type,
-			Version:  uint32(summary.Version),
-			Remarks:  summary.Remarks,
+			WalletId:  summary.WalletID,
+			Type:      summary.Type,
+			Version:   uint32(summary.Version),
+			Remarks:   summary.Remarks,
+			WatchOnly: summary.WatchOnly,
 		}
 		switch {
 		case summary.Status.IsRemoved():
 			ws.Status = walletStatusRemoving
 			ws.StatusMsg = walletStatusMsg[walletStatusRemoving]
+		case summary.Status.IsRescanning():
+			ws.Status = walletStatusRescanning
+			ws.StatusMsg = walletStatusMsg[walletStatusRescanning]
 		case summary.Status.Ready():
 			ws.Status = walletStatusReady
 			ws.StatusMsg = walletStatusMsg[walletStatusReady]
@@ -458,6 +521,10 @@ func (s *APIServer) Wallets(ctx context.Context, in *empty.Empty) (*pb.WalletsRe
 func (s *APIServer) GetUtxo(ctx context.Context, in *pb.GetUtxoRequest) (*pb.GetUtxoResponse, error) {
 	logging.CPrint(logging.INFO, "api: GetUtxo", logging.LogFormat{"addresses": in.Addresses})
 
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
 	for _, addr := range in.Addresses {
 		err := checkAddressLen(addr)
 		if err != nil {
@@ -465,7 +532,7 @@ func (s *APIServer) GetUtxo(ctx context.Context, in *pb.GetUtxoRequest) (*pb.Get
 		}
 	}
 
-	m, err := s.massWallet.GetUtxo(in.Addresses)
+	m, err := s.massWallet.GetUtxo(in.WalletId, in.Addresses)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "GetUtxo failed", logging.LogFormat{"err": err})
 		cvtErr := convertResponseError(err)
@@ -474,6 +541,11 @@ func (s *APIServer) GetUtxo(ctx context.Context, in *pb.GetUtxoRequest) (*pb.Get
 		}
 		return nil, cvtErr
 	}
+	labels, err := s.walletLabels(in.WalletId)
+	if err != nil {
+		return nil, err
+	}
+
 	list := make([]*pb.AddressUTXO, 0)
 	for k, v := range m {
 		utxos := make([]*pb.UTXO, 0)
@@ -490,11 +562,13 @@ func (s *APIServer) GetUtxo(ctx context.Context, in *pb.GetUtxoRequest) (*pb.Get
 				Maturity:       item.Maturity,
 				Confirmations:  item.Confirmations,
 				SpentByUnmined: item.SpentByUnmined,
+				Note:           labels.Transactions[item.TxId],
 			})
 		}
 		list = append(list, &pb.AddressUTXO{
 			Address: k,
 			Utxos:   utxos,
+			Label:   labels.Addresses[k],
 		})
 	}
 
@@ -504,6 +578,125 @@ func (s *APIServer) GetUtxo(ctx context.Context, in *pb.GetUtxoRequest) (*pb.Get
 	}, nil
 }
 
+func (s *APIServer) LockUnspent(ctx context.Context, in *pb.LockUnspentRequest) (*pb.LockUnspentResponse, error) {
+	logging.CPrint(logging.INFO, "api: LockUnspent", logging.LogFormat{"name": in.Name, "expires_in": in.ExpiresIn})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	if err := checkNotEmpty(in.Outpoints); err != nil {
+		return nil, err
+	}
+	outPoints, err := parseOutPoints(in.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiry int64
+	if in.ExpiresIn > 0 {
+		now := time.Now().Unix()
+		if in.ExpiresIn > uint64(math.MaxInt64-now) {
+			logging.CPrint(logging.ERROR, "expires_in out of range", logging.LogFormat{"expires_in": in.ExpiresIn})
+			return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+		}
+		expiry = now + int64(in.ExpiresIn)
+	}
+
+	locks, err := s.massWallet.LockUnspent(in.WalletId, outPoints, checkRemarksLen(in.Name), expiry)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "LockUnspent failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: LockUnspent completed", logging.LogFormat{"locked": len(locks)})
+	return &pb.LockUnspentResponse{
+		Locks: buildLockedUnspents(locks),
+	}, nil
+}
+
+func (s *APIServer) UnlockUnspent(ctx context.Context, in *pb.UnlockUnspentRequest) (*pb.UnlockUnspentResponse, error) {
+	logging.CPrint(logging.INFO, "api: UnlockUnspent", logging.LogFormat{})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	outPoints, err := parseOutPoints(in.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	unlocked, err := s.massWallet.UnlockUnspent(in.WalletId, outPoints)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "UnlockUnspent failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: UnlockUnspent completed", logging.LogFormat{"unlocked": unlocked})
+	return &pb.UnlockUnspentResponse{
+		Unlocked: uint32(unlocked),
+	}, nil
+}
+
+func (s *APIServer) ListLockUnspent(ctx context.Context, in *pb.ListLockUnspentRequest) (*pb.ListLockUnspentResponse, error) {
+	logging.CPrint(logging.INFO, "api: ListLockUnspent", logging.LogFormat{})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
+	locks, err := s.massWallet.ListLockUnspent(in.WalletId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ListLockUnspent failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: ListLockUnspent completed", logging.LogFormat{})
+	return &pb.ListLockUnspentResponse{
+		Locks: buildLockedUnspents(locks),
+	}, nil
+}
+
+func parseOutPoints(inputs []*pb.TransactionInput) ([]*wire.OutPoint, error) {
+	outPoints := make([]*wire.OutPoint, 0, len(inputs))
+	for _, input := range inputs {
+		if err := checkTransactionIdLen(input.TxId); err != nil {
+			return nil, err
+		}
+		hash, err := wire.NewHashFromStr(input.TxId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "invalid tx id", logging.LogFormat{"err": err, "tx_id": input.TxId})
+			return nil, status.New(ErrAPIInvalidTxId, ErrCode[ErrAPIInvalidTxId]).Err()
+		}
+		outPoints = append(outPoints, wire.NewOutPoint(hash, input.Vout))
+	}
+	return outPoints, nil
+}
+
+func buildLockedUnspents(locks []*txmgr.LockedUnspent) []*pb.LockedUnspent {
+	ret := make([]*pb.LockedUnspent, 0, len(locks))
+	for _, lock := range locks {
+		ret = append(ret, &pb.LockedUnspent{
+			TxId:   lock.Hash.String(),
+			Vout:   lock.Index,
+			Name:   lock.Name,
+			Expiry: lock.Expiry,
+		})
+	}
+	return ret
+}
+
 func (s *APIServer) ImportWallet(ctx context.Context, in *pb.ImportWalletRequest) (*pb.ImportWalletResponse, error) {
 	logging.CPrint(logging.INFO, "api: ImportWallet", logging.LogFormat{})
 	err := checkPassLen(in.Passphrase)
@@ -581,6 +774,116 @@ func (s *APIServer) ImportMnemonic(ctx context.Context, in *pb.ImportMnemonicReq
 	}, nil
 }
 
+func (s *APIServer) ImportWatchOnly(ctx context.Context, in *pb.ImportWatchOnlyRequest) (*pb.ImportWalletResponse, error) {
+	logging.CPrint(logging.INFO, "api: ImportWatchOnly", logging.LogFormat{"remarks": in.Remarks})
+
+	err := checkAccountPubKeyLen(in.AccountPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	remarks := checkRemarksLen(in.Remarks)
+
+	params := &keystore.WalletParams{
+		Version:         keystore.KeystoreVersionLatest,
+		Remarks:         remarks,
+		ExternalIndex:   in.ExternalIndex,
+		InternalIndex:   in.InternalIndex,
+		AddressGapLimit: s.config.Wallet.Settings.AddressGapLimit,
+	}
+	ws, err := s.massWallet.ImportWatchOnlyWallet(in.AccountPubKey, params)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ImportWatchOnlyWallet failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: ImportWatchOnly completed",
+		logging.LogFormat{
+			"wallet id": ws.WalletID,
+		})
+	return &pb.ImportWalletResponse{
+		Ok:        true,
+		WalletId:  ws.WalletID,
+		Type:      ws.Type,
+		Version:   uint32(ws.Version),
+		Remarks:   ws.Remarks,
+		WatchOnly: ws.WatchOnly,
+	}, nil
+}
+
+func (s *APIServer) ImportMultisig(ctx context.Context, in *pb.ImportMultisigRequest) (*pb.ImportWalletResponse, error) {
+	logging.CPrint(logging.INFO, "api: ImportMultisig", logging.LogFormat{
+		"threshold": in.Threshold,
+		"cosigners": len(in.AccountPubKeys),
+		"remarks":   in.Remarks,
+	})
+
+	for _, key := range in.AccountPubKeys {
+		if err := checkAccountPubKeyLen(key); err != nil {
+			return nil, err
+		}
+	}
+
+	remarks := checkRemarksLen(in.Remarks)
+
+	params := &keystore.WalletParams{
+		Version:         keystore.KeystoreVersionLatest,
+		Remarks:         remarks,
+		ExternalIndex:   in.ExternalIndex,
+		InternalIndex:   in.InternalIndex,
+		AddressGapLimit: s.config.Wallet.Settings.AddressGapLimit,
+	}
+	ws, err := s.massWallet.ImportMultisigWallet(int(in.Threshold), in.AccountPubKeys, params)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ImportMultisigWallet failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: ImportMultisig completed",
+		logging.LogFormat{
+			"wallet id": ws.WalletID,
+		})
+	return &pb.ImportWalletResponse{
+		Ok:        true,
+		WalletId:  ws.WalletID,
+		Type:      ws.Type,
+		Version:   uint32(ws.Version),
+		Remarks:   ws.Remarks,
+		WatchOnly: ws.WatchOnly,
+	}, nil
+}
+
+func (s *APIServer) ExportWatchOnly(ctx context.Context, in *pb.ExportWatchOnlyRequest) (*pb.ExportWatchOnlyResponse, error) {
+	logging.CPrint(logging.INFO, "api: ExportWatchOnly", logging.LogFormat{"walletId": in.WalletId})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
+	key, err := s.massWallet.ExportWatchOnly(in.WalletId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "ExportWatchOnly failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIQueryDataFailed, ErrCode[ErrAPIQueryDataFailed]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: ExportWatchOnly completed", logging.LogFormat{})
+	return &pb.ExportWatchOnlyResponse{
+		AccountPubKey: key,
+	}, nil
+}
+
 func (s *APIServer) CreateWallet(ctx context.Context, in *pb.CreateWalletRequest) (*pb.CreateWalletResponse, error) {
 	logging.CPrint(logging.INFO, "api: CreateWallet",
 		logging.LogFormat{
@@ -650,9 +953,12 @@ func (s *APIServer) RemoveWallet(ctx context.Context, in *pb.RemoveWalletRequest
 		return nil, err
 	}
 
-	err = checkPassLen(in.Passphrase)
-	if err != nil {
-		return nil, err
+	// watch-only wallets are removed without passphrase
+	if len(in.Passphrase) > 0 {
+		err = checkPassLen(in.Passphrase)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err = s.massWallet.RemoveWallet(in.WalletId, in.Passphrase)
@@ -671,6 +977,148 @@ func (s *APIServer) RemoveWallet(ctx context.Context, in *pb.RemoveWalletRequest
 	}, nil
 }
 
+func (s *APIServer) RescanWallet(ctx context.Context, in *pb.RescanWalletRequest) (*pb.RescanWalletResponse, error) {
+	logging.CPrint(logging.INFO, "api: RescanWallet", logging.LogFormat{
+		"walletId":   in.WalletId,
+		"fromHeight": in.FromHeight,
+		"gapLimit":   in.GapLimit,
+	})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	if in.GapLimit > RescanGapLimitMax {
+		logging.CPrint(logging.ERROR, "gap limit too large", logging.LogFormat{
+			"gapLimit": in.GapLimit,
+			"max":      RescanGapLimitMax,
+		})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+
+	discovered, err := s.massWallet.RescanWallet(in.WalletId, in.FromHeight, in.GapLimit)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "RescanWallet failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+	walletId := in.WalletId
+	if len(walletId) == 0 {
+		walletId = s.massWallet.CurrentWallet()
+	}
+
+	logging.CPrint(logging.INFO, "api: RescanWallet completed", logging.LogFormat{
+		"walletId":   walletId,
+		"discovered": discovered,
+	})
+	return &pb.RescanWalletResponse{
+		WalletId:            walletId,
+		FromHeight:          in.FromHeight,
+		DiscoveredAddresses: uint32(discovered),
+	}, nil
+}
+
+// BackupWallet writes the whole wallet db to an archive file on the wallet host.
+func (s *APIServer) BackupWallet(ctx context.Context, in *pb.BackupWalletRequest) (*pb.BackupWalletResponse, error) {
+	logging.CPrint(logging.INFO, "api: BackupWallet", logging.LogFormat{"path": in.Path})
+
+	if !filepath.IsAbs(in.Path) {
+		logging.CPrint(logging.ERROR, "backup path must be absolute", logging.LogFormat{"path": in.Path})
+		return nil, status.New(ErrAPIInvalidParameter, ErrCode[ErrAPIInvalidParameter]).Err()
+	}
+
+	summary, err := s.massWallet.BackupWallet(in.Path)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "BackupWallet failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: BackupWallet completed", logging.LogFormat{
+		"path":    summary.Path,
+		"entries": summary.Entries,
+	})
+	return &pb.BackupWalletResponse{
+		Path:          summary.Path,
+		Size:          summary.Size,
+		Checksum:      summary.Checksum,
+		Entries:       summary.Entries,
+		CreatedAt:     summary.Metadata.CreatedAt,
+		SchemaVersion: summary.Metadata.SchemaVersion,
+		SyncedHeight:  summary.Metadata.SyncedHeight,
+		Wallets:       summary.Metadata.Wallets,
+		Encrypted:     summary.Metadata.Encrypted,
+	}, nil
+}
+
+// Timeouts of UnlockWallet, in seconds.
+const (
+	defaultUnlockTimeout = 300
+	maxUnlockTimeout     = 86400
+)
+
+func (s *APIServer) UnlockWallet(ctx context.Context, in *pb.UnlockWalletRequest) (*pb.UnlockWalletResponse, error) {
+	logging.CPrint(logging.INFO, "api: UnlockWallet", logging.LogFormat{"walletId": in.WalletId, "timeout": in.Timeout})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+	if err := checkPassLen(in.Passphrase); err != nil {
+		return nil, err
+	}
+	timeout := in.Timeout
+	if timeout == 0 {
+		timeout = defaultUnlockTimeout
+	}
+	if timeout > maxUnlockTimeout {
+		logging.CPrint(logging.ERROR, "invalid unlock timeout", logging.LogFormat{"timeout": in.Timeout})
+		return nil, status.New(ErrAPIInvalidParameter, fmt.Sprintf("timeout should not exceed %d seconds", maxUnlockTimeout)).Err()
+	}
+
+	expires, err := s.massWallet.UnlockWallet(in.WalletId, in.Passphrase, time.Duration(timeout)*time.Second)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "UnlockWallet failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: UnlockWallet completed", logging.LogFormat{"expires": expires})
+	return &pb.UnlockWalletResponse{
+		Expires: expires.Unix(),
+	}, nil
+}
+
+func (s *APIServer) LockWallet(ctx context.Context, in *pb.LockWalletRequest) (*pb.LockWalletResponse, error) {
+	logging.CPrint(logging.INFO, "api: LockWallet", logging.LogFormat{"walletId": in.WalletId})
+
+	if err := checkOptionalWalletId(in.WalletId); err != nil {
+		return nil, err
+	}
+
+	err := s.massWallet.LockWallet(in.WalletId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "LockWallet failed", logging.LogFormat{"err": err})
+		cvtErr := convertResponseError(err)
+		if cvtErr == apiUnknownError {
+			return nil, status.New(ErrAPIAbnormalData, ErrCode[ErrAPIAbnormalData]).Err()
+		}
+		return nil, cvtErr
+	}
+
+	logging.CPrint(logging.INFO, "api: LockWallet completed", logging.LogFormat{})
+	return &pb.LockWalletResponse{
+		Ok: true,
+	}, nil
+}
+
 /* func (s *APIServer) ChangePrivPassphrase(ctx context.Context, in *pb.ChangePrivPassphraseRequest) (*pb.ChangePrivPassphraseResponse, error) {
 	logging.CPrint(logging.INFO, "api: ChangePrivPassphrase")
 
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"github.com/massnetorg/mass-core/logging"
+	"golang.org/x/net/context"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	gw "massnet.org/mass-wallet/api/proto"
+	"massnet.org/mass-wallet/config"
+)
+
+const walletEventsWebSocketPath = "/v1/events/ws"
+
+// walletEventsWebSocket relays SubscribeWalletEvents to WebSocket clients, one
+// JSON text frame per event. It accepts the same query parameters as
+// GET /v1/events. If the stream fails, a final frame carrying the error
+// status is sent before closing.
+func walletEventsWebSocket(client gw.ApiServiceClient, cfg *config.Config, gatewaySecret string) http.Handler {
+	marshaler := &runtime.JSONPb{OrigName: true, EmitDefaults: true}
+
+	return websocket.Server{
+		Handshake: func(_ *websocket.Config, r *http.Request) error {
+			return checkWebSocketOrigin(r, cfg.Wallet.API.HttpCORSAddr)
+		},
+		Handler: func(ws *websocket.Conn) {
+			defer ws.Close()
+
+			req := &gw.SubscribeWalletEventsRequest{}
+			err := runtime.PopulateQueryParameters(req, ws.Request().URL.Query(), utilities.NewDoubleArray(nil))
+			if err != nil {
+				sendWebSocketStatus(ws, marshaler, status.New(codes.InvalidArgument, err.Error()))
+				return
+			}
+
+			ctx, cancel := context.WithCancel(ws.Request().Context())
+			defer cancel()
+			md := gatewayAuthMetadata(ws.Request(), gatewaySecret)
+			if authorization := ws.Request().Header.Get("Authorization"); len(authorization) > 0 {
+				md = metadata.Join(md, metadata.Pairs("authorization", authorization))
+			}
+			ctx = metadata.NewOutgoingContext(ctx, md)
+			// Clients are not expected to send anything, reading only detects close.
+			go func() {
+				io.Copy(ioutil.Discard, ws)
+				cancel()
+			}()
+
+			stream, err := client.SubscribeWalletEvents(ctx, req)
+			if err != nil {
+				sendWebSocketStatus(ws, marshaler, status.Convert(err))
+				return
+			}
+			for {
+				ev, err := stream.Recv()
+				if err != nil {
+					if err != io.EOF && ctx.Err() == nil {
+						sendWebSocketStatus(ws, marshaler, status.Convert(err))
+					}
+					return
+				}
+				buf, err := marshaler.Marshal(ev)
+				if err != nil {
+					logging.CPrint(logging.ERROR, "failed to marshal wallet event", logging.LogFormat{"err": err})
+					return
+				}
+				if err = websocket.Message.Send(ws, string(buf)); err != nil {
+					return
+				}
+			}
+		},
+	}
+}
+
+func sendWebSocketStatus(ws *websocket.Conn, marshaler runtime.Marshaler, st *status.Status) {
+	buf, err := marshaler.Marshal(st.Proto())
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to marshal status", logging.LogFormat{"err": err})
+		return
+	}
+	websocket.Message.Send(ws, string(buf))
+}
+
+// checkWebSocketOrigin accepts any origin if CORS is not configured, the same
+// as plain HTTP requests.
+func checkWebSocketOrigin(r *http.Request, allowed []string) error {
+	if len(allowed) == 0 {
+		return nil
+	}
+	origin := r.Header.Get("Origin")
+	for _, o := range allowed {
+		if o == "*" || o == origin {
+			return nil
+		}
+	}
+	return websocket.ErrBadWebSocketOrigin
+}
@@ -38,6 +38,8 @@ func Execute() {
 func init() {
 	cobra.OnInitialize(initConfig)
 
+	rootCmd.PersistentFlags().StringVarP(&walletIdFlag, "wallet", "w", "", "specify the wallet to operate on instead of the one selected by usewallet")
+
 	// cmd_others
 	rootCmd.AddCommand(createCertCmd)
 	rootCmd.AddCommand(getClientStatusCmd)
@@ -51,32 +53,67 @@ func init() {
 	rootCmd.AddCommand(useWalletCmd)
 	rootCmd.AddCommand(importWalletCmd)
 	rootCmd.AddCommand(importMnemonicCmd)
+	rootCmd.AddCommand(importWatchOnlyCmd)
+	rootCmd.AddCommand(importMultisigCmd)
+	rootCmd.AddCommand(exportWatchOnlyCmd)
 	rootCmd.AddCommand(exportWalletCmd)
 	rootCmd.AddCommand(removeWalletCmd)
+	rootCmd.AddCommand(rescanWalletCmd)
+	rootCmd.AddCommand(backupWalletCmd)
+	rootCmd.AddCommand(unlockWalletCmd)
+	rootCmd.AddCommand(lockWalletCmd)
 	rootCmd.AddCommand(getWalletMnemonicCmd)
 	rootCmd.AddCommand(getWalletBalanceCmd)
 	rootCmd.AddCommand(getAddressBalanceCmd)
 	rootCmd.AddCommand(listUtxoCmd)
+	rootCmd.AddCommand(lockUnspentCmd)
+	rootCmd.AddCommand(unlockUnspentCmd)
+	rootCmd.AddCommand(listLockUnspentCmd)
 	rootCmd.AddCommand(createAddressCmd)
 	rootCmd.AddCommand(listAddressesCmd)
 	rootCmd.AddCommand(validateAddressCmd)
+	signMessageCmd.Flags().BoolVarP(&unlockedFlag, "unlocked", "u", false, "skip the password prompt, the wallet is unlocked by unlockwallet")
+	rootCmd.AddCommand(signMessageCmd)
+	rootCmd.AddCommand(verifyMessageCmd)
+	rootCmd.AddCommand(setLabelCmd)
+	rootCmd.AddCommand(getLabelsCmd)
+	rootCmd.AddCommand(searchLabelsCmd)
 
 	//
 	rootCmd.AddCommand(createRawTransactionCmd)
 	rootCmd.AddCommand(autoCreateRawTransactionCmd)
+	signRawTransactionCmd.Flags().BoolVarP(&unlockedFlag, "unlocked", "u", false, "skip the password prompt, the wallet is unlocked by unlockwallet")
 	rootCmd.AddCommand(signRawTransactionCmd)
+	rootCmd.AddCommand(createPsbtCmd)
+	rootCmd.AddCommand(decodePsbtCmd)
+	signPsbtCmd.Flags().StringVarP(&signPsbtFlagKeystore, "keystore", "", "", "sign offline with the exported keystore file instead of the wallet")
+	signPsbtCmd.Flags().BoolVarP(&unlockedFlag, "unlocked", "u", false, "skip the password prompt, the wallet is unlocked by unlockwallet")
+	rootCmd.AddCommand(signPsbtCmd)
+	rootCmd.AddCommand(combinePsbtCmd)
+	rootCmd.AddCommand(finalizePsbtCmd)
 	rootCmd.AddCommand(getTransactionFeeCmd)
 	rootCmd.AddCommand(sendRawTransactionCmd)
 	rootCmd.AddCommand(getRawTransactionCmd)
 	rootCmd.AddCommand(decodeRawTransactionCmd)
+	rootCmd.AddCommand(estimateFeeRateCmd)
 	rootCmd.AddCommand(getTxStatusCmd)
+	rootCmd.AddCommand(listUnconfirmedCmd)
+	rootCmd.AddCommand(abandonTransactionCmd)
+	createPayoutBatchCmd.Flags().BoolVarP(&unlockedFlag, "unlocked", "u", false, "skip the password prompt, the wallet is unlocked by unlockwallet")
+	rootCmd.AddCommand(createPayoutBatchCmd)
+	rootCmd.AddCommand(getPayoutBatchCmd)
 	rootCmd.AddCommand(listTrasactionsCmd)
+	rootCmd.AddCommand(listTxHistoryCmd)
+	exportHistoryCmd.Flags().StringVarP(&exportHistoryFlagOutput, "output", "o", "", "write the ledger to the file instead of stdout")
+	rootCmd.AddCommand(exportHistoryCmd)
 
 	rootCmd.AddCommand(createStakingTransactionCmd)
+	rootCmd.AddCommand(createWithdrawStakingTransactionCmd)
 	rootCmd.AddCommand(getStakingHistoryCmd)
 	rootCmd.AddCommand(getBlockStakingReward)
 
 	rootCmd.AddCommand(createBindingTransactionCmd)
+	rootCmd.AddCommand(createWithdrawBindingTransactionCmd)
 
 	batchBindPoolPkCmd.Flags().BoolP("check", "c", false, "only check current bound coinbase")
 	rootCmd.AddCommand(batchBindPoolPkCmd)
@@ -100,4 +137,23 @@ func init() {
 	getBindingListCmd.Flags().StringVarP(&getBindingListFlagPlotType, "type", "t", "", "specify the searching plot type: m1 (for native MassDB) or m2 (for Chia Plot)")
 	getBindingListCmd.Flags().StringSliceVarP(&getBindingListFlagDirectories, "dirs", "d", nil, "specify the searching directories")
 	rootCmd.AddCommand(getBindingListCmd)
+
+	migrateWalletDbCmd.Flags().StringVarP(&migrateWalletDbFlagDbType, "dbtype", "", "leveldb", "specify the 'datastore.db_type' of the wallet")
+	migrateWalletDbCmd.Flags().BoolVarP(&migrateWalletDbFlagApply, "apply", "", false, "apply pending migrations")
+	migrateWalletDbCmd.Flags().BoolVarP(&migrateWalletDbFlagDryRun, "dry-run", "", false, "run pending migrations without writing the wallet db")
+	migrateWalletDbCmd.Flags().BoolVarP(&migrateWalletDbFlagNoBackup, "no-backup", "", false, "skip the backup of the wallet db before applying migrations")
+	rootCmd.AddCommand(migrateWalletDbCmd)
+	restoreWalletDbCmd.Flags().StringVarP(&restoreWalletDbFlagDbType, "dbtype", "", "leveldb", "specify the 'datastore.db_type' of the wallet")
+	restoreWalletDbCmd.Flags().BoolVarP(&restoreWalletDbFlagCheck, "check", "", false, "only verify the archive")
+	rootCmd.AddCommand(restoreWalletDbCmd)
+	encryptWalletDbCmd.Flags().StringVarP(&encryptWalletDbFlagDbType, "dbtype", "", "leveldb", "specify the 'datastore.db_type' of the wallet")
+	encryptWalletDbCmd.Flags().BoolVarP(&encryptWalletDbFlagEncryptKeys, "encrypt-keys", "", false, "also encrypt keys and bucket names, see 'wallet.encryption.encrypt_keys'")
+	encryptWalletDbCmd.Flags().BoolVarP(&encryptWalletDbFlagDecrypt, "decrypt", "", false, "decrypt the encrypted wallet db")
+	rootCmd.AddCommand(encryptWalletDbCmd)
+	walletDbCmd.PersistentFlags().StringVarP(&walletDbFlagDbType, "dbtype", "", "leveldb", "specify the 'datastore.db_type' of the wallet")
+	walletDbCmd.AddCommand(walletDbBucketsCmd)
+	walletDbCmd.AddCommand(walletDbDumpCmd)
+	walletDbCheckCmd.Flags().BoolVarP(&walletDbCheckFlagRepair, "repair", "", false, "resolve the repairable inconsistencies")
+	walletDbCmd.AddCommand(walletDbCheckCmd)
+	rootCmd.AddCommand(walletDbCmd)
 }
@@ -30,7 +30,7 @@ import (
 )
 
 var createBindingTransactionCmd = &cobra.Command{
-	Use:   "createbindingtransaction <outputs> [fee=?] [from=?]",
+	Use:   "createbindingtransaction <outputs> [fee=?] [from=?] [fee_mode=?]",
 	Short: "Creates a binding transaction.",
 	Long: "Creates a binding transaction.\n" +
 		"\nArguments:\n" +
@@ -39,14 +39,15 @@ var createBindingTransactionCmd = &cobra.Command{
 		"                 binding_address   -   poc address\n" +
 		"  [fee]        optional, MASS paid to miner, a real with max 8 decimal places\n" +
 		"  [from]       optional, the address of current wallet from which all inputs selected. \n" +
-		"               If not provided inputs may be selected from any address of current wallet\n",
+		"               If not provided inputs may be selected from any address of current wallet\n" +
+		"  [fee_mode]   optional, fee rate by expected confirmation, one of minimum(default), economical, normal and priority\n",
 	Example: `  createbindingtransaction '[{"holder_address":"ms1qq7xrhu6dh6r02ep42p563nmku3d9t8e6mu6yz0h7k9rnc4gr53a7sl7tw3r","binding_address":"18gsEwbYu65Qjwz4dUtKpYqfyYawQF8yga","amount":"1000.001"},...]'` +
 		` from=ms1qq0d99znj2pc032frunvme29ypquxprxrrexthv2d9t5v6zgul4a7qapk0jj fee=0.01` +
 		"\n  // win\n" +
 		`  createbindingtransaction "[{\"holder_address\":\"ms1qq7xrhu6dh6r02ep42p563nmku3d9t8e6mu6yz0h7k9rnc4gr53a7sl7tw3r\",\"binding_address\":\"18gsEwbYu65Qjwz4dUtKpYqfyYawQF8yga\",\"amount\":\"1000.001\"},...]"` +
 		` from=ms1qq0d99znj2pc032frunvme29ypquxprxrrexthv2d9t5v6zgul4a7qapk0jj fee=0.01`,
 	Args: func(cmd *cobra.Command, args []string) error {
-		if err := cobra.RangeArgs(1, 3)(cmd, args); err != nil {
+		if err := cobra.RangeArgs(1, 4)(cmd, args); err != nil {
 			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
 			return err
 		}
@@ -60,6 +61,8 @@ var createBindingTransactionCmd = &cobra.Command{
 				fee = value
 			case "from":
 				from = value
+			case "fee_mode":
+				feeMode = value
 			default:
 				return errorUnknownCommandParam(key)
 			}
@@ -84,6 +87,8 @@ var createBindingTransactionCmd = &cobra.Command{
 			Outputs:     outs,
 			FromAddress: from,
 			Fee:         fee,
+			WalletId:    walletIdFlag,
+			FeeMode:     feeMode,
 		}
 
 		resp := &pb.CreateRawTransactionResponse{}
@@ -91,6 +96,33 @@ var createBindingTransactionCmd = &cobra.Command{
 	},
 }
 
+var createWithdrawBindingTransactionCmd = &cobra.Command{
+	Use:   "createwithdrawbindingtransaction [json_data]",
+	Short: "Creates a transaction withdrawing matured binding outputs of current wallet.",
+	Long: "Creates a transaction withdrawing matured binding outputs of current wallet.\n" +
+		"\n[json_data]:\n" +
+		"  - inputs		optional, binding outputs to withdraw, all matured binding outputs by default\n" +
+		"  - to_address		optional, the standard address of the first input's owner will be used by default.\n" +
+		"  - fee			optional, floating fee with max 8 decimal places\n",
+	Example: `  createwithdrawbindingtransaction '{"inputs":[{"tx_id": "af03d3916639143e343628ba9286c33a70752bf6bc495512dbd093c18e033bc0", "vout": 0}],` +
+		`"to_address":"ms1qq8mg72nwy02g0zpej0247rwtccycy3zrjmv8na5vl3yp6dgttd7ds0pa2df"}'`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "createwithdrawbindingtransaction called", logging.LogFormat{})
+
+		req := &pb.CreateWithdrawTransactionRequest{}
+		if len(args) > 0 {
+			if err := json.Unmarshal([]byte(args[0]), req); err != nil {
+				return err
+			}
+		}
+		req.WalletId = walletIdFlag
+
+		resp := &pb.CreateRawTransactionResponse{}
+		return ClientCall("/v1/transactions/binding/withdraw", POST, req, resp)
+	},
+}
+
 var getBindingHistoryCmd = &cobra.Command{
 	Use:   "listbindingtransactions [all]",
 	Short: "Returns binding transaction of current wallet.",
@@ -103,9 +135,9 @@ var getBindingHistoryCmd = &cobra.Command{
 
 		resp := &pb.GetBindingHistoryResponse{}
 		if len(args) == 0 || strings.ToLower(args[0]) != "all" {
-			return ClientCall("/v1/transactions/binding/history", GET, nil, resp)
+			return ClientCall(withWalletId("/v1/transactions/binding/history"), GET, nil, resp)
 		}
-		return ClientCall("/v1/transactions/binding/history/all", GET, nil, resp)
+		return ClientCall(withWalletId("/v1/transactions/binding/history/all"), GET, nil, resp)
 	},
 }
 
@@ -237,6 +269,7 @@ var batchBindPoolPkCmd = &cobra.Command{
 			reqCreate := &pb.CreatePoolPkCoinbaseTransactionRequest{
 				FromAddress: args[1],
 				Payload:     hex.EncodeToString(blockchain.EncodePayload(payload)),
+				WalletId:    walletIdFlag,
 			}
 			respCreate := &pb.CreateRawTransactionResponse{}
 			if err := ClientCallWithoutPrintResponse("/v1/transactions/poolpkcoinbase", POST, reqCreate, respCreate); err != nil {
@@ -435,6 +468,7 @@ var batchBindingCmd = &cobra.Command{
 		if err := ClientCallWithoutPrintResponse("/v1/addresses/balance", POST, &pb.GetAddressBalanceRequest{
 			RequiredConfirmations: 1,
 			Addresses:             []string{from},
+			WalletId:              walletIdFlag,
 		}, resp); err != nil {
 			return err
 		}
@@ -569,6 +603,7 @@ func createSignSend(outputs []*pb.CreateBindingTransactionRequest_Output, pass,
 	req1 := &pb.CreateBindingTransactionRequest{
 		Outputs:     outputs,
 		FromAddress: from,
+		WalletId:    walletIdFlag,
 	}
 
 	unsigned := ""
@@ -599,6 +634,7 @@ func signSendTx(txHex, pass string) (string, error) {
 		RawTx:      txHex,
 		Passphrase: pass,
 		Flags:      "ALL",
+		WalletId:   walletIdFlag,
 	}
 	respSign := &pb.SignRawTransactionResponse{}
 	if err := ClientCallWithoutPrintResponse("/v1/transactions/sign", POST, reqSign, respSign); err != nil {
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"encoding/hex"
+
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/spf13/cobra"
+
+	pb "massnet.org/mass-wallet/api/proto"
+)
+
+var setLabelCmd = &cobra.Command{
+	Use:   "setlabel <address|tx_id> [label]",
+	Short: "Labels an address or notes a transaction of current wallet.",
+	Long: "Labels an address or notes a transaction of current wallet.\n" +
+		"Labels are returned along with addresses, utxos and histories, and carried by exportwallet.\n" +
+		"\nArguments:\n" +
+		"  <address|tx_id>	address of current wallet, or id of a transaction\n" +
+		"  [label]		optional, the existing label is removed if not provided\n",
+	Example: "  setlabel ms1qqgq750hhj0lcem3pmv6gymvvmfmrzmvhyxqwyp4fey2cmec372pjq3uw7yg \"customer alice\"",
+	Args:    cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "setlabel called", logging.LogFormat{"target": args[0]})
+
+		req := &pb.SetLabelRequest{WalletId: walletIdFlag}
+		if isTxIdArg(args[0]) {
+			req.TxId = args[0]
+		} else {
+			req.Address = args[0]
+		}
+		if len(args) > 1 {
+			req.Label = args[1]
+		}
+		resp := &pb.SetLabelResponse{}
+		return ClientCall("/v1/labels/set", POST, req, resp)
+	},
+}
+
+var getLabelsCmd = &cobra.Command{
+	Use:   "getlabels [address|tx_id]...",
+	Short: "Returns labels of addresses and notes of transactions of current wallet.",
+	Long: "Returns labels of addresses and notes of transactions of current wallet.\n" +
+		"\nArguments:\n" +
+		"  [address|tx_id]	optional, all labels are returned if not provided\n",
+	Args: cobra.MinimumNArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "getlabels called", logging.LogFormat{"targets": args})
+
+		req := &pb.GetLabelsRequest{WalletId: walletIdFlag}
+		for _, arg := range args {
+			if isTxIdArg(arg) {
+				req.TxIds = append(req.TxIds, arg)
+			} else {
+				req.Addresses = append(req.Addresses, arg)
+			}
+		}
+		resp := &pb.LabelsResponse{}
+		return ClientCall("/v1/labels", POST, req, resp)
+	},
+}
+
+var searchLabelsCmd = &cobra.Command{
+	Use:   "searchlabels <query>",
+	Short: "Searches labels of addresses and notes of transactions of current wallet.",
+	Long: "Searches labels of addresses and notes of transactions of current wallet.\n" +
+		"\nArguments:\n" +
+		"  <query>	case-insensitive text contained by labels, addresses or transaction ids\n",
+	Example: "  searchlabels alice",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "searchlabels called", logging.LogFormat{"query": args[0]})
+
+		resp := &pb.LabelsResponse{}
+		return ClientCall("/v1/labels/search", POST, &pb.SearchLabelsRequest{Query: args[0], WalletId: walletIdFlag}, resp)
+	},
+}
+
+// isTxIdArg reports whether arg looks like a transaction id rather than an address.
+func isTxIdArg(arg string) bool {
+	if len(arg) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(arg)
+	return err == nil
+}
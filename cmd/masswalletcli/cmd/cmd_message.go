@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/spf13/cobra"
+
+	pb "massnet.org/mass-wallet/api/proto"
+)
+
+var signMessageCmd = &cobra.Command{
+	Use:   "signmessage <address> <message>",
+	Short: "Signs a message with the key of an address, proving control of the address.",
+	Long: "Signs a message with the key of an address, proving control of the address.\n" +
+		"The signature is verified by verifymessage with only the address and the message.\n" +
+		"\nArguments:\n" +
+		"  <address>	standard or staking address of a wallet, multisig addresses are not supported\n" +
+		"  <message>	the message to sign\n",
+	Example: "  signmessage ms1qqgq750hhj0lcem3pmv6gymvvmfmrzmvhyxqwyp4fey2cmec372pjq3uw7yg \"pool operated by alice\"",
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "signmessage called", logging.LogFormat{"address": args[0]})
+
+		req := &pb.SignMessageRequest{
+			Address:    args[0],
+			Message:    args[1],
+			Passphrase: readPasswordUnlessUnlocked(),
+		}
+		resp := &pb.SignMessageResponse{}
+		return ClientCall("/v1/addresses/message/sign", POST, req, resp)
+	},
+}
+
+var verifyMessageCmd = &cobra.Command{
+	Use:   "verifymessage <address> <signature> <message>",
+	Short: "Verifies a message signed by signmessage.",
+	Long: "Verifies a message signed by signmessage.\n" +
+		"\nArguments:\n" +
+		"  <address>	the address that signed the message\n" +
+		"  <signature>	base64 encoded signature returned by signmessage\n" +
+		"  <message>	the signed message\n",
+	Args: cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "verifymessage called", logging.LogFormat{"address": args[0]})
+
+		req := &pb.VerifyMessageRequest{
+			Address:   args[0],
+			Signature: args[1],
+			Message:   args[2],
+		}
+		resp := &pb.VerifyMessageResponse{}
+		return ClientCall("/v1/addresses/message/verify", POST, req, resp)
+	},
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"io/ioutil"
+
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/spf13/cobra"
+
+	pb "massnet.org/mass-wallet/api/proto"
+	wcfg "massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet"
+	"massnet.org/mass-wallet/masswallet/psbt"
+)
+
+var signPsbtFlagKeystore string
+
+var createPsbtCmd = &cobra.Command{
+	Use:   "createpsbt <hex>",
+	Short: "Converts a raw transaction of current wallet into a partially-signed transaction.",
+	Long: "Converts a raw transaction of current wallet into a partially-signed transaction (psbt).\n" +
+		"The psbt carries the value, pkScript, witness script and key derivation of every input,\n" +
+		"so it can be signed by 'signpsbt --keystore' on a machine without chain data.\n" +
+		"\nArguments:\n" +
+		"  <hex>     unsigned, serialized, hex-encoded transaction",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "createpsbt called", EmptyLogFormat)
+		req := &pb.CreatePsbtRequest{
+			Hex:      args[0],
+			WalletId: walletIdFlag,
+		}
+		resp := &pb.PsbtResponse{}
+		return ClientCall("/v1/transactions/psbt/create", POST, req, resp)
+	},
+}
+
+var decodePsbtCmd = &cobra.Command{
+	Use:   "decodepsbt <psbt>",
+	Short: "Decodes a base64-encoded partially-signed transaction.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "decodepsbt called", EmptyLogFormat)
+		resp := &pb.DecodePsbtResponse{}
+		return ClientCall("/v1/transactions/psbt/decode", POST, &pb.DecodePsbtRequest{Psbt: args[0]}, resp)
+	},
+}
+
+var signPsbtCmd = &cobra.Command{
+	Use:   "signpsbt <psbt> [mode=?]",
+	Short: "Adds signatures of current wallet or a keystore file to a partially-signed transaction.",
+	Long: "Adds signatures of current wallet or a keystore file to a partially-signed transaction.\n" +
+		"With '--keystore', the psbt is signed locally by the exported keystore without connecting to the wallet.\n" +
+		"\nArguments:\n" +
+		"  <psbt>        base64-encoded partially-signed transaction\n" +
+		"  [mode]        Optional, allowed modes are the same as 'signrawtransaction', default ALL",
+	Example: "  signpsbt eyJ2ZXJzaW9uIjox...\n" +
+		"  signpsbt eyJ2ZXJzaW9uIjox... --keystore keystore.json",
+	Args: signModeArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "signpsbt called", logging.LogFormat{"mode": signFlags, "keystore": signPsbtFlagKeystore})
+
+		if len(signPsbtFlagKeystore) == 0 {
+			req := &pb.SignPsbtRequest{
+				Psbt:       args[0],
+				Passphrase: readPasswordUnlessUnlocked(),
+				Flags:      signFlags,
+				WalletId:   walletIdFlag,
+			}
+			resp := &pb.SignPsbtResponse{}
+			return ClientCall("/v1/transactions/psbt/sign", POST, req, resp)
+		}
+
+		keystoreJson, err := ioutil.ReadFile(signPsbtFlagKeystore)
+		if err != nil {
+			return err
+		}
+		p, err := psbt.Decode(args[0])
+		if err != nil {
+			return err
+		}
+		signed, err := masswallet.SignPsbtWithKeystore(keystoreJson, []byte(readPassword()), signFlags, p, wcfg.ChainParams)
+		if err != nil {
+			logging.VPrint(logging.ERROR, "failed to sign psbt", logging.LogFormat{"err": err})
+			return err
+		}
+		encoded, err := p.Encode()
+		if err != nil {
+			return err
+		}
+		printJSON(&pb.SignPsbtResponse{
+			Psbt:     encoded,
+			Complete: p.IsComplete(wcfg.ChainParams),
+			Signed:   uint32(signed),
+		})
+		return nil
+	},
+}
+
+var combinePsbtCmd = &cobra.Command{
+	Use:   "combinepsbt <psbt> <psbt>...",
+	Short: "Combines signatures of several partially-signed transactions of the same transaction.",
+	Args:  cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "combinepsbt called", EmptyLogFormat)
+		resp := &pb.PsbtResponse{}
+		return ClientCall("/v1/transactions/psbt/combine", POST, &pb.CombinePsbtRequest{Psbts: args}, resp)
+	},
+}
+
+var finalizePsbtCmd = &cobra.Command{
+	Use:   "finalizepsbt <psbt>",
+	Short: "Finalizes a fully signed psbt and returns the hex-encoded transaction ready to send.",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "finalizepsbt called", EmptyLogFormat)
+		resp := &pb.FinalizePsbtResponse{}
+		return ClientCall("/v1/transactions/psbt/finalize", POST, &pb.FinalizePsbtRequest{Psbt: args[0]}, resp)
+	},
+}
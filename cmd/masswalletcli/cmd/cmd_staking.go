@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -15,7 +16,7 @@ var (
 )
 
 var createStakingTransactionCmd = &cobra.Command{
-	Use:   "createstakingtransaction <staking_address> <frozen_period> <value> [fee=?] [from=?]",
+	Use:   "createstakingtransaction <staking_address> <frozen_period> <value> [fee=?] [from=?] [fee_mode=?]",
 	Short: "Creates a staking transaction.",
 	Long: "Creates a staking transaction.\n" +
 		"\nArguments:\n" +
@@ -24,10 +25,12 @@ var createStakingTransactionCmd = &cobra.Command{
 		"  <value>              amount of staked MASS, a real with max 8 decimal places\n" +
 		"  [fee]                optional, MASS paid to miner, a real with max 8 decimal places\n" +
 		"  [from]               optional, the address of current wallet from which all inputs selected. \n" +
-		"                       if not provided inputs may be selected from any address of current wallet\n",
+		"                       if not provided inputs may be selected from any address of current wallet\n" +
+		"  [fee_mode]           optional, fee rate by expected confirmation, one of minimum(default), economical,\n" +
+		"                       normal and priority\n",
 	Example: `  createstakingtransaction ms1qp0czrc8errz8gdmpjgxd59kwvydf3g3ch72d6qm2kqwzlgm232pksqw0eky 1000 95.5 fee=0.05`,
 	Args: func(cmd *cobra.Command, args []string) error {
-		if err := cobra.RangeArgs(3, 5)(cmd, args); err != nil {
+		if err := cobra.RangeArgs(3, 6)(cmd, args); err != nil {
 			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
 			return err
 		}
@@ -46,6 +49,8 @@ var createStakingTransactionCmd = &cobra.Command{
 				fee = value
 			case "from":
 				from = value
+			case "fee_mode":
+				feeMode = value
 			default:
 				return errorUnknownCommandParam(key)
 			}
@@ -59,6 +64,7 @@ var createStakingTransactionCmd = &cobra.Command{
 			"frozen_period":   frozenPeriod,
 			"amount":          args[2],
 			"fee":             fee,
+			"fee_mode":        feeMode,
 		})
 
 		req := &pb.CreateStakingTransactionRequest{
@@ -67,12 +73,41 @@ var createStakingTransactionCmd = &cobra.Command{
 			FrozenPeriod:   frozenPeriod,
 			Amount:         args[2],
 			Fee:            fee,
+			WalletId:       walletIdFlag,
+			FeeMode:        feeMode,
 		}
 		resp := &pb.CreateRawTransactionResponse{}
 		return ClientCall("/v1/transactions/staking", POST, req, resp)
 	},
 }
 
+var createWithdrawStakingTransactionCmd = &cobra.Command{
+	Use:   "createwithdrawstakingtransaction [json_data]",
+	Short: "Creates a transaction withdrawing matured staking outputs of current wallet.",
+	Long: "Creates a transaction withdrawing matured staking outputs of current wallet.\n" +
+		"\n[json_data]:\n" +
+		"  - inputs		optional, staking outputs to withdraw, all matured staking outputs by default\n" +
+		"  - to_address		optional, the standard address of the first input's owner will be used by default.\n" +
+		"  - fee			optional, floating fee with max 8 decimal places\n",
+	Example: `  createwithdrawstakingtransaction '{"inputs":[{"tx_id": "af03d3916639143e343628ba9286c33a70752bf6bc495512dbd093c18e033bc0", "vout": 0}],` +
+		`"to_address":"ms1qq8mg72nwy02g0zpej0247rwtccycy3zrjmv8na5vl3yp6dgttd7ds0pa2df"}'`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "createwithdrawstakingtransaction called", logging.LogFormat{})
+
+		req := &pb.CreateWithdrawTransactionRequest{}
+		if len(args) > 0 {
+			if err := json.Unmarshal([]byte(args[0]), req); err != nil {
+				return err
+			}
+		}
+		req.WalletId = walletIdFlag
+
+		resp := &pb.CreateRawTransactionResponse{}
+		return ClientCall("/v1/transactions/staking/withdraw", POST, req, resp)
+	},
+}
+
 var getStakingHistoryCmd = &cobra.Command{
 	Use:   "liststakingtransactions [all]",
 	Short: "Returns staking transactions of current wallet.",
@@ -84,9 +119,9 @@ var getStakingHistoryCmd = &cobra.Command{
 		logging.VPrint(logging.INFO, "liststakingtransactions called", logging.LogFormat{"args": args})
 		resp := &pb.GetStakingHistoryResponse{}
 		if len(args) == 0 || strings.ToLower(args[0]) != "all" {
-			return ClientCall("/v1/transactions/staking/history", GET, nil, resp)
+			return ClientCall(withWalletId("/v1/transactions/staking/history"), GET, nil, resp)
 		}
-		return ClientCall("/v1/transactions/staking/history/all", GET, nil, resp)
+		return ClientCall(withWalletId("/v1/transactions/staking/history/all"), GET, nil, resp)
 	},
 }
 
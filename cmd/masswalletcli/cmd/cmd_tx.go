@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -11,6 +14,7 @@ import (
 	"github.com/massnetorg/mass-core/logging"
 	pb "massnet.org/mass-wallet/api/proto"
 
+	"github.com/golang/protobuf/jsonpb"
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -19,6 +23,7 @@ import (
 var (
 	locktime        uint64
 	fee             string
+	feeMode         string
 	from            string
 	inputs          []*pb.TransactionInput
 	outputs         map[string]string
@@ -61,6 +66,10 @@ var createRawTransactionCmd = &cobra.Command{
 			return err
 		}
 
+		if len(walletIdFlag) > 0 {
+			req.WalletId = walletIdFlag
+		}
+
 		resp := &pb.CreateRawTransactionResponse{}
 		return ClientCall("/v1/transactions/create", POST, req, resp)
 	},
@@ -75,7 +84,10 @@ var autoCreateRawTransactionCmd = &cobra.Command{
 		"  - fee			optional, floating fee with max 8 decimal places\n" +
 		"  - lock_time		optional\n" +
 		"  - change_address	optional, the first sender address will be used by default.\n" +
-		"  - from_address	optional, specific sender, if not provided, the inputs may be selected from any address of current wallet\n",
+		"  - from_address	optional, specific sender, if not provided, the inputs may be selected from any address of current wallet\n" +
+		"  - coin_selection	optional, how inputs are selected, one of largest_first(default), branch_and_bound,\n" +
+		"			smallest_first, oldest_first and random\n" +
+		"  - fee_mode		optional, fee rate by expected confirmation, one of minimum(default), economical, normal and priority\n",
 	Example: `	autocreaterawtransaction '{"amounts":{"ms1qqwmyrmca0zfcpyhjv7tdek2mvsrtr6yzrm8g227r4ryadn42hs0hst2gvut": "1.01"},` +
 		`"change_address":"ms1qq8mg72nwy02g0zpej0247rwtccycy3zrjmv8na5vl3yp6dgttd7ds0pa2df",` +
 		`"fee":"0.005"}'`,
@@ -88,6 +100,10 @@ var autoCreateRawTransactionCmd = &cobra.Command{
 			return err
 		}
 
+		if len(walletIdFlag) > 0 {
+			req.WalletId = walletIdFlag
+		}
+
 		resp := &pb.CreateRawTransactionResponse{}
 		return ClientCall("/v1/transactions/create/auto", POST, req, resp)
 	},
@@ -106,44 +122,48 @@ var signRawTransactionCmd = &cobra.Command{
 		"            ALL|ANYONECANPAY:    sign for one specified input and all outputs\n" +
 		"            NONE|ANYONECANPAY:   sign for one specified input\n" +
 		"            SINGLE|ANYONECANPAY: sign for one specified input and corresponding output",
-	Args: func(cmd *cobra.Command, args []string) error {
-		if err := cobra.RangeArgs(1, 2)(cmd, args); err != nil {
-			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
-			return err
-		}
-		for i := 1; i < len(args); i++ {
-			key, value, err := parseCommandVar(args[i])
-			if err != nil {
-				return err
-			}
-			switch key {
-			case "mode":
-				upper := strings.ToUpper(value)
-				switch upper {
-				case "ALL", "NONE", "SINGLE", "ALL|ANYONECANPAY", "NONE|ANYONECANPAY", "SINGLE|ANYONECANPAY":
-					signFlags = upper
-				default:
-					return fmt.Errorf("invalid mode: %s", value)
-				}
-			default:
-				return errorUnknownCommandParam(key)
-			}
-		}
-		return nil
-	},
+	Args: signModeArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		logging.VPrint(logging.INFO, "signrawtransaction called", logging.LogFormat{"hex": args[0], "mode": signFlags})
 
 		req := &pb.SignRawTransactionRequest{
 			RawTx:      args[0],
-			Passphrase: readPassword(),
+			Passphrase: readPasswordUnlessUnlocked(),
 			Flags:      signFlags,
+			WalletId:   walletIdFlag,
 		}
 		resp := &pb.SignRawTransactionResponse{}
 		return ClientCall("/v1/transactions/sign", POST, req, resp)
 	},
 }
 
+// signModeArgs accepts one positional argument followed by an optional [mode=?].
+func signModeArgs(cmd *cobra.Command, args []string) error {
+	if err := cobra.RangeArgs(1, 2)(cmd, args); err != nil {
+		logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+		return err
+	}
+	for i := 1; i < len(args); i++ {
+		key, value, err := parseCommandVar(args[i])
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "mode":
+			upper := strings.ToUpper(value)
+			switch upper {
+			case "ALL", "NONE", "SINGLE", "ALL|ANYONECANPAY", "NONE|ANYONECANPAY", "SINGLE|ANYONECANPAY":
+				signFlags = upper
+			default:
+				return fmt.Errorf("invalid mode: %s", value)
+			}
+		default:
+			return errorUnknownCommandParam(key)
+		}
+	}
+	return nil
+}
+
 var getTransactionFeeCmd = &cobra.Command{
 	Use:   "gettransactionfee <outputs> <inputs> [binding=true]",
 	Short: "Estimates transaction fee.",
@@ -200,6 +220,7 @@ var getTransactionFeeCmd = &cobra.Command{
 			Amounts:    outputs,
 			Inputs:     inputs,
 			HasBinding: estimateBinding,
+			WalletId:   walletIdFlag,
 		}
 
 		resp := &pb.GetTransactionFeeResponse{}
@@ -246,6 +267,29 @@ var getRawTransactionCmd = &cobra.Command{
 	},
 }
 
+var estimateFeeRateCmd = &cobra.Command{
+	Use:   "estimatefeerate <target_blocks>",
+	Short: "Returns the fee rate per kB expected to get a transaction confirmed within target blocks.",
+	Long: "Returns the fee rate per kB expected to get a transaction confirmed within target blocks.\n" +
+		"\nArguments:\n" +
+		"  <target_blocks>   number of blocks, 1 to 48\n",
+	Example: `  estimatefeerate 6`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		_, err := strconv.ParseUint(args[0], 10, 32)
+		return err
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "estimatefeerate called", logging.LogFormat{"target_blocks": args[0]})
+
+		resp := &pb.EstimateFeeRateResponse{}
+		return ClientCall(fmt.Sprintf("/v1/transactions/feerate/%s", args[0]), GET, nil, resp)
+	},
+}
+
 var getTxStatusCmd = &cobra.Command{
 	Use:   "gettransactionstatus <txid>",
 	Short: "Returns transaction status for given transaction id.",
@@ -264,6 +308,102 @@ var getTxStatusCmd = &cobra.Command{
 	},
 }
 
+var listUnconfirmedCmd = &cobra.Command{
+	Use:   "listunconfirmed",
+	Short: "Lists unconfirmed transactions of current wallet.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "listunconfirmed called", EmptyLogFormat)
+
+		resp := &pb.ListUnconfirmedResponse{}
+		return ClientCall("/v1/transactions/unconfirmed", POST, &pb.ListUnconfirmedRequest{WalletId: walletIdFlag}, resp)
+	},
+}
+
+var abandonTransactionCmd = &cobra.Command{
+	Use:   "abandontransaction <txid>",
+	Short: "Removes an unconfirmed transaction dropped by the mempool and releases its inputs.",
+	Long: "Removes an unconfirmed transaction of current wallet that is no longer in the mempool,\n" +
+		"along with unconfirmed transactions spending its outputs, so that the inputs become spendable again.\n" +
+		"\nArguments:\n" +
+		"  <txid>   the unconfirmed transaction\n",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "abandontransaction called", logging.LogFormat{"txid": args[0]})
+
+		resp := &pb.AbandonTransactionResponse{}
+		return ClientCall("/v1/transactions/abandon", POST, &pb.AbandonTransactionRequest{TxId: args[0], WalletId: walletIdFlag}, resp)
+	},
+}
+
+var createPayoutBatchCmd = &cobra.Command{
+	Use:   "createpayoutbatch <json_data>",
+	Short: "Pays a list of payouts by signed transactions submitted to the mempool.",
+	Long: "Pays a list of payouts from current wallet, splitting them into standard transactions which are signed\n" +
+		"and submitted to the mempool. Repeating the request with the same payout keys returns the stored\n" +
+		"batch instead of paying again.\n" +
+		"\n<json_data>:\n" +
+		"  - payouts		required, list of {key, address, amount}, key is unique in the wallet with at most 64 bytes\n" +
+		"  - fee_mode		optional, fee rate by expected confirmation, one of minimum(default), economical, normal and priority\n",
+	Example: `	createpayoutbatch '{"payouts":[{"key":"withdraw-1001",` +
+		`"address":"ms1qqwmyrmca0zfcpyhjv7tdek2mvsrtr6yzrm8g227r4ryadn42hs0hst2gvut","amount":"1.01"}]}'`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "createpayoutbatch called", logging.LogFormat{})
+
+		req := &pb.CreatePayoutBatchRequest{}
+		if err := json.Unmarshal([]byte(args[0]), req); err != nil {
+			return err
+		}
+		req.WalletId = walletIdFlag
+		req.Passphrase = readPasswordUnlessUnlocked()
+
+		resp := &pb.PayoutBatchResponse{}
+		return ClientCall("/v1/transactions/payouts", POST, req, resp)
+	},
+}
+
+var getPayoutBatchCmd = &cobra.Command{
+	Use:   "getpayoutbatch <batch_id|key=?>",
+	Short: "Returns a payout batch and the status of its payouts.",
+	Long: "Returns a payout batch of current wallet and the status of its payouts.\n" +
+		"\nArguments:\n" +
+		"  <batch_id>   id of the batch\n" +
+		"  [key]        alternatively, key of a payout of the batch\n",
+	Example: "	getpayoutbatch key=withdraw-1001",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if err := cobra.ExactArgs(1)(cmd, args); err != nil {
+			logging.VPrint(logging.ERROR, LogMsgIncorrectArgsNumber, logging.LogFormat{"actual": len(args)})
+			return err
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "getpayoutbatch called", logging.LogFormat{"arg": args[0]})
+
+		req := &pb.GetPayoutBatchRequest{WalletId: walletIdFlag}
+		if strings.HasPrefix(args[0], "key=") {
+			req.PayoutKey = strings.TrimPrefix(args[0], "key=")
+		} else {
+			req.BatchId = args[0]
+		}
+		resp := &pb.PayoutBatchResponse{}
+		return ClientCall("/v1/transactions/payouts/batch", POST, req, resp)
+	},
+}
+
 var listTrasactionsCmd = &cobra.Command{
 	Use:   "listtransactions [count=?] [address=?]",
 	Short: "Returns up to N most recent transactions for current wallet.",
@@ -303,14 +443,188 @@ var listTrasactionsCmd = &cobra.Command{
 		})
 
 		req := &pb.TxHistoryRequest{
-			Count:   historyCount,
-			Address: from,
+			Count:    historyCount,
+			Address:  from,
+			WalletId: walletIdFlag,
 		}
 		resp := &pb.TxHistoryResponse{}
 		return ClientCall("/v1/transactions/history", POST, req, resp)
 	},
 }
 
+var listTxHistoryCmd = &cobra.Command{
+	Use: "listtxhistory [cursor=?] [limit=?] [order=?] [from_height=?] [to_height=?] [from_time=?] [to_time=?] " +
+		"[address=?] [types=?]",
+	Short: "Returns a page of transaction history indexed by current wallet.",
+	Long: "Returns a page of transaction history indexed by current wallet, with the net amount and fee of each transaction.\n" +
+		"\nArguments:\n" +
+		"  [cursor]        optional, next_cursor of the previous page\n" +
+		"  [limit]         optional, max number of transactions returned, 50 if not provided\n" +
+		"  [order]         optional, desc(newest first, default) or asc\n" +
+		"  [from_height]   optional\n" +
+		"  [to_height]     optional\n" +
+		"  [from_time]     optional, unix seconds of block time\n" +
+		"  [to_time]       optional, unix seconds of block time\n" +
+		"  [address]       optional, only transactions involving the address of current wallet\n" +
+		"  [types]         optional, comma separated transfer, staking, binding or coinbase\n",
+	Example: "  listtxhistory limit=20 types=transfer,staking",
+	Args:    cobra.MaximumNArgs(9),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "listtxhistory called", logging.LogFormat{"args": args})
+
+		req := &pb.ListTxHistoryRequest{WalletId: walletIdFlag}
+		for _, arg := range args {
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "cursor":
+				req.Cursor = value
+			case "order":
+				req.Order = value
+			case "address":
+				req.Address = value
+			case "types":
+				req.Types = strings.Split(value, ",")
+			case "limit":
+				n, err := strconv.ParseUint(value, 10, 32)
+				if err != nil {
+					return err
+				}
+				req.Limit = uint32(n)
+			case "from_height", "to_height":
+				n, err := strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					return err
+				}
+				if key == "from_height" {
+					req.FromHeight = n
+				} else {
+					req.ToHeight = n
+				}
+			case "from_time", "to_time":
+				n, err := strconv.ParseInt(value, 10, 64)
+				if err != nil {
+					return err
+				}
+				if key == "from_time" {
+					req.FromTime = n
+				} else {
+					req.ToTime = n
+				}
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+		resp := &pb.ListTxHistoryResponse{}
+		return ClientCall("/v1/transactions/history/list", POST, req, resp)
+	},
+}
+
+var exportHistoryFlagOutput string
+
+var exportHistoryCmd = &cobra.Command{
+	Use:   "exporthistory [format=?] [from_height=?] [to_height=?] [from_time=?] [to_time=?]",
+	Short: "Exports the accounting ledger of current wallet in csv or json lines.",
+	Long: "Exports the accounting ledger of current wallet in csv or json lines, with the date, counterparts, amount,\n" +
+		"fee, running balance and classification of each transaction, oldest first.\n" +
+		"\nArguments:\n" +
+		"  [format]        optional, csv(default) or jsonl\n" +
+		"  [from_height]   optional\n" +
+		"  [to_height]     optional\n" +
+		"  [from_time]     optional, unix seconds of block time\n" +
+		"  [to_time]       optional, unix seconds of block time\n",
+	Example: "  exporthistory format=csv from_height=100000 -o ledger.csv",
+	Args:    cobra.MaximumNArgs(5),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "exporthistory called", logging.LogFormat{"args": args})
+
+		req := &pb.ExportHistoryRequest{WalletId: walletIdFlag}
+		for _, arg := range args {
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "format":
+				req.Format = value
+			case "from_height", "to_height":
+				n, err := strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					return err
+				}
+				if key == "from_height" {
+					req.FromHeight = n
+				} else {
+					req.ToHeight = n
+				}
+			case "from_time", "to_time":
+				n, err := strconv.ParseInt(value, 10, 64)
+				if err != nil {
+					return err
+				}
+				if key == "from_time" {
+					req.FromTime = n
+				} else {
+					req.ToTime = n
+				}
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+
+		out := os.Stdout
+		if len(exportHistoryFlagOutput) > 0 {
+			f, err := os.Create(exportHistoryFlagOutput)
+			if err != nil {
+				logging.VPrint(logging.ERROR, "failed to create file", logging.LogFormat{"err": err})
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+
+		initClient()
+		resp, err := client.CallRaw(context.Background(), "/v1/transactions/history/export", POST, req)
+		if err != nil {
+			logging.VPrint(logging.ERROR, "fail on client call", logging.LogFormat{"err": err})
+			return err
+		}
+		defer resp.Body.Close()
+
+		// the gateway streams a json object of either result or error per chunk
+		decoder := json.NewDecoder(resp.Body)
+		for {
+			var chunk struct {
+				Result json.RawMessage `json:"result"`
+				Error  *struct {
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+			if err := decoder.Decode(&chunk); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return fmt.Errorf("failed to unmarshal response: %v", err)
+			}
+			if chunk.Error != nil {
+				logging.VPrint(logging.ERROR, "fail on client call", logging.LogFormat{"err": chunk.Error.Message})
+				return fmt.Errorf(chunk.Error.Message)
+			}
+			if len(chunk.Result) == 0 {
+				continue
+			}
+			msg := &pb.ExportHistoryResponse{}
+			if err := (&jsonpb.Unmarshaler{AllowUnknownFields: true}).Unmarshal(bytes.NewReader(chunk.Result), msg); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %v", err)
+			}
+			if _, err := io.WriteString(out, msg.Data); err != nil {
+				return err
+			}
+		}
+	},
+}
+
 func readPassword() string {
 	for {
 		fmt.Fprint(os.Stdout, "Enter password:")
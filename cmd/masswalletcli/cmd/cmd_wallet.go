@@ -27,7 +27,7 @@ var createAddressCmd = &cobra.Command{
 		logging.VPrint(logging.INFO, "createaddress called", logging.LogFormat{"version": version})
 
 		resp := &pb.CreateAddressResponse{}
-		return ClientCall("/v1/addresses/create", POST, &pb.CreateAddressRequest{Version: int32(version)}, resp)
+		return ClientCall("/v1/addresses/create", POST, &pb.CreateAddressRequest{Version: int32(version), WalletId: walletIdFlag}, resp)
 	},
 }
 
@@ -47,7 +47,7 @@ var listAddressesCmd = &cobra.Command{
 		logging.VPrint(logging.INFO, "listaddresses called", logging.LogFormat{"version": version})
 
 		resp := &pb.GetAddressesResponse{}
-		return ClientCall(fmt.Sprintf("/v1/addresses/%s", args[0]), GET, nil, resp)
+		return ClientCall(withWalletId(fmt.Sprintf("/v1/addresses/%s", args[0])), GET, nil, resp)
 	},
 }
 
@@ -88,6 +88,7 @@ var getWalletBalanceCmd = &cobra.Command{
 		req := &pb.GetWalletBalanceRequest{
 			RequiredConfirmations: int32(minconf),
 			Detail:                detail,
+			WalletId:              walletIdFlag,
 		}
 		resp := &pb.GetWalletBalanceResponse{}
 		return ClientCall("/v1/wallets/current/balance", POST, req, resp)
@@ -113,6 +114,7 @@ var getAddressBalanceCmd = &cobra.Command{
 		})
 		req := &pb.GetAddressBalanceRequest{
 			RequiredConfirmations: int32(confs),
+			WalletId:              walletIdFlag,
 		}
 		if len(args) > 1 {
 			req.Addresses = args[1:]
@@ -130,7 +132,7 @@ var validateAddressCmd = &cobra.Command{
 		logging.VPrint(logging.INFO, "validateaddress called", logging.LogFormat{"address": args[0]})
 
 		resp := &pb.ValidateAddressResponse{}
-		return ClientCall(fmt.Sprintf("/v1/addresses/%s/validate", args[0]), GET, nil, resp)
+		return ClientCall(withWalletId(fmt.Sprintf("/v1/addresses/%s/validate", args[0])), GET, nil, resp)
 	},
 }
 
@@ -145,10 +147,108 @@ var listUtxoCmd = &cobra.Command{
 		logging.VPrint(logging.INFO, "listutxo called", logging.LogFormat{"addresses": args})
 
 		resp := &pb.GetUtxoResponse{}
-		return ClientCall("/v1/addresses/utxos", POST, &pb.GetUtxoRequest{Addresses: args}, resp)
+		return ClientCall("/v1/addresses/utxos", POST, &pb.GetUtxoRequest{Addresses: args, WalletId: walletIdFlag}, resp)
 	},
 }
 
+var lockUnspentCmd = &cobra.Command{
+	Use:   "lockunspent <tx_id>:<vout> <tx_id>:<vout>... [name=?] [expires=?]",
+	Short: "Keeps UTXOs of current wallet from being selected automatically.",
+	Long: "Keeps UTXOs of current wallet from being selected by autocreaterawtransaction and\n" +
+		"other transactions constructed automatically, until they are unlocked, spent or expired.\n" +
+		"Locks are stored in the wallet database and survive restarts.\n" +
+		"\nArguments:\n" +
+		"  <tx_id>:<vout>	output to lock\n" +
+		"  [name]		optional, label of the locks\n" +
+		"  [expires]		optional, seconds until the locks expire, never expire by default\n",
+	Example: "  lockunspent af03d3916639143e343628ba9286c33a70752bf6bc495512dbd093c18e033bc0:1 name=cold",
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req := &pb.LockUnspentRequest{WalletId: walletIdFlag}
+		for _, arg := range args {
+			if !strings.Contains(arg, "=") {
+				input, err := parseOutPointArg(arg)
+				if err != nil {
+					return err
+				}
+				req.Outpoints = append(req.Outpoints, input)
+				continue
+			}
+			key, value, err := parseCommandVar(arg)
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "name":
+				req.Name = value
+			case "expires":
+				req.ExpiresIn, err = strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					return err
+				}
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+
+		logging.VPrint(logging.INFO, "lockunspent called", logging.LogFormat{
+			"outpoints": len(req.Outpoints),
+			"name":      req.Name,
+			"expires":   req.ExpiresIn,
+		})
+		resp := &pb.LockUnspentResponse{}
+		return ClientCall("/v1/addresses/utxos/lock", POST, req, resp)
+	},
+}
+
+var unlockUnspentCmd = &cobra.Command{
+	Use:   "unlockunspent <tx_id>:<vout> <tx_id>:<vout>...",
+	Short: "Unlocks UTXOs of current wallet locked by lockunspent.",
+	Long: "Unlocks UTXOs of current wallet locked by lockunspent.\n" +
+		"\nArguments:\n" +
+		"  <tx_id>:<vout>	if not provided, all locked UTXOs of current wallet are unlocked\n",
+	Args: cobra.MinimumNArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "unlockunspent called", logging.LogFormat{"outpoints": args})
+
+		req := &pb.UnlockUnspentRequest{WalletId: walletIdFlag}
+		for _, arg := range args {
+			input, err := parseOutPointArg(arg)
+			if err != nil {
+				return err
+			}
+			req.Outpoints = append(req.Outpoints, input)
+		}
+		resp := &pb.UnlockUnspentResponse{}
+		return ClientCall("/v1/addresses/utxos/unlock", POST, req, resp)
+	},
+}
+
+var listLockUnspentCmd = &cobra.Command{
+	Use:   "listlockunspent",
+	Short: "Lists locked UTXOs of current wallet.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "listlockunspent called", EmptyLogFormat)
+
+		resp := &pb.ListLockUnspentResponse{}
+		return ClientCall("/v1/addresses/utxos/locked", POST, &pb.ListLockUnspentRequest{WalletId: walletIdFlag}, resp)
+	},
+}
+
+// parseOutPointArg parses an output given as <tx_id>:<vout>.
+func parseOutPointArg(arg string) (*pb.TransactionInput, error) {
+	parts := strings.Split(arg, ":")
+	if len(parts) != 2 || len(parts[0]) == 0 {
+		return nil, ErrInvalidArgument
+	}
+	vout, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return &pb.TransactionInput{TxId: parts[0], Vout: uint32(vout)}, nil
+}
+
 var listWalletsCmd = &cobra.Command{
 	Use:   "listwallets",
 	Short: "Returns all wallets imported into this server.",
@@ -225,6 +325,57 @@ var useWalletCmd = &cobra.Command{
 	},
 }
 
+var unlockWalletCmd = &cobra.Command{
+	Use:   "unlockwallet [timeout]",
+	Short: "Unlocks current wallet for a while, so that signing can omit its passphrase.",
+	Long: "Unlocks current wallet for a while, so that signing can omit its passphrase.\n" +
+		"Until the wallet is locked by lockwallet, timeout or stopping the server, signing commands\n" +
+		"skip the password prompt with '--unlocked'. Exporting or removing the wallet still asks for it.\n" +
+		"\nArguments:\n" +
+		"  [timeout]    optional, seconds to keep the wallet unlocked, default 300, at most 86400\n",
+	Example: "  unlockwallet 600\n" +
+		"  signrawtransaction 080112a6010a... --unlocked",
+	Args: cobra.RangeArgs(0, 1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "unlockwallet called", logging.LogFormat{"args": args})
+
+		req := &pb.UnlockWalletRequest{WalletId: walletIdFlag}
+		if len(args) > 0 {
+			timeout, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid timeout %s", args[0])
+			}
+			req.Timeout = uint32(timeout)
+		}
+		req.Passphrase = readPassword()
+		resp := &pb.UnlockWalletResponse{}
+		return ClientCall("/v1/wallets/unlock", POST, req, resp)
+	},
+}
+
+var lockWalletCmd = &cobra.Command{
+	Use:   "lockwallet",
+	Short: "Locks current wallet unlocked by unlockwallet.",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "lockwallet called", EmptyLogFormat)
+
+		resp := &pb.LockWalletResponse{}
+		return ClientCall("/v1/wallets/lock", POST, &pb.LockWalletRequest{WalletId: walletIdFlag}, resp)
+	},
+}
+
+// unlockedFlag skips the password prompt, the wallet is unlocked by unlockwallet.
+var unlockedFlag bool
+
+// readPasswordUnlessUnlocked prompts for the password unless '--unlocked' is set.
+func readPasswordUnlessUnlocked() string {
+	if unlockedFlag {
+		return ""
+	}
+	return readPassword()
+}
+
 var removeWalletCmd = &cobra.Command{
 	Use:   "removewallet <wallet_id>",
 	Short: "Removes specified wallet from server.",
@@ -241,6 +392,61 @@ var removeWalletCmd = &cobra.Command{
 	},
 }
 
+var rescanWalletCmd = &cobra.Command{
+	Use:   "rescanwallet <from_height> [gap_limit]",
+	Short: "Rediscovers addresses of current wallet and rebuilds its records from a height.",
+	Long: "Derives addresses of current wallet until [gap_limit] unused ones follow the last used one,\n" +
+		"then rebuilds its utxos, balance and histories from <from_height> in the background.\n" +
+		"The wallet is 'rescanning' and then importing in listwallets until it is ready again.\n" +
+		"Records below <from_height> are kept as they are, records from <from_height> on are rebuilt.\n" +
+		"\nArguments:\n" +
+		"  <from_height>    the height to rescan from\n" +
+		"  [gap_limit]      optional, number of unused addresses after the last used one, default by the server config\n",
+	Example: "  rescanwallet 0\n" +
+		"  rescanwallet 120000 100 --wallet ac10uz28q8yjevkvvfva84txu2dztsahu7mqxlvxds",
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "rescanwallet called", logging.LogFormat{"args": args})
+
+		fromHeight, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid from_height %s", args[0])
+		}
+		req := &pb.RescanWalletRequest{
+			WalletId:   walletIdFlag,
+			FromHeight: fromHeight,
+		}
+		if len(args) > 1 {
+			gapLimit, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid gap_limit %s", args[1])
+			}
+			req.GapLimit = uint32(gapLimit)
+		}
+		resp := &pb.RescanWalletResponse{}
+		return ClientCall("/v1/wallets/rescan", POST, req, resp)
+	},
+}
+
+var backupWalletCmd = &cobra.Command{
+	Use:   "backupwallet <path>",
+	Short: "Backs up the wallet database of the running wallet to an archive.",
+	Long: "Writes a consistent snapshot of the whole wallet database, including the keystores,\n" +
+		"sync state and histories of all wallets, to a new archive file on the wallet host.\n" +
+		"The archive is restored by restorewalletdb while the wallet is stopped.\n" +
+		"\nArguments:\n" +
+		"  <path>     absolute path of the archive on the wallet host, must not exist\n",
+	Example: "  backupwallet /data/backups/wallet-20201016.mwbak",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "backupwallet called", logging.LogFormat{"path": args[0]})
+
+		req := &pb.BackupWalletRequest{Path: args[0]}
+		resp := &pb.BackupWalletResponse{}
+		return ClientCall("/v1/wallets/backup", POST, req, resp)
+	},
+}
+
 var exportWalletCmd = &cobra.Command{
 	Use:   "exportwallet <wallet_id>",
 	Short: "Exports wallet keystore.",
@@ -324,6 +530,132 @@ var importMnemonicCmd = &cobra.Command{
 	},
 }
 
+var importWatchOnlyCmd = &cobra.Command{
+	Use:   "importwatchonly <account_pub_key> [initial=?] [remarks=?]",
+	Short: "Imports a watch-only wallet from the account extended public key.",
+	Long: "Imports a watch-only wallet from the account extended public key.\n" +
+		"A watch-only wallet syncs balances and creates raw transactions, but cannot sign them.\n" +
+		"\nArguments:\n" +
+		"  <account_pub_key>	account extended public key, see exportwatchonly\n" +
+		"  [initial]		number of initial addresses, default 0\n",
+	Example: `  importwatchonly 'xpub6C...' initial=10 remarks='hot node'`,
+	Args:    cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+
+		initial := 0
+		remarks := ""
+		for i := 1; i < len(args); i++ {
+			key, value, err := parseCommandVar(args[i])
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "initial":
+				initial, err = strconv.Atoi(value)
+				if err != nil {
+					return err
+				}
+			case "remarks":
+				remarks = value
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+
+		logging.VPrint(logging.INFO, "importwatchonly called", logging.LogFormat{
+			"initial": initial,
+			"remarks": remarks,
+		})
+
+		req := &pb.ImportWatchOnlyRequest{
+			AccountPubKey: args[0],
+			ExternalIndex: uint32(initial),
+			Remarks:       remarks,
+		}
+		resp := &pb.ImportWalletResponse{}
+		return ClientCall("/v1/wallets/import/watchonly", POST, req, resp)
+	},
+}
+
+var importMultisigCmd = &cobra.Command{
+	Use:   "importmultisig <threshold> <account_pub_key> <account_pub_key>... [initial=?] [remarks=?]",
+	Short: "Imports a multisig wallet from the account extended public keys of its cosigners.",
+	Long: "Imports a multisig wallet from the account extended public keys of its cosigners.\n" +
+		"Every address of the wallet requires <threshold> signatures of the cosigners, the same\n" +
+		"addresses are derived no matter in which order the keys are given. Like a watch-only wallet\n" +
+		"it cannot sign, each cosigner signs with 'signpsbt' and the psbts are then combined.\n" +
+		"\nArguments:\n" +
+		"  <threshold>		number of required signatures\n" +
+		"  <account_pub_key>	account extended public key of a cosigner, see exportwatchonly\n" +
+		"  [initial]		number of initial addresses, default 0\n",
+	Example: `  importmultisig 2 'xpub6C...' 'xpub6D...' 'xpub6E...' remarks='treasury'`,
+	Args:    cobra.MinimumNArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		threshold, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return err
+		}
+
+		initial := 0
+		remarks := ""
+		pubKeys := make([]string, 0, len(args)-1)
+		for i := 1; i < len(args); i++ {
+			if !strings.Contains(args[i], "=") {
+				pubKeys = append(pubKeys, args[i])
+				continue
+			}
+			key, value, err := parseCommandVar(args[i])
+			if err != nil {
+				return err
+			}
+			switch key {
+			case "initial":
+				initial, err = strconv.Atoi(value)
+				if err != nil {
+					return err
+				}
+			case "remarks":
+				remarks = value
+			default:
+				return errorUnknownCommandParam(key)
+			}
+		}
+
+		logging.VPrint(logging.INFO, "importmultisig called", logging.LogFormat{
+			"threshold": threshold,
+			"cosigners": len(pubKeys),
+			"initial":   initial,
+			"remarks":   remarks,
+		})
+
+		req := &pb.ImportMultisigRequest{
+			Threshold:      uint32(threshold),
+			AccountPubKeys: pubKeys,
+			ExternalIndex:  uint32(initial),
+			Remarks:        remarks,
+		}
+		resp := &pb.ImportWalletResponse{}
+		return ClientCall("/v1/wallets/import/multisig", POST, req, resp)
+	},
+}
+
+var exportWatchOnlyCmd = &cobra.Command{
+	Use:   "exportwatchonly",
+	Short: "Returns the account extended public key of current wallet.",
+	Long: "Returns the account extended public key of current wallet,\n" +
+		"which can be imported elsewhere by importwatchonly.\n",
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "exportwatchonly called", EmptyLogFormat)
+
+		req := &pb.ExportWatchOnlyRequest{
+			WalletId: walletIdFlag,
+		}
+		resp := &pb.ExportWatchOnlyResponse{}
+		return ClientCall("/v1/wallets/export/watchonly", POST, req, resp)
+	},
+}
+
 var getWalletMnemonicCmd = &cobra.Command{
 	Use:   "getwalletmnemonic <wallet_id>",
 	Short: "Returns mnemonic of the specified wallet.",
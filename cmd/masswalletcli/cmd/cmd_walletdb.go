@@ -0,0 +1,505 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/spf13/cobra"
+
+	"massnet.org/mass-wallet/masswallet"
+	"massnet.org/mass-wallet/masswallet/backup"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/db/encdb"
+	_ "massnet.org/mass-wallet/masswallet/db/ldb"
+	_ "massnet.org/mass-wallet/masswallet/db/rdb"
+	"massnet.org/mass-wallet/masswallet/migration"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+const walletDbName = "wallet.db"
+
+var (
+	migrateWalletDbFlagDbType   string
+	migrateWalletDbFlagApply    bool
+	migrateWalletDbFlagDryRun   bool
+	migrateWalletDbFlagNoBackup bool
+
+	restoreWalletDbFlagDbType string
+	restoreWalletDbFlagCheck  bool
+
+	encryptWalletDbFlagDbType      string
+	encryptWalletDbFlagEncryptKeys bool
+	encryptWalletDbFlagDecrypt     bool
+
+	walletDbFlagDbType      string
+	walletDbCheckFlagRepair bool
+)
+
+var migrateWalletDbCmd = &cobra.Command{
+	Use:   "migratewalletdb <datastorePath>",
+	Short: "Checks or applies the schema migrations of the wallet database.",
+	Long: "Checks or applies the schema migrations of the wallet database.\n" +
+		"The wallet must be stopped, it applies pending migrations itself on startup.\n" +
+		"Without '--apply' or '--dry-run', only the schema version and pending migrations are printed.\n" +
+		"\nArguments:\n" +
+		"  <datastorePath>   the 'datastore.dir' of the wallet, containing wallet.db\n" +
+		"\nExamples:\n" +
+		"  migratewalletdb ./chain\n" +
+		"  migratewalletdb ./chain --dry-run\n" +
+		"  migratewalletdb ./chain --apply",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "migratewalletdb called", logging.LogFormat{
+			"path":    args[0],
+			"db_type": migrateWalletDbFlagDbType,
+			"apply":   migrateWalletDbFlagApply,
+			"dry_run": migrateWalletDbFlagDryRun,
+		})
+
+		raw, err := mwdb.OpenDB(migrateWalletDbFlagDbType, filepath.Join(args[0], walletDbName))
+		if err != nil {
+			return fmt.Errorf("failed to open wallet db, is the wallet still running? %v", err)
+		}
+		defer raw.Close()
+		db, err := openWalletDbAsIs(raw)
+		if err != nil {
+			return err
+		}
+
+		reg := migration.DefaultRegistry
+		status, err := reg.Status(db)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("schema version: %d, latest: %d\n", status.Version, status.Latest)
+		if status.Fresh {
+			fmt.Println("empty wallet db, nothing to migrate")
+			return nil
+		}
+		if len(status.Pending) == 0 {
+			fmt.Println("up to date")
+			return nil
+		}
+		fmt.Println("pending migrations:")
+		for _, step := range status.Pending {
+			fmt.Printf("  %d  %s\n", step.Version, step.Description)
+		}
+		if !migrateWalletDbFlagApply && !migrateWalletDbFlagDryRun {
+			return nil
+		}
+
+		opts := &migration.Options{DryRun: migrateWalletDbFlagDryRun}
+		if !migrateWalletDbFlagNoBackup {
+			opts.BackupDir = args[0]
+			opts.BackupDBType = migrateWalletDbFlagDbType
+			// the backup of an encrypted db remains encrypted
+			opts.BackupSource = raw
+		}
+		res, err := reg.Migrate(db, opts)
+		if err != nil {
+			if res != nil {
+				fmt.Printf("failed after migrating to version %d\n", res.To)
+				if res.Backup != "" {
+					fmt.Printf("backup: %s\n", res.Backup)
+				}
+			}
+			return err
+		}
+		if opts.DryRun {
+			fmt.Printf("dry run from version %d to %d succeeded, nothing written\n", res.From, res.To)
+			return nil
+		}
+		fmt.Printf("migrated from version %d to %d\n", res.From, res.To)
+		if res.Backup != "" {
+			fmt.Printf("backup: %s\n", res.Backup)
+		}
+		return nil
+	},
+}
+
+var restoreWalletDbCmd = &cobra.Command{
+	Use:   "restorewalletdb <archive> <datastorePath>",
+	Short: "Restores the wallet database from a backup archive.",
+	Long: "Verifies the archive written by backupwallet or the scheduled backups and restores it\n" +
+		"as the wallet database. The wallet must be stopped. An existing wallet.db is renamed to\n" +
+		"'wallet.db.<time>.old' rather than removed.\n" +
+		"With '--check', the archive is only verified and described.\n" +
+		"\nArguments:\n" +
+		"  <archive>         the backup archive\n" +
+		"  <datastorePath>   the 'datastore.dir' of the wallet, containing wallet.db\n" +
+		"\nExamples:\n" +
+		"  restorewalletdb ./chain/backups/wallet-20201016120000.mwbak ./chain --check\n" +
+		"  restorewalletdb ./chain/backups/wallet-20201016120000.mwbak ./chain",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "restorewalletdb called", logging.LogFormat{
+			"archive": args[0],
+			"path":    args[1],
+			"db_type": restoreWalletDbFlagDbType,
+			"check":   restoreWalletDbFlagCheck,
+		})
+
+		var (
+			s   *backup.FileSummary
+			old string
+			err error
+		)
+		if restoreWalletDbFlagCheck {
+			s, err = backup.VerifyFile(args[0])
+		} else {
+			s, old, err = backup.RestoreFile(args[0], restoreWalletDbFlagDbType, filepath.Join(args[1], walletDbName))
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("archive:        %s (%d bytes)\n", s.Path, s.Size)
+		fmt.Printf("checksum:       %s\n", s.Checksum)
+		fmt.Printf("created at:     %s\n", time.Unix(s.Metadata.CreatedAt, 0).Format(time.RFC3339))
+		fmt.Printf("chain id:       %s\n", s.Metadata.ChainID)
+		fmt.Printf("schema version: %d\n", s.Metadata.SchemaVersion)
+		fmt.Printf("synced height:  %d\n", s.Metadata.SyncedHeight)
+		fmt.Printf("entries:        %d\n", s.Entries)
+		fmt.Printf("encrypted:      %v\n", s.Metadata.Encrypted)
+		fmt.Printf("wallets:        %d\n", len(s.Metadata.Wallets))
+		for _, id := range s.Metadata.Wallets {
+			fmt.Printf("  %s\n", id)
+		}
+		if restoreWalletDbFlagCheck {
+			fmt.Println("archive verified, nothing restored")
+			return nil
+		}
+		fmt.Printf("restored to %s\n", filepath.Join(args[1], walletDbName))
+		if old != "" {
+			fmt.Printf("previous wallet db moved to %s\n", old)
+		}
+		return nil
+	},
+}
+
+var encryptWalletDbCmd = &cobra.Command{
+	Use:   "encryptwalletdb <datastorePath>",
+	Short: "Encrypts or decrypts the wallet database at rest.",
+	Long: "Rewrites the wallet database encrypted by a passphrase, or decrypted with '--decrypt'.\n" +
+		"The wallet must be stopped. Enable or disable 'wallet.encryption' in the config accordingly,\n" +
+		"the passphrase is 'wallet.encryption.passphrase', or 'wallet.pub_pass' if it is not set.\n" +
+		"The previous wallet.db is renamed to 'wallet.db.<time>.old' rather than removed, remove it\n" +
+		"once the wallet runs with the rewritten one.\n" +
+		"\nArguments:\n" +
+		"  <datastorePath>   the 'datastore.dir' of the wallet, containing wallet.db\n" +
+		"\nExamples:\n" +
+		"  encryptwalletdb ./chain\n" +
+		"  encryptwalletdb ./chain --encrypt-keys\n" +
+		"  encryptwalletdb ./chain --decrypt",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "encryptwalletdb called", logging.LogFormat{
+			"path":         args[0],
+			"db_type":      encryptWalletDbFlagDbType,
+			"encrypt_keys": encryptWalletDbFlagEncryptKeys,
+			"decrypt":      encryptWalletDbFlagDecrypt,
+		})
+
+		dbPath := filepath.Join(args[0], walletDbName)
+		src, err := mwdb.OpenDB(encryptWalletDbFlagDbType, dbPath)
+		if err != nil {
+			return fmt.Errorf("failed to open wallet db, is the wallet still running? %v", err)
+		}
+		encrypted, err := encdb.IsEncrypted(src)
+		if err == nil && encrypted != encryptWalletDbFlagDecrypt {
+			err = encdb.ErrNotEncrypted
+			if encrypted {
+				err = encdb.ErrEncrypted
+			}
+		}
+		if err != nil {
+			src.Close()
+			return err
+		}
+
+		fmt.Println("Passphrase of the wallet db")
+		passphrase := readPassword()
+		if !encryptWalletDbFlagDecrypt {
+			fmt.Println("Repeat the passphrase")
+			if readPassword() != passphrase {
+				src.Close()
+				return fmt.Errorf("passphrases do not match")
+			}
+		}
+
+		tmp := dbPath + ".rewriting"
+		if err = os.RemoveAll(tmp); err == nil {
+			err = rewriteWalletDb(src, tmp, passphrase)
+		}
+		src.Close()
+		if err != nil {
+			os.RemoveAll(tmp)
+			return err
+		}
+		old := fmt.Sprintf("%s.%s.old", dbPath, time.Now().Format("20060102150405"))
+		if err = os.Rename(dbPath, old); err != nil {
+			os.RemoveAll(tmp)
+			return err
+		}
+		if err = os.Rename(tmp, dbPath); err != nil {
+			return err
+		}
+		if encryptWalletDbFlagDecrypt {
+			fmt.Printf("decrypted %s\n", dbPath)
+		} else {
+			fmt.Printf("encrypted %s\n", dbPath)
+		}
+		fmt.Printf("previous wallet db moved to %s\n", old)
+		return nil
+	},
+}
+
+// openWalletDbAsIs returns raw, or the decrypting db over raw if it is
+// encrypted, prompting for the passphrase.
+func openWalletDbAsIs(raw mwdb.DB) (mwdb.DB, error) {
+	encrypted, err := encdb.IsEncrypted(raw)
+	if err != nil || !encrypted {
+		return raw, err
+	}
+	fmt.Println("Passphrase of the encrypted wallet db")
+	return encdb.Open(raw, []byte(readPassword()))
+}
+
+// rewriteWalletDb copies src to a new db at path, encrypted or decrypted by
+// the flags of encryptwalletdb.
+func rewriteWalletDb(src mwdb.DB, path, passphrase string) error {
+	dbType := encryptWalletDbFlagDbType
+	if encryptWalletDbFlagDecrypt {
+		edb, err := encdb.Open(src, []byte(passphrase))
+		if err != nil {
+			return err
+		}
+		dst, err := mwdb.CreateDB(dbType, path)
+		if err != nil {
+			return err
+		}
+		defer dst.Close()
+		return mwdb.CopyDB(dst, edb)
+	}
+	dst, err := mwdb.CreateDB(encdb.DBType, path, dbType, passphrase,
+		&encdb.Options{EncryptKeys: encryptWalletDbFlagEncryptKeys})
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+	return mwdb.CopyDB(dst, src)
+}
+
+var walletDbCmd = &cobra.Command{
+	Use:   "walletdb",
+	Short: "Inspects the wallet database offline.",
+	Long: "Lists the buckets, dumps the decoded records or checks the integrity of the wallet database.\n" +
+		"The wallet must be stopped. An encrypted wallet db prompts for its passphrase.",
+}
+
+var walletDbBucketsCmd = &cobra.Command{
+	Use:   "buckets <datastorePath>",
+	Short: "Lists the buckets of the wallet database.",
+	Long: "Lists the buckets of the wallet database with the number and size of their entries.\n" +
+		"\nArguments:\n" +
+		"  <datastorePath>   the 'datastore.dir' of the wallet, containing wallet.db\n" +
+		"\nExamples:\n" +
+		"  walletdb buckets ./chain",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "walletdb buckets called", logging.LogFormat{
+			"path":    args[0],
+			"db_type": walletDbFlagDbType,
+		})
+
+		return viewWalletDb(args[0], func(tx mwdb.ReadTransaction) error {
+			names, err := tx.BucketNames()
+			if err != nil {
+				return err
+			}
+			sort.Strings(names)
+			fmt.Printf("%-24s %10s %12s\n", "bucket", "entries", "bytes")
+			for _, name := range names {
+				if err = printBucket(name, tx.TopLevelBucket(name)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	},
+}
+
+var walletDbDumpCmd = &cobra.Command{
+	Use:   "dump <datastorePath> <kind>",
+	Short: "Dumps the decoded records of the wallet database.",
+	Long: "Prints the records of a kind decoded, one JSON object per line. Amounts are in Maxwell.\n" +
+		"With '--wallet', only the records of the wallet are printed, for the kinds kept by wallet:\n" +
+		"walletstatus, balances, addresses, unspent and gamehistory.\n" +
+		"\nArguments:\n" +
+		"  <datastorePath>   the 'datastore.dir' of the wallet, containing wallet.db\n" +
+		"  <kind>            " + strings.Join(txmgr.DumpKinds, ", ") + "\n" +
+		"\nExamples:\n" +
+		"  walletdb dump ./chain credits\n" +
+		"  walletdb dump ./chain unspent --wallet ac10jv5xfkywm9fu2elcjyqyq4gr4dgyw7gvgp2ls4",
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "walletdb dump called", logging.LogFormat{
+			"path":    args[0],
+			"kind":    args[1],
+			"wallet":  walletIdFlag,
+			"db_type": walletDbFlagDbType,
+		})
+
+		return viewWalletDb(args[0], func(tx mwdb.ReadTransaction) error {
+			insp, err := masswallet.NewInspector(tx)
+			if err != nil {
+				return err
+			}
+			return insp.Dump(tx, args[1], walletIdFlag, func(record interface{}) error {
+				buf, err := json.Marshal(record)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(buf))
+				return nil
+			})
+		})
+	},
+}
+
+var walletDbCheckCmd = &cobra.Command{
+	Use:   "check <datastorePath>",
+	Short: "Checks the integrity of the wallet database.",
+	Long: "Cross validates the records of the wallet database: unspent records against credits,\n" +
+		"debits against the credits they spend, mined balances against the balances recomputed from\n" +
+		"the unspent credits, and block records against tx records.\n" +
+		"With '--repair', the repairable inconsistencies are resolved. Back up the wallet db first,\n" +
+		"others are resolved by rescanning the wallets.\n" +
+		"\nArguments:\n" +
+		"  <datastorePath>   the 'datastore.dir' of the wallet, containing wallet.db\n" +
+		"\nExamples:\n" +
+		"  walletdb check ./chain\n" +
+		"  walletdb check ./chain --repair",
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logging.VPrint(logging.INFO, "walletdb check called", logging.LogFormat{
+			"path":    args[0],
+			"repair":  walletDbCheckFlagRepair,
+			"db_type": walletDbFlagDbType,
+		})
+
+		raw, err := mwdb.OpenDB(walletDbFlagDbType, filepath.Join(args[0], walletDbName))
+		if err != nil {
+			return fmt.Errorf("failed to open wallet db, is the wallet still running? %v", err)
+		}
+		defer raw.Close()
+		db, err := openWalletDbAsIs(raw)
+		if err != nil {
+			return err
+		}
+
+		var (
+			insp     *txmgr.Inspector
+			report   *txmgr.IntegrityReport
+			repaired int
+		)
+		check := func(tx mwdb.ReadTransaction) (err error) {
+			if insp, err = masswallet.NewInspector(tx); err != nil {
+				return err
+			}
+			report, err = insp.CheckIntegrity(tx)
+			return err
+		}
+		if walletDbCheckFlagRepair {
+			// repaired in the transaction of the check
+			err = mwdb.Update(db, func(tx mwdb.DBTransaction) (err error) {
+				if err = check(tx); err != nil {
+					return err
+				}
+				repaired, err = insp.Repair(tx, report)
+				return err
+			})
+		} else {
+			err = mwdb.View(db, check)
+		}
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("checked %d unspent, %d credits, %d debits, %d tx records, %d block records, %d wallets\n",
+			report.Unspent, report.Credits, report.Debits, report.TxRecords, report.Blocks, report.Wallets)
+		if len(report.SkippedWallets) > 0 {
+			fmt.Println("balances not checked of wallets not synced or removed:")
+			for _, id := range report.SkippedWallets {
+				fmt.Printf("  %s\n", id)
+			}
+		}
+		if len(report.Inconsistencies) == 0 {
+			fmt.Println("no inconsistency found")
+			return nil
+		}
+		fmt.Printf("inconsistencies: %d, repairable: %d\n", len(report.Inconsistencies), report.Repairable())
+		for _, c := range report.Inconsistencies {
+			if c.Repairable() {
+				fmt.Printf("  [%s] %s (repairable)\n", c.Check, c.Detail)
+			} else {
+				fmt.Printf("  [%s] %s\n", c.Check, c.Detail)
+			}
+		}
+		if walletDbCheckFlagRepair {
+			fmt.Printf("repaired %d inconsistencies\n", repaired)
+		}
+		if remaining := len(report.Inconsistencies) - repaired; remaining > 0 {
+			return fmt.Errorf("%d inconsistencies remain", remaining)
+		}
+		return nil
+	},
+}
+
+// viewWalletDb opens the wallet db in datastorePath and runs f in a read
+// transaction of it.
+func viewWalletDb(datastorePath string, f func(tx mwdb.ReadTransaction) error) error {
+	raw, err := mwdb.OpenDB(walletDbFlagDbType, filepath.Join(datastorePath, walletDbName))
+	if err != nil {
+		return fmt.Errorf("failed to open wallet db, is the wallet still running? %v", err)
+	}
+	defer raw.Close()
+	db, err := openWalletDbAsIs(raw)
+	if err != nil {
+		return err
+	}
+	return mwdb.View(db, f)
+}
+
+// printBucket prints the number and size of the entries of b and its sub
+// buckets.
+func printBucket(path string, b mwdb.Bucket) error {
+	entries, size := 0, 0
+	it := b.NewIterator(mwdb.BytesPrefix(nil))
+	for it.Next() {
+		entries++
+		size += len(it.Key()) + len(it.Value())
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return err
+	}
+	fmt.Printf("%-24s %10d %12d\n", path, entries, size)
+
+	names, err := b.BucketNames()
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err = printBucket(path+"/"+name, b.Bucket(name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
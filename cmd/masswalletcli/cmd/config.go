@@ -28,6 +28,7 @@ type CliConfig struct {
 	LogLevel string `json:"log_level"`
 	RpcCert  string `json:"rpc_cert"`
 	RpcKey   string `json:"rpc_key"`
+	ApiToken string `json:"api_token"`
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -68,5 +69,7 @@ func initConfig() {
 		config.RpcKey = defaultRpcKey
 	}
 
+	config.ApiToken = viper.GetString("api_token")
+
 	logging.Init(filepath.Join(".", config.LogDir), defaultLogFilename, config.LogLevel, 1, false)
 }
@@ -91,6 +91,9 @@ func (c *Client) call(ctx context.Context, u *url.URL, method Method, bodyReader
 	if err != nil {
 		return nil, err
 	}
+	if len(config.ApiToken) > 0 {
+		req.Header.Set("Authorization", "Bearer "+config.ApiToken)
+	}
 
 	resp, err := c.client.Do(req.WithContext(ctx))
 	if err != nil && ctx.Err() != nil { // check if it timed out
@@ -105,7 +108,11 @@ func (c *Client) call(ctx context.Context, u *url.URL, method Method, bodyReader
 // CallRaw calls a remote node, specified by the path.
 // It returns the raw response body
 func (c *Client) CallRaw(ctx context.Context, path string, method Method, request interface{}) (*http.Response, error) {
-	c.url.Path = path
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		c.url.Path, c.url.RawQuery = path[:i], path[i+1:]
+	} else {
+		c.url.Path, c.url.RawQuery = path, ""
+	}
 
 	var bodyReader io.Reader
 	if request != nil {
@@ -195,6 +202,18 @@ func parseCommandVar(arg string) (key, value string, err error) {
 	return strings.ToLower(key), strings.ToLower(value), nil
 }
 
+// walletIdFlag holds the value of the persistent --wallet flag.
+var walletIdFlag string
+
+// withWalletId appends the wallet_id query parameter to a GET path
+// when --wallet is specified.
+func withWalletId(path string) string {
+	if len(walletIdFlag) == 0 {
+		return path
+	}
+	return path + "?wallet_id=" + url.QueryEscape(walletIdFlag)
+}
+
 func errorUnknownCommandParam(name string) error {
 	return fmt.Errorf("unknown command param: %s", name)
 }
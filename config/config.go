@@ -42,6 +42,16 @@ const (
 	DefaultAddressGapLimit         = 20
 	DefaultMaxUnusedStakingAddress = 8
 	DefaultMaxTxFee                = "1.0" // MASS
+	DefaultRebroadcastInterval     = 600   // seconds
+	DefaultAuthKeyFile             = "api-keys.json"
+	DefaultChainSource             = ChainSourceEmbedded
+	DefaultChainPollInterval       = 3 // seconds
+	DefaultMetricsListenAddress    = "127.0.0.1:9689"
+	DefaultBackupDir               = "backups" // in datastore dir
+	DefaultBackupRetention         = 7
+
+	ChainSourceEmbedded = "embedded"
+	ChainSourceRemote   = "remote"
 )
 
 var (
@@ -211,6 +221,72 @@ func CheckConfig(cfg *Config) *Config {
 	if len(cfg.Wallet.Settings.MaxTxFee) == 0 {
 		cfg.Wallet.Settings.MaxTxFee = DefaultMaxTxFee
 	}
+	if cfg.Wallet.Settings.RebroadcastInterval == 0 {
+		cfg.Wallet.Settings.RebroadcastInterval = DefaultRebroadcastInterval
+	}
+
+	// Checks for Auth
+	if cfg.Wallet.Auth == nil {
+		cfg.Wallet.Auth = &configpb.WalletConfig_Auth{}
+	}
+	if len(cfg.Wallet.Auth.KeyFile) == 0 {
+		cfg.Wallet.Auth.KeyFile = DefaultAuthKeyFile
+	}
+	cfg.Wallet.Auth.KeyFile = cleanAndExpandPath(cfg.Wallet.Auth.KeyFile)
+
+	// Checks for Chain
+	if cfg.Wallet.Chain == nil {
+		cfg.Wallet.Chain = &configpb.WalletConfig_Chain{}
+	}
+	if len(cfg.Wallet.Chain.Source) == 0 {
+		cfg.Wallet.Chain.Source = DefaultChainSource
+	}
+	switch cfg.Wallet.Chain.Source {
+	case ChainSourceEmbedded:
+	case ChainSourceRemote:
+		if len(cfg.Wallet.Chain.RemoteUrl) == 0 {
+			fmt.Fprintln(os.Stderr, errors.New("remote_url is required by remote chain source"))
+			os.Exit(0)
+		}
+		if len(cfg.Wallet.Chain.ListenAddress) > 0 {
+			fmt.Fprintln(os.Stderr, errors.New("listen_address is not supported by remote chain source"))
+			os.Exit(0)
+		}
+	default:
+		fmt.Fprintln(os.Stderr, fmt.Errorf("invalid chain source %s", cfg.Wallet.Chain.Source))
+		os.Exit(0)
+	}
+	if cfg.Wallet.Chain.PollInterval == 0 {
+		cfg.Wallet.Chain.PollInterval = DefaultChainPollInterval
+	}
+
+	// Checks for Metrics
+	if cfg.Wallet.Metrics == nil {
+		cfg.Wallet.Metrics = &configpb.WalletConfig_Metrics{}
+	}
+	if len(cfg.Wallet.Metrics.ListenAddress) == 0 {
+		cfg.Wallet.Metrics.ListenAddress = DefaultMetricsListenAddress
+	}
+
+	// Checks for Backup
+	if cfg.Wallet.Backup == nil {
+		cfg.Wallet.Backup = &configpb.WalletConfig_Backup{}
+	}
+	if len(cfg.Wallet.Backup.Dir) == 0 {
+		cfg.Wallet.Backup.Dir = filepath.Join(cfg.Core.Datastore.Dir, DefaultBackupDir)
+	}
+	cfg.Wallet.Backup.Dir = cleanAndExpandPath(cfg.Wallet.Backup.Dir)
+	if cfg.Wallet.Backup.Retention == 0 {
+		cfg.Wallet.Backup.Retention = DefaultBackupRetention
+	}
+
+	// Checks for Encryption
+	if cfg.Wallet.Encryption == nil {
+		cfg.Wallet.Encryption = &configpb.WalletConfig_Encryption{}
+	}
+	if len(cfg.Wallet.Encryption.Passphrase) == 0 {
+		cfg.Wallet.Encryption.Passphrase = cfg.Wallet.PubPass
+	}
 
 	return cfg
 }
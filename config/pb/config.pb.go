@@ -28,9 +28,14 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 type WalletConfig struct {
-	PubPass  string                 `protobuf:"bytes,1,opt,name=pub_pass,json=pubPass,proto3" json:"pub_pass"`
-	API      *WalletConfig_API      `protobuf:"bytes,2,opt,name=api" json:"api"`
-	Settings *WalletConfig_Settings `protobuf:"bytes,3,opt,name=settings" json:"settings"`
+	PubPass    string                   `protobuf:"bytes,1,opt,name=pub_pass,json=pubPass,proto3" json:"pub_pass"`
+	API        *WalletConfig_API        `protobuf:"bytes,2,opt,name=api" json:"api"`
+	Settings   *WalletConfig_Settings   `protobuf:"bytes,3,opt,name=settings" json:"settings"`
+	Auth       *WalletConfig_Auth       `protobuf:"bytes,4,opt,name=auth" json:"auth"`
+	Chain      *WalletConfig_Chain      `protobuf:"bytes,5,opt,name=chain" json:"chain"`
+	Metrics    *WalletConfig_Metrics    `protobuf:"bytes,6,opt,name=metrics" json:"metrics"`
+	Backup     *WalletConfig_Backup     `protobuf:"bytes,7,opt,name=backup" json:"backup"`
+	Encryption *WalletConfig_Encryption `protobuf:"bytes,8,opt,name=encryption" json:"encryption"`
 }
 
 func (m *WalletConfig) Reset()                    { *m = WalletConfig{} }
@@ -59,6 +64,41 @@ func (m *WalletConfig) GetSettings() *WalletConfig_Settings {
 	return nil
 }
 
+func (m *WalletConfig) GetAuth() *WalletConfig_Auth {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
+func (m *WalletConfig) GetChain() *WalletConfig_Chain {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
+func (m *WalletConfig) GetMetrics() *WalletConfig_Metrics {
+	if m != nil {
+		return m.Metrics
+	}
+	return nil
+}
+
+func (m *WalletConfig) GetBackup() *WalletConfig_Backup {
+	if m != nil {
+		return m.Backup
+	}
+	return nil
+}
+
+func (m *WalletConfig) GetEncryption() *WalletConfig_Encryption {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
 type WalletConfig_API struct {
 	Host         string   `protobuf:"bytes,1,opt,name=host,proto3" json:"host"`
 	GRPCPort     string   `protobuf:"bytes,2,opt,name=grpc_port,json=grpcPort,proto3" json:"grpc_port"`
@@ -127,6 +167,7 @@ type WalletConfig_Settings struct {
 	AddressGapLimit         uint32 `protobuf:"varint,1,opt,name=address_gap_limit,json=addressGapLimit,proto3" json:"address_gap_limit"`
 	MaxUnusedStakingAddress uint32 `protobuf:"varint,2,opt,name=max_unused_staking_address,json=maxUnusedStakingAddress,proto3" json:"max_unused_staking_address"`
 	MaxTxFee                string `protobuf:"bytes,3,opt,name=max_tx_fee,json=maxTxFee,proto3" json:"max_tx_fee"`
+	RebroadcastInterval     uint32 `protobuf:"varint,4,opt,name=rebroadcast_interval,json=rebroadcastInterval,proto3" json:"rebroadcast_interval"`
 }
 
 func (m *WalletConfig_Settings) Reset()                    { *m = WalletConfig_Settings{} }
@@ -155,38 +196,223 @@ func (m *WalletConfig_Settings) GetMaxTxFee() string {
 	return ""
 }
 
+func (m *WalletConfig_Settings) GetRebroadcastInterval() uint32 {
+	if m != nil {
+		return m.RebroadcastInterval
+	}
+	return 0
+}
+
+type WalletConfig_Auth struct {
+	Enable  bool   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable"`
+	KeyFile string `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file"`
+}
+
+func (m *WalletConfig_Auth) Reset()                    { *m = WalletConfig_Auth{} }
+func (m *WalletConfig_Auth) String() string            { return proto.CompactTextString(m) }
+func (*WalletConfig_Auth) ProtoMessage()               {}
+func (*WalletConfig_Auth) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{0, 2} }
+
+func (m *WalletConfig_Auth) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *WalletConfig_Auth) GetKeyFile() string {
+	if m != nil {
+		return m.KeyFile
+	}
+	return ""
+}
+
+type WalletConfig_Chain struct {
+	Source        string `protobuf:"bytes,1,opt,name=source,proto3" json:"source"`
+	RemoteUrl     string `protobuf:"bytes,2,opt,name=remote_url,json=remoteUrl,proto3" json:"remote_url"`
+	PollInterval  uint32 `protobuf:"varint,3,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval"`
+	ListenAddress string `protobuf:"bytes,4,opt,name=listen_address,json=listenAddress,proto3" json:"listen_address"`
+}
+
+func (m *WalletConfig_Chain) Reset()                    { *m = WalletConfig_Chain{} }
+func (m *WalletConfig_Chain) String() string            { return proto.CompactTextString(m) }
+func (*WalletConfig_Chain) ProtoMessage()               {}
+func (*WalletConfig_Chain) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{0, 3} }
+
+func (m *WalletConfig_Chain) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *WalletConfig_Chain) GetRemoteUrl() string {
+	if m != nil {
+		return m.RemoteUrl
+	}
+	return ""
+}
+
+func (m *WalletConfig_Chain) GetPollInterval() uint32 {
+	if m != nil {
+		return m.PollInterval
+	}
+	return 0
+}
+
+func (m *WalletConfig_Chain) GetListenAddress() string {
+	if m != nil {
+		return m.ListenAddress
+	}
+	return ""
+}
+
+type WalletConfig_Metrics struct {
+	Enable        bool   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable"`
+	ListenAddress string `protobuf:"bytes,2,opt,name=listen_address,json=listenAddress,proto3" json:"listen_address"`
+}
+
+func (m *WalletConfig_Metrics) Reset()                    { *m = WalletConfig_Metrics{} }
+func (m *WalletConfig_Metrics) String() string            { return proto.CompactTextString(m) }
+func (*WalletConfig_Metrics) ProtoMessage()               {}
+func (*WalletConfig_Metrics) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{0, 4} }
+
+func (m *WalletConfig_Metrics) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *WalletConfig_Metrics) GetListenAddress() string {
+	if m != nil {
+		return m.ListenAddress
+	}
+	return ""
+}
+
+type WalletConfig_Backup struct {
+	Dir       string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir"`
+	Interval  uint32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval"`
+	Retention uint32 `protobuf:"varint,3,opt,name=retention,proto3" json:"retention"`
+}
+
+func (m *WalletConfig_Backup) Reset()                    { *m = WalletConfig_Backup{} }
+func (m *WalletConfig_Backup) String() string            { return proto.CompactTextString(m) }
+func (*WalletConfig_Backup) ProtoMessage()               {}
+func (*WalletConfig_Backup) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{0, 5} }
+
+func (m *WalletConfig_Backup) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *WalletConfig_Backup) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *WalletConfig_Backup) GetRetention() uint32 {
+	if m != nil {
+		return m.Retention
+	}
+	return 0
+}
+
+type WalletConfig_Encryption struct {
+	Enable      bool   `protobuf:"varint,1,opt,name=enable,proto3" json:"enable"`
+	EncryptKeys bool   `protobuf:"varint,2,opt,name=encrypt_keys,json=encryptKeys,proto3" json:"encrypt_keys"`
+	Passphrase  string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase"`
+}
+
+func (m *WalletConfig_Encryption) Reset()         { *m = WalletConfig_Encryption{} }
+func (m *WalletConfig_Encryption) String() string { return proto.CompactTextString(m) }
+func (*WalletConfig_Encryption) ProtoMessage()    {}
+func (*WalletConfig_Encryption) Descriptor() ([]byte, []int) {
+	return fileDescriptorConfig, []int{0, 6}
+}
+
+func (m *WalletConfig_Encryption) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *WalletConfig_Encryption) GetEncryptKeys() bool {
+	if m != nil {
+		return m.EncryptKeys
+	}
+	return false
+}
+
+func (m *WalletConfig_Encryption) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*WalletConfig)(nil), "configpb.WalletConfig")
 	proto.RegisterType((*WalletConfig_API)(nil), "configpb.WalletConfig.API")
 	proto.RegisterType((*WalletConfig_Settings)(nil), "configpb.WalletConfig.Settings")
+	proto.RegisterType((*WalletConfig_Auth)(nil), "configpb.WalletConfig.Auth")
+	proto.RegisterType((*WalletConfig_Chain)(nil), "configpb.WalletConfig.Chain")
+	proto.RegisterType((*WalletConfig_Metrics)(nil), "configpb.WalletConfig.Metrics")
+	proto.RegisterType((*WalletConfig_Backup)(nil), "configpb.WalletConfig.Backup")
+	proto.RegisterType((*WalletConfig_Encryption)(nil), "configpb.WalletConfig.Encryption")
 }
 
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 372 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x8f, 0x93, 0x40,
-	0x18, 0xc6, 0x83, 0xac, 0x5d, 0x78, 0xb7, 0xab, 0x71, 0x2e, 0x8b, 0x68, 0xb2, 0xc4, 0x78, 0x20,
-	0xc6, 0x70, 0xd0, 0x63, 0x4f, 0xa4, 0x89, 0xa6, 0xd1, 0x03, 0x99, 0xd6, 0x78, 0x9c, 0x0c, 0x30,
-	0xa5, 0xa4, 0xc0, 0x4c, 0xe6, 0x1d, 0x12, 0xfa, 0x31, 0xfc, 0x62, 0x7e, 0x1f, 0x6f, 0x66, 0x06,
-	0x6a, 0xbc, 0xec, 0xed, 0xe5, 0xf9, 0x3d, 0x0f, 0xef, 0x9f, 0x0c, 0xac, 0x2b, 0x39, 0x1c, 0xdb,
-	0x26, 0x53, 0x5a, 0x1a, 0x49, 0x82, 0xf9, 0x4b, 0x95, 0xef, 0xfe, 0xf8, 0xb0, 0xfe, 0xc9, 0xbb,
-	0x4e, 0x98, 0xad, 0x93, 0xc8, 0x6b, 0x08, 0xd4, 0x58, 0x32, 0xc5, 0x11, 0x23, 0x2f, 0xf1, 0xd2,
-	0x90, 0xde, 0xaa, 0xb1, 0x2c, 0x38, 0x22, 0xf9, 0x08, 0x3e, 0x57, 0x6d, 0xf4, 0x2c, 0xf1, 0xd2,
-	0xbb, 0x4f, 0x71, 0x76, 0xfd, 0x47, 0xf6, 0x7f, 0x3e, 0xcb, 0x8b, 0x1d, 0xb5, 0x36, 0xb2, 0x81,
-	0x00, 0x85, 0x31, 0xed, 0xd0, 0x60, 0xe4, 0xbb, 0xc8, 0xe3, 0x13, 0x91, 0xfd, 0x62, 0xa3, 0xff,
-	0x02, 0xf1, 0x6f, 0x0f, 0xfc, 0xbc, 0xd8, 0x11, 0x02, 0x37, 0x27, 0x89, 0x66, 0x99, 0xc4, 0xd5,
-	0xe4, 0x0d, 0x84, 0x8d, 0x56, 0x15, 0x53, 0x52, 0x1b, 0x37, 0x4c, 0x48, 0x03, 0x2b, 0x14, 0x52,
-	0x3b, 0x78, 0x32, 0x46, 0xcd, 0xd0, 0x9f, 0xa1, 0x15, 0x1c, 0x7c, 0x0f, 0x2f, 0x1c, 0xac, 0xa4,
-	0x46, 0xc6, 0xeb, 0x5a, 0x47, 0x37, 0x89, 0x9f, 0x86, 0x74, 0x6d, 0xd5, 0xad, 0xd4, 0x98, 0xd7,
-	0xb5, 0x26, 0x8f, 0x70, 0x57, 0xb7, 0xc8, 0xcb, 0x4e, 0x30, 0xd3, 0x61, 0xf4, 0x3c, 0xf1, 0xd2,
-	0x80, 0xc2, 0x22, 0x1d, 0x3a, 0xb4, 0x27, 0xb2, 0xfd, 0x2b, 0xa1, 0x4d, 0xb4, 0x9a, 0x4f, 0xa4,
-	0x55, 0xb5, 0x15, 0xda, 0x90, 0x07, 0xb0, 0x25, 0x3b, 0x8b, 0x4b, 0x74, 0xeb, 0xc8, 0x4a, 0xab,
-	0xea, 0x9b, 0xb8, 0xc4, 0xbf, 0x3c, 0x08, 0xae, 0x7b, 0x92, 0x0f, 0xf0, 0xca, 0x76, 0x17, 0x88,
-	0xac, 0xe1, 0x8a, 0x75, 0x6d, 0xdf, 0xce, 0x2b, 0xde, 0xd3, 0x97, 0x0b, 0xf8, 0xca, 0xd5, 0x77,
-	0x2b, 0x93, 0x0d, 0xc4, 0x3d, 0x9f, 0xd8, 0x38, 0x8c, 0x28, 0x6a, 0x86, 0x86, 0x9f, 0xdb, 0xa1,
-	0x61, 0x8b, 0xcb, 0xad, 0x7f, 0x4f, 0x1f, 0x7a, 0x3e, 0xfd, 0x70, 0x86, 0xfd, 0xcc, 0xf3, 0x19,
-	0x93, 0xb7, 0x00, 0x36, 0x6c, 0x26, 0x76, 0x14, 0xe2, 0x7a, 0x8e, 0x9e, 0x4f, 0x87, 0xe9, 0x8b,
-	0x10, 0xe5, 0xca, 0x3d, 0x86, 0xcf, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xab, 0xbd, 0xaa, 0x8a,
-	0x1c, 0x02, 0x00, 0x00,
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xdb, 0x6e, 0xd3, 0x30,
+	0x18, 0x56, 0xd7, 0xae, 0x4d, 0xfe, 0xb5, 0x03, 0x0c, 0x62, 0x21, 0x3b, 0x73, 0x90, 0x26, 0x84,
+	0x8a, 0x18, 0x42, 0x02, 0xed, 0xaa, 0x54, 0x0c, 0xa6, 0x81, 0x54, 0x79, 0x9b, 0xb8, 0x8c, 0x9c,
+	0xe4, 0x5f, 0x6b, 0x35, 0x4d, 0x2c, 0xdb, 0x41, 0xed, 0x2b, 0xf0, 0x00, 0x3c, 0x11, 0xd7, 0x3c,
+	0x13, 0xb2, 0xe3, 0x76, 0x95, 0x20, 0x77, 0xf6, 0x77, 0xb0, 0xff, 0x93, 0x0d, 0xdd, 0xa4, 0xc8,
+	0x6f, 0xf9, 0xb8, 0x2f, 0x64, 0xa1, 0x0b, 0xe2, 0x55, 0x3b, 0x11, 0x3f, 0xfd, 0x05, 0xd0, 0xfd,
+	0xce, 0xb2, 0x0c, 0xf5, 0xd0, 0x42, 0xe4, 0x09, 0x78, 0xa2, 0x8c, 0x23, 0xc1, 0x94, 0x0a, 0x1a,
+	0x47, 0x8d, 0x13, 0x9f, 0x76, 0x44, 0x19, 0x8f, 0x98, 0x52, 0xe4, 0x15, 0x34, 0x99, 0xe0, 0xc1,
+	0xc6, 0x51, 0xe3, 0x64, 0xeb, 0x34, 0xec, 0x2f, 0xcf, 0xe8, 0xaf, 0xfb, 0xfb, 0x83, 0xd1, 0x05,
+	0x35, 0x32, 0x72, 0x06, 0x9e, 0x42, 0xad, 0x79, 0x3e, 0x56, 0x41, 0xd3, 0x5a, 0x0e, 0x6b, 0x2c,
+	0x57, 0x4e, 0x46, 0x57, 0x06, 0xf2, 0x1a, 0x5a, 0xac, 0xd4, 0x93, 0xa0, 0x65, 0x8d, 0xbb, 0x75,
+	0x77, 0x95, 0x7a, 0x42, 0xad, 0x90, 0x9c, 0xc2, 0x66, 0x32, 0x61, 0x3c, 0x0f, 0x36, 0xad, 0x63,
+	0xaf, 0xc6, 0x31, 0x34, 0x1a, 0x5a, 0x49, 0xc9, 0x7b, 0xe8, 0xcc, 0x50, 0x4b, 0x9e, 0xa8, 0xa0,
+	0x6d, 0x5d, 0x07, 0x35, 0xae, 0x6f, 0x95, 0x8a, 0x2e, 0xe5, 0xe4, 0x1d, 0xb4, 0x63, 0x96, 0x4c,
+	0x4b, 0x11, 0x74, 0xac, 0x71, 0xbf, 0xc6, 0xf8, 0xd1, 0x8a, 0xa8, 0x13, 0x93, 0x01, 0x00, 0xe6,
+	0x89, 0x5c, 0x08, 0xcd, 0x8b, 0x3c, 0xf0, 0xac, 0xf5, 0xb8, 0xc6, 0xfa, 0x69, 0x25, 0xa4, 0x6b,
+	0xa6, 0xf0, 0x4f, 0x03, 0x9a, 0x83, 0xd1, 0x05, 0x21, 0xd0, 0x9a, 0x14, 0x4a, 0xbb, 0x16, 0xd9,
+	0x35, 0xd9, 0x05, 0x7f, 0x2c, 0x45, 0x12, 0x89, 0x42, 0x6a, 0xdb, 0x25, 0x9f, 0x7a, 0x06, 0x18,
+	0x15, 0xd2, 0x92, 0x13, 0xad, 0x45, 0x45, 0x36, 0x2b, 0xd2, 0x00, 0x96, 0x7c, 0x0e, 0xdb, 0x96,
+	0x4c, 0x0a, 0xa9, 0x22, 0x96, 0xa6, 0x32, 0x68, 0x1d, 0x35, 0x4f, 0x7c, 0xda, 0x35, 0xe8, 0xb0,
+	0x90, 0x6a, 0x90, 0xa6, 0x92, 0x1c, 0xc2, 0x56, 0xca, 0x15, 0x8b, 0x33, 0x8c, 0x74, 0xa6, 0x6c,
+	0xa5, 0x3d, 0x0a, 0x0e, 0xba, 0xce, 0x94, 0x99, 0x1d, 0x73, 0x7f, 0x82, 0x52, 0xdb, 0x8a, 0xfa,
+	0xb4, 0x23, 0x45, 0x32, 0x44, 0xa9, 0xc9, 0x0e, 0x98, 0x65, 0x34, 0xc5, 0x85, 0x2d, 0x99, 0x4f,
+	0xdb, 0x52, 0x24, 0x97, 0xb8, 0x08, 0x7f, 0x37, 0xc0, 0x5b, 0x0e, 0x00, 0x79, 0x09, 0x0f, 0xcc,
+	0xed, 0xa8, 0x54, 0x34, 0x66, 0x22, 0xca, 0xf8, 0x8c, 0x57, 0x29, 0xf6, 0xe8, 0x3d, 0x47, 0x7c,
+	0x66, 0xe2, 0xab, 0x81, 0xc9, 0x19, 0x84, 0x33, 0x36, 0x8f, 0xca, 0xbc, 0x54, 0x98, 0x46, 0x4a,
+	0xb3, 0x29, 0xcf, 0xc7, 0x91, 0x53, 0xd9, 0xf4, 0x7b, 0x74, 0x67, 0xc6, 0xe6, 0x37, 0x56, 0x70,
+	0x55, 0xf1, 0x83, 0x8a, 0x26, 0x7b, 0x00, 0xc6, 0xac, 0xe7, 0xd1, 0x2d, 0xe2, 0xb2, 0x1c, 0x33,
+	0x36, 0xbf, 0x9e, 0x9f, 0x23, 0x92, 0x37, 0xf0, 0x48, 0x62, 0x2c, 0x0b, 0x96, 0x26, 0x4c, 0xe9,
+	0x88, 0xe7, 0x1a, 0xe5, 0x0f, 0x96, 0xd9, 0x69, 0xec, 0xd1, 0x87, 0x6b, 0xdc, 0x85, 0xa3, 0xc2,
+	0x0f, 0xd0, 0x32, 0xd3, 0x48, 0x1e, 0x43, 0x1b, 0x73, 0x53, 0x0f, 0x1b, 0xb6, 0x47, 0xdd, 0xce,
+	0x94, 0x66, 0x8a, 0x8b, 0xe8, 0x96, 0x67, 0xe8, 0x5a, 0xd3, 0x99, 0xe2, 0xe2, 0x9c, 0x67, 0x18,
+	0xfe, 0x6c, 0xc0, 0xa6, 0x9d, 0x4b, 0x63, 0x56, 0x45, 0x29, 0x13, 0x74, 0x6d, 0x75, 0x3b, 0xb2,
+	0x0f, 0x20, 0x71, 0x56, 0x68, 0x8c, 0x4a, 0x99, 0x39, 0xbb, 0x5f, 0x21, 0x37, 0x32, 0x23, 0xcf,
+	0xa0, 0x27, 0x8a, 0x2c, 0xbb, 0x8b, 0xb3, 0x69, 0xe3, 0xec, 0x1a, 0x70, 0x19, 0x20, 0x79, 0x01,
+	0xdb, 0x19, 0x57, 0x1a, 0xf3, 0x55, 0x89, 0x5a, 0xf6, 0x9c, 0x5e, 0x85, 0xba, 0xc2, 0x84, 0x5f,
+	0xa0, 0xe3, 0xa6, 0xbd, 0x36, 0x95, 0x7f, 0x4f, 0xda, 0xf8, 0xdf, 0x49, 0xd7, 0xd0, 0xae, 0xc6,
+	0x9f, 0xdc, 0x87, 0x66, 0xca, 0xa5, 0xcb, 0xc9, 0x2c, 0x49, 0x08, 0xde, 0x2a, 0xd8, 0xaa, 0x53,
+	0xab, 0x3d, 0xd9, 0x03, 0x5f, 0xa2, 0xc6, 0xdc, 0xbe, 0x91, 0x2a, 0x93, 0x3b, 0x20, 0x1c, 0x03,
+	0xdc, 0xbd, 0x8c, 0xda, 0x10, 0x8f, 0xa1, 0xeb, 0xde, 0x8c, 0x99, 0xb8, 0x2a, 0x40, 0x8f, 0x6e,
+	0x39, 0xec, 0x12, 0x17, 0x8a, 0x1c, 0x00, 0x98, 0x3f, 0x4e, 0x4c, 0x24, 0x53, 0xcb, 0x09, 0x58,
+	0x43, 0xe2, 0xb6, 0xfd, 0x29, 0xdf, 0xfe, 0x1d, 0x00, 0xe5, 0x5e, 0x02, 0xfc, 0x39, 0x05, 0x00,
+	0x00,
 }
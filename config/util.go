@@ -260,6 +260,27 @@ func NewDefWalletConfig() *configpb.WalletConfig {
 			AddressGapLimit:         DefaultAddressGapLimit,
 			MaxUnusedStakingAddress: DefaultMaxUnusedStakingAddress,
 			MaxTxFee:                DefaultMaxTxFee,
+			RebroadcastInterval:     DefaultRebroadcastInterval,
+		},
+		Auth: &configpb.WalletConfig_Auth{
+			Enable:  false,
+			KeyFile: DefaultAuthKeyFile,
+		},
+		Chain: &configpb.WalletConfig_Chain{
+			Source:       DefaultChainSource,
+			PollInterval: DefaultChainPollInterval,
+		},
+		Metrics: &configpb.WalletConfig_Metrics{
+			Enable:        false,
+			ListenAddress: DefaultMetricsListenAddress,
+		},
+		Backup: &configpb.WalletConfig_Backup{
+			Interval:  0,
+			Retention: DefaultBackupRetention,
+		},
+		Encryption: &configpb.WalletConfig_Encryption{
+			Enable:      false,
+			EncryptKeys: false,
 		},
 	}
 }
@@ -15,6 +15,9 @@ import (
 	"massnet.org/mass-wallet/config"
 	"massnet.org/mass-wallet/masswallet"
 	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/db/encdb"
+	"massnet.org/mass-wallet/masswallet/remote"
+	"massnet.org/mass-wallet/metrics"
 )
 
 const (
@@ -44,7 +47,9 @@ var (
 // Loader is safe for concurrent access.
 type Loader struct {
 	massServer  *server
+	remoteNode  *remote.Node // followed instead of massServer if not nil
 	apiServer   *api.APIServer
+	metrics     *metrics.Server // nil if metrics are disabled
 	callbacks   []func(*masswallet.WalletManager)
 	chainParams *config.Params
 	cfg         *config.Config
@@ -55,10 +60,11 @@ type Loader struct {
 // NewLoader constructs a Loader with an optional recovery window. If the
 // recovery window is non-zero, the wallet will attempt to recovery addresses
 // starting from the last SyncedTo height.
-func NewLoader(massServer *server, chainParams *config.Params, cfg *config.Config) *Loader {
+func NewLoader(massServer *server, remoteNode *remote.Node, chainParams *config.Params, cfg *config.Config) *Loader {
 
 	return &Loader{
 		massServer:  massServer,
+		remoteNode:  remoteNode,
 		chainParams: chainParams,
 		cfg:         cfg,
 	}
@@ -73,7 +79,12 @@ func (l *Loader) onLoaded(w *masswallet.WalletManager) (err error) {
 
 	l.walletMgr = w
 	l.callbacks = nil // not needed anymore
-	l.apiServer, err = api.NewAPIServer(l.massServer, w, func() { interruptChannel <- os.Interrupt }, l.cfg)
+	quitClient := func() { interruptChannel <- os.Interrupt }
+	if l.remoteNode != nil {
+		l.apiServer, err = api.NewRemoteAPIServer(l.remoteNode, w, quitClient, l.cfg)
+	} else {
+		l.apiServer, err = api.NewAPIServer(l.massServer, w, quitClient, l.cfg)
+	}
 	if err != nil {
 		return
 	}
@@ -81,9 +92,26 @@ func (l *Loader) onLoaded(w *masswallet.WalletManager) (err error) {
 		return
 	}
 	l.apiServer.RunGateway()
+
+	if m := l.cfg.Wallet.Metrics; m != nil && m.Enable {
+		w.RegisterMetrics(metrics.DefaultRegistry)
+		l.metrics = metrics.NewServer(m.ListenAddress, metrics.DefaultRegistry)
+		if err = l.metrics.Start(); err != nil {
+			l.metrics = nil
+			return
+		}
+	}
 	return
 }
 
+// walletChain returns the chain source followed by the wallet.
+func (l *Loader) walletChain() masswallet.Server {
+	if l.remoteNode != nil {
+		return l.remoteNode
+	}
+	return l.massServer.WalletChain()
+}
+
 // RunAfterLoad adds a function to be executed when the loader creates or opens
 // a wallet.  Functions are executed in a single goroutine in the order they are
 // added.
@@ -108,12 +136,12 @@ func (l *Loader) createWallet(dbPath string) (*masswallet.WalletManager, error)
 		return nil, ErrLoaded
 	}
 
-	db, err := mwdb.CreateDB(l.cfg.Core.Datastore.DBType, dbPath)
+	db, err := l.createWalletDB(dbPath)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "Error opening database", logging.LogFormat{"err": err})
 		return nil, err
 	}
-	w, err := masswallet.NewWalletManager(l.massServer, db, l.cfg, l.chainParams, l.cfg.Wallet.PubPass)
+	w, err := masswallet.NewWalletManager(l.walletChain(), db, l.cfg, l.chainParams, l.cfg.Wallet.PubPass)
 	if err != nil {
 		if e := db.Close(); e != nil {
 			logging.CPrint(logging.WARN, "Error closing database", logging.LogFormat{"dbPath": dbPath, "err": err})
@@ -141,11 +169,11 @@ func (l *Loader) openWallet(dbPath string) (*masswallet.WalletManager, error) {
 		return nil, ErrLoaded
 	}
 
-	db, err := mwdb.OpenDB(l.cfg.Core.Datastore.DBType, dbPath)
+	db, err := l.openWalletDB(dbPath)
 	if err != nil {
 		return nil, err
 	}
-	w, err := masswallet.NewWalletManager(l.massServer, db, l.cfg, l.chainParams, l.cfg.Wallet.PubPass)
+	w, err := masswallet.NewWalletManager(l.walletChain(), db, l.cfg, l.chainParams, l.cfg.Wallet.PubPass)
 	if err != nil {
 		if e := db.Close(); e != nil {
 			logging.CPrint(logging.WARN, "Error closing database", logging.LogFormat{"dbPath": dbPath, "err": err})
@@ -164,6 +192,39 @@ func (l *Loader) openWallet(dbPath string) (*masswallet.WalletManager, error) {
 	return w, nil
 }
 
+// createWalletDB creates the wallet db, encrypted if wallet.encryption is
+// enabled.
+func (l *Loader) createWalletDB(dbPath string) (mwdb.DB, error) {
+	dbType := l.cfg.Core.Datastore.DBType
+	if e := l.cfg.Wallet.Encryption; e != nil && e.Enable {
+		return mwdb.CreateDB(encdb.DBType, dbPath, dbType, e.Passphrase, &encdb.Options{EncryptKeys: e.EncryptKeys})
+	}
+	return mwdb.CreateDB(dbType, dbPath)
+}
+
+// openWalletDB opens the wallet db, which must be encrypted if and only if
+// wallet.encryption is enabled.
+func (l *Loader) openWalletDB(dbPath string) (mwdb.DB, error) {
+	dbType := l.cfg.Core.Datastore.DBType
+	if e := l.cfg.Wallet.Encryption; e != nil && e.Enable {
+		return mwdb.OpenDB(encdb.DBType, dbPath, dbType, e.Passphrase)
+	}
+	db, err := mwdb.OpenDB(dbType, dbPath)
+	if err != nil {
+		return nil, err
+	}
+	// read as plain, an encrypted db would be taken for one of an old schema
+	encrypted, err := encdb.IsEncrypted(db)
+	if err == nil && encrypted {
+		err = encdb.ErrEncrypted
+	}
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
 // LoadedWalletManager returns the loaded wallet, if any, and a bool for whether the
 // wallet has been loaded or not.  If true, the wallet pointer should be safe to
 // dereference.
@@ -212,11 +273,16 @@ func (l *Loader) UnloadWallet() error {
 		return nil
 	}
 
+	if l.metrics != nil {
+		l.metrics.Stop()
+	}
 	l.apiServer.Stop()
 	l.walletMgr.Stop()
 
 	l.massServer = nil
+	l.remoteNode = nil
 	l.apiServer = nil
+	l.metrics = nil
 	l.callbacks = nil
 	l.walletMgr = nil
 	return nil
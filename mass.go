@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/massnetorg/mass-core/blockchain/state"
 	"github.com/massnetorg/mass-core/database"
@@ -26,7 +27,9 @@ import (
 	"github.com/massnetorg/mass-core/version"
 	"massnet.org/mass-wallet/config"
 	_ "massnet.org/mass-wallet/masswallet/db/ldb"
+	_ "massnet.org/mass-wallet/masswallet/db/memdb"
 	_ "massnet.org/mass-wallet/masswallet/db/rdb"
+	"massnet.org/mass-wallet/masswallet/remote"
 )
 
 var (
@@ -72,6 +75,10 @@ func massMain(serverChan chan<- *server) error {
 		}()
 	}
 
+	if cfg.Wallet.Chain.Source == config.ChainSourceRemote {
+		return remoteMain()
+	}
+
 	bindingDb, err := openStateDatabase(cfg.Core.Datastore.Dir, "bindingstate", 0, 0, "", false)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to load binding database", logging.LogFormat{"err": err})
@@ -96,14 +103,29 @@ func massMain(serverChan chan<- *server) error {
 	}
 
 	// Load wallet
-	loader := NewLoader(server, config.ChainParams, cfg)
+	loader := NewLoader(server, nil, config.ChainParams, cfg)
 	if err = loader.LoadWallet(); err != nil {
 		bindingDb.Close()
 		db.Close()
 		return err
 	}
 
+	// Serve the chain api to remote wallets if requested.
+	var chainAPI *remote.Server
+	if addr := cfg.Wallet.Chain.ListenAddress; len(addr) > 0 {
+		chainAPI = remote.NewServer(addr, db, &walletChain{server: server})
+		if err = chainAPI.Start(); err != nil {
+			loader.UnloadWallet()
+			bindingDb.Close()
+			db.Close()
+			return err
+		}
+	}
+
 	addInterruptHandler(func() {
+		if chainAPI != nil {
+			chainAPI.Stop()
+		}
 		server.Stop()
 		err := loader.UnloadWallet()
 		if err != nil {
@@ -141,6 +163,42 @@ func massMain(serverChan chan<- *server) error {
 	return nil
 }
 
+// remoteMain runs the wallet following a remote full node instead of embedding
+// the chain.
+func remoteMain() error {
+	node := remote.NewNode(cfg.Wallet.Chain.RemoteUrl, time.Duration(cfg.Wallet.Chain.PollInterval)*time.Second)
+	if err := node.Start(); err != nil {
+		return err
+	}
+	if chainID := config.ChainParams.ChainID.String(); node.ChainID() != chainID {
+		node.Stop()
+		err := fmt.Errorf("remote node is on chain %s, expect %s", node.ChainID(), chainID)
+		logging.CPrint(logging.ERROR, "mismatched remote node", logging.LogFormat{"err": err})
+		return err
+	}
+
+	loader := NewLoader(nil, node, config.ChainParams, cfg)
+	if err := loader.LoadWallet(); err != nil {
+		node.Stop()
+		return err
+	}
+
+	addInterruptHandler(func() {
+		err := loader.UnloadWallet()
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to unload wallet", logging.LogFormat{"err": err})
+		}
+		node.Stop()
+		closeDbChannel <- struct{}{}
+	})
+
+	go func() {
+		shutdownChannel <- (<-closeDbChannel)
+	}()
+	<-shutdownChannel
+	return nil
+}
+
 func main() {
 	// Use all processor cores.
 	runtime.GOMAXPROCS(runtime.NumCPU())
@@ -0,0 +1,134 @@
+package masswallet
+
+import (
+	"os"
+	"time"
+
+	"github.com/massnetorg/mass-core/logging"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/backup"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/db/encdb"
+	"massnet.org/mass-wallet/masswallet/migration"
+)
+
+// BackupWallet writes the wallet db, including keystores, sync state and
+// histories of all wallets, to a new archive at path. The archive is read
+// from a snapshot of the db, so it is consistent while the wallet runs. The
+// archive of an encrypted db holds its encrypted entries.
+func (w *WalletManager) BackupWallet(path string) (*backup.FileSummary, error) {
+	if len(path) == 0 {
+		return nil, ErrInvalidParameter
+	}
+	var s *backup.FileSummary
+	// tx reads the wallet db, raw the entries written to the archive
+	write := func(tx, raw mwdb.ReadTransaction, encrypted bool) error {
+		meta, err := w.backupMetadata(tx)
+		if err != nil {
+			return err
+		}
+		if encrypted {
+			// wallet ids are not revealed by the archive of an encrypted db
+			meta.Encrypted = true
+			meta.Wallets = nil
+		}
+		s, err = backup.WriteFile(path, raw, meta)
+		return err
+	}
+	var err error
+	if edb, ok := w.db.(*encdb.DB); ok {
+		err = mwdb.View(edb.Inner(), func(raw mwdb.ReadTransaction) error {
+			return write(edb.ReadTx(raw), raw, true)
+		})
+	} else {
+		err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+			return write(tx, tx, false)
+		})
+	}
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to back up wallet db", logging.LogFormat{
+			"path": path,
+			"err":  err,
+		})
+		return nil, err
+	}
+	logging.CPrint(logging.INFO, "wallet db backed up", logging.LogFormat{
+		"path":    path,
+		"size":    s.Size,
+		"entries": s.Entries,
+		"height":  s.Metadata.SyncedHeight,
+	})
+	return s, nil
+}
+
+func (w *WalletManager) backupMetadata(tx mwdb.ReadTransaction) (*backup.Metadata, error) {
+	version, err := migration.ReadVersion(tx)
+	if err != nil {
+		return nil, err
+	}
+	syncedTo, err := w.syncStore.SyncedTo(tx)
+	if err != nil {
+		return nil, err
+	}
+	wss, err := w.syncStore.GetAllWalletStatus(tx)
+	if err != nil {
+		return nil, err
+	}
+	wallets := make([]string, 0, len(wss))
+	for _, ws := range wss {
+		if !ws.IsRemoved() {
+			wallets = append(wallets, ws.WalletID)
+		}
+	}
+	return &backup.Metadata{
+		CreatedAt:     time.Now().Unix(),
+		SchemaVersion: version,
+		ChainID:       w.chainParams.ChainID.String(),
+		SyncedHeight:  syncedTo.Height,
+		Wallets:       wallets,
+	}, nil
+}
+
+// autoBackup backs up the wallet db to the backup dir every backup interval,
+// keeping the latest archives up to the retention.
+func autoBackup(h *NtfnsHandler) {
+	defer Recover()
+	defer h.quitWg.Done()
+
+	cfg := h.walletMgr.config.Wallet.Backup
+	retention := int(cfg.Retention)
+	if retention == 0 {
+		retention = config.DefaultBackupRetention
+	}
+	if err := os.MkdirAll(cfg.Dir, 0700); err != nil {
+		logging.CPrint(logging.ERROR, "failed to create backup dir", logging.LogFormat{
+			"dir": cfg.Dir,
+			"err": err,
+		})
+		return
+	}
+	ticker := time.NewTicker(time.Duration(cfg.Interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.quit:
+			return
+		case now := <-ticker.C:
+			if _, err := h.walletMgr.BackupWallet(backup.AutoPath(cfg.Dir, now)); err != nil {
+				continue
+			}
+			removed, err := backup.Prune(cfg.Dir, retention)
+			if err != nil {
+				logging.CPrint(logging.WARN, "failed to prune backups", logging.LogFormat{
+					"dir": cfg.Dir,
+					"err": err,
+				})
+			}
+			if len(removed) > 0 {
+				logging.CPrint(logging.INFO, "pruned backups", logging.LogFormat{"removed": removed})
+			}
+		}
+	}
+}
@@ -0,0 +1,352 @@
+// Package backup writes the wallet db to a single archive file and restores
+// it.
+//
+// An archive is a gzip stream of
+//
+//	magic "MWBACKUP" | format version (uint32) | metadata (uvarint length, json)
+//	records...
+//	'Z' | entry count (uvarint) | sha256 of all the bytes before the hash
+//
+// where a record is either
+//
+//	'B' | depth (uvarint) | names (uvarint length, bytes)...    enters a bucket
+//	'E' | key (uvarint length, bytes) | value (uvarint length, bytes)
+//
+// entries belong to the bucket entered last.
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash"
+	"io"
+	"io/ioutil"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+)
+
+const (
+	magic         = "MWBACKUP"
+	formatVersion = uint32(1)
+
+	recordBucket = 'B'
+	recordEntry  = 'E'
+	recordEnd    = 'Z'
+
+	// maxFieldLen bounds the length of a field read from an archive
+	maxFieldLen = 64 * 1024 * 1024
+	maxDepth    = 16
+)
+
+var (
+	ErrInvalidArchive  = errors.New("invalid wallet backup archive")
+	ErrChecksum        = errors.New("wallet backup archive checksum mismatch")
+	ErrUnknownFormat   = errors.New("unknown wallet backup archive format")
+	ErrEntryOutOfScope = errors.New("wallet backup archive entry out of bucket")
+)
+
+// Metadata describes the wallet db an archive is taken from.
+type Metadata struct {
+	CreatedAt     int64    `json:"created_at"`
+	SchemaVersion uint32   `json:"schema_version"`
+	ChainID       string   `json:"chain_id"`
+	SyncedHeight  uint64   `json:"synced_height"`
+	Wallets       []string `json:"wallets"`
+	// Encrypted is true if the entries are those of an encrypted db, which
+	// is restored as is and opened with its passphrase.
+	Encrypted bool `json:"encrypted,omitempty"`
+}
+
+// Summary is what is known of an archive once written or verified.
+type Summary struct {
+	Metadata *Metadata
+	Entries  uint64
+	// Checksum is the hex encoded sha256 stored in the archive
+	Checksum string
+}
+
+// Write writes all buckets read by tx to w. tx should read a snapshot of the
+// db for the archive to be consistent.
+func Write(w io.Writer, tx mwdb.ReadTransaction, meta *Metadata) (*Summary, error) {
+	gz := gzip.NewWriter(w)
+	aw := &writer{w: bufio.NewWriter(gz), h: sha256.New()}
+
+	aw.write([]byte(magic))
+	var v [4]byte
+	binary.BigEndian.PutUint32(v[:], formatVersion)
+	aw.write(v[:])
+	m, err := json.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+	aw.writeField(m)
+
+	names, err := tx.BucketNames()
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if err = aw.writeBucket([]string{name}, tx.TopLevelBucket(name)); err != nil {
+			return nil, err
+		}
+	}
+
+	aw.write([]byte{recordEnd})
+	aw.writeUvarint(aw.entries)
+	sum := aw.h.Sum(nil)
+	aw.write(sum)
+	if aw.err != nil {
+		return nil, aw.err
+	}
+	if err = aw.w.Flush(); err != nil {
+		return nil, err
+	}
+	if err = gz.Close(); err != nil {
+		return nil, err
+	}
+	return &Summary{
+		Metadata: meta,
+		Entries:  aw.entries,
+		Checksum: hex.EncodeToString(sum),
+	}, nil
+}
+
+// writer hashes what it writes and keeps the first error.
+type writer struct {
+	w       *bufio.Writer
+	h       hash.Hash
+	entries uint64
+	err     error
+}
+
+func (w *writer) write(p []byte) {
+	if w.err != nil {
+		return
+	}
+	w.h.Write(p)
+	_, w.err = w.w.Write(p)
+}
+
+func (w *writer) writeUvarint(x uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	w.write(buf[:binary.PutUvarint(buf[:], x)])
+}
+
+func (w *writer) writeField(p []byte) {
+	w.writeUvarint(uint64(len(p)))
+	w.write(p)
+}
+
+func (w *writer) writeBucket(path []string, b mwdb.Bucket) error {
+	w.write([]byte{recordBucket})
+	w.writeUvarint(uint64(len(path)))
+	for _, name := range path {
+		w.writeField([]byte(name))
+	}
+
+	it := b.NewIterator(nil)
+	for it.Next() {
+		w.write([]byte{recordEntry})
+		w.writeField(it.Key())
+		w.writeField(it.Value())
+		w.entries++
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if w.err != nil {
+		return w.err
+	}
+
+	names, err := b.BucketNames()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		sub := append(path[:len(path):len(path)], name)
+		if err = w.writeBucket(sub, b.Bucket(name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Verify reads the whole archive from r and checks its checksum.
+func Verify(r io.Reader) (*Summary, error) {
+	return read(r, nil)
+}
+
+// Restore writes the archive read from r into dst in a single transaction,
+// which is rolled back if the archive turns out to be corrupted.
+func Restore(r io.Reader, dst mwdb.DB) (*Summary, error) {
+	var s *Summary
+	err := mwdb.Update(dst, func(tx mwdb.DBTransaction) (err error) {
+		s, err = read(r, tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// read decodes an archive, writing its buckets and entries to tx if not nil.
+func read(r io.Reader, tx mwdb.DBTransaction) (*Summary, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, ErrInvalidArchive
+	}
+	ar := &reader{r: bufio.NewReader(gz), h: sha256.New()}
+
+	head, err := ar.read(len(magic) + 4)
+	if err != nil || string(head[:len(magic)]) != magic {
+		return nil, ErrInvalidArchive
+	}
+	if binary.BigEndian.Uint32(head[len(magic):]) != formatVersion {
+		return nil, ErrUnknownFormat
+	}
+	m, err := ar.readField()
+	if err != nil {
+		return nil, err
+	}
+	meta := &Metadata{}
+	if err = json.Unmarshal(m, meta); err != nil {
+		return nil, ErrInvalidArchive
+	}
+
+	var (
+		bucket   mwdb.Bucket
+		inBucket bool
+		entries  uint64
+	)
+	for {
+		kind, err := ar.ReadByte()
+		if err != nil {
+			return nil, ErrInvalidArchive
+		}
+		switch kind {
+		case recordBucket:
+			path, err := ar.readPath()
+			if err != nil {
+				return nil, err
+			}
+			if tx != nil {
+				if bucket, err = getOrCreateBucket(tx, path); err != nil {
+					return nil, err
+				}
+			}
+			inBucket = true
+
+		case recordEntry:
+			if !inBucket {
+				return nil, ErrEntryOutOfScope
+			}
+			k, err := ar.readField()
+			if err != nil {
+				return nil, err
+			}
+			v, err := ar.readField()
+			if err != nil {
+				return nil, err
+			}
+			if bucket != nil {
+				if err = bucket.Put(k, v); err != nil {
+					return nil, err
+				}
+			}
+			entries++
+
+		case recordEnd:
+			count, err := binary.ReadUvarint(ar)
+			if err != nil || count != entries {
+				return nil, ErrInvalidArchive
+			}
+			want := ar.h.Sum(nil)
+			sum, err := ar.read(sha256.Size)
+			if err != nil {
+				return nil, err
+			}
+			if !bytes.Equal(sum, want) {
+				return nil, ErrChecksum
+			}
+			// nothing may follow the checksum, reading to the end also
+			// verifies the crc of gzip
+			if n, err := io.Copy(ioutil.Discard, ar.r); err != nil || n != 0 {
+				return nil, ErrInvalidArchive
+			}
+			return &Summary{
+				Metadata: meta,
+				Entries:  entries,
+				Checksum: hex.EncodeToString(sum),
+			}, nil
+
+		default:
+			return nil, ErrInvalidArchive
+		}
+	}
+}
+
+// reader hashes what it reads.
+type reader struct {
+	r *bufio.Reader
+	h hash.Hash
+}
+
+func (r *reader) ReadByte() (byte, error) {
+	c, err := r.r.ReadByte()
+	if err == nil {
+		r.h.Write([]byte{c})
+	}
+	return c, err
+}
+
+func (r *reader) read(n int) ([]byte, error) {
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r.r, buf); err != nil {
+		return nil, ErrInvalidArchive
+	}
+	r.h.Write(buf)
+	return buf, nil
+}
+
+func (r *reader) readField() ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil || n > maxFieldLen {
+		return nil, ErrInvalidArchive
+	}
+	return r.read(int(n))
+}
+
+func (r *reader) readPath() ([]string, error) {
+	depth, err := binary.ReadUvarint(r)
+	if err != nil || depth == 0 || depth > maxDepth {
+		return nil, ErrInvalidArchive
+	}
+	path := make([]string, depth)
+	for i := range path {
+		name, err := r.readField()
+		if err != nil {
+			return nil, err
+		}
+		path[i] = string(name)
+	}
+	return path, nil
+}
+
+func getOrCreateBucket(tx mwdb.DBTransaction, path []string) (mwdb.Bucket, error) {
+	b, err := mwdb.GetOrCreateTopLevelBucket(tx, path[0])
+	for _, name := range path[1:] {
+		if err != nil {
+			return nil, err
+		}
+		b, err = mwdb.GetOrCreateBucket(b, name)
+	}
+	return b, err
+}
@@ -0,0 +1,288 @@
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	_ "massnet.org/mass-wallet/masswallet/db/ldb"
+	"massnet.org/mass-wallet/masswallet/db/memdb"
+)
+
+var testMeta = &Metadata{
+	CreatedAt:     1600000000,
+	SchemaVersion: 1,
+	ChainID:       "test",
+	SyncedHeight:  100,
+	Wallets:       []string{"ac10abc"},
+}
+
+func newTestDB(t *testing.T, path string) mwdb.DB {
+	memdb.RemoveDB(path)
+	db, err := memdb.CreateDB(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func fill(t *testing.T, db mwdb.DB) {
+	err := mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		k, err := tx.CreateTopLevelBucket("k")
+		if err != nil {
+			return err
+		}
+		if err = k.Put([]byte("pubpass"), []byte("secret")); err != nil {
+			return err
+		}
+		wallet, err := k.NewBucket("ac10abc")
+		if err != nil {
+			return err
+		}
+		if err = wallet.Put([]byte("addr1"), []byte("pk1")); err != nil {
+			return err
+		}
+		if _, err = wallet.NewBucket("empty"); err != nil {
+			return err
+		}
+		s, err := tx.CreateTopLevelBucket("s")
+		if err != nil {
+			return err
+		}
+		return s.Put([]byte("syncedTo"), bytes.Repeat([]byte{1}, 100))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// dump returns all entries of db keyed by bucket path, empty buckets included.
+func dump(t *testing.T, db mwdb.DB) map[string]map[string]string {
+	m := make(map[string]map[string]string)
+	var walk func(path string, b mwdb.Bucket) error
+	walk = func(path string, b mwdb.Bucket) error {
+		entries, err := b.GetByPrefix(nil)
+		if err != nil {
+			return err
+		}
+		m[path] = make(map[string]string)
+		for _, e := range entries {
+			m[path][string(e.Key)] = string(e.Value)
+		}
+		names, err := b.BucketNames()
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if err = walk(path+"/"+name, b.Bucket(name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	err := mwdb.View(db, func(tx mwdb.ReadTransaction) error {
+		names, err := tx.BucketNames()
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if err = walk(name, tx.TopLevelBucket(name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func writeArchive(t *testing.T, db mwdb.DB) ([]byte, *Summary) {
+	var buf bytes.Buffer
+	var s *Summary
+	err := mwdb.View(db, func(tx mwdb.ReadTransaction) (err error) {
+		s, err = Write(&buf, tx, testMeta)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes(), s
+}
+
+// recompress applies f to the uncompressed content of an archive.
+func recompress(t *testing.T, archive []byte, f func([]byte) []byte) []byte {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := ioutil.ReadAll(gz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write(f(raw))
+	w.Close()
+	return buf.Bytes()
+}
+
+func TestWrite_Restore(t *testing.T) {
+	src := newTestDB(t, "Tst_BackupSrc")
+	defer memdb.RemoveDB("Tst_BackupSrc")
+	fill(t, src)
+
+	archive, s := writeArchive(t, src)
+	assert.Equal(t, uint64(3), s.Entries)
+	assert.Equal(t, 64, len(s.Checksum))
+
+	verified, err := Verify(bytes.NewReader(archive))
+	assert.Nil(t, err)
+	assert.Equal(t, s, verified)
+
+	dst := newTestDB(t, "Tst_BackupDst")
+	defer memdb.RemoveDB("Tst_BackupDst")
+	restored, err := Restore(bytes.NewReader(archive), dst)
+	assert.Nil(t, err)
+	assert.Equal(t, s, restored)
+	assert.Equal(t, map[string]map[string]string{
+		"k":               {"pubpass": "secret"},
+		"k/ac10abc":       {"addr1": "pk1"},
+		"k/ac10abc/empty": {},
+		"s":               {"syncedTo": string(bytes.Repeat([]byte{1}, 100))},
+	}, dump(t, dst))
+	assert.Equal(t, dump(t, src), dump(t, dst))
+}
+
+func TestVerify_Corrupted(t *testing.T) {
+	src := newTestDB(t, "Tst_BackupCorrupted")
+	defer memdb.RemoveDB("Tst_BackupCorrupted")
+	fill(t, src)
+	archive, _ := writeArchive(t, src)
+
+	tests := []struct {
+		name    string
+		archive []byte
+		err     error
+	}{
+		{"not gzip", []byte("MWBACKUP"), ErrInvalidArchive},
+		{"truncated", archive[:len(archive)-10], ErrInvalidArchive},
+		{"flipped value", recompress(t, archive, func(raw []byte) []byte {
+			i := bytes.Index(raw, []byte("secret"))
+			raw[i] = 'S'
+			return raw
+		}), ErrChecksum},
+		{"trailing data", recompress(t, archive, func(raw []byte) []byte {
+			return append(raw, 0)
+		}), ErrInvalidArchive},
+		{"unknown format", recompress(t, archive, func(raw []byte) []byte {
+			raw[len(magic)+3] = 2
+			return raw
+		}), ErrUnknownFormat},
+	}
+	for _, test := range tests {
+		_, err := Verify(bytes.NewReader(test.archive))
+		assert.Equal(t, test.err, err, test.name)
+	}
+
+	// nothing is restored from a corrupted archive
+	dst := newTestDB(t, "Tst_BackupCorruptedDst")
+	defer memdb.RemoveDB("Tst_BackupCorruptedDst")
+	_, err := Restore(bytes.NewReader(tests[2].archive), dst)
+	assert.Equal(t, ErrChecksum, err)
+	assert.Equal(t, 0, len(dump(t, dst)))
+}
+
+func TestRestoreFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mwbackup")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	src := newTestDB(t, "Tst_BackupFile")
+	defer memdb.RemoveDB("Tst_BackupFile")
+	fill(t, src)
+	path := filepath.Join(dir, "backup"+Ext)
+	// a file at the former temporary path is left alone
+	assert.Nil(t, ioutil.WriteFile(path+".tmp", []byte("other"), 0600))
+	var s *FileSummary
+	err = mwdb.View(src, func(tx mwdb.ReadTransaction) (err error) {
+		s, err = WriteFile(path, tx, testMeta)
+		return err
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, path, s.Path)
+	other, err := ioutil.ReadFile(path + ".tmp")
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("other"), other)
+	assert.Nil(t, os.Remove(path+".tmp"))
+	err = mwdb.View(src, func(tx mwdb.ReadTransaction) error {
+		_, err := WriteFile(path, tx, testMeta)
+		return err
+	})
+	assert.Equal(t, ErrFileExists, err)
+
+	// an existing db is kept aside
+	dbPath := filepath.Join(dir, "wallet.db")
+	db, err := mwdb.CreateDB("leveldb", dbPath)
+	assert.Nil(t, err)
+	db.Close()
+	restored, old, err := RestoreFile(path, "leveldb", dbPath)
+	assert.Nil(t, err)
+	assert.Equal(t, s, restored)
+	assert.NotEqual(t, "", old)
+	_, err = os.Stat(old)
+	assert.Nil(t, err)
+
+	db, err = mwdb.OpenDB("leveldb", dbPath)
+	assert.Nil(t, err)
+	assert.Equal(t, dump(t, src), dump(t, db))
+	db.Close()
+
+	newer := *testMeta
+	newer.SchemaVersion = 1000
+	err = mwdb.View(src, func(tx mwdb.ReadTransaction) error {
+		_, err := WriteFile(filepath.Join(dir, "newer"+Ext), tx, &newer)
+		return err
+	})
+	assert.Nil(t, err)
+	_, _, err = RestoreFile(filepath.Join(dir, "newer"+Ext), "leveldb", dbPath)
+	assert.Equal(t, ErrSchemaTooNew, err)
+}
+
+func TestPrune(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mwbackup")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	var paths []string
+	for i := 0; i < 5; i++ {
+		path := AutoPath(dir, now.Add(time.Duration(i)*time.Hour))
+		assert.Nil(t, ioutil.WriteFile(path, nil, 0600))
+		paths = append(paths, path)
+	}
+	manual := filepath.Join(dir, "manual"+Ext)
+	assert.Nil(t, ioutil.WriteFile(manual, nil, 0600))
+
+	removed, err := Prune(dir, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, paths[:3], removed)
+	for i, path := range paths {
+		_, err = os.Stat(path)
+		assert.Equal(t, i < 3, os.IsNotExist(err))
+	}
+	_, err = os.Stat(manual)
+	assert.Nil(t, err)
+
+	removed, err = Prune(dir, 2)
+	assert.Nil(t, err)
+	assert.Nil(t, removed)
+}
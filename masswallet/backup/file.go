@@ -0,0 +1,188 @@
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/massnetorg/mass-core/logging"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/migration"
+)
+
+const (
+	// archives written by the scheduler are named <autoPrefix><time><Ext>
+	autoPrefix = "wallet-"
+	timeFormat = "20060102150405"
+
+	// Ext is the extension of archive files.
+	Ext = ".mwbak"
+)
+
+var (
+	ErrFileExists       = errors.New("backup file already exists")
+	ErrSchemaTooNew     = errors.New("wallet backup archive is of a newer schema version than supported")
+	ErrRestoreDBMissing = errors.New("restored wallet db not found")
+)
+
+// FileSummary is the Summary of an archive file.
+type FileSummary struct {
+	*Summary
+	Path string
+	Size int64
+}
+
+// WriteFile writes the archive of tx to path, which must not exist. The
+// archive is written to a new temporary file in the same dir first and renamed
+// to path once complete.
+func WriteFile(path string, tx mwdb.ReadTransaction, meta *Metadata) (*FileSummary, error) {
+	if _, err := os.Stat(path); err == nil {
+		return nil, ErrFileExists
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	tmp := f.Name()
+	s, err := Write(f, tx, meta)
+	if err == nil {
+		err = f.Sync()
+	}
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return nil, err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return nil, err
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSummary{Summary: s, Path: path, Size: fi.Size()}, nil
+}
+
+// VerifyFile checks the archive file at path.
+func VerifyFile(path string) (*FileSummary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	s, err := Verify(f)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return &FileSummary{Summary: s, Path: path, Size: fi.Size()}, nil
+}
+
+// RestoreFile verifies the archive and restores it to a new db of dbType at
+// dbPath. An existing db at dbPath is renamed aside rather than removed, its
+// new path is returned. The wallet must not be running.
+func RestoreFile(archive, dbType, dbPath string) (s *FileSummary, old string, err error) {
+	if s, err = VerifyFile(archive); err != nil {
+		return nil, "", err
+	}
+	if s.Metadata.SchemaVersion > migration.DefaultRegistry.LatestVersion() {
+		return nil, "", ErrSchemaTooNew
+	}
+
+	tmp := dbPath + ".restoring"
+	if err = os.RemoveAll(tmp); err != nil {
+		return nil, "", err
+	}
+	db, err := mwdb.CreateDB(dbType, tmp)
+	if err != nil {
+		return nil, "", err
+	}
+	f, err := os.Open(archive)
+	if err != nil {
+		db.Close()
+		os.RemoveAll(tmp)
+		return nil, "", err
+	}
+	_, err = Restore(f, db)
+	f.Close()
+	if e := db.Close(); err == nil {
+		err = e
+	}
+	if err == nil {
+		// drivers keeping the db in memory leave nothing to rename
+		if _, err = os.Stat(tmp); os.IsNotExist(err) {
+			err = ErrRestoreDBMissing
+		}
+	}
+	if err != nil {
+		os.RemoveAll(tmp)
+		return nil, "", err
+	}
+
+	if _, err = os.Stat(dbPath); err == nil {
+		old = fmt.Sprintf("%s.%s.old", dbPath, time.Now().Format(timeFormat))
+		if err = os.Rename(dbPath, old); err != nil {
+			os.RemoveAll(tmp)
+			return nil, "", err
+		}
+	}
+	if err = os.Rename(tmp, dbPath); err != nil {
+		return nil, old, err
+	}
+	logging.CPrint(logging.INFO, "wallet db restored", logging.LogFormat{
+		"archive": archive,
+		"path":    dbPath,
+		"old":     old,
+	})
+	return s, old, nil
+}
+
+// AutoPath returns the path of the archive the scheduler writes to dir at t.
+func AutoPath(dir string, t time.Time) string {
+	return filepath.Join(dir, autoPrefix+t.Format(timeFormat)+Ext)
+}
+
+// Prune removes the oldest archives written by the scheduler in dir, so that
+// at most keep of them are left. Other files are not touched.
+func Prune(dir string, keep int) ([]string, error) {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, fi := range infos {
+		name := fi.Name()
+		if fi.IsDir() || !strings.HasPrefix(name, autoPrefix) || !strings.HasSuffix(name, Ext) {
+			continue
+		}
+		if _, err := time.Parse(timeFormat, name[len(autoPrefix):len(name)-len(Ext)]); err != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+	if len(names) <= keep {
+		return nil, nil
+	}
+	sort.Strings(names)
+	var removed []string
+	for _, name := range names[:len(names)-keep] {
+		path := filepath.Join(dir, name)
+		if err := os.Remove(path); err != nil {
+			return removed, err
+		}
+		removed = append(removed, path)
+	}
+	return removed, nil
+}
@@ -0,0 +1,239 @@
+package masswallet
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/massnetorg/mass-core/blockchain"
+	"github.com/massnetorg/mass-core/massutil"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+// Coin selection strategies accepted by NewCoinSelector.
+const (
+	CoinSelectionLargestFirst   = "largest_first"
+	CoinSelectionBranchAndBound = "branch_and_bound"
+	CoinSelectionSmallestFirst  = "smallest_first"
+	CoinSelectionOldestFirst    = "oldest_first"
+	CoinSelectionRandom         = "random"
+)
+
+// bnbMaxTries bounds the nodes visited by a branch-and-bound search.
+const bnbMaxTries = 100000
+
+// CoinSelector chooses the inputs of an automatically constructed transaction.
+type CoinSelector interface {
+	// Select returns no more than maxInputs of utxos whose amounts sum to no
+	// less than target, or nil if there is no such selection. It may reorder utxos.
+	Select(target massutil.Amount, utxos []*txmgr.Credit, maxInputs int) ([]*txmgr.Credit, error)
+}
+
+// changeAvoider is implemented by selectors looking for selections without change.
+// A change below the dust threshold is then paid as fee, rather than asking the
+// selector for another input to cover it.
+type changeAvoider interface {
+	avoidsChange() bool
+}
+
+// NewCoinSelector returns the selector of strategy, largest_first if strategy is empty.
+func NewCoinSelector(strategy string) (CoinSelector, error) {
+	switch strategy {
+	case "", CoinSelectionLargestFirst:
+		return &largestFirstSelector{}, nil
+	case CoinSelectionBranchAndBound:
+		return &branchAndBoundSelector{costOfChange: massutil.MinRelayTxFee()}, nil
+	case CoinSelectionSmallestFirst:
+		return &smallestFirstSelector{}, nil
+	case CoinSelectionOldestFirst:
+		return &oldestFirstSelector{}, nil
+	case CoinSelectionRandom:
+		return newRandomSelector(rand.NewSource(time.Now().UnixNano())), nil
+	default:
+		return nil, ErrUnknownCoinSelection
+	}
+}
+
+// maxSelectedInputs returns the most inputs a standard transaction can spend.
+func maxSelectedInputs() int {
+	// See policy.go isDust(...)
+	return blockchain.GetMaxStandardTxSize() / 154
+}
+
+// largestFirstSelector prefers the largest utxos not exceeding target, then the
+// smallest one exceeding it.
+type largestFirstSelector struct{}
+
+func (s *largestFirstSelector) Select(target massutil.Amount, utxos []*txmgr.Credit, maxInputs int) ([]*txmgr.Credit, error) {
+	topK := newTopKSelector(maxInputs, target)
+	for _, item := range utxos {
+		topK.submit(item)
+	}
+	selections, sum, _, err := optOutputs(target, topK.Items())
+	if err != nil {
+		return nil, err
+	}
+	if sum.Cmp(target) < 0 {
+		return nil, nil
+	}
+	return selections, nil
+}
+
+// branchAndBoundSelector searches for a selection exceeding target by less than
+// costOfChange, so that no change output is needed. It falls back to
+// largest-first if there is no such selection.
+type branchAndBoundSelector struct {
+	costOfChange massutil.Amount
+}
+
+func (s *branchAndBoundSelector) avoidsChange() bool {
+	return true
+}
+
+func (s *branchAndBoundSelector) Select(target massutil.Amount, utxos []*txmgr.Credit, maxInputs int) ([]*txmgr.Credit, error) {
+	sortCredits(utxos, func(a, b *txmgr.Credit) int {
+		return b.Amount.Cmp(a.Amount)
+	})
+
+	// remaining[i] is the sum of utxos[i:]
+	values := make([]int64, len(utxos))
+	remaining := make([]int64, len(utxos)+1)
+	for i := len(utxos) - 1; i >= 0; i-- {
+		values[i] = utxos[i].Amount.IntValue()
+		remaining[i] = remaining[i+1] + values[i]
+	}
+
+	var (
+		low        = target.IntValue()
+		high       = low + s.costOfChange.IntValue()
+		tries      = 0
+		selected   = make([]int, 0, maxInputs)
+		best       []int
+		bestExcess int64 = -1
+	)
+	// search returns true when the search should stop
+	var search func(i int, sum int64) bool
+	search = func(i int, sum int64) bool {
+		tries++
+		if tries > bnbMaxTries {
+			return true
+		}
+		if sum >= low {
+			if sum < high && (bestExcess < 0 || sum-low < bestExcess) {
+				bestExcess = sum - low
+				best = append(best[:0], selected...)
+			}
+			return bestExcess == 0
+		}
+		if i == len(values) || len(selected) == maxInputs || sum+remaining[i] < low {
+			return false
+		}
+
+		selected = append(selected, i)
+		if search(i+1, sum+values[i]) {
+			return true
+		}
+		selected = selected[:len(selected)-1]
+
+		// omitting utxos[i] but including one of the same amount is explored already
+		next := i + 1
+		for next < len(values) && values[next] == values[i] {
+			next++
+		}
+		return search(next, sum)
+	}
+	search(0, 0)
+
+	if bestExcess < 0 {
+		return (&largestFirstSelector{}).Select(target, utxos, maxInputs)
+	}
+	selections := make([]*txmgr.Credit, 0, len(best))
+	for _, i := range best {
+		selections = append(selections, utxos[i])
+	}
+	return selections, nil
+}
+
+// smallestFirstSelector spends the smallest utxos first to consolidate the utxo
+// set. It falls back to largest-first if target can't be reached within maxInputs.
+type smallestFirstSelector struct{}
+
+func (s *smallestFirstSelector) Select(target massutil.Amount, utxos []*txmgr.Credit, maxInputs int) ([]*txmgr.Credit, error) {
+	sortCredits(utxos, func(a, b *txmgr.Credit) int {
+		return a.Amount.Cmp(b.Amount)
+	})
+	return accumulateOrFallback(target, utxos, maxInputs)
+}
+
+// oldestFirstSelector spends the utxos of the lowest blocks first. It falls back
+// to largest-first if target can't be reached within maxInputs.
+type oldestFirstSelector struct{}
+
+func (s *oldestFirstSelector) Select(target massutil.Amount, utxos []*txmgr.Credit, maxInputs int) ([]*txmgr.Credit, error) {
+	sortCredits(utxos, func(a, b *txmgr.Credit) int {
+		switch {
+		case a.Height < b.Height:
+			return -1
+		case a.Height > b.Height:
+			return 1
+		}
+		return b.Amount.Cmp(a.Amount)
+	})
+	return accumulateOrFallback(target, utxos, maxInputs)
+}
+
+// randomSelector spends utxos in random order, so that the inputs reveal less
+// about the wallet. It falls back to largest-first if target can't be reached
+// within maxInputs.
+type randomSelector struct {
+	rand *rand.Rand
+}
+
+func newRandomSelector(src rand.Source) *randomSelector {
+	return &randomSelector{rand: rand.New(src)}
+}
+
+func (s *randomSelector) Select(target massutil.Amount, utxos []*txmgr.Credit, maxInputs int) ([]*txmgr.Credit, error) {
+	// sort first so that the result depends on the source only
+	sortCredits(utxos, func(a, b *txmgr.Credit) int { return 0 })
+	s.rand.Shuffle(len(utxos), func(i, j int) {
+		utxos[i], utxos[j] = utxos[j], utxos[i]
+	})
+	return accumulateOrFallback(target, utxos, maxInputs)
+}
+
+// accumulateOrFallback selects utxos in order until target is reached, or falls
+// back to largest-first if more than maxInputs utxos are needed.
+func accumulateOrFallback(target massutil.Amount, utxos []*txmgr.Credit, maxInputs int) ([]*txmgr.Credit, error) {
+	sum := massutil.ZeroAmount()
+	for i, item := range utxos {
+		if i == maxInputs {
+			return (&largestFirstSelector{}).Select(target, utxos, maxInputs)
+		}
+		var err error
+		sum, err = sum.Add(item.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if sum.Cmp(target) >= 0 {
+			selections := make([]*txmgr.Credit, i+1)
+			copy(selections, utxos[:i+1])
+			return selections, nil
+		}
+	}
+	return nil, nil
+}
+
+// sortCredits sorts utxos by cmp, then by outpoint so that the order is deterministic.
+func sortCredits(utxos []*txmgr.Credit, cmp func(a, b *txmgr.Credit) int) {
+	sort.Slice(utxos, func(i, j int) bool {
+		if c := cmp(utxos[i], utxos[j]); c != 0 {
+			return c < 0
+		}
+		if c := bytes.Compare(utxos[i].OutPoint.Hash[:], utxos[j].OutPoint.Hash[:]); c != 0 {
+			return c < 0
+		}
+		return utxos[i].OutPoint.Index < utxos[j].OutPoint.Index
+	})
+}
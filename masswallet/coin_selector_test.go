@@ -0,0 +1,164 @@
+package masswallet
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/wire"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+// mockSelectorCredits returns utxos of amounts (in 0.1 MASS) mined at heights.
+func mockSelectorCredits(amounts []uint64, heights []uint64) []*txmgr.Credit {
+	credits := make([]*txmgr.Credit, 0, len(amounts))
+	for i, a := range amounts {
+		amt, _ := massutil.NewAmountFromUint(a * 1e7)
+		credits = append(credits, &txmgr.Credit{
+			OutPoint:  wire.OutPoint{Hash: wire.Hash{byte(i + 1)}, Index: uint32(i)},
+			BlockMeta: txmgr.BlockMeta{Height: heights[i]},
+			Amount:    amt,
+		})
+	}
+	return credits
+}
+
+func selectedAmounts(credits []*txmgr.Credit) []uint64 {
+	if credits == nil {
+		return nil
+	}
+	amounts := make([]uint64, 0, len(credits))
+	for _, c := range credits {
+		amounts = append(amounts, uint64(c.Amount.IntValue()/1e7))
+	}
+	return amounts
+}
+
+func TestNewCoinSelector(t *testing.T) {
+	tests := []struct {
+		strategy string
+		want     CoinSelector
+		err      error
+	}{
+		{"", &largestFirstSelector{}, nil},
+		{CoinSelectionLargestFirst, &largestFirstSelector{}, nil},
+		{CoinSelectionBranchAndBound, &branchAndBoundSelector{costOfChange: massutil.MinRelayTxFee()}, nil},
+		{CoinSelectionSmallestFirst, &smallestFirstSelector{}, nil},
+		{CoinSelectionOldestFirst, &oldestFirstSelector{}, nil},
+		{"largest", nil, ErrUnknownCoinSelection},
+	}
+	for _, test := range tests {
+		selector, err := NewCoinSelector(test.strategy)
+		if err != test.err {
+			t.Fatalf("%q: expect error %v, got %v", test.strategy, test.err, err)
+		}
+		if !reflect.DeepEqual(selector, test.want) {
+			t.Fatalf("%q: unexpected selector %#v", test.strategy, selector)
+		}
+	}
+
+	selector, err := NewCoinSelector(CoinSelectionRandom)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := selector.(*randomSelector); !ok {
+		t.Fatalf("unexpected selector %#v", selector)
+	}
+}
+
+func TestCoinSelector_Select(t *testing.T) {
+	amounts := []uint64{60, 50, 50, 20, 10}
+	heights := []uint64{3, 1, 4, 2, 5}
+	bnb := &branchAndBoundSelector{costOfChange: massutil.MinRelayTxFee()}
+
+	tests := []struct {
+		name      string
+		selector  CoinSelector
+		target    uint64
+		maxInputs int
+		want      []uint64
+	}{
+		{"largest first", &largestFirstSelector{}, 100, 10, []uint64{60, 50}},
+		{"largest first exceeds max inputs", &largestFirstSelector{}, 100, 1, nil},
+		{"branch and bound exact match", bnb, 100, 10, []uint64{50, 50}},
+		{"branch and bound exact match of one", bnb, 20, 10, []uint64{20}},
+		{"branch and bound falls back", bnb, 95, 10, []uint64{60, 50}},
+		{"branch and bound exceeds max inputs", bnb, 130, 2, nil},
+		{"smallest first", &smallestFirstSelector{}, 70, 10, []uint64{10, 20, 50}},
+		{"smallest first falls back", &smallestFirstSelector{}, 70, 2, []uint64{60, 50}},
+		{"oldest first", &oldestFirstSelector{}, 70, 10, []uint64{50, 20}},
+		{"oldest first falls back", &oldestFirstSelector{}, 120, 2, nil},
+		{"insufficient largest first", &largestFirstSelector{}, 200, 10, nil},
+		{"insufficient branch and bound", bnb, 200, 10, nil},
+		{"insufficient smallest first", &smallestFirstSelector{}, 200, 10, nil},
+		{"insufficient oldest first", &oldestFirstSelector{}, 200, 10, nil},
+	}
+	for _, test := range tests {
+		target, _ := massutil.NewAmountFromUint(test.target * 1e7)
+		selections, err := test.selector.Select(target, mockSelectorCredits(amounts, heights), test.maxInputs)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if got := selectedAmounts(selections); !reflect.DeepEqual(got, test.want) {
+			t.Fatalf("%s: expect %v, got %v", test.name, test.want, got)
+		}
+	}
+}
+
+func TestCoinSelector_BranchAndBoundTolerance(t *testing.T) {
+	bnb := &branchAndBoundSelector{costOfChange: massutil.MinRelayTxFee()}
+	credits := mockSelectorCredits([]uint64{60, 50, 50}, []uint64{1, 2, 3})
+
+	// 60 + 50 exceeds the target by less than costOfChange
+	target, _ := credits[0].Amount.Add(credits[1].Amount)
+	target, _ = target.Sub(massutil.MinRelayTxFee())
+	target, _ = target.AddInt(1)
+	selections, err := bnb.Select(target, credits, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := selectedAmounts(selections); !reflect.DeepEqual(got, []uint64{60, 50}) {
+		t.Fatalf("unexpected selection %v", got)
+	}
+}
+
+func TestCoinSelector_RandomDeterministic(t *testing.T) {
+	amounts := []uint64{60, 50, 50, 20, 10, 30, 40}
+	heights := []uint64{1, 2, 3, 4, 5, 6, 7}
+	target, _ := massutil.NewAmountFromUint(90 * 1e7)
+
+	distinct := make(map[string]struct{})
+	for seed := int64(0); seed < 20; seed++ {
+		first, err := newRandomSelector(rand.NewSource(seed)).Select(target, mockSelectorCredits(amounts, heights), 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the result depends on the seed only, not on the order of utxos
+		reversed := mockSelectorCredits(amounts, heights)
+		for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+			reversed[i], reversed[j] = reversed[j], reversed[i]
+		}
+		second, err := newRandomSelector(rand.NewSource(seed)).Select(target, reversed, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(selectedAmounts(first), selectedAmounts(second)) {
+			t.Fatalf("seed %d: selections mismatched, %v vs %v", seed, selectedAmounts(first), selectedAmounts(second))
+		}
+
+		sum := massutil.ZeroAmount()
+		key := ""
+		for _, c := range first {
+			sum, _ = sum.Add(c.Amount)
+			key += c.OutPoint.String()
+		}
+		if sum.Cmp(target) < 0 {
+			t.Fatalf("seed %d: selection %v below target", seed, selectedAmounts(first))
+		}
+		distinct[key] = struct{}{}
+	}
+	if len(distinct) < 2 {
+		t.Fatalf("expect random selections, got %d distinct", len(distinct))
+	}
+}
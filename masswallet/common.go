@@ -16,14 +16,14 @@ import (
 
 	"massnet.org/mass-wallet/config"
 	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/keystore"
 	"massnet.org/mass-wallet/masswallet/txmgr"
 	"massnet.org/mass-wallet/masswallet/utils"
 )
 
-// for current wallet
-func (w *WalletManager) existsMsgTx(out *wire.OutPoint) (mtx *wire.MsgTx, meta *txmgr.BlockMeta, err error) {
+func (w *WalletManager) existsMsgTx(walletId string, out *wire.OutPoint) (mtx *wire.MsgTx, meta *txmgr.BlockMeta, err error) {
 	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
-		mtx, meta, err = w.txStore.ExistsTx(tx, out)
+		mtx, meta, err = w.txStore.ExistsTx(tx, walletId, out)
 		return err
 	})
 	return
@@ -37,23 +37,22 @@ func (w *WalletManager) existsUnminedTx(hash *wire.Hash) (mtx *wire.MsgTx, err e
 	return
 }
 
-// for current wallet
-func (w *WalletManager) existsOutPoint(out *wire.OutPoint) (utxoFlags *txmgr.UtxoFlags, err error) {
+func (w *WalletManager) existsOutPoint(walletId string, out *wire.OutPoint) (utxoFlags *txmgr.UtxoFlags, err error) {
 	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
-		utxoFlags, err = w.txStore.ExistsUtxo(tx, out)
+		utxoFlags, err = w.txStore.ExistsUtxo(tx, walletId, out)
 		return err
 	})
 	return
 }
 
-func (w *WalletManager) addTxIn(msgTx *wire.MsgTx, LockTime uint64, inputUtxos []*txmgr.Credit) error {
+func (w *WalletManager) addTxIn(am *keystore.AddrManager, msgTx *wire.MsgTx, LockTime uint64, inputUtxos []*txmgr.Credit) error {
 	for _, utx := range inputUtxos {
 		txIn := wire.NewTxIn(&utx.OutPoint, nil)
 		if LockTime != 0 {
 			txIn.Sequence = wire.MaxTxInSequenceNum - 1
 		}
 
-		prevTx, block, err := w.existsMsgTx(&txIn.PreviousOutPoint)
+		prevTx, block, err := w.existsMsgTx(am.Name(), &txIn.PreviousOutPoint)
 		if err != nil {
 			return err
 		}
@@ -79,8 +78,14 @@ func (w *WalletManager) addTxIn(msgTx *wire.MsgTx, LockTime uint64, inputUtxos [
 	return nil
 }
 
-func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockTime uint64,
-	addrs []string, userTxFee massutil.Amount, changeAddr string) (fee massutil.Amount, err error) {
+func (w *WalletManager) autoConstructTxInAndChangeTxOut(am *keystore.AddrManager, msgTx *wire.MsgTx, LockTime uint64,
+	addrs []string, userTxFee, feeRate massutil.Amount, changeAddr string, selector CoinSelector) (fee massutil.Amount, err error) {
+
+	if selector == nil {
+		selector = &largestFirstSelector{}
+	}
+	avoider, ok := selector.(changeAvoider)
+	avoidChange := ok && avoider.avoidsChange()
 
 	targetTxFee := massutil.MinRelayTxFee()
 	if !userTxFee.IsZero() {
@@ -100,10 +105,11 @@ func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockT
 	// construct input and change output
 	for {
 		var (
-			adj       = massutil.ZeroAmount()
-			changeOut *wire.TxOut
-			txOutLen  int
-			utxos     []*txmgr.Credit
+			adj        = massutil.ZeroAmount()
+			dustChange = massutil.ZeroAmount()
+			changeOut  *wire.TxOut
+			txOutLen   int
+			utxos      []*txmgr.Credit
 		)
 		for {
 			want, err := targetTxFee.Add(outAmounts)
@@ -123,7 +129,7 @@ func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockT
 				overfull  bool
 			)
 
-			utxos, firstAddr, found, overfull, err = w.findEligibleUtxos(wantAdj, addrs)
+			utxos, firstAddr, found, overfull, err = w.findEligibleUtxos(am, wantAdj, addrs, selector)
 			if err != nil {
 				return outAmounts, err
 			}
@@ -146,8 +152,13 @@ func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockT
 			changeAmount, _ := found.Sub(want)
 			if !changeAmount.IsZero() {
 				if changeAmount.Cmp(massutil.MinRelayTxFee()) < 0 {
-					adj = massutil.MinRelayTxFee()
-					continue
+					if !avoidChange {
+						adj = massutil.MinRelayTxFee()
+						continue
+					}
+					// pay the dust change as fee
+					dustChange = changeAmount
+					break
 				}
 				if len(changeAddr) > 0 {
 					changeOut, err = amountToTxOut(changeAddr, changeAmount)
@@ -163,25 +174,25 @@ func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockT
 		}
 
 		// calc acutal fee
-		signedTxSize, err := w.estimateSignedSize(utxos, txOutLen)
+		signedTxSize, err := w.estimateSignedSize(am, utxos, txOutLen)
 		if err != nil {
 			logging.CPrint(logging.ERROR, "estimate signedSize failed", logging.LogFormat{"err": err})
 			return outAmounts, ErrInvalidParameter
 		}
 		signedTxSize += int64(len(msgTx.Payload))
-		requiredFee, err := blockchain.CalcMinRequiredTxRelayFee(signedTxSize, massutil.MinRelayTxFee())
+		requiredFee, err := blockchain.CalcMinRequiredTxRelayFee(signedTxSize, feeRate)
 		if err != nil {
 			return outAmounts, err
 		}
 		if targetTxFee.Cmp(requiredFee) >= 0 {
-			err = w.addTxIn(msgTx, LockTime, utxos)
+			err = w.addTxIn(am, msgTx, LockTime, utxos)
 			if err != nil {
 				return outAmounts, err
 			}
 			if changeOut != nil {
 				msgTx.AddTxOut(changeOut)
 			}
-			return targetTxFee, nil
+			return targetTxFee.Add(dustChange)
 		}
 
 		msgTx.TxIn = make([]*wire.TxIn, 0)
@@ -189,12 +200,7 @@ func (w *WalletManager) autoConstructTxInAndChangeTxOut(msgTx *wire.MsgTx, LockT
 	}
 }
 
-func (w *WalletManager) prepareFromAddresses(from string) (addrs []string, err error) {
-	ks := w.ksmgr.CurrentKeystore()
-	if ks == nil {
-		return nil, ErrNoWalletInUse
-	}
-
+func (w *WalletManager) prepareFromAddresses(ks *keystore.AddrManager, from string) (addrs []string, err error) {
 	if len(from) > 0 {
 		ma, err := ks.Address(from)
 		if err != nil {
@@ -362,3 +368,23 @@ func amountToTxOut(encodedAddr string, amount massutil.Amount) (*wire.TxOut, err
 	}
 	return wire.NewTxOut(amount.IntValue(), pkScript), nil
 }
+
+func parseSigHashType(flag string) (txscript.SigHashType, error) {
+	switch flag {
+	case "ALL":
+		return txscript.SigHashAll, nil
+	case "NONE":
+		return txscript.SigHashNone, nil
+	case "SINGLE":
+		return txscript.SigHashSingle, nil
+	case "ALL|ANYONECANPAY":
+		return txscript.SigHashAll | txscript.SigHashAnyOneCanPay, nil
+	case "NONE|ANYONECANPAY":
+		return txscript.SigHashNone | txscript.SigHashAnyOneCanPay, nil
+	case "SINGLE|ANYONECANPAY":
+		return txscript.SigHashSingle | txscript.SigHashAnyOneCanPay, nil
+	default:
+		logging.CPrint(logging.ERROR, "Invalid sighash parameter", logging.LogFormat{"flag": flag})
+		return 0, ErrInvalidFlag
+	}
+}
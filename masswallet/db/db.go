@@ -2,6 +2,9 @@ package db
 
 import (
 	"errors"
+	"time"
+
+	"massnet.org/mass-wallet/metrics"
 )
 
 // Error definition
@@ -108,8 +111,17 @@ type Entry struct {
 	Value []byte
 }
 
+// dbOpDuration times transactions run by View and Update.
+var dbOpDuration = metrics.NewHistogramVec("masswallet_db_operation_duration_seconds",
+	"Duration of wallet db transactions.", metrics.DefBuckets, "op")
+
+func init() {
+	metrics.DefaultRegistry.Register(dbOpDuration)
+}
+
 // View ...
 func View(db DB, f func(tx ReadTransaction) error) error {
+	defer observeDuration("view", time.Now())
 	tx, err := db.BeginReadTx()
 	if err != nil {
 		return err
@@ -120,6 +132,7 @@ func View(db DB, f func(tx ReadTransaction) error) error {
 
 // Update ...
 func Update(db DB, f func(tx DBTransaction) error) error {
+	defer observeDuration("update", time.Now())
 	tx, err := db.BeginTx()
 	if err != nil {
 		return err
@@ -132,6 +145,10 @@ func Update(db DB, f func(tx DBTransaction) error) error {
 	return tx.Commit()
 }
 
+func observeDuration(op string, start time.Time) {
+	dbOpDuration.Observe(time.Since(start).Seconds(), op)
+}
+
 var drivers []DBDriver
 
 type DBDriver struct {
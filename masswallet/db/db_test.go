@@ -10,7 +10,9 @@ import (
 	walletdb "massnet.org/mass-wallet/masswallet/db"
 
 	"github.com/stretchr/testify/assert"
+	"massnet.org/mass-wallet/masswallet/db/encdb"
 	_ "massnet.org/mass-wallet/masswallet/db/ldb"
+	"massnet.org/mass-wallet/masswallet/db/memdb"
 	_ "massnet.org/mass-wallet/masswallet/db/rdb"
 )
 
@@ -19,8 +21,22 @@ const testDbRoot = "testDbs"
 var (
 	dbtype          = ""
 	triggerRollback = errors.New("trigger rollback")
+
+	// encrypted dbs are tested over leveldb, with cheap scrypt parameters
+	encdbOptions = &encdb.Options{ScryptN: 1024, ScryptR: 8, ScryptP: 1}
 )
 
+// dbArgs returns the driver args of the db at path.
+func dbArgs(path string, create bool) []interface{} {
+	if dbtype != encdb.DBType {
+		return []interface{}{path}
+	}
+	if create {
+		return []interface{}{path, "leveldb", "passphrase", encdbOptions}
+	}
+	return []interface{}{path, "leveldb", "passphrase"}
+}
+
 // filesExists returns whether or not the named file or directory exists.
 func fileExists(name string) bool {
 	if _, err := os.Stat(name); err != nil {
@@ -45,7 +61,7 @@ func GetDb(dbName string) (walletdb.DB, func(), error) {
 		err := fmt.Errorf("cannot remove old db: %v", err)
 		return nil, nil, err
 	}
-	db, err := walletdb.CreateDB(dbtype, dbPath)
+	db, err := walletdb.CreateDB(dbtype, dbArgs(dbPath, true)...)
 	if err != nil {
 		fmt.Println("create db error: ", err)
 		return nil, nil, err
@@ -54,6 +70,7 @@ func GetDb(dbName string) (walletdb.DB, func(), error) {
 		dbVersionPath := filepath.Join(testDbRoot, dbName+".ver")
 		db.Close()
 		os.RemoveAll(dbPath)
+		memdb.RemoveDB(dbPath)
 		os.Remove(dbVersionPath)
 		os.RemoveAll(testDbRoot)
 	}
@@ -64,26 +81,41 @@ func GetDb(dbName string) (walletdb.DB, func(), error) {
 func TestAll(t *testing.T) {
 	for _, tp := range walletdb.RegisteredDbTypes() {
 		dbtype = tp
+		if dbtype == encdb.DBType {
+			for _, encryptKeys := range []bool{false, true} {
+				encdbOptions.EncryptKeys = encryptKeys
+				t.Logf("run tests with %s, encrypt keys %v...", dbtype, encryptKeys)
+				testAll(t)
+			}
+			continue
+		}
 		t.Logf("run tests with %s...", dbtype)
-		testDB_NewRootBucket(t)
-		testDB_RootBucket(t)
-		testDB_RootBucketNames(t)
-		testDB_DeleteRootBucket(t)
-		testBucket_NewSubBucket(t)
-		testBucket_SubBucket(t)
-		testBucket_SubBucketNames(t)
-		testBucket_DeleteSubBucket(t)
-		testBucket_Put(t)
-		testBucket_Get(t)
-		testBucket_Delete(t)
-		testBucket_Clear(t)
-		testCreateOrOpenDB(t)
-		testGetByPrefix(t)
-		testIterator(t)
-		testSeek(t)
+		testAll(t)
 	}
 }
 
+func testAll(t *testing.T) {
+	testDB_NewRootBucket(t)
+	testDB_RootBucket(t)
+	testDB_RootBucketNames(t)
+	testDB_DeleteRootBucket(t)
+	testBucket_NewSubBucket(t)
+	testBucket_SubBucket(t)
+	testBucket_SubBucketNames(t)
+	testBucket_DeleteSubBucket(t)
+	testBucket_Put(t)
+	testBucket_Get(t)
+	testBucket_Delete(t)
+	testBucket_Clear(t)
+	testCreateOrOpenDB(t)
+	testGetByPrefix(t)
+	testIterator(t)
+	testSeek(t)
+	testCopyDB(t)
+	testReadTxSnapshot(t)
+	testViewSnapshot(t)
+}
+
 //test create a new bucket
 func testDB_NewRootBucket(t *testing.T) {
 	tests := []struct {
@@ -775,6 +807,9 @@ func testCreateOrOpenDB(t *testing.T) {
 	}
 	defer func() {
 		os.RemoveAll(testDbRoot)
+		for _, test := range tests {
+			memdb.RemoveDB(test.dbPath)
+		}
 	}()
 
 	for _, test := range tests {
@@ -784,9 +819,9 @@ func testCreateOrOpenDB(t *testing.T) {
 				err error
 			)
 			if test.create {
-				db, err = walletdb.CreateDB(dbtype, test.dbPath)
+				db, err = walletdb.CreateDB(dbtype, dbArgs(test.dbPath, true)...)
 			} else {
-				db, err = walletdb.OpenDB(dbtype, test.dbPath)
+				db, err = walletdb.OpenDB(dbtype, dbArgs(test.dbPath, false)...)
 			}
 
 			assert.Equal(t, test.err, err)
@@ -1238,3 +1273,199 @@ func testSeek(t *testing.T) {
 		})
 	}
 }
+
+func testCopyDB(t *testing.T) {
+	src, tearDown, err := GetDb("Tst_CopySrc")
+	if err != nil {
+		t.Fatalf("init db error:%v", err)
+	}
+	defer tearDown()
+	dst, tearDown2, err := GetDb("Tst_CopyDst")
+	if err != nil {
+		t.Fatalf("init db error:%v", err)
+	}
+	defer tearDown2()
+
+	err = walletdb.Update(src, func(tx walletdb.DBTransaction) error {
+		for _, name := range []string{"root1", "root2"} {
+			root, err := tx.CreateTopLevelBucket(name)
+			if err != nil {
+				return err
+			}
+			if err = root.Put([]byte("k"), []byte(name)); err != nil {
+				return err
+			}
+			sub, err := root.NewBucket("sub")
+			if err != nil {
+				return err
+			}
+			deep, err := sub.NewBucket("deep")
+			if err != nil {
+				return err
+			}
+			if err = deep.Put([]byte("k"), []byte(name+"_deep")); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	// existing buckets of dst are merged
+	err = walletdb.Update(dst, func(tx walletdb.DBTransaction) error {
+		root, err := tx.CreateTopLevelBucket("root1")
+		if err != nil {
+			return err
+		}
+		return root.Put([]byte("old"), []byte("old"))
+	})
+	assert.Nil(t, err)
+
+	assert.Nil(t, walletdb.CopyDB(dst, src))
+	err = walletdb.View(dst, func(tx walletdb.ReadTransaction) error {
+		names, err := tx.BucketNames()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(names))
+		for _, name := range []string{"root1", "root2"} {
+			root := tx.TopLevelBucket(name)
+			v, err := root.Get([]byte("k"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte(name), v)
+			sub := root.Bucket("sub")
+			entries, err := sub.GetByPrefix(nil)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(entries))
+			v, err = sub.Bucket("deep").Get([]byte("k"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte(name+"_deep"), v)
+		}
+		v, err := tx.TopLevelBucket("root1").Get([]byte("old"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("old"), v)
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func testReadTxSnapshot(t *testing.T) {
+	stor, tearDown, err := GetDb("Tst_Snapshot")
+	if err != nil {
+		t.Fatalf("init db error:%v", err)
+	}
+	defer tearDown()
+
+	err = walletdb.Update(stor, func(tx walletdb.DBTransaction) error {
+		root, err := tx.CreateTopLevelBucket("root")
+		if err != nil {
+			return err
+		}
+		return root.Put([]byte("k1"), []byte("v1"))
+	})
+	assert.Nil(t, err)
+
+	rtx, err := stor.BeginReadTx()
+	assert.Nil(t, err)
+	defer rtx.Rollback()
+
+	err = walletdb.Update(stor, func(tx walletdb.DBTransaction) error {
+		root := tx.TopLevelBucket("root")
+		if err := root.Put([]byte("k1"), []byte("v2")); err != nil {
+			return err
+		}
+		if err := root.Put([]byte("k2"), []byte("v2")); err != nil {
+			return err
+		}
+		_, err := tx.CreateTopLevelBucket("other")
+		return err
+	})
+	assert.Nil(t, err)
+
+	// changes committed after the read transaction began are invisible
+	root := rtx.TopLevelBucket("root")
+	v, err := root.Get([]byte("k1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v1"), v)
+	v, err = root.Get([]byte("k2"))
+	assert.Nil(t, err)
+	assert.Nil(t, v)
+	entries, err := root.GetByPrefix([]byte("k"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+	names, err := rtx.BucketNames()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"root"}, names)
+	assert.Nil(t, rtx.TopLevelBucket("other"))
+}
+
+func testViewSnapshot(t *testing.T) {
+	stor, tearDown, err := GetDb("Tst_ViewSnapshot")
+	if err != nil {
+		t.Fatalf("init db error:%v", err)
+	}
+	defer tearDown()
+
+	buckets := []string{"root1", "root2"}
+	err = walletdb.Update(stor, func(tx walletdb.DBTransaction) error {
+		for _, name := range buckets {
+			root, err := tx.CreateTopLevelBucket(name)
+			if err != nil {
+				return err
+			}
+			for _, k := range []string{"k1", "k2"} {
+				if err = root.Put([]byte(k), []byte("v1")); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+
+	keys := func(it walletdb.Iterator) []string {
+		defer it.Release()
+		var ks []string
+		for it.Next() {
+			ks = append(ks, string(it.Key())+"="+string(it.Value()))
+		}
+		return ks
+	}
+	err = walletdb.View(stor, func(tx walletdb.ReadTransaction) error {
+		it := tx.TopLevelBucket("root1").NewIterator(nil)
+		// commit while the view is open
+		err := walletdb.Update(stor, func(tx walletdb.DBTransaction) error {
+			for _, name := range buckets {
+				root := tx.TopLevelBucket(name)
+				if err := root.Put([]byte("k1"), []byte("v2")); err != nil {
+					return err
+				}
+				if err := root.Put([]byte("k3"), []byte("v2")); err != nil {
+					return err
+				}
+				if err := root.Delete([]byte("k2")); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		assert.Nil(t, err)
+
+		// the view reads the entries of all buckets as they were when it began
+		assert.Equal(t, []string{"k1=v1", "k2=v1"}, keys(it))
+		for _, name := range buckets {
+			root := tx.TopLevelBucket(name)
+			v, err := root.Get([]byte("k2"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("v1"), v)
+			assert.Equal(t, []string{"k1=v1", "k2=v1"}, keys(root.NewIterator(nil)))
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+
+	err = walletdb.View(stor, func(tx walletdb.ReadTransaction) error {
+		for _, name := range buckets {
+			assert.Equal(t, []string{"k1=v2", "k3=v2"}, keys(tx.TopLevelBucket(name).NewIterator(nil)))
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+}
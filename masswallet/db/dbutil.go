@@ -22,3 +22,55 @@ func GetOrCreateTopLevelBucket(tx DBTransaction, name string) (b Bucket, err err
 	}
 	return
 }
+
+// CopyDB copies all buckets of src into dst, buckets already in dst are merged.
+func CopyDB(dst, src DB) error {
+	return View(src, func(rtx ReadTransaction) error {
+		names, err := rtx.BucketNames()
+		if err != nil {
+			return err
+		}
+		return Update(dst, func(wtx DBTransaction) error {
+			for _, name := range names {
+				to, err := GetOrCreateTopLevelBucket(wtx, name)
+				if err != nil {
+					return err
+				}
+				if err = copyBucket(to, rtx.TopLevelBucket(name)); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func copyBucket(dst, src Bucket) error {
+	it := src.NewIterator(nil)
+	defer it.Release()
+	for it.Next() {
+		k := append([]byte(nil), it.Key()...)
+		v := append([]byte(nil), it.Value()...)
+		if err := dst.Put(k, v); err != nil {
+			return err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	names, err := src.BucketNames()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		sub, err := GetOrCreateBucket(dst, name)
+		if err != nil {
+			return err
+		}
+		if err = copyBucket(sub, src.Bucket(name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
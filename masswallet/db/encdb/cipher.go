@@ -0,0 +1,134 @@
+package encdb
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+)
+
+// dataCipher encrypts the entries of a db with keys derived from its data key.
+type dataCipher struct {
+	// values are sealed by AES-256-GCM, bound to their bucket path and key
+	aead cipher.AEAD
+
+	// keys and bucket names are encrypted by keyBlock if not nil, see
+	// encryptKey
+	keyBlock cipher.Block
+	keyIV    [aes.BlockSize]byte
+}
+
+func newDataCipher(dataKey []byte, encryptKeys bool) (*dataCipher, error) {
+	block, err := aes.NewCipher(subKey(dataKey, "value"))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	c := &dataCipher{aead: aead}
+	if encryptKeys {
+		if c.keyBlock, err = aes.NewCipher(subKey(dataKey, "key")); err != nil {
+			return nil, err
+		}
+		c.keyBlock.Encrypt(c.keyIV[:], c.keyIV[:])
+	}
+	return c, nil
+}
+
+func subKey(dataKey []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, dataKey)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// sealValue returns nonce | ciphertext of value.
+func (c *dataCipher) sealValue(path []string, key, value []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(value)+c.aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return c.aead.Seal(nonce, nonce, value, additionalData(path, key)), nil
+}
+
+func (c *dataCipher) openValue(path []string, key, sealed []byte) ([]byte, error) {
+	n := c.aead.NonceSize()
+	if len(sealed) < n+c.aead.Overhead() {
+		return nil, ErrDecryptFailed
+	}
+	value, err := c.aead.Open(nil, sealed[:n], sealed[n:], additionalData(path, key))
+	if err != nil {
+		return nil, ErrDecryptFailed
+	}
+	return value, nil
+}
+
+// additionalData binds a value to where it is stored, so that values can not
+// be swapped between keys without being detected.
+func additionalData(path []string, key []byte) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	ad := make([]byte, 0, 64)
+	for _, name := range path {
+		ad = append(ad, buf[:binary.PutUvarint(buf[:], uint64(len(name)))]...)
+		ad = append(ad, name...)
+	}
+	return append(ad, key...)
+}
+
+// encryptKey encrypts p deterministically and byte by byte, each byte is
+// xored with a byte of the AES-CBC-MAC of all the bytes before it. Keys
+// sharing a prefix share the encrypted prefix, so that prefix iteration is
+// served by the underlying db. Nothing but common prefixes and lengths is
+// revealed, the order of keys is not preserved.
+func (c *dataCipher) encryptKey(p []byte) []byte {
+	if c.keyBlock == nil || p == nil {
+		return p
+	}
+	out := make([]byte, len(p))
+	s := c.keyIV
+	for i, b := range p {
+		out[i] = b ^ s[0]
+		s[0] = out[i]
+		c.keyBlock.Encrypt(s[:], s[:])
+	}
+	return out
+}
+
+func (c *dataCipher) decryptKey(p []byte) []byte {
+	if c.keyBlock == nil || p == nil {
+		return p
+	}
+	out := make([]byte, len(p))
+	s := c.keyIV
+	for i, b := range p {
+		out[i] = b ^ s[0]
+		s[0] = b
+		c.keyBlock.Encrypt(s[:], s[:])
+	}
+	return out
+}
+
+// encryptName encrypts a bucket name like a key, hex encoded to remain a
+// valid bucket name of the underlying db.
+func (c *dataCipher) encryptName(name string) string {
+	if c.keyBlock == nil {
+		return name
+	}
+	return hex.EncodeToString(c.encryptKey([]byte(name)))
+}
+
+func (c *dataCipher) decryptName(name string) (string, error) {
+	if c.keyBlock == nil {
+		return name, nil
+	}
+	p, err := hex.DecodeString(name)
+	if err != nil {
+		return "", ErrDecryptFailed
+	}
+	return string(c.decryptKey(p)), nil
+}
@@ -0,0 +1,656 @@
+// Package encdb is a wallet db driver encrypting the entries of another
+// driver at rest.
+//
+// Values are sealed by AES-256-GCM. Optionally keys and bucket names are
+// encrypted too, deterministically so that equal keys and prefixes remain
+// equal and prefix iteration is served by the underlying db. The order of
+// encrypted keys is not preserved, iterators and GetByPrefix of such dbs
+// read the matching entries into memory and sort them.
+//
+// The data key is random, it is stored in the underlying db encrypted by a
+// key derived from the passphrase with scrypt, in the top level bucket
+// "encdb" which is hidden from users of the driver.
+package encdb
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/massnetorg/mass-core/logging"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/keystore/snacl"
+)
+
+const (
+	// DBType is the type of the driver.
+	DBType = "encrypted"
+
+	headerBucket  = "encdb"
+	formatVersion = byte(1)
+
+	flagEncryptKeys = byte(1)
+)
+
+var (
+	keyVersion = []byte("version")
+	keyFlags   = []byte("flags")
+	keyParams  = []byte("params")
+	keyDataKey = []byte("key")
+)
+
+var (
+	ErrNotEncrypted      = errors.New("wallet db is not encrypted")
+	ErrEncrypted         = errors.New("wallet db is encrypted")
+	ErrNotEmpty          = errors.New("wallet db to encrypt is not empty")
+	ErrInvalidPassphrase = errors.New("invalid wallet db passphrase")
+	ErrUnknownFormat     = errors.New("unknown wallet db encryption format")
+	ErrDecryptFailed     = errors.New("failed to decrypt wallet db entry")
+)
+
+// Options controls the encryption of a new db.
+type Options struct {
+	// EncryptKeys encrypts keys and bucket names besides values.
+	EncryptKeys bool
+
+	// scrypt parameters, default snacl.DefaultN, DefaultR and DefaultP
+	ScryptN, ScryptR, ScryptP int
+}
+
+// DB encrypts the entries of an underlying db.
+type DB struct {
+	inner mwdb.DB
+	c     *dataCipher
+}
+
+// Init encrypts the empty db inner with passphrase.
+func Init(inner mwdb.DB, passphrase []byte, opts *Options) (*DB, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	n, r, p := opts.ScryptN, opts.ScryptR, opts.ScryptP
+	if n == 0 {
+		n, r, p = snacl.DefaultN, snacl.DefaultR, snacl.DefaultP
+	}
+	var db *DB
+	err := mwdb.Update(inner, func(tx mwdb.DBTransaction) error {
+		names, err := tx.BucketNames()
+		if err != nil {
+			return err
+		}
+		if len(names) != 0 {
+			return ErrNotEmpty
+		}
+		sk, err := snacl.NewSecretKey(&passphrase, n, r, p)
+		if err != nil {
+			return err
+		}
+		dataKey, err := snacl.GenerateCryptoKey()
+		if err != nil {
+			return err
+		}
+		defer dataKey.Zero()
+		sealed, err := sk.Encrypt(dataKey[:])
+		if err != nil {
+			return err
+		}
+		flags := byte(0)
+		if opts.EncryptKeys {
+			flags |= flagEncryptKeys
+		}
+		c, err := newDataCipher(dataKey[:], opts.EncryptKeys)
+		if err != nil {
+			return err
+		}
+
+		header, err := tx.CreateTopLevelBucket(headerBucket)
+		if err != nil {
+			return err
+		}
+		for _, e := range []*mwdb.Entry{
+			{Key: keyVersion, Value: []byte{formatVersion}},
+			{Key: keyFlags, Value: []byte{flags}},
+			{Key: keyParams, Value: sk.Marshal()},
+			{Key: keyDataKey, Value: sealed},
+		} {
+			if err = header.Put(e.Key, e.Value); err != nil {
+				return err
+			}
+		}
+		db = &DB{inner: inner, c: c}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	logging.CPrint(logging.INFO, "wallet db encrypted", logging.LogFormat{"encrypt_keys": opts.EncryptKeys})
+	return db, nil
+}
+
+// Open decrypts the data key of the encrypted db inner with passphrase.
+func Open(inner mwdb.DB, passphrase []byte) (*DB, error) {
+	var db *DB
+	err := mwdb.View(inner, func(tx mwdb.ReadTransaction) error {
+		header := tx.TopLevelBucket(headerBucket)
+		if header == nil {
+			return ErrNotEncrypted
+		}
+		values := make([][]byte, 4)
+		for i, k := range [][]byte{keyVersion, keyFlags, keyParams, keyDataKey} {
+			v, err := header.Get(k)
+			if err != nil {
+				return err
+			}
+			if len(v) == 0 {
+				return ErrUnknownFormat
+			}
+			values[i] = v
+		}
+		if len(values[0]) != 1 || values[0][0] != formatVersion || len(values[1]) != 1 {
+			return ErrUnknownFormat
+		}
+		var sk snacl.SecretKey
+		if err := sk.Unmarshal(values[2]); err != nil {
+			return ErrUnknownFormat
+		}
+		if err := sk.DeriveKey(&passphrase); err != nil {
+			if err == snacl.ErrInvalidPassword {
+				return ErrInvalidPassphrase
+			}
+			return err
+		}
+		defer sk.Zero()
+		dataKey, err := sk.Decrypt(values[3])
+		if err != nil {
+			return ErrInvalidPassphrase
+		}
+		c, err := newDataCipher(dataKey, values[1][0]&flagEncryptKeys != 0)
+		if err != nil {
+			return err
+		}
+		db = &DB{inner: inner, c: c}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// IsEncrypted returns whether inner is an encrypted db.
+func IsEncrypted(inner mwdb.DB) (bool, error) {
+	var encrypted bool
+	err := mwdb.View(inner, func(tx mwdb.ReadTransaction) error {
+		encrypted = tx.TopLevelBucket(headerBucket) != nil
+		return nil
+	})
+	return encrypted, err
+}
+
+// Inner returns the underlying db, whose entries are encrypted.
+func (db *DB) Inner() mwdb.DB {
+	return db.inner
+}
+
+// EncryptsKeys returns whether keys and bucket names are encrypted.
+func (db *DB) EncryptsKeys() bool {
+	return db.c.keyBlock != nil
+}
+
+// Close closes the underlying db.
+func (db *DB) Close() error {
+	return db.inner.Close()
+}
+
+// BeginTx ...
+func (db *DB) BeginTx() (mwdb.DBTransaction, error) {
+	tx, err := db.inner.BeginTx()
+	if err != nil {
+		return nil, err
+	}
+	return &transaction{db: db, r: tx, w: tx}, nil
+}
+
+// BeginReadTx ...
+func (db *DB) BeginReadTx() (mwdb.ReadTransaction, error) {
+	tx, err := db.inner.BeginReadTx()
+	if err != nil {
+		return nil, err
+	}
+	return db.ReadTx(tx), nil
+}
+
+// ReadTx decrypts what is read by tx, a read transaction of the underlying
+// db. Rolling back the returned transaction rolls back tx.
+func (db *DB) ReadTx(tx mwdb.ReadTransaction) mwdb.ReadTransaction {
+	return &transaction{db: db, r: tx}
+}
+
+type transaction struct {
+	db *DB
+	r  mwdb.ReadTransaction
+	w  mwdb.DBTransaction // nil for read transactions
+}
+
+func (tx *transaction) TopLevelBucket(name string) mwdb.Bucket {
+	if name == headerBucket {
+		return nil
+	}
+	b := tx.r.TopLevelBucket(tx.db.c.encryptName(name))
+	if b == nil {
+		return nil
+	}
+	return &bucket{tx: tx, b: b, path: []string{name}}
+}
+
+func (tx *transaction) BucketNames() ([]string, error) {
+	names, err := tx.r.BucketNames()
+	if err != nil {
+		return nil, err
+	}
+	return tx.decryptNames(names, true)
+}
+
+func (tx *transaction) decryptNames(names []string, top bool) ([]string, error) {
+	plain := make([]string, 0, len(names))
+	for _, name := range names {
+		if top && name == headerBucket {
+			continue
+		}
+		name, err := tx.db.c.decryptName(name)
+		if err != nil {
+			return nil, err
+		}
+		plain = append(plain, name)
+	}
+	if tx.db.c.keyBlock != nil {
+		sort.Strings(plain)
+	}
+	return plain, nil
+}
+
+func (tx *transaction) FetchBucket(meta mwdb.BucketMeta) mwdb.Bucket {
+	m, ok := meta.(*bucketMeta)
+	if !ok {
+		return nil
+	}
+	b := tx.r.FetchBucket(m.inner)
+	if b == nil {
+		return nil
+	}
+	return &bucket{tx: tx, b: b, path: m.paths}
+}
+
+func (tx *transaction) CreateTopLevelBucket(name string) (mwdb.Bucket, error) {
+	if name == headerBucket {
+		return nil, mwdb.ErrInvalidBucketName
+	}
+	b, err := tx.w.CreateTopLevelBucket(tx.db.c.encryptName(name))
+	if err != nil {
+		return nil, err
+	}
+	return &bucket{tx: tx, b: b, path: []string{name}}, nil
+}
+
+func (tx *transaction) DeleteTopLevelBucket(name string) error {
+	if name == headerBucket {
+		return mwdb.ErrBucketNotFound
+	}
+	return tx.w.DeleteTopLevelBucket(tx.db.c.encryptName(name))
+}
+
+func (tx *transaction) Commit() error {
+	return tx.w.Commit()
+}
+
+func (tx *transaction) Rollback() error {
+	return tx.r.Rollback()
+}
+
+type bucket struct {
+	tx   *transaction
+	b    mwdb.Bucket
+	path []string // plain names from the top level bucket
+}
+
+func (b *bucket) sub(inner mwdb.Bucket, name string) *bucket {
+	path := make([]string, len(b.path)+1)
+	copy(path, b.path)
+	path[len(b.path)] = name
+	return &bucket{tx: b.tx, b: inner, path: path}
+}
+
+func (b *bucket) NewBucket(name string) (mwdb.Bucket, error) {
+	sub, err := b.b.NewBucket(b.tx.db.c.encryptName(name))
+	if err != nil {
+		return nil, err
+	}
+	return b.sub(sub, name), nil
+}
+
+func (b *bucket) Bucket(name string) mwdb.Bucket {
+	sub := b.b.Bucket(b.tx.db.c.encryptName(name))
+	if sub == nil {
+		return nil
+	}
+	return b.sub(sub, name)
+}
+
+func (b *bucket) BucketNames() ([]string, error) {
+	names, err := b.b.BucketNames()
+	if err != nil {
+		return nil, err
+	}
+	return b.tx.decryptNames(names, false)
+}
+
+func (b *bucket) DeleteBucket(name string) error {
+	return b.b.DeleteBucket(b.tx.db.c.encryptName(name))
+}
+
+func (b *bucket) Put(key, value []byte) error {
+	if len(key) == 0 {
+		return mwdb.ErrIllegalKey
+	}
+	if len(value) == 0 {
+		return mwdb.ErrIllegalValue
+	}
+	sealed, err := b.tx.db.c.sealValue(b.path, key, value)
+	if err != nil {
+		return err
+	}
+	return b.b.Put(b.tx.db.c.encryptKey(key), sealed)
+}
+
+func (b *bucket) Delete(key []byte) error {
+	return b.b.Delete(b.tx.db.c.encryptKey(key))
+}
+
+// Get returns nil if not found
+func (b *bucket) Get(key []byte) ([]byte, error) {
+	sealed, err := b.b.Get(b.tx.db.c.encryptKey(key))
+	if err != nil || sealed == nil {
+		return nil, err
+	}
+	return b.tx.db.c.openValue(b.path, key, sealed)
+}
+
+func (b *bucket) Clear() error {
+	return b.b.Clear()
+}
+
+func (b *bucket) GetByPrefix(prefix []byte) ([]*mwdb.Entry, error) {
+	c := b.tx.db.c
+	entries, err := b.b.GetByPrefix(c.encryptKey(prefix))
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		e.Key = c.decryptKey(e.Key)
+		if e.Value, err = c.openValue(b.path, e.Key, e.Value); err != nil {
+			return nil, err
+		}
+	}
+	if c.keyBlock != nil {
+		sort.Slice(entries, func(i, j int) bool {
+			return bytes.Compare(entries[i].Key, entries[j].Key) < 0
+		})
+	}
+	return entries, nil
+}
+
+func (b *bucket) GetBucketMeta() mwdb.BucketMeta {
+	return &bucketMeta{inner: b.b.GetBucketMeta(), paths: b.path}
+}
+
+func (b *bucket) NewIterator(slice *mwdb.Range) mwdb.Iterator {
+	var start, limit []byte
+	if slice != nil {
+		start, limit = slice.Start, slice.Limit
+	}
+	if b.tx.db.c.keyBlock == nil {
+		// the underlying driver may modify the range
+		return &iterator{b: b, it: b.b.NewIterator(&mwdb.Range{Start: start, Limit: limit})}
+	}
+	return b.newSortedIterator(start, limit)
+}
+
+type bucketMeta struct {
+	inner mwdb.BucketMeta
+	paths []string
+}
+
+func (m *bucketMeta) Paths() []string {
+	return m.paths
+}
+
+func (m *bucketMeta) Name() string {
+	return m.paths[len(m.paths)-1]
+}
+
+func (m *bucketMeta) Depth() int {
+	return m.inner.Depth()
+}
+
+// iterator decrypts the values of an iterator of the underlying db, for dbs
+// of plain keys.
+type iterator struct {
+	b     *bucket
+	it    mwdb.Iterator
+	value []byte
+	err   error
+}
+
+func (it *iterator) open(ok bool) bool {
+	it.value = nil
+	if !ok || it.err != nil {
+		return false
+	}
+	it.value, it.err = it.b.tx.db.c.openValue(it.b.path, it.it.Key(), it.it.Value())
+	return it.err == nil
+}
+
+func (it *iterator) Seek(key []byte) bool {
+	return it.open(it.it.Seek(key))
+}
+
+func (it *iterator) Next() bool {
+	return it.open(it.it.Next())
+}
+
+func (it *iterator) Key() []byte {
+	if it.value == nil {
+		return nil
+	}
+	return it.it.Key()
+}
+
+func (it *iterator) Value() []byte {
+	return it.value
+}
+
+func (it *iterator) Release() {
+	it.it.Release()
+}
+
+func (it *iterator) Error() error {
+	if it.err != nil {
+		return it.err
+	}
+	return it.it.Error()
+}
+
+// sortedIterator iterates the entries of a range read into memory, for dbs of
+// encrypted keys.
+type sortedIterator struct {
+	entries []*mwdb.Entry
+	pos     int
+	err     error
+}
+
+func (b *bucket) newSortedIterator(start, limit []byte) *sortedIterator {
+	// only a common prefix of the range survives the encryption of keys
+	var prefix []byte
+	switch {
+	case len(limit) == 0:
+	case bytes.Equal(mwdb.BytesPrefix(start).Limit, limit):
+		prefix = start
+	default:
+		for len(prefix) < len(start) && len(prefix) < len(limit) && start[len(prefix)] == limit[len(prefix)] {
+			prefix = start[:len(prefix)+1]
+		}
+	}
+
+	c := b.tx.db.c
+	si := &sortedIterator{pos: -1}
+	it := b.b.NewIterator(mwdb.BytesPrefix(c.encryptKey(prefix)))
+	defer it.Release()
+	for it.Next() {
+		key := c.decryptKey(it.Key())
+		if bytes.Compare(key, start) < 0 || (len(limit) > 0 && bytes.Compare(key, limit) >= 0) {
+			continue
+		}
+		value, err := c.openValue(b.path, key, it.Value())
+		if err != nil {
+			si.err = err
+			return si
+		}
+		si.entries = append(si.entries, &mwdb.Entry{Key: key, Value: value})
+	}
+	if si.err = it.Error(); si.err != nil {
+		return si
+	}
+	sort.Slice(si.entries, func(i, j int) bool {
+		return bytes.Compare(si.entries[i].Key, si.entries[j].Key) < 0
+	})
+	return si
+}
+
+func (si *sortedIterator) Seek(key []byte) bool {
+	if si.err != nil {
+		return false
+	}
+	si.pos = sort.Search(len(si.entries), func(i int) bool {
+		return bytes.Compare(si.entries[i].Key, key) >= 0
+	})
+	return si.pos < len(si.entries)
+}
+
+func (si *sortedIterator) Next() bool {
+	if si.err != nil || si.pos >= len(si.entries) {
+		return false
+	}
+	si.pos++
+	return si.pos < len(si.entries)
+}
+
+func (si *sortedIterator) valid() bool {
+	return si.pos >= 0 && si.pos < len(si.entries)
+}
+
+func (si *sortedIterator) Key() []byte {
+	if !si.valid() {
+		return nil
+	}
+	return si.entries[si.pos].Key
+}
+
+func (si *sortedIterator) Value() []byte {
+	if !si.valid() {
+		return nil
+	}
+	return si.entries[si.pos].Value
+}
+
+func (si *sortedIterator) Release() {
+	si.entries = nil
+}
+
+func (si *sortedIterator) Error() error {
+	return si.err
+}
+
+// ------------------ Export -------------------- //
+
+func init() {
+	mwdb.RegisterDriver(mwdb.DBDriver{
+		Type:     DBType,
+		OpenDB:   OpenDB,
+		CreateDB: CreateDB,
+	})
+}
+
+// parseArgs parses the driver args path, type of the underlying driver,
+// passphrase (string or []byte) and, if create, optional *Options.
+func parseArgs(create bool, args ...interface{}) (path, dbType string, passphrase []byte, opts *Options, err error) {
+	if len(args) < 3 || len(args) > 4 || (!create && len(args) == 4) {
+		return "", "", nil, nil, mwdb.ErrInvalidArgument
+	}
+	var ok bool
+	if path, ok = args[0].(string); !ok {
+		return "", "", nil, nil, mwdb.ErrInvalidArgument
+	}
+	if dbType, ok = args[1].(string); !ok || dbType == DBType {
+		return "", "", nil, nil, mwdb.ErrInvalidArgument
+	}
+	switch p := args[2].(type) {
+	case string:
+		passphrase = []byte(p)
+	case []byte:
+		passphrase = p
+	default:
+		return "", "", nil, nil, mwdb.ErrInvalidArgument
+	}
+	if len(passphrase) == 0 {
+		return "", "", nil, nil, mwdb.ErrInvalidArgument
+	}
+	if len(args) == 4 {
+		if opts, ok = args[3].(*Options); !ok {
+			return "", "", nil, nil, mwdb.ErrInvalidArgument
+		}
+	}
+	return path, dbType, passphrase, opts, nil
+}
+
+// CreateDB creates an encrypted db of args path, type of the underlying
+// driver, passphrase and optional *Options.
+func CreateDB(args ...interface{}) (mwdb.DB, error) {
+	path, dbType, passphrase, opts, err := parseArgs(true, args...)
+	if err != nil {
+		return nil, err
+	}
+	inner, err := mwdb.CreateDB(dbType, path)
+	if err != nil {
+		return nil, err
+	}
+	db, err := Init(inner, passphrase, opts)
+	if err != nil {
+		inner.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// OpenDB opens an encrypted db of args path, type of the underlying driver
+// and passphrase.
+func OpenDB(args ...interface{}) (mwdb.DB, error) {
+	path, dbType, passphrase, _, err := parseArgs(false, args...)
+	if err != nil {
+		return nil, err
+	}
+	inner, err := mwdb.OpenDB(dbType, path)
+	if err != nil {
+		return nil, err
+	}
+	db, err := Open(inner, passphrase)
+	if err != nil {
+		inner.Close()
+		logging.CPrint(logging.ERROR, "failed to open encrypted wallet db", logging.LogFormat{
+			"path": path,
+			"err":  err,
+		})
+		return nil, err
+	}
+	return db, nil
+}
@@ -0,0 +1,220 @@
+package encdb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/db/memdb"
+)
+
+var (
+	testPass = []byte("passphrase")
+	testOpts = &Options{ScryptN: 1024, ScryptR: 8, ScryptP: 1}
+)
+
+func newTestDB(t *testing.T, name string, encryptKeys bool) (*DB, mwdb.DB) {
+	memdb.RemoveDB(name)
+	inner, err := memdb.CreateDB(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := *testOpts
+	opts.EncryptKeys = encryptKeys
+	db, err := Init(inner, testPass, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, inner
+}
+
+// rawEntries returns all keys, values and bucket names of the underlying db.
+func rawEntries(t *testing.T, inner mwdb.DB) [][]byte {
+	var raw [][]byte
+	var walk func(b mwdb.Bucket) error
+	walk = func(b mwdb.Bucket) error {
+		it := b.NewIterator(nil)
+		for it.Next() {
+			raw = append(raw, append([]byte{}, it.Key()...), append([]byte{}, it.Value()...))
+		}
+		it.Release()
+		names, err := b.BucketNames()
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			raw = append(raw, []byte(name))
+			if err = walk(b.Bucket(name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	err := mwdb.View(inner, func(tx mwdb.ReadTransaction) error {
+		names, err := tx.BucketNames()
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			raw = append(raw, []byte(name))
+			if err = walk(tx.TopLevelBucket(name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func contains(raw [][]byte, s string) bool {
+	for _, r := range raw {
+		if bytes.Contains(r, []byte(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestEncryptedAtRest(t *testing.T) {
+	for _, encryptKeys := range []bool{false, true} {
+		db, inner := newTestDB(t, "Tst_EncAtRest", encryptKeys)
+
+		err := mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+			top, err := tx.CreateTopLevelBucket("addresses")
+			if err != nil {
+				return err
+			}
+			wallet, err := top.NewBucket("ac10wallet")
+			if err != nil {
+				return err
+			}
+			return wallet.Put([]byte("ms1qaddress"), []byte("pubkey-of-address"))
+		})
+		assert.Nil(t, err)
+
+		raw := rawEntries(t, inner)
+		assert.False(t, contains(raw, "pubkey-of-address"))
+		assert.Equal(t, !encryptKeys, contains(raw, "ms1qaddress"))
+		assert.Equal(t, !encryptKeys, contains(raw, "ac10wallet"))
+		assert.Equal(t, !encryptKeys, contains(raw, "addresses"))
+
+		// the header is hidden
+		err = mwdb.View(db, func(tx mwdb.ReadTransaction) error {
+			names, err := tx.BucketNames()
+			assert.Equal(t, []string{"addresses"}, names)
+			assert.Nil(t, tx.TopLevelBucket(headerBucket))
+			return err
+		})
+		assert.Nil(t, err)
+		db.Close()
+
+		// reopen
+		inner, err = memdb.OpenDB("Tst_EncAtRest")
+		assert.Nil(t, err)
+		db, err = Open(inner, testPass)
+		assert.Nil(t, err)
+		assert.Equal(t, encryptKeys, db.EncryptsKeys())
+		err = mwdb.View(db, func(tx mwdb.ReadTransaction) error {
+			v, err := tx.TopLevelBucket("addresses").Bucket("ac10wallet").Get([]byte("ms1qaddress"))
+			assert.Equal(t, []byte("pubkey-of-address"), v)
+			return err
+		})
+		assert.Nil(t, err)
+		db.Close()
+		memdb.RemoveDB("Tst_EncAtRest")
+	}
+}
+
+func TestOpen(t *testing.T) {
+	db, inner := newTestDB(t, "Tst_EncOpen", false)
+	defer memdb.RemoveDB("Tst_EncOpen")
+
+	_, err := Open(inner, []byte("wrong"))
+	assert.Equal(t, ErrInvalidPassphrase, err)
+	_, err = Init(inner, testPass, testOpts)
+	assert.Equal(t, ErrNotEmpty, err)
+	encrypted, err := IsEncrypted(inner)
+	assert.Nil(t, err)
+	assert.True(t, encrypted)
+	db.Close()
+
+	memdb.RemoveDB("Tst_EncPlain")
+	defer memdb.RemoveDB("Tst_EncPlain")
+	plain, err := memdb.CreateDB("Tst_EncPlain")
+	assert.Nil(t, err)
+	defer plain.Close()
+	_, err = Open(plain, testPass)
+	assert.Equal(t, ErrNotEncrypted, err)
+	encrypted, err = IsEncrypted(plain)
+	assert.Nil(t, err)
+	assert.False(t, encrypted)
+}
+
+func TestValueBoundToKey(t *testing.T) {
+	db, inner := newTestDB(t, "Tst_EncSwap", false)
+	defer memdb.RemoveDB("Tst_EncSwap")
+	defer db.Close()
+
+	err := mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		b, err := tx.CreateTopLevelBucket("balances")
+		if err != nil {
+			return err
+		}
+		if err = b.Put([]byte("a"), []byte("1")); err != nil {
+			return err
+		}
+		return b.Put([]byte("b"), []byte("1000"))
+	})
+	assert.Nil(t, err)
+
+	// move the value of b to a in the underlying db
+	err = mwdb.Update(inner, func(tx mwdb.DBTransaction) error {
+		b := tx.TopLevelBucket("balances")
+		v, err := b.Get([]byte("b"))
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte("a"), v)
+	})
+	assert.Nil(t, err)
+
+	err = mwdb.View(db, func(tx mwdb.ReadTransaction) error {
+		_, err := tx.TopLevelBucket("balances").Get([]byte("a"))
+		return err
+	})
+	assert.Equal(t, ErrDecryptFailed, err)
+}
+
+func TestEncryptKey(t *testing.T) {
+	c, err := newDataCipher(bytes.Repeat([]byte{1}, 32), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := [][]byte{
+		[]byte("ac10wallet1"),
+		[]byte("ac10wallet1\x00\x00\x00\x00\x00\x00\x00\x01"),
+		[]byte("ac10wallet2"),
+		{},
+	}
+	for _, k := range keys {
+		enc := c.encryptKey(k)
+		assert.Equal(t, len(k), len(enc))
+		assert.Equal(t, k, c.decryptKey(enc))
+	}
+	// prefixes are preserved, nothing else
+	enc0, enc1, enc2 := c.encryptKey(keys[0]), c.encryptKey(keys[1]), c.encryptKey(keys[2])
+	assert.True(t, bytes.HasPrefix(enc1, enc0))
+	assert.Equal(t, enc0[:10], enc2[:10])
+	assert.NotEqual(t, enc0[10], enc2[10])
+	assert.NotEqual(t, keys[0], enc0)
+
+	name := c.encryptName("ac10wallet1")
+	plain, err := c.decryptName(name)
+	assert.Nil(t, err)
+	assert.Equal(t, "ac10wallet1", plain)
+}
@@ -0,0 +1,740 @@
+package memdb
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"massnet.org/mass-wallet/masswallet/db"
+)
+
+const (
+	// Keys are laid out the same as package ldb,
+	//
+	//	<b_1_top, top>             index entry of top level bucket
+	//	<b_2_top_sub, sub>         index entry of sub bucket
+	//	<1_top_key, value>         k/v entry in top level bucket
+	//	<2_top_sub_key, value>     k/v entry in sub bucket
+	bucketNameBucket    = "b"
+	bucketPathSep       = "_"
+	topLevelBucketDepth = "1"
+
+	maxBucketNameLen = 256
+
+	// pending changes of a write transaction are prefixed by the op
+	opPut    = byte(1)
+	opDelete = byte(0)
+
+	initialCapacity = 4 * 1024 * 1024
+	// minCompactGarbage is the least bytes of deleted or overwritten entries to
+	// rebuild the store, the skiplist never reclaims them itself.
+	minCompactGarbage = 16 * 1024 * 1024
+)
+
+// ErrClosed is returned beginning a transaction on a closed MemDB.
+var ErrClosed = errors.New("db closed")
+
+var (
+	storesMtx sync.Mutex
+	stores    = make(map[string]*store)
+)
+
+// store is the content of a named in-memory db, it outlives the MemDB handles
+// so that a closed db can be opened again, the way a db on disk is.
+type store struct {
+	mu      sync.Mutex // guards cur and garbage
+	muTr    sync.Mutex // one write transaction at a time
+	cur     *generation
+	garbage int
+}
+
+// generation is the committed entries of a store read by transactions. Once
+// a read transaction holds it, it is never changed again, commits apply their
+// changes to a copy instead.
+type generation struct {
+	data    *memdb.DB
+	readers int // open read transactions
+}
+
+// MemDB is a wallet db held in memory, the content is lost on exit.
+type MemDB struct {
+	path   string
+	s      *store
+	mu     sync.RWMutex
+	closed bool
+}
+
+// Close closes the handle, the content is kept until RemoveDB.
+func (m *MemDB) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+	return nil
+}
+
+// BeginTx ...
+func (m *MemDB) BeginTx() (db.DBTransaction, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return nil, ErrClosed
+	}
+	m.s.muTr.Lock()
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	return &transaction{
+		s:       m.s,
+		gen:     m.s.cur,
+		pending: memdb.New(comparer.DefaultComparer, 0),
+	}, nil
+}
+
+// BeginReadTx returns a transaction reading a snapshot of the committed
+// entries, like ldb and rdb it doesn't see later commits.
+func (m *MemDB) BeginReadTx() (db.ReadTransaction, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return nil, ErrClosed
+	}
+	m.s.mu.Lock()
+	defer m.s.mu.Unlock()
+	m.s.cur.readers++
+	return &transaction{
+		s:        m.s,
+		gen:      m.s.cur,
+		readOnly: true,
+	}, nil
+}
+
+// transaction reads committed entries of the generation it began with, a
+// write transaction also reads its own pending changes, which are applied to
+// the store on Commit.
+type transaction struct {
+	s        *store
+	gen      *generation
+	readOnly bool
+	pending  *memdb.DB // nil if readOnly
+	done     bool
+}
+
+func joinBucketPath(arr ...string) string {
+	return strings.Join(arr, bucketPathSep)
+}
+
+// get returns a copy of the value of k, nil if not found.
+func (tx *transaction) get(k []byte) []byte {
+	if !tx.readOnly {
+		if v, err := tx.pending.Get(k); err == nil {
+			if v[0] == opDelete {
+				return nil
+			}
+			return append([]byte(nil), v[1:]...)
+		}
+	}
+	v, err := tx.gen.data.Get(k)
+	if err != nil {
+		return nil
+	}
+	return append([]byte(nil), v...)
+}
+
+func (tx *transaction) put(k, v []byte) {
+	pv := make([]byte, len(v)+1)
+	pv[0] = opPut
+	copy(pv[1:], v)
+	tx.pending.Put(k, pv)
+}
+
+func (tx *transaction) delete(k []byte) {
+	tx.pending.Put(k, []byte{opDelete})
+}
+
+// newIterator returns an iterator of entries in slice, merging pending changes.
+func (tx *transaction) newIterator(slice *util.Range) *mergedIterator {
+	it := &mergedIterator{base: tx.gen.data.NewIterator(slice)}
+	if !tx.readOnly {
+		it.pending = tx.pending.NewIterator(slice)
+	}
+	return it
+}
+
+// names returns the names of bucket index entries under prefix.
+func (tx *transaction) names(prefix []byte) ([]string, error) {
+	it := tx.newIterator(util.BytesPrefix(prefix))
+	defer it.Release()
+	names := make([]string, 0)
+	for it.Next() {
+		names = append(names, string(it.Value()))
+	}
+	return names, it.Error()
+}
+
+func (tx *transaction) bucket(path string, name string, depth int) *memBucket {
+	if tx.get([]byte(joinBucketPath(bucketNameBucket, path))) == nil {
+		return nil
+	}
+	return &memBucket{
+		tx:    tx,
+		name:  name,
+		path:  path,
+		depth: depth,
+	}
+}
+
+// TopLevelBucket ...
+func (tx *transaction) TopLevelBucket(name string) db.Bucket {
+	if b := tx.bucket(joinBucketPath(topLevelBucketDepth, name), name, 1); b != nil {
+		return b
+	}
+	return nil
+}
+
+// BucketNames ...
+func (tx *transaction) BucketNames() ([]string, error) {
+	return tx.names([]byte(joinBucketPath(bucketNameBucket, topLevelBucketDepth, "")))
+}
+
+// FetchBucket returns Bucket
+func (tx *transaction) FetchBucket(meta db.BucketMeta) db.Bucket {
+	if meta == nil {
+		return nil
+	}
+	if b := tx.bucket(joinBucketPath(meta.Paths()...), meta.Name(), meta.Depth()); b != nil {
+		return b
+	}
+	return nil
+}
+
+// CreateTopLevelBucket ...
+func (tx *transaction) CreateTopLevelBucket(name string) (db.Bucket, error) {
+	if tx.readOnly {
+		return nil, db.ErrWriteNotAllowed
+	}
+	if !isValidBucketName(name) {
+		return nil, db.ErrInvalidBucketName
+	}
+	return tx.newBucket(joinBucketPath(topLevelBucketDepth, name), name, 1)
+}
+
+func (tx *transaction) newBucket(path string, name string, depth int) (*memBucket, error) {
+	key := []byte(joinBucketPath(bucketNameBucket, path))
+	if tx.get(key) != nil {
+		return nil, db.ErrBucketExist
+	}
+	tx.put(key, []byte(name))
+	logging.VPrint(logging.DEBUG, "new bucket", logging.LogFormat{"bucket": string(key)})
+	return &memBucket{
+		tx:    tx,
+		name:  name,
+		path:  path,
+		depth: depth,
+	}, nil
+}
+
+// DeleteTopLevelBucket ...
+func (tx *transaction) DeleteTopLevelBucket(name string) error {
+	return db.ErrNotSupported
+}
+
+// Rollback discards pending changes, a read transaction releases its
+// snapshot.
+func (tx *transaction) Rollback() error {
+	if tx.done {
+		return nil
+	}
+	tx.done = true
+	if tx.readOnly {
+		tx.s.mu.Lock()
+		tx.gen.readers--
+		tx.s.mu.Unlock()
+		return nil
+	}
+	tx.s.muTr.Unlock()
+	return nil
+}
+
+// Commit applies pending changes to the store at once.
+func (tx *transaction) Commit() error {
+	if tx.readOnly || tx.done {
+		return nil
+	}
+	tx.done = true
+	defer tx.s.muTr.Unlock()
+
+	s := tx.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cur.readers > 0 {
+		// open read transactions keep reading the current generation
+		s.cur = &generation{data: copyData(s.cur.data)}
+		s.garbage = 0
+	}
+	data := s.cur.data
+	it := tx.pending.NewIterator(nil)
+	defer it.Release()
+	for it.Next() {
+		k, v := it.Key(), it.Value()
+		if old, err := data.Get(k); err == nil {
+			s.garbage += len(k) + len(old)
+		}
+		if v[0] == opDelete {
+			data.Delete(k)
+		} else {
+			data.Put(k, v[1:])
+		}
+	}
+	s.compact()
+	return nil
+}
+
+// compact rebuilds data once deleted and overwritten entries outweigh the
+// live ones, requires s.mu to be locked and no reader of s.cur.
+func (s *store) compact() {
+	if s.garbage < minCompactGarbage || s.garbage < s.cur.data.Size() {
+		return
+	}
+	data := copyData(s.cur.data)
+	logging.CPrint(logging.DEBUG, "compact memdb", logging.LogFormat{
+		"size":    data.Size(),
+		"garbage": s.garbage,
+	})
+	s.cur.data = data
+	s.garbage = 0
+}
+
+// copyData returns a copy of the live entries of data.
+func copyData(data *memdb.DB) *memdb.DB {
+	cp := memdb.New(comparer.DefaultComparer, data.Size())
+	it := data.NewIterator(nil)
+	for it.Next() {
+		cp.Put(it.Key(), it.Value())
+	}
+	it.Release()
+	return cp
+}
+
+// memBucket ...
+type memBucket struct {
+	tx    *transaction
+	name  string
+	path  string
+	depth int
+}
+
+// NewBucket create sub bucket
+func (b *memBucket) NewBucket(name string) (db.Bucket, error) {
+	if b.tx.readOnly {
+		return nil, db.ErrWriteNotAllowed
+	}
+	path, err := b.subPath(name)
+	if err != nil {
+		return nil, err
+	}
+	return b.tx.newBucket(path, name, b.depth+1)
+}
+
+// Bucket ...
+func (b *memBucket) Bucket(name string) db.Bucket {
+	path, err := b.subPath(name)
+	if err != nil {
+		return nil
+	}
+	if sub := b.tx.bucket(path, name, b.depth+1); sub != nil {
+		return sub
+	}
+	return nil
+}
+
+// subPath returns the path of sub bucket name, e.g. 1_top --> 2_top_child
+func (b *memBucket) subPath(name string) (string, error) {
+	if !isValidBucketName(name) {
+		return "", db.ErrInvalidBucketName
+	}
+	ss := strings.Split(b.path, bucketPathSep)
+	if len(ss) < 2 {
+		return "", db.ErrIllegalBucketPath
+	}
+	ss[0] = strconv.Itoa(b.depth + 1)
+	return joinBucketPath(append(ss, name)...), nil
+}
+
+// BucketNames ...
+func (b *memBucket) BucketNames() ([]string, error) {
+	ss := strings.Split(b.path, bucketPathSep)
+	if len(ss) < 2 {
+		return nil, db.ErrIllegalBucketPath
+	}
+	ss[0] = strconv.Itoa(b.depth + 1)
+	ss = append(ss, "")
+	return b.tx.names([]byte(joinBucketPath(bucketNameBucket, joinBucketPath(ss...))))
+}
+
+// DeleteBucket deletes sub bucket name, along with its entries and sub buckets.
+func (b *memBucket) DeleteBucket(name string) error {
+	if b.tx.readOnly {
+		return db.ErrWriteNotAllowed
+	}
+	if _, err := b.subPath(name); err != nil {
+		return err
+	}
+	sub := b.Bucket(name)
+	if sub == nil {
+		return nil
+	}
+	return sub.(*memBucket).delete()
+}
+
+func (b *memBucket) delete() error {
+	names, err := b.BucketNames()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if sub := b.Bucket(name); sub != nil {
+			if err = sub.(*memBucket).delete(); err != nil {
+				return err
+			}
+		}
+	}
+	if err = b.Clear(); err != nil {
+		return err
+	}
+	b.tx.delete([]byte(joinBucketPath(bucketNameBucket, b.path)))
+	return nil
+}
+
+// Put ...
+func (b *memBucket) Put(key, value []byte) error {
+	if b.tx.readOnly {
+		return db.ErrWriteNotAllowed
+	}
+	if len(value) == 0 {
+		return db.ErrIllegalValue
+	}
+	if len(key) == 0 {
+		return db.ErrIllegalKey
+	}
+	b.tx.put(b.innerKey(key), value)
+	return nil
+}
+
+// Get returns nil if not found
+func (b *memBucket) Get(key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, nil
+	}
+	return b.tx.get(b.innerKey(key)), nil
+}
+
+// Delete ...
+func (b *memBucket) Delete(key []byte) error {
+	if b.tx.readOnly {
+		return db.ErrWriteNotAllowed
+	}
+	if len(key) == 0 {
+		return nil
+	}
+	b.tx.delete(b.innerKey(key))
+	return nil
+}
+
+// Clear deletes all entries of the bucket, sub buckets are kept.
+func (b *memBucket) Clear() error {
+	if b.tx.readOnly {
+		return db.ErrWriteNotAllowed
+	}
+	it := b.tx.newIterator(util.BytesPrefix(b.innerKey(nil)))
+	defer it.Release()
+	var keys [][]byte
+	for it.Next() {
+		keys = append(keys, append([]byte(nil), it.Key()...))
+	}
+	for _, k := range keys {
+		b.tx.delete(k)
+	}
+	return it.Error()
+}
+
+// GetByPrefix ...
+func (b *memBucket) GetByPrefix(prefix []byte) ([]*db.Entry, error) {
+	it := b.tx.newIterator(util.BytesPrefix(b.innerKey(prefix)))
+	defer it.Release()
+	entries := make([]*db.Entry, 0)
+	for it.Next() {
+		entries = append(entries, &db.Entry{
+			Key:   append([]byte(nil), it.Key()[len(b.path)+1:]...),
+			Value: append([]byte(nil), it.Value()...),
+		})
+	}
+	return entries, it.Error()
+}
+
+// GetBucketMeta ...
+func (b *memBucket) GetBucketMeta() db.BucketMeta {
+	return &memBucketMeta{
+		paths: strings.Split(b.path, bucketPathSep),
+	}
+}
+
+// NewIterator returns an iterator of the entries in slice, all entries of the
+// bucket if slice is nil.
+func (b *memBucket) NewIterator(slice *db.Range) db.Iterator {
+	rg := &util.Range{}
+	if slice != nil {
+		rg.Start = b.innerKey(slice.Start)
+		if len(slice.Limit) > 0 {
+			rg.Limit = b.innerKey(slice.Limit)
+		}
+	} else {
+		rg.Start = b.innerKey(nil)
+	}
+	if rg.Limit == nil {
+		rg.Limit = db.BytesPrefix(b.innerKey(nil)).Limit
+	}
+	return &memIterator{
+		b:  b,
+		it: b.tx.newIterator(rg),
+	}
+}
+
+func (b *memBucket) innerKey(key []byte) []byte {
+	buf := make([]byte, len(b.path)+1+len(key))
+	copy(buf, b.path)
+	copy(buf[len(b.path):], bucketPathSep)
+	copy(buf[len(b.path)+1:], key)
+	return buf
+}
+
+type memBucketMeta struct {
+	paths []string
+}
+
+// Paths ...
+func (m *memBucketMeta) Paths() []string {
+	return m.paths
+}
+
+// Name ...
+func (m *memBucketMeta) Name() string {
+	return m.paths[m.Depth()]
+}
+
+// Depth ...
+func (m *memBucketMeta) Depth() int {
+	depth, _ := strconv.Atoi(m.paths[0])
+	return depth
+}
+
+// is valid bucket name
+func isValidBucketName(name string) bool {
+	return len(name) > 0 && len(name) <= maxBucketNameLen && strings.Index(name, bucketPathSep) < 0
+}
+
+// ------------------ mergedIterator -------------------- //
+
+// mergedIterator iterates committed entries along with the pending changes of
+// a write transaction, a pending change takes the place of the committed entry
+// of the same key.
+type mergedIterator struct {
+	base    iterator.Iterator
+	pending iterator.Iterator // nil if readOnly
+
+	started             bool
+	baseOk, pendingOk   bool
+	useBase, usePending bool
+	key, value          []byte
+}
+
+func (it *mergedIterator) Seek(key []byte) bool {
+	it.started = true
+	it.baseOk = it.base.Seek(key)
+	if it.pending != nil {
+		it.pendingOk = it.pending.Seek(key)
+	}
+	return it.settle()
+}
+
+func (it *mergedIterator) Next() bool {
+	if !it.started {
+		it.started = true
+		it.baseOk = it.base.First()
+		if it.pending != nil {
+			it.pendingOk = it.pending.First()
+		}
+	} else {
+		it.advance()
+	}
+	return it.settle()
+}
+
+func (it *mergedIterator) advance() {
+	if it.useBase {
+		it.baseOk = it.base.Next()
+	}
+	if it.usePending {
+		it.pendingOk = it.pending.Next()
+	}
+}
+
+// settle moves to the least key not deleted by pending changes.
+func (it *mergedIterator) settle() bool {
+	for {
+		it.useBase, it.usePending = false, false
+		switch {
+		case !it.baseOk && !it.pendingOk:
+			it.key, it.value = nil, nil
+			return false
+		case !it.pendingOk:
+			it.useBase = true
+		case !it.baseOk:
+			it.usePending = true
+		default:
+			cmp := bytes.Compare(it.pending.Key(), it.base.Key())
+			it.usePending = cmp <= 0
+			it.useBase = cmp >= 0
+		}
+
+		if !it.usePending {
+			it.key, it.value = it.base.Key(), it.base.Value()
+			return true
+		}
+		v := it.pending.Value()
+		if v[0] == opDelete {
+			it.advance()
+			continue
+		}
+		it.key, it.value = it.pending.Key(), v[1:]
+		return true
+	}
+}
+
+func (it *mergedIterator) Key() []byte {
+	return it.key[:len(it.key):len(it.key)]
+}
+
+func (it *mergedIterator) Value() []byte {
+	return it.value[:len(it.value):len(it.value)]
+}
+
+func (it *mergedIterator) Release() {
+	it.base.Release()
+	if it.pending != nil {
+		it.pending.Release()
+	}
+}
+
+func (it *mergedIterator) Error() error {
+	if err := it.base.Error(); err != nil {
+		return err
+	}
+	if it.pending != nil {
+		return it.pending.Error()
+	}
+	return nil
+}
+
+// memIterator strips the bucket path off the keys of mergedIterator.
+type memIterator struct {
+	b  *memBucket
+	it *mergedIterator
+}
+
+func (it *memIterator) Seek(key []byte) bool {
+	return it.it.Seek(it.b.innerKey(key))
+}
+
+func (it *memIterator) Next() bool {
+	return it.it.Next()
+}
+
+func (it *memIterator) Key() []byte {
+	if key := it.it.Key(); len(key) > 0 {
+		return key[len(it.b.path)+1:]
+	}
+	return nil
+}
+
+func (it *memIterator) Value() []byte {
+	return it.it.Value()
+}
+
+func (it *memIterator) Release() {
+	it.it.Release()
+}
+
+func (it *memIterator) Error() error {
+	return it.it.Error()
+}
+
+// ------------------ Export -------------------- //
+
+func init() {
+	db.RegisterDriver(db.DBDriver{
+		Type:     "memdb",
+		OpenDB:   OpenDB,
+		CreateDB: CreateDB,
+	})
+}
+
+func parseDbPath(args ...interface{}) (string, error) {
+	if len(args) != 1 {
+		return "", db.ErrInvalidArgument
+	}
+	path, ok := args[0].(string)
+	if !ok {
+		return "", db.ErrInvalidArgument
+	}
+	return path, nil
+}
+
+// CreateDB creates an empty db named by path, which must not exist.
+func CreateDB(args ...interface{}) (db.DB, error) {
+	path, err := parseDbPath(args...)
+	if err != nil {
+		return nil, err
+	}
+	storesMtx.Lock()
+	defer storesMtx.Unlock()
+	if _, ok := stores[path]; ok || len(path) == 0 {
+		logging.CPrint(logging.ERROR, "newMemDB failed", logging.LogFormat{"create": true, "path": path})
+		return nil, db.ErrCreateDBFailed
+	}
+	s := &store{cur: &generation{data: memdb.New(comparer.DefaultComparer, initialCapacity)}}
+	stores[path] = s
+	logging.CPrint(logging.INFO, "init memdb", logging.LogFormat{"path": path, "create": true})
+	return &MemDB{path: path, s: s}, nil
+}
+
+// OpenDB opens the db named by path created before in this process.
+func OpenDB(args ...interface{}) (db.DB, error) {
+	path, err := parseDbPath(args...)
+	if err != nil {
+		return nil, err
+	}
+	storesMtx.Lock()
+	defer storesMtx.Unlock()
+	s, ok := stores[path]
+	if !ok {
+		logging.CPrint(logging.ERROR, "newMemDB failed", logging.LogFormat{"create": false, "path": path})
+		return nil, db.ErrOpenDBFailed
+	}
+	logging.CPrint(logging.INFO, "init memdb", logging.LogFormat{"path": path, "create": false})
+	return &MemDB{path: path, s: s}, nil
+}
+
+// RemoveDB discards the content of the db named by path, the way removing the
+// files of a db on disk does. Handles still open on it are not affected.
+func RemoveDB(path string) {
+	storesMtx.Lock()
+	defer storesMtx.Unlock()
+	delete(stores, path)
+}
@@ -0,0 +1,221 @@
+package memdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"massnet.org/mass-wallet/masswallet/db"
+)
+
+var errRollback = errors.New("trigger rollback")
+
+func collect(it db.Iterator) map[string]string {
+	defer it.Release()
+	m := make(map[string]string)
+	var last string
+	for it.Next() {
+		if len(m) > 0 && string(it.Key()) <= last {
+			panic("iterator out of order")
+		}
+		last = string(it.Key())
+		m[last] = string(it.Value())
+	}
+	return m
+}
+
+func TestMemDB_Transaction(t *testing.T) {
+	const path = "Tst_Transaction"
+	defer RemoveDB(path)
+	mdb, err := CreateDB(path)
+	assert.Nil(t, err)
+
+	err = db.Update(mdb, func(tx db.DBTransaction) error {
+		root, err := tx.CreateTopLevelBucket("root")
+		if err != nil {
+			return err
+		}
+		sub, err := root.NewBucket("sub")
+		if err != nil {
+			return err
+		}
+		for _, k := range []string{"a1", "a2", "b1", "b2"} {
+			if err = sub.Put([]byte(k), []byte(k)); err != nil {
+				return err
+			}
+		}
+		deep, err := sub.NewBucket("deep")
+		if err != nil {
+			return err
+		}
+		return deep.Put([]byte("a1"), []byte("deep"))
+	})
+	assert.Nil(t, err)
+
+	// read-your-writes, merged with committed entries
+	err = db.Update(mdb, func(tx db.DBTransaction) error {
+		sub := tx.TopLevelBucket("root").Bucket("sub")
+		assert.Nil(t, sub.Put([]byte("a0"), []byte("new")))
+		assert.Nil(t, sub.Put([]byte("a2"), []byte("overwritten")))
+		assert.Nil(t, sub.Delete([]byte("b1")))
+
+		v, err := sub.Get([]byte("a2"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("overwritten"), v)
+		v, err = sub.Get([]byte("b1"))
+		assert.Nil(t, err)
+		assert.Nil(t, v)
+
+		assert.Equal(t, map[string]string{"a0": "new", "a1": "a1", "a2": "overwritten", "b2": "b2"},
+			collect(sub.NewIterator(nil)))
+		assert.Equal(t, map[string]string{"a0": "new", "a1": "a1", "a2": "overwritten"},
+			collect(sub.NewIterator(db.BytesPrefix([]byte("a")))))
+		entries, err := sub.GetByPrefix([]byte("b"))
+		assert.Nil(t, err)
+		assert.Equal(t, []*db.Entry{{Key: []byte("b2"), Value: []byte("b2")}}, entries)
+
+		it := sub.NewIterator(&db.Range{Start: []byte("a1"), Limit: []byte("b3")})
+		assert.True(t, it.Seek([]byte("a15")))
+		assert.Equal(t, []byte("a2"), it.Key())
+		assert.True(t, it.Next())
+		assert.Equal(t, []byte("b2"), it.Key())
+		assert.False(t, it.Next())
+		it.Release()
+
+		// uncommitted changes are invisible to read transactions
+		rtx, err := mdb.BeginReadTx()
+		assert.Nil(t, err)
+		v, _ = rtx.TopLevelBucket("root").Bucket("sub").Get([]byte("a2"))
+		assert.Equal(t, []byte("a2"), v)
+		rtx.Rollback()
+		return errRollback
+	})
+	assert.Equal(t, errRollback, err)
+
+	// rollback discards changes, including new buckets
+	err = db.Update(mdb, func(tx db.DBTransaction) error {
+		_, err := tx.CreateTopLevelBucket("discarded")
+		assert.Nil(t, err)
+		assert.Nil(t, tx.TopLevelBucket("root").Bucket("sub").Clear())
+		return errRollback
+	})
+	assert.Equal(t, errRollback, err)
+	err = db.View(mdb, func(tx db.ReadTransaction) error {
+		names, err := tx.BucketNames()
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"root"}, names)
+		assert.Equal(t, map[string]string{"a1": "a1", "a2": "a2", "b1": "b1", "b2": "b2"},
+			collect(tx.TopLevelBucket("root").Bucket("sub").NewIterator(nil)))
+		return nil
+	})
+	assert.Nil(t, err)
+
+	// deleting a bucket deletes its sub buckets
+	var meta db.BucketMeta
+	err = db.Update(mdb, func(tx db.DBTransaction) error {
+		sub := tx.TopLevelBucket("root").Bucket("sub")
+		meta = sub.Bucket("deep").GetBucketMeta()
+		return tx.TopLevelBucket("root").DeleteBucket("sub")
+	})
+	assert.Nil(t, err)
+	err = db.Update(mdb, func(tx db.DBTransaction) error {
+		assert.Nil(t, tx.FetchBucket(meta))
+		sub, err := tx.TopLevelBucket("root").NewBucket("sub")
+		assert.Nil(t, err)
+		deep, err := sub.NewBucket("deep")
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{}, collect(sub.NewIterator(nil)))
+		assert.Equal(t, map[string]string{}, collect(deep.NewIterator(nil)))
+		return deep.Put([]byte("k"), []byte("v"))
+	})
+	assert.Nil(t, err)
+
+	// content outlives the handle until RemoveDB
+	assert.Nil(t, mdb.Close())
+	_, err = mdb.BeginReadTx()
+	assert.Equal(t, ErrClosed, err)
+	mdb, err = OpenDB(path)
+	assert.Nil(t, err)
+	err = db.View(mdb, func(tx db.ReadTransaction) error {
+		v, err := tx.FetchBucket(meta).Get([]byte("k"))
+		assert.Equal(t, []byte("v"), v)
+		return err
+	})
+	assert.Nil(t, err)
+	RemoveDB(path)
+	_, err = OpenDB(path)
+	assert.Equal(t, db.ErrOpenDBFailed, err)
+}
+
+func TestMemDB_Compact(t *testing.T) {
+	const path = "Tst_Compact"
+	defer RemoveDB(path)
+	mdb, err := CreateDB(path)
+	assert.Nil(t, err)
+
+	value := make([]byte, 1024*1024)
+	for i := 0; i < 40; i++ {
+		err = db.Update(mdb, func(tx db.DBTransaction) error {
+			bucket, err := db.GetOrCreateTopLevelBucket(tx, "root")
+			if err != nil {
+				return err
+			}
+			value[0] = byte(i)
+			return bucket.Put([]byte("k"), value)
+		})
+		assert.Nil(t, err)
+	}
+	s := mdb.(*MemDB).s
+	assert.True(t, s.garbage < minCompactGarbage)
+	assert.True(t, s.cur.data.Capacity() < 40*len(value))
+
+	err = db.View(mdb, func(tx db.ReadTransaction) error {
+		v, err := tx.TopLevelBucket("root").Get([]byte("k"))
+		assert.Equal(t, byte(39), v[0])
+		assert.Equal(t, len(value), len(v))
+		return err
+	})
+	assert.Nil(t, err)
+}
+
+func TestMemDB_Snapshot(t *testing.T) {
+	const path = "Tst_Snapshot"
+	defer RemoveDB(path)
+	mdb, err := CreateDB(path)
+	assert.Nil(t, err)
+	put := func(v string) {
+		err := db.Update(mdb, func(tx db.DBTransaction) error {
+			bucket, err := db.GetOrCreateTopLevelBucket(tx, "root")
+			if err != nil {
+				return err
+			}
+			return bucket.Put([]byte("k"), []byte(v))
+		})
+		assert.Nil(t, err)
+	}
+	put("v1")
+
+	// commits without open read transactions apply in place
+	s := mdb.(*MemDB).s
+	gen := s.cur
+	put("v2")
+	assert.True(t, gen == s.cur)
+
+	// commits during a read transaction apply to a copy
+	rtx, err := mdb.BeginReadTx()
+	assert.Nil(t, err)
+	put("v3")
+	assert.True(t, gen != s.cur)
+	assert.Equal(t, 1, gen.readers)
+	v, err := rtx.TopLevelBucket("root").Get([]byte("k"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v2"), v)
+	assert.Nil(t, rtx.Rollback())
+	assert.Nil(t, rtx.Rollback())
+	assert.Equal(t, 0, gen.readers)
+
+	gen = s.cur
+	put("v4")
+	assert.True(t, gen == s.cur)
+}
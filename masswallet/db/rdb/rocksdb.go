@@ -58,6 +58,10 @@ type transaction struct {
 	readOnly bool
 	rdb      *RocksDB
 	tr       *gorocksdb.Transaction
+	// ro reads from a snapshot taken at the beginning of read transactions
+	// so that they see a consistent view of the db.
+	ro   *gorocksdb.ReadOptions
+	snap *gorocksdb.Snapshot
 }
 
 type rocksBucket struct {
@@ -211,15 +215,21 @@ func (rdb *RocksDB) BeginTx() (db.DBTransaction, error) {
 		readOnly: false,
 		rdb:      rdb,
 		tr:       rdb.tdb.TransactionBegin(rdb.wo, rdb.to, nil),
+		ro:       rdb.ro,
 	}, nil
 }
 
 // BeginReadTx ...
 func (rdb *RocksDB) BeginReadTx() (db.ReadTransaction, error) {
+	snap := rdb.tdb.NewSnapshot()
+	ro := gorocksdb.NewDefaultReadOptions()
+	ro.SetSnapshot(snap)
 	return &transaction{
 		readOnly: true,
 		rdb:      rdb,
 		tr:       rdb.tdb.TransactionBegin(rdb.wo, rdb.to, nil),
+		ro:       ro,
+		snap:     snap,
 	}, nil
 }
 
@@ -228,7 +238,7 @@ func (tx *transaction) TopLevelBucket(name string) db.Bucket {
 	bucketPath := joinBucketPath(topLevelBucketDepth, name)
 
 	key := []byte(joinBucketPath(bucketNameBucket, bucketPath))
-	v, err := tx.tr.Get(tx.rdb.ro, key)
+	v, err := tx.tr.Get(tx.ro, key)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to get top bucket", logging.LogFormat{"err": err, "bucket": name})
 		return nil
@@ -252,7 +262,7 @@ func (tx *transaction) TopLevelBucket(name string) db.Bucket {
 func (tx *transaction) BucketNames() (names []string, err error) {
 	names = make([]string, 0)
 	prefix := []byte(joinBucketPath(bucketNameBucket, topLevelBucketDepth, ""))
-	iter := tx.tr.NewIterator(tx.rdb.ro)
+	iter := tx.tr.NewIterator(tx.ro)
 	defer iter.Close()
 	iter.Seek(prefix)
 	for ; iter.ValidForPrefix(prefix); iter.Next() {
@@ -274,7 +284,7 @@ func (tx *transaction) BucketNames() (names []string, err error) {
 func (tx *transaction) FetchBucket(meta db.BucketMeta) db.Bucket {
 	path := joinBucketPath(meta.Paths()...)
 	key := []byte(joinBucketPath(bucketNameBucket, path))
-	v, err := tx.tr.Get(tx.rdb.ro, key)
+	v, err := tx.tr.Get(tx.ro, key)
 	if err != nil {
 		logging.CPrint(logging.WARN, "failed to fetch bucket", logging.LogFormat{"err": err, "bucket": meta.Paths()})
 		return nil
@@ -309,7 +319,7 @@ func (tx *transaction) CreateTopLevelBucket(name string) (db.Bucket, error) {
 
 	// check exist
 	key := []byte(joinBucketPath(bucketNameBucket, bucketPath))
-	v, err := tx.tr.Get(tx.rdb.ro, key)
+	v, err := tx.tr.Get(tx.ro, key)
 	if err != nil {
 		return nil, err
 	}
@@ -343,6 +353,11 @@ func (tx *transaction) Rollback() error {
 	// if tx.readOnly {
 	// 	return nil
 	// }
+	if tx.snap != nil {
+		tx.ro.Destroy()
+		tx.rdb.tdb.ReleaseSnapshot(tx.snap)
+		tx.snap = nil
+	}
 	return tx.tr.Rollback()
 }
 
@@ -367,7 +382,7 @@ func (b *rocksBucket) NewBucket(name string) (db.Bucket, error) {
 	}
 
 	key := []byte(joinBucketPath(bucketNameBucket, sub.path))
-	v, err := b.tx.tr.Get(b.tx.rdb.ro, key)
+	v, err := b.tx.tr.Get(b.tx.ro, key)
 	if err != nil {
 		return nil, err
 	}
@@ -425,7 +440,7 @@ func (b *rocksBucket) Bucket(name string) db.Bucket {
 	}
 
 	key := []byte(joinBucketPath(bucketNameBucket, sub.path))
-	v, err := b.tx.tr.Get(b.tx.rdb.ro, key)
+	v, err := b.tx.tr.Get(b.tx.ro, key)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "get bucket error",
 			logging.LogFormat{
@@ -463,7 +478,7 @@ func (b *rocksBucket) BucketNames() (names []string, err error) {
 	ss[0] = strconv.Itoa(b.depth + 1)
 	ss = append(ss, "")
 	prefix := []byte(joinBucketPath(bucketNameBucket, joinBucketPath(ss...)))
-	iter := b.tx.tr.NewIterator(b.tx.rdb.ro)
+	iter := b.tx.tr.NewIterator(b.tx.ro)
 	defer iter.Close()
 	iter.Seek(prefix)
 	for ; iter.ValidForPrefix(prefix); iter.Next() {
@@ -570,7 +585,7 @@ func (b *rocksBucket) Get(key []byte) ([]byte, error) {
 		// no need return error
 		return nil, nil
 	}
-	v, err := b.tx.tr.Get(b.tx.rdb.ro, key)
+	v, err := b.tx.tr.Get(b.tx.ro, key)
 	if err != nil {
 		return nil, err
 	}
@@ -601,7 +616,7 @@ func (b *rocksBucket) Clear() error {
 		return db.ErrWriteNotAllowed
 	}
 	prefix := []byte(joinBucketPath(b.path, ""))
-	iter := b.tx.tr.NewIterator(b.tx.rdb.ro)
+	iter := b.tx.tr.NewIterator(b.tx.ro)
 	defer iter.Close()
 	iter.Seek(prefix)
 	l := make([][]byte, 0)
@@ -639,7 +654,7 @@ func (b *rocksBucket) GetByPrefix(prefix []byte) ([]*db.Entry, error) {
 		// no need return error
 		return []*db.Entry{}, nil
 	}
-	iter := b.tx.tr.NewIterator(b.tx.rdb.ro)
+	iter := b.tx.tr.NewIterator(b.tx.ro)
 	defer iter.Close()
 	iter.Seek(innerPrefix)
 
@@ -697,6 +712,9 @@ func (b *rocksBucket) NewIterator(slice *db.Range) db.Iterator {
 
 	ro := gorocksdb.NewDefaultReadOptions()
 	ro.SetIterateUpperBound(slice.Limit)
+	if b.tx.snap != nil {
+		ro.SetSnapshot(b.tx.snap)
+	}
 	return &rocksIterator{
 		b:       b,
 		started: false,
@@ -23,18 +23,30 @@ var (
 	ErrDustAmount            = errors.New("Amount is dust")
 	ErrNotEnoughInputs       = errors.New("Not enough inputs")
 
-	ErrSignWitnessTx = errors.New("Failed to sign witness tx")
+	ErrSignWitnessTx    = errors.New("Failed to sign witness tx")
+	ErrInvalidSignature = errors.New("Invalid signature")
 
-	ErrNoWalletInUse     = errors.New("no wallet in use")
-	ErrIllegalReorgBlock = errors.New("illegal reorg block")
-	ErrNilDB             = errors.New("db is nil")
-	ErrChangeInUseWallet = errors.New("failed to change in-use wallet")
-	ErrInvalidVersion    = errors.New("unknown version")
-	ErrNoAddressInWallet = errors.New("no address in wallet")
-	ErrUTXONotExists     = errors.New("utxo not exists")
+	ErrNoWalletInUse        = errors.New("no wallet in use")
+	ErrWalletNotFound       = errors.New("wallet not found")
+	ErrIllegalReorgBlock    = errors.New("illegal reorg block")
+	ErrNilDB                = errors.New("db is nil")
+	ErrChangeInUseWallet    = errors.New("failed to change in-use wallet")
+	ErrInvalidVersion       = errors.New("unknown version")
+	ErrNoAddressInWallet    = errors.New("no address in wallet")
+	ErrUTXONotExists        = errors.New("utxo not exists")
+	ErrUnknownCoinSelection = errors.New("unknown coin selection strategy")
+	ErrUnknownFeeMode       = errors.New("unknown fee mode")
+	ErrUnconfirmedNotFound  = errors.New("unconfirmed transaction not found")
+	ErrTxInMempool          = errors.New("transaction is still in mempool")
+	ErrPayoutKeyConflict    = errors.New("payout key used for a different payout")
+	ErrPayoutBatchNotFound  = errors.New("payout batch not found")
+	ErrBigTxFee             = errors.New("transaction fee exceeds the limit")
 
 	ErrImportingContinuable = errors.New("importing continuable")
 	ErrWalletUnready        = errors.New("wallet is unready")
 	ErrTooManyTask          = errors.New("too many task")
 	ErrTaskAbort            = errors.New("task abort")
+
+	ErrEventCursorExpired    = errors.New("event cursor expired")
+	ErrEventSubscriberLagged = errors.New("event subscriber lagged behind")
 )
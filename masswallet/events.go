@@ -0,0 +1,425 @@
+package masswallet
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/massnetorg/mass-core/massutil"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+// Wallet event types.
+const (
+	EventTxReceived     = "tx_received"
+	EventTxConfirmed    = "tx_confirmed"
+	EventTxConflicted   = "tx_conflicted"
+	EventBalanceChanged = "balance_changed"
+	EventStakingChanged = "staking_changed"
+	EventBindingChanged = "binding_changed"
+	EventReorgRollback  = "reorg_rollback"
+	EventSyncProgress   = "sync_progress"
+)
+
+const (
+	// eventBacklogSize is the number of recent events kept for resuming subscribers.
+	eventBacklogSize = 4096
+	// eventQueueSize is the number of undelivered events a subscriber may hold
+	// before it is dropped.
+	eventQueueSize = 256
+	// syncProgressInterval emits sync_progress every N blocks while catching up.
+	syncProgressInterval = 100
+)
+
+// EventCursor is the position of an event in the stream. Sequence increases
+// monotonically across the events published since the wallet started, Height
+// is the wallet synced height at the time the event was published. Events
+// rebuilt from the wallet db carry Sequence 0 and the height of their block.
+type EventCursor struct {
+	Height   uint64
+	Sequence uint64
+}
+
+// WalletEvent describes a change observed by the wallet. WalletId is empty for
+// chain-wide events (reorg_rollback, sync_progress). balance_changed follows
+// connected and disconnected blocks, whose height it refers to.
+type WalletEvent struct {
+	Cursor     EventCursor
+	Type       string
+	WalletId   string
+	TxId       string
+	BlockHash  string
+	Height     uint64          // block height the event refers to, 0 for unmined tx
+	Balance    massutil.Amount // mined balance, balance_changed only
+	BestHeight uint64          // best indexed chain height, sync_progress only
+}
+
+func (e *WalletEvent) match(walletId string) bool {
+	return len(walletId) == 0 || len(e.WalletId) == 0 || e.WalletId == walletId
+}
+
+// EventSubscription delivers events to a single subscriber. C is closed when
+// the subscription ends, after which Err reports why.
+type EventSubscription struct {
+	C <-chan *WalletEvent
+
+	hub      *eventHub
+	walletId string
+	ch       chan *WalletEvent
+	err      error
+	closed   bool
+}
+
+// Err returns the reason the subscription was closed by the wallet, or nil.
+func (sub *EventSubscription) Err() error {
+	sub.hub.mtx.Lock()
+	defer sub.hub.mtx.Unlock()
+	return sub.err
+}
+
+// Close ends the subscription. It is safe to call more than once.
+func (sub *EventSubscription) Close() {
+	sub.hub.mtx.Lock()
+	sub.hub.remove(sub, nil)
+	sub.hub.mtx.Unlock()
+}
+
+// eventReplayer rebuilds the confirmed events of walletId (all wallets if
+// empty) from fromHeight on, out of the wallet db.
+type eventReplayer func(walletId string, fromHeight uint64) ([]*WalletEvent, error)
+
+// eventHub keeps a bounded backlog of recent events and fans them out to
+// subscribers.
+type eventHub struct {
+	mtx     sync.Mutex
+	seq     uint64
+	backlog []*WalletEvent
+	subs    map[*EventSubscription]struct{}
+	replay  eventReplayer
+}
+
+func newEventHub(replay eventReplayer) *eventHub {
+	return &eventHub{
+		// Seeded with the start time so that cursors issued before a restart
+		// are not mistaken for events of the backlog.
+		seq:     uint64(time.Now().UnixNano()),
+		backlog: make([]*WalletEvent, 0, eventBacklogSize),
+		subs:    make(map[*EventSubscription]struct{}),
+		replay:  replay,
+	}
+}
+
+// inBacklog tells whether all events after cursor are still in the backlog.
+// It must be called with hub.mtx held.
+func (hub *eventHub) inBacklog(cursor *EventCursor) bool {
+	if cursor.Sequence == 0 || cursor.Sequence > hub.seq {
+		return false
+	}
+	return cursor.Sequence == hub.seq ||
+		(len(hub.backlog) > 0 && hub.backlog[0].Cursor.Sequence <= cursor.Sequence+1)
+}
+
+// subscribe registers a subscriber for walletId (all wallets if empty). If
+// cursor is not nil, buffered events after cursor are replayed first. If the
+// backlog no longer holds them, e.g. after a restart, the confirmed events from
+// cursor.Height on are rebuilt from the wallet db instead; events of that height
+// may be delivered again.
+func (hub *eventHub) subscribe(walletId string, cursor *EventCursor) (*EventSubscription, error) {
+	hub.mtx.Lock()
+	defer hub.mtx.Unlock()
+
+	var replay []*WalletEvent
+	if cursor != nil {
+		if hub.inBacklog(cursor) {
+			for _, ev := range hub.backlog {
+				if ev.Cursor.Sequence > cursor.Sequence && ev.match(walletId) {
+					replay = append(replay, ev)
+				}
+			}
+		} else {
+			if hub.replay == nil {
+				return nil, ErrEventCursorExpired
+			}
+			// hub.mtx is held so that no event is published between the
+			// replay and the registration of the subscriber.
+			var err error
+			if replay, err = hub.replay(walletId, cursor.Height); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	ch := make(chan *WalletEvent, eventQueueSize+len(replay))
+	for _, ev := range replay {
+		ch <- ev
+	}
+	sub := &EventSubscription{
+		C:        ch,
+		hub:      hub,
+		walletId: walletId,
+		ch:       ch,
+	}
+	hub.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// publish assigns cursors to events and delivers them. Subscribers that
+// cannot keep up are closed with ErrEventSubscriberLagged.
+func (hub *eventHub) publish(height uint64, events []*WalletEvent) {
+	if len(events) == 0 {
+		return
+	}
+	hub.mtx.Lock()
+	defer hub.mtx.Unlock()
+
+	for _, ev := range events {
+		hub.seq++
+		ev.Cursor = EventCursor{Height: height, Sequence: hub.seq}
+
+		if len(hub.backlog) == eventBacklogSize {
+			copy(hub.backlog, hub.backlog[1:])
+			hub.backlog = hub.backlog[:eventBacklogSize-1]
+		}
+		hub.backlog = append(hub.backlog, ev)
+
+		for sub := range hub.subs {
+			if !ev.match(sub.walletId) {
+				continue
+			}
+			select {
+			case sub.ch <- ev:
+			default:
+				hub.remove(sub, ErrEventSubscriberLagged)
+			}
+		}
+	}
+}
+
+// remove must be called with hub.mtx held.
+func (hub *eventHub) remove(sub *EventSubscription, err error) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	sub.err = err
+	delete(hub.subs, sub)
+	close(sub.ch)
+}
+
+// eventBatch collects events produced inside a db transaction so that they
+// are published only after it commits.
+type eventBatch struct {
+	events []*WalletEvent
+}
+
+func (b *eventBatch) add(ev *WalletEvent) {
+	b.events = append(b.events, ev)
+}
+
+// addBalanceEvents adds balance_changed for every wallet whose balance in cur
+// differs from prev, or for every wallet in cur if prev is nil.
+func (b *eventBatch) addBalanceEvents(prev, cur map[string]massutil.Amount, height uint64, blockHash string) {
+	walletIds := make([]string, 0, len(cur))
+	for walletId, bal := range cur {
+		if before, ok := prev[walletId]; ok && before.Cmp(bal) == 0 {
+			continue
+		}
+		walletIds = append(walletIds, walletId)
+	}
+	sort.Strings(walletIds)
+	for _, walletId := range walletIds {
+		b.add(&WalletEvent{
+			Type:      EventBalanceChanged,
+			WalletId:  walletId,
+			BlockHash: blockHash,
+			Height:    height,
+			Balance:   cur[walletId],
+		})
+	}
+}
+
+// addTxEvents adds an event of typ for every wallet involved in rec, along
+// with staking/binding changes.
+func (b *eventBatch) addTxEvents(typ string, rec *txmgr.TxRecord, height uint64, blockHash string) {
+	txId := rec.Hash.String()
+	seen := make(map[string]struct{})
+	for _, metas := range [][]*txmgr.RelevantMeta{rec.RelevantTxIn, rec.RelevantTxOut} {
+		for _, meta := range metas {
+			if _, ok := seen[meta.WalletId]; ok {
+				continue
+			}
+			seen[meta.WalletId] = struct{}{}
+			b.add(&WalletEvent{
+				Type:      typ,
+				WalletId:  meta.WalletId,
+				TxId:      txId,
+				BlockHash: blockHash,
+				Height:    height,
+			})
+		}
+	}
+
+	gameSeen := make(map[string]struct{})
+	for _, metas := range [][]*txmgr.RelevantMeta{rec.RelevantTxIn, rec.RelevantTxOut} {
+		for _, meta := range metas {
+			var gameType string
+			switch {
+			case meta.PkScript.IsStaking():
+				gameType = EventStakingChanged
+			case meta.PkScript.IsBinding():
+				gameType = EventBindingChanged
+			default:
+				continue
+			}
+			key := gameType + meta.WalletId
+			if _, ok := gameSeen[key]; ok {
+				continue
+			}
+			gameSeen[key] = struct{}{}
+			b.add(&WalletEvent{
+				Type:      gameType,
+				WalletId:  meta.WalletId,
+				TxId:      txId,
+				BlockHash: blockHash,
+				Height:    height,
+			})
+		}
+	}
+}
+
+// SubscribeEvents subscribes to events of walletId, or of all wallets if
+// walletId is empty. If cursor is not nil, events after it are replayed first;
+// ErrEventCursorExpired is returned if they can be neither replayed from the
+// backlog nor rebuilt from the wallet db.
+func (w *WalletManager) SubscribeEvents(walletId string, cursor *EventCursor) (*EventSubscription, error) {
+	if len(walletId) > 0 {
+		if _, err := w.ksmgr.GetAddrManagerByAccountID(walletId); err != nil {
+			return nil, ErrWalletNotFound
+		}
+	}
+	return w.events.subscribe(walletId, cursor)
+}
+
+// replayEvents rebuilds from the transaction history index the events of the
+// transactions confirmed at fromHeight and above: tx_confirmed, followed by
+// staking_changed or binding_changed, and finally the current balance of each
+// wallet. Unconfirmed, conflicted and rollback events are not kept in the wallet
+// db and are not rebuilt. Wallets not synced yet are skipped, their events are
+// published once they are.
+func (w *WalletManager) replayEvents(walletId string, fromHeight uint64) ([]*WalletEvent, error) {
+	type walletEntry struct {
+		walletId string
+		entry    *txmgr.TxHistoryEntry
+	}
+	var (
+		events  []*WalletEvent
+		entries []*walletEntry
+	)
+	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+		syncedTo, err := w.syncStore.SyncedTo(tx)
+		if err != nil {
+			return err
+		}
+		if fromHeight > syncedTo.Height {
+			// the wallet is behind the cursor, blocks it has seen may be revoked
+			return ErrEventCursorExpired
+		}
+		wss, err := w.syncStore.GetAllWalletStatus(tx)
+		if err != nil {
+			return err
+		}
+		balances, err := w.utxoStore.FetchAllMinedBalance(tx)
+		if err != nil {
+			return err
+		}
+
+		var wallets []string
+		for _, ws := range wss {
+			if ws.Ready() && !ws.IsRemoved() && (len(walletId) == 0 || ws.WalletID == walletId) {
+				wallets = append(wallets, ws.WalletID)
+			}
+		}
+		sort.Strings(wallets)
+		for _, id := range wallets {
+			query := &txmgr.TxHistoryQuery{FromHeight: fromHeight, Limit: ledgerPageSize}
+			for {
+				page, cursor, err := w.txStore.TxHistory(tx, id, query)
+				if err != nil {
+					return err
+				}
+				for _, entry := range page {
+					entries = append(entries, &walletEntry{walletId: id, entry: entry})
+				}
+				if cursor == nil {
+					break
+				}
+				query.Cursor = cursor
+			}
+		}
+		sort.SliceStable(entries, func(i, j int) bool {
+			ei, ej := entries[i].entry, entries[j].entry
+			if ei.BlockHeight != ej.BlockHeight {
+				return ei.BlockHeight < ej.BlockHeight
+			}
+			return ei.TxStart < ej.TxStart
+		})
+
+		blockHashes := make(map[uint64]string)
+		for _, we := range entries {
+			height := we.entry.BlockHeight
+			blockHash, ok := blockHashes[height]
+			if !ok {
+				bm, err := w.syncStore.SyncedBlock(tx, height)
+				if err != nil {
+					return err
+				}
+				if bm != nil {
+					blockHash = bm.Hash.String()
+				}
+				blockHashes[height] = blockHash
+			}
+			ev := &WalletEvent{
+				Cursor:    EventCursor{Height: height},
+				Type:      EventTxConfirmed,
+				WalletId:  we.walletId,
+				TxId:      we.entry.TxHash.String(),
+				BlockHash: blockHash,
+				Height:    height,
+			}
+			events = append(events, ev)
+			var gameType string
+			switch we.entry.Type {
+			case txmgr.HistoryStaking:
+				gameType = EventStakingChanged
+			case txmgr.HistoryBinding:
+				gameType = EventBindingChanged
+			default:
+				continue
+			}
+			gameEv := *ev
+			gameEv.Type = gameType
+			events = append(events, &gameEv)
+		}
+
+		for _, id := range wallets {
+			bal, ok := balances[id]
+			if !ok {
+				continue
+			}
+			events = append(events, &WalletEvent{
+				Cursor:    EventCursor{Height: syncedTo.Height},
+				Type:      EventBalanceChanged,
+				WalletId:  id,
+				BlockHash: syncedTo.Hash.String(),
+				Height:    syncedTo.Height,
+				Balance:   bal,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
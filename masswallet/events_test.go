@@ -0,0 +1,135 @@
+package masswallet
+
+import (
+	"testing"
+)
+
+func TestEventHub_SubscribeResume(t *testing.T) {
+	hub := newEventHub(nil)
+
+	all, err := hub.subscribe("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer all.Close()
+	w1, err := hub.subscribe("w1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w1.Close()
+
+	hub.publish(10, []*WalletEvent{
+		{Type: EventTxReceived, WalletId: "w1", TxId: "a"},
+		{Type: EventTxReceived, WalletId: "w2", TxId: "b"},
+		{Type: EventSyncProgress, Height: 10},
+	})
+
+	if len(all.C) != 3 {
+		t.Fatalf("expect 3 events, got %d", len(all.C))
+	}
+	// w1 gets its own and chain-wide events
+	if len(w1.C) != 2 {
+		t.Fatalf("expect 2 events, got %d", len(w1.C))
+	}
+	first := <-all.C
+	if first.Cursor.Height != 10 || first.TxId != "a" {
+		t.Fatalf("unexpected event %+v", first)
+	}
+
+	// resume after the first event
+	resumed, err := hub.subscribe("", &first.Cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resumed.Close()
+	if len(resumed.C) != 2 {
+		t.Fatalf("expect 2 replayed events, got %d", len(resumed.C))
+	}
+	if ev := <-resumed.C; ev.TxId != "b" || ev.Cursor.Sequence != first.Cursor.Sequence+1 {
+		t.Fatalf("unexpected replayed event %+v", ev)
+	}
+
+	// cursor of another run
+	if _, err := hub.subscribe("", &EventCursor{Sequence: 1}); err != ErrEventCursorExpired {
+		t.Fatalf("expect ErrEventCursorExpired, got %v", err)
+	}
+	if _, err := hub.subscribe("", &EventCursor{Sequence: hub.seq + 1}); err != ErrEventCursorExpired {
+		t.Fatalf("expect ErrEventCursorExpired, got %v", err)
+	}
+}
+
+func TestEventHub_SubscribeReplay(t *testing.T) {
+	var replayed []uint64
+	hub := newEventHub(func(walletId string, fromHeight uint64) ([]*WalletEvent, error) {
+		replayed = append(replayed, fromHeight)
+		if fromHeight > 20 {
+			return nil, ErrEventCursorExpired
+		}
+		return []*WalletEvent{
+			{Cursor: EventCursor{Height: 12}, Type: EventTxConfirmed, WalletId: walletId, TxId: "a", Height: 12},
+		}, nil
+	})
+	hub.publish(20, []*WalletEvent{{Type: EventSyncProgress, Height: 20}})
+
+	// cursor of another run, not in the backlog
+	sub, err := hub.subscribe("w1", &EventCursor{Height: 10, Sequence: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+	if len(sub.C) != 1 {
+		t.Fatalf("expect 1 rebuilt event, got %d", len(sub.C))
+	}
+	if ev := <-sub.C; ev.TxId != "a" || ev.Cursor.Sequence != 0 {
+		t.Fatalf("unexpected rebuilt event %+v", ev)
+	}
+
+	// live events follow the rebuilt ones
+	hub.publish(21, []*WalletEvent{{Type: EventSyncProgress, Height: 21}})
+	if ev := <-sub.C; ev.Type != EventSyncProgress || ev.Height != 21 {
+		t.Fatalf("unexpected event %+v", ev)
+	}
+
+	// cursor in the backlog is not rebuilt
+	resumed, err := hub.subscribe("", &EventCursor{Height: 20, Sequence: hub.seq - 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resumed.Close()
+	if len(resumed.C) != 1 {
+		t.Fatalf("expect 1 replayed event, got %d", len(resumed.C))
+	}
+
+	if _, err := hub.subscribe("", &EventCursor{Height: 30}); err != ErrEventCursorExpired {
+		t.Fatalf("expect ErrEventCursorExpired, got %v", err)
+	}
+	if len(replayed) != 2 || replayed[0] != 10 || replayed[1] != 30 {
+		t.Fatalf("unexpected replayed heights %v", replayed)
+	}
+}
+
+func TestEventHub_Lagged(t *testing.T) {
+	hub := newEventHub(nil)
+	sub, err := hub.subscribe("", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := make([]*WalletEvent, 0, eventQueueSize+1)
+	for i := 0; i <= eventQueueSize; i++ {
+		events = append(events, &WalletEvent{Type: EventSyncProgress, Height: uint64(i)})
+	}
+	hub.publish(1, events)
+
+	n := 0
+	for range sub.C {
+		n++
+	}
+	if n != eventQueueSize {
+		t.Fatalf("expect %d events, got %d", eventQueueSize, n)
+	}
+	if sub.Err() != ErrEventSubscriberLagged {
+		t.Fatalf("expect ErrEventSubscriberLagged, got %v", sub.Err())
+	}
+	sub.Close()
+}
@@ -0,0 +1,357 @@
+package masswallet
+
+import (
+	"encoding/json"
+	"math"
+	"sync"
+
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/wire"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+)
+
+// mempoolFeeFetcher is implemented by the servers whose mempool knows the fees
+// of the txs it holds.
+type mempoolFeeFetcher interface {
+	MempoolTxFee(hash *wire.Hash) (massutil.Amount, bool)
+}
+
+// FeeMode decides the fee rate of transactions constructed by the wallet.
+type FeeMode uint8
+
+const (
+	// FeeModeMinimum pays the minimum relay fee rate, the default.
+	FeeModeMinimum FeeMode = iota
+	FeeModeEconomical
+	FeeModeNormal
+	FeeModePriority
+)
+
+var feeModeNames = map[string]FeeMode{
+	"":           FeeModeMinimum,
+	"minimum":    FeeModeMinimum,
+	"economical": FeeModeEconomical,
+	"normal":     FeeModeNormal,
+	"priority":   FeeModePriority,
+}
+
+// ParseFeeMode returns the fee mode by name, an empty name is FeeModeMinimum.
+func ParseFeeMode(name string) (FeeMode, error) {
+	mode, ok := feeModeNames[name]
+	if !ok {
+		return FeeModeMinimum, ErrUnknownFeeMode
+	}
+	return mode, nil
+}
+
+// target returns the number of blocks the mode expects a transaction to be
+// confirmed within, 0 for FeeModeMinimum.
+func (m FeeMode) target() uint32 {
+	switch m {
+	case FeeModeEconomical:
+		return 24
+	case FeeModeNormal:
+		return 6
+	case FeeModePriority:
+		return 2
+	default:
+		return 0
+	}
+}
+
+const (
+	// MaxFeeEstimateTarget is the max number of blocks a fee rate estimate may target.
+	MaxFeeEstimateTarget = 48
+
+	feeBucketCount   = 64
+	feeBucketSpacing = 1.2
+	// feeStatsDecay is applied to all stats on each block, so that recent
+	// blocks weigh more.
+	feeStatsDecay = 0.998
+	// feeStatsSuccessPct is the least ratio of txs confirmed within target for
+	// a fee rate to be estimated.
+	feeStatsSuccessPct = 0.85
+	// feeStatsSufficientTxs is the least (decayed) number of txs a group of
+	// buckets needs to be evaluated.
+	feeStatsSufficientTxs = 4.0
+	// feeStatsFlushInterval saves stats to db every N blocks.
+	feeStatsFlushInterval = 20
+	maxTrackedFeeTxs      = 100000
+
+	feeStatsKey     = "stats"
+	feeStatsVersion = 1
+)
+
+type trackedFeeTx struct {
+	height  uint64 // best height when the tx was seen
+	bucket  int
+	feeRate float64 // Maxwell/kB
+}
+
+// feeEstimator learns how fee rates relate to confirmation time by tracking
+// mempool txs until they are mined. Fee rates are grouped into exponentially
+// spaced buckets starting at the minimum relay fee rate, and for each bucket
+// it counts txs confirmed within 1..MaxFeeEstimateTarget blocks. Txs staying
+// unconfirmed over MaxFeeEstimateTarget blocks are dropped as failures.
+type feeEstimator struct {
+	mu         sync.Mutex
+	bestHeight uint64
+	bounds     []float64   // lower bound fee rate of each bucket, Maxwell/kB
+	confirmed  [][]float64 // [target-1][bucket], txs confirmed within target blocks
+	txCount    []float64   // [bucket], txs confirmed or dropped
+	feeRateSum []float64   // [bucket], sum of fee rates of txCount
+	tracked    map[wire.Hash]*trackedFeeTx
+	dirty      bool
+}
+
+// feeEstimatorStats is the persisted form of feeEstimator, tracked txs are not
+// persisted since the mempool is relayed again after restarting.
+type feeEstimatorStats struct {
+	Version    int         `json:"version"`
+	BestHeight uint64      `json:"best_height"`
+	Confirmed  [][]float64 `json:"confirmed"`
+	TxCount    []float64   `json:"tx_count"`
+	FeeRateSum []float64   `json:"fee_rate_sum"`
+}
+
+func newFeeEstimator() *feeEstimator {
+	e := &feeEstimator{
+		bounds:     make([]float64, feeBucketCount),
+		confirmed:  make([][]float64, MaxFeeEstimateTarget),
+		txCount:    make([]float64, feeBucketCount),
+		feeRateSum: make([]float64, feeBucketCount),
+		tracked:    make(map[wire.Hash]*trackedFeeTx),
+	}
+	bound := float64(massutil.MinRelayTxFee().UintValue())
+	for i := range e.bounds {
+		e.bounds[i] = bound
+		bound *= feeBucketSpacing
+	}
+	for i := range e.confirmed {
+		e.confirmed[i] = make([]float64, feeBucketCount)
+	}
+	return e
+}
+
+// loadFeeEstimator restores the estimator from bucket, stats of an unknown
+// layout are discarded.
+func loadFeeEstimator(bucket mwdb.Bucket) (*feeEstimator, error) {
+	e := newFeeEstimator()
+	v, err := bucket.Get([]byte(feeStatsKey))
+	if err != nil || v == nil {
+		return e, err
+	}
+	stats := &feeEstimatorStats{}
+	if err = json.Unmarshal(v, stats); err != nil || !stats.valid() {
+		logging.CPrint(logging.WARN, "discard fee estimator stats", logging.LogFormat{"err": err})
+		return e, nil
+	}
+	e.bestHeight = stats.BestHeight
+	e.confirmed = stats.Confirmed
+	e.txCount = stats.TxCount
+	e.feeRateSum = stats.FeeRateSum
+	return e, nil
+}
+
+func (s *feeEstimatorStats) valid() bool {
+	if s.Version != feeStatsVersion || len(s.Confirmed) != MaxFeeEstimateTarget ||
+		len(s.TxCount) != feeBucketCount || len(s.FeeRateSum) != feeBucketCount {
+		return false
+	}
+	for _, row := range s.Confirmed {
+		if len(row) != feeBucketCount {
+			return false
+		}
+	}
+	return true
+}
+
+// save writes the stats to bucket if changed since last saving.
+func (e *feeEstimator) save(bucket mwdb.Bucket) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.dirty {
+		return nil
+	}
+	v, err := json.Marshal(&feeEstimatorStats{
+		Version:    feeStatsVersion,
+		BestHeight: e.bestHeight,
+		Confirmed:  e.confirmed,
+		TxCount:    e.txCount,
+		FeeRateSum: e.feeRateSum,
+	})
+	if err != nil {
+		return err
+	}
+	if err = bucket.Put([]byte(feeStatsKey), v); err != nil {
+		return err
+	}
+	e.dirty = false
+	return nil
+}
+
+// bucketIndex returns the bucket of feeRate, -1 if it is below the minimum
+// relay fee rate.
+func (e *feeEstimator) bucketIndex(feeRate float64) int {
+	if feeRate < e.bounds[0] {
+		return -1
+	}
+	i := int(math.Log(feeRate/e.bounds[0]) / math.Log(feeBucketSpacing))
+	if i >= len(e.bounds) {
+		return len(e.bounds) - 1
+	}
+	// correct float rounding at bucket bounds
+	if i > 0 && feeRate < e.bounds[i] {
+		i--
+	}
+	return i
+}
+
+// processTx starts tracking a mempool tx paying fee with the serialized size.
+func (e *feeEstimator) processTx(hash wire.Hash, fee massutil.Amount, size int) {
+	if size <= 0 {
+		return
+	}
+	feeRate := float64(fee.UintValue()) * 1000 / float64(size)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.tracked[hash]; ok || len(e.tracked) >= maxTrackedFeeTxs {
+		return
+	}
+	bucket := e.bucketIndex(feeRate)
+	if bucket < 0 {
+		return
+	}
+	e.tracked[hash] = &trackedFeeTx{
+		height:  e.bestHeight,
+		bucket:  bucket,
+		feeRate: feeRate,
+	}
+}
+
+// processBlock records the tracked txs mined in the block at height. Blocks not
+// extending the known best height, such as those connected by reorg, are ignored.
+func (e *feeEstimator) processBlock(height uint64, txs []wire.Hash) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if height <= e.bestHeight {
+		return
+	}
+	e.bestHeight = height
+	e.dirty = true
+
+	for i := range e.txCount {
+		e.txCount[i] *= feeStatsDecay
+		e.feeRateSum[i] *= feeStatsDecay
+		for t := range e.confirmed {
+			e.confirmed[t][i] *= feeStatsDecay
+		}
+	}
+
+	for i := range txs {
+		ttx, ok := e.tracked[txs[i]]
+		if !ok {
+			continue
+		}
+		delete(e.tracked, txs[i])
+		blocks := int(height - ttx.height)
+		if blocks < 1 || blocks > MaxFeeEstimateTarget {
+			continue
+		}
+		for t := blocks - 1; t < MaxFeeEstimateTarget; t++ {
+			e.confirmed[t][ttx.bucket]++
+		}
+		e.txCount[ttx.bucket]++
+		e.feeRateSum[ttx.bucket] += ttx.feeRate
+	}
+
+	for hash, ttx := range e.tracked {
+		if height-ttx.height > MaxFeeEstimateTarget {
+			delete(e.tracked, hash)
+			e.txCount[ttx.bucket]++
+			e.feeRateSum[ttx.bucket] += ttx.feeRate
+		}
+	}
+}
+
+// estimateFeeRate returns the fee rate in Maxwell/kB expected to get a tx
+// confirmed within target blocks, or false if there is not enough data.
+//
+// Buckets are grouped from the highest fee rate down until each group holds
+// sufficient txs, the average fee rate of the lowest group in which at least
+// feeStatsSuccessPct of txs were confirmed within target is returned.
+func (e *feeEstimator) estimateFeeRate(target uint32) (massutil.Amount, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// txs still pending over target blocks are failures so far
+	pending := make([]float64, len(e.bounds))
+	for _, ttx := range e.tracked {
+		if e.bestHeight-ttx.height >= uint64(target) {
+			pending[ttx.bucket]++
+		}
+	}
+
+	var (
+		confirmed, total, rateSum, rateCount float64
+		feeRate                              float64
+		found                                bool
+	)
+	for i := len(e.bounds) - 1; i >= 0; i-- {
+		confirmed += e.confirmed[target-1][i]
+		total += e.txCount[i] + pending[i]
+		rateSum += e.feeRateSum[i]
+		rateCount += e.txCount[i]
+		if total < feeStatsSufficientTxs {
+			continue
+		}
+		if confirmed/total < feeStatsSuccessPct {
+			break
+		}
+		feeRate = rateSum / rateCount
+		found = true
+		confirmed, total, rateSum, rateCount = 0, 0, 0, 0
+	}
+	if !found {
+		return massutil.MinRelayTxFee(), false
+	}
+	amt, err := massutil.NewAmountFromUint(uint64(math.Round(feeRate)))
+	if err != nil || amt.Cmp(massutil.MinRelayTxFee()) < 0 {
+		return massutil.MinRelayTxFee(), true
+	}
+	return amt, true
+}
+
+// EstimateFeeRate returns the fee rate per kB expected to get a tx confirmed
+// within targetBlocks, estimated bool is false if the wallet has not observed
+// enough txs and the minimum relay fee rate is returned.
+func (w *WalletManager) EstimateFeeRate(targetBlocks uint32) (feeRate massutil.Amount, estimated bool, err error) {
+	if targetBlocks < 1 || targetBlocks > MaxFeeEstimateTarget {
+		return massutil.ZeroAmount(), false, ErrInvalidParameter
+	}
+	feeRate, estimated = w.fees.estimateFeeRate(targetBlocks)
+	return feeRate, estimated, nil
+}
+
+// feeRate returns the fee rate per kB used by mode.
+func (w *WalletManager) feeRate(mode FeeMode) massutil.Amount {
+	target := mode.target()
+	if target == 0 {
+		return massutil.MinRelayTxFee()
+	}
+	feeRate, _ := w.fees.estimateFeeRate(target)
+	return feeRate
+}
+
+// saveFeeStats persists the stats of fee estimator.
+func (w *WalletManager) saveFeeStats() error {
+	return mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, feeBucket)
+		if err != nil {
+			return err
+		}
+		return w.fees.save(bucket)
+	})
+}
@@ -0,0 +1,164 @@
+package masswallet
+
+import (
+	"testing"
+
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/wire"
+	"github.com/stretchr/testify/assert"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+)
+
+func feeTestHash(i int) wire.Hash {
+	var h wire.Hash
+	h[0], h[1], h[2] = byte(i), byte(i>>8), byte(i>>16)
+	return h
+}
+
+// feedFeeRounds feeds rounds of synthetic mempool txs and blocks, in each round
+// txs paying highRate confirm in the next block and those paying lowRate
+// confirm after lowDelay blocks.
+func feedFeeRounds(e *feeEstimator, rounds, lowDelay int, highRate, lowRate massutil.Amount) {
+	n := 0
+	height := e.bestHeight
+	for r := 0; r < rounds; r++ {
+		var high, low []wire.Hash
+		for i := 0; i < 5; i++ {
+			high = append(high, feeTestHash(n))
+			e.processTx(feeTestHash(n), highRate, 1000)
+			n++
+			low = append(low, feeTestHash(n))
+			e.processTx(feeTestHash(n), lowRate, 1000)
+			n++
+		}
+		height++
+		e.processBlock(height, high)
+		for i := 1; i < lowDelay-1; i++ {
+			height++
+			e.processBlock(height, nil)
+		}
+		height++
+		e.processBlock(height, low)
+	}
+}
+
+func TestFeeEstimator(t *testing.T) {
+	minRate := massutil.MinRelayTxFee()
+	highRate, err := minRate.MulF64(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := newFeeEstimator()
+	rate, ok := e.estimateFeeRate(6)
+	assert.False(t, ok)
+	assert.Equal(t, minRate, rate)
+
+	// below the minimum relay fee rate is not tracked
+	e.processTx(feeTestHash(1<<20), massutil.ZeroAmount(), 1000)
+	assert.Equal(t, 0, len(e.tracked))
+
+	feedFeeRounds(e, 20, 10, highRate, minRate)
+	assert.Equal(t, 0, len(e.tracked))
+	assert.Equal(t, uint64(200), e.bestHeight)
+
+	for _, target := range []uint32{1, 2, 9} {
+		rate, ok = e.estimateFeeRate(target)
+		assert.True(t, ok, target)
+		assert.Equal(t, highRate, rate, target)
+	}
+	for _, target := range []uint32{10, 24, MaxFeeEstimateTarget} {
+		rate, ok = e.estimateFeeRate(target)
+		assert.True(t, ok, target)
+		assert.Equal(t, minRate, rate, target)
+	}
+
+	// stale blocks are ignored
+	e.processBlock(150, nil)
+	assert.Equal(t, uint64(200), e.bestHeight)
+
+	// txs never confirmed are dropped as failures, then no rate meets the target
+	e = newFeeEstimator()
+	for i := 0; i < 10; i++ {
+		e.processTx(feeTestHash(i), highRate, 1000)
+	}
+	for h := uint64(1); h <= MaxFeeEstimateTarget; h++ {
+		e.processBlock(h, nil)
+	}
+	assert.Equal(t, 10, len(e.tracked))
+	_, ok = e.estimateFeeRate(1)
+	assert.False(t, ok)
+	e.processBlock(MaxFeeEstimateTarget+1, nil)
+	assert.Equal(t, 0, len(e.tracked))
+	_, ok = e.estimateFeeRate(MaxFeeEstimateTarget)
+	assert.False(t, ok)
+}
+
+func TestFeeEstimator_SaveLoad(t *testing.T) {
+	db, tearDown, err := testDB("fees")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tearDown()
+	defer db.Close()
+
+	minRate := massutil.MinRelayTxFee()
+	highRate, err := minRate.MulF64(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := newFeeEstimator()
+	feedFeeRounds(e, 10, 6, highRate, minRate)
+
+	err = mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, feeBucket)
+		if err != nil {
+			return err
+		}
+		return e.save(bucket)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, e.dirty)
+
+	var loaded *feeEstimator
+	err = mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, feeBucket)
+		if err != nil {
+			return err
+		}
+		loaded, err = loadFeeEstimator(bucket)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, e.bestHeight, loaded.bestHeight)
+	for target := uint32(1); target <= MaxFeeEstimateTarget; target++ {
+		expect, expectOk := e.estimateFeeRate(target)
+		rate, ok := loaded.estimateFeeRate(target)
+		assert.Equal(t, expectOk, ok, target)
+		assert.Equal(t, expect, rate, target)
+	}
+}
+
+func TestParseFeeMode(t *testing.T) {
+	for name, mode := range map[string]FeeMode{
+		"":           FeeModeMinimum,
+		"minimum":    FeeModeMinimum,
+		"economical": FeeModeEconomical,
+		"normal":     FeeModeNormal,
+		"priority":   FeeModePriority,
+	} {
+		m, err := ParseFeeMode(name)
+		assert.Nil(t, err, name)
+		assert.Equal(t, mode, m, name)
+	}
+	_, err := ParseFeeMode("fast")
+	assert.Equal(t, ErrUnknownFeeMode, err)
+	assert.True(t, FeeModePriority.target() < FeeModeNormal.target())
+	assert.True(t, FeeModeNormal.target() < FeeModeEconomical.target())
+	assert.Equal(t, uint32(0), FeeModeMinimum.target())
+}
@@ -14,6 +14,11 @@ type TransactionListener interface {
 	OnTransactionReceived(tx *wire.MsgTx) error
 }
 
+type ChainListener interface {
+	BlockListener
+	TransactionListener
+}
+
 type HeightSortedRelatedTx struct {
 	Descending    bool
 	SortedHeights []uint64
@@ -0,0 +1,14 @@
+package masswallet
+
+import (
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+// NewInspector returns the inspector of the stores in the wallet db read by
+// tx. The wallet db is not loaded, so it serves offline tools while the wallet
+// is stopped.
+func NewInspector(tx mwdb.ReadTransaction) (*txmgr.Inspector, error) {
+	return txmgr.NewInspector(tx.TopLevelBucket(utxoBucket), tx.TopLevelBucket(txBucket),
+		tx.TopLevelBucket(syncBucket))
+}
@@ -9,7 +9,12 @@ import (
 )
 
 type ManagedAddress struct {
-	pubKey         *btcec.PublicKey
+	// pubKey is the key of the first cosigner for multisig addresses.
+	pubKey *btcec.PublicKey
+	// pubKeys holds the keys of all cosigners in cosigner order, it's nil
+	// for 1-1 addresses.
+	pubKeys        []*btcec.PublicKey
+	nRequired      int
 	privKey        *btcec.PrivateKey
 	scriptHash     []byte
 	derivationPath DerivationPath
@@ -22,6 +27,15 @@ func newManagedAddressWithoutPrivKey(keystoreName string, derivationPath Derivat
 	pubKey *btcec.PublicKey, nRequired int, addressClass uint16, net *config.Params) (*ManagedAddress, error) {
 	var pubkeys []*btcec.PublicKey
 	pubkeys = append(pubkeys, pubKey)
+	return newManagedAddressForPubKeys(keystoreName, derivationPath, pubkeys, nRequired, addressClass, net)
+}
+
+// newManagedAddressForPubKeys creates an nRequired-of-len(pubKeys) address.
+// pubKeys are sorted in the redeem script, so any cosigner order results in
+// the same address.
+func newManagedAddressForPubKeys(keystoreName string, derivationPath DerivationPath,
+	pubKeys []*btcec.PublicKey, nRequired int, addressClass uint16, net *config.Params) (*ManagedAddress, error) {
+	pubkeys := sortPubKeys(pubKeys)
 	_, witAddress, err := newWitnessScriptAddressForBtcec(pubkeys, nRequired, addressClass, net)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "newWitnessScriptAddressForBtcec error",
@@ -30,15 +44,20 @@ func newManagedAddressWithoutPrivKey(keystoreName string, derivationPath Derivat
 			})
 		return nil, err
 	}
+	mAddr := &ManagedAddress{
+		derivationPath: derivationPath,
+		pubKey:         pubKeys[0],
+		keystoreName:   keystoreName,
+	}
+	if len(pubKeys) > 1 {
+		mAddr.pubKeys = pubKeys
+		mAddr.nRequired = nRequired
+	}
 	switch addressClass {
 	case massutil.AddressClassWitnessV0:
-		return &ManagedAddress{
-			address:        witAddress.EncodeAddress(),
-			derivationPath: derivationPath,
-			pubKey:         pubKey,
-			keystoreName:   keystoreName,
-			scriptHash:     witAddress.ScriptAddress(),
-		}, nil
+		mAddr.address = witAddress.EncodeAddress()
+		mAddr.scriptHash = witAddress.ScriptAddress()
+		return mAddr, nil
 	case massutil.AddressClassWitnessStaking:
 		witV0Addr, err := massutil.NewAddressWitnessScriptHash(witAddress.ScriptAddress(), net)
 		if err != nil {
@@ -48,14 +67,10 @@ func newManagedAddressWithoutPrivKey(keystoreName string, derivationPath Derivat
 				})
 			return nil, err
 		}
-		return &ManagedAddress{
-			address:        witV0Addr.EncodeAddress(),
-			stakingAddress: witAddress.EncodeAddress(),
-			derivationPath: derivationPath,
-			pubKey:         pubKey,
-			keystoreName:   keystoreName,
-			scriptHash:     witV0Addr.ScriptAddress(),
-		}, nil
+		mAddr.address = witV0Addr.EncodeAddress()
+		mAddr.stakingAddress = witAddress.EncodeAddress()
+		mAddr.scriptHash = witV0Addr.ScriptAddress()
+		return mAddr, nil
 	default:
 		return nil, ErrAddressVersion
 	}
@@ -112,6 +127,10 @@ func (mAddr *ManagedAddress) Account() string {
 	return mAddr.keystoreName
 }
 
+func (mAddr *ManagedAddress) DerivationPath() DerivationPath {
+	return mAddr.derivationPath
+}
+
 func (mAddr *ManagedAddress) IsChangeAddr() bool {
 	return mAddr.derivationPath.Branch == InternalBranch
 }
@@ -134,14 +153,30 @@ func (mAddr *ManagedAddress) PubKey() *btcec.PublicKey {
 	return mAddr.pubKey
 }
 
+// PubKeys returns the keys of all cosigners in cosigner order, which is the
+// order of AddrManager.CosignerIDs.
+func (mAddr *ManagedAddress) PubKeys() []*btcec.PublicKey {
+	if mAddr.pubKeys == nil {
+		return []*btcec.PublicKey{mAddr.pubKey}
+	}
+	return mAddr.pubKeys
+}
+
+// NRequired returns the number of signatures required to spend from the address.
+func (mAddr *ManagedAddress) NRequired() int {
+	if mAddr.pubKeys == nil {
+		return nRequiredDefault
+	}
+	return mAddr.nRequired
+}
+
 func (mAddr *ManagedAddress) PrivKey() *btcec.PrivateKey {
 	return mAddr.privKey
 }
 
 func (mAddr *ManagedAddress) RedeemScript(chainParams *config.Params) ([]byte, error) {
-	var pubkeys []*btcec.PublicKey
-	pubkeys = append(pubkeys, mAddr.PubKey())
-	script, _, err := NewNonPersistentWitSAddrForBtcec(pubkeys, nRequiredDefault, massutil.AddressClassWitnessV0, chainParams)
+	pubkeys := sortPubKeys(mAddr.PubKeys())
+	script, _, err := NewNonPersistentWitSAddrForBtcec(pubkeys, mAddr.NRequired(), massutil.AddressClassWitnessV0, chainParams)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +41,18 @@ type AddrManager struct {
 
 	unlocked bool
 
+	// session is the unlock session started by unlock, nil if the private
+	// keys are only unlocked for the duration of a call.
+	session *unlockSession
+
+	// watchOnly is set when no private material is stored, all operations
+	// requiring the private passphrase fail with ErrWatchOnly.
+	watchOnly bool
+
+	// multisig is set for keystores defined by cosigner public keys, which
+	// are always watch-only.
+	multisig *multisigInfo
+
 	// masterKeyPub is the secret key used to secure the cryptoKeyPub key
 	// and masterKeyPriv is the secret key used to secure the cryptoKeyPriv
 	// key.  This approach is used because it makes changing the passwords
@@ -76,6 +88,13 @@ type AddrManager struct {
 	hashedPrivPassphrase [sha512.Size]byte
 }
 
+// unlockSession keeps the private keys of an AddrManager unlocked until it
+// expires, signing calls can omit the private passphrase meanwhile.
+type unlockSession struct {
+	expires time.Time
+	timer   *time.Timer
+}
+
 type Keystore struct {
 	Remarks string     `json:"remarks"`
 	Crypto  cryptoJSON `json:"crypto"`
@@ -148,8 +167,31 @@ func getKeystoreFromJson(keysJson []byte) (*Keystore, error) {
 	return keystore, nil
 }
 
+// checkSigningPassword checks the passphrase of signing calls, which may
+// omit it while an unlock session is active.
+// NOTE: this func will leave the masterKeyPriv derived
+func (a *AddrManager) checkSigningPassword(passphrase []byte) error {
+	if a.watchOnly {
+		return ErrWatchOnly
+	}
+	if len(passphrase) == 0 {
+		if a.session != nil {
+			return nil
+		}
+		return ErrLocked
+	}
+	return a.checkPassword(passphrase)
+}
+
 // NOTE: this func will leave the masterKeyPriv derived
 func (a *AddrManager) checkPassword(passphrase []byte) error {
+	if a.watchOnly {
+		return ErrWatchOnly
+	}
+	// an empty passphrase would be salted in place, zeroing the salt
+	if len(passphrase) == 0 {
+		return ErrInvalidPassphrase
+	}
 	if a.unlocked {
 		saltedPassphrase := append(a.privPassphraseSalt[:],
 			passphrase...)
@@ -179,7 +221,9 @@ func (a *AddrManager) safelyCheckPassword(privPass []byte) error {
 	if err != nil {
 		return err
 	}
-	a.masterKeyPriv.Zero()
+	if !a.unlocked {
+		a.masterKeyPriv.Zero()
+	}
 	return nil
 }
 
@@ -286,6 +330,10 @@ func (a *AddrManager) clearPrivKeys() {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 	a.unlocked = false
+	if a.session != nil {
+		a.session.timer.Stop()
+		a.session = nil
+	}
 
 	for _, mAddr := range a.addrs {
 		if mAddr.privKey != nil {
@@ -412,6 +460,46 @@ func (a *AddrManager) updatePrivKeys(pass []byte) error {
 	return nil
 }
 
+// unlock decrypts the private keys and keeps them until timeout, relock is
+// called with the session once it expires. An existing session is renewed.
+func (a *AddrManager) unlock(privPass []byte, timeout time.Duration, relock func(*unlockSession)) (time.Time, error) {
+	if len(privPass) == 0 {
+		return time.Time{}, ErrInvalidPassphrase
+	}
+	a.mu.Lock()
+	err := a.checkPassword(privPass)
+	a.mu.Unlock()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err = a.updatePrivKeys(privPass); err != nil {
+		a.clearPrivKeys()
+		return time.Time{}, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.session != nil {
+		a.session.timer.Stop()
+	}
+	session := &unlockSession{expires: time.Now().Add(timeout)}
+	session.timer = time.AfterFunc(timeout, func() { relock(session) })
+	a.session = session
+	return session.expires, nil
+}
+
+// expireSession locks the private keys if session is still the current one,
+// it reports whether they are locked.
+func (a *AddrManager) expireSession(session *unlockSession) bool {
+	a.mu.Lock()
+	current := a.session == session
+	a.mu.Unlock()
+	if current {
+		a.clearPrivKeys()
+	}
+	return current
+}
+
 func (a *AddrManager) nextAddresses(dbTransaction db.DBTransaction, checkfunc func([]byte) (bool, error), internal bool, numAddresses, addressGapLimit uint32, net *config.Params, nRequired int, addressClass uint16) ([]*ManagedAddress, error) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
@@ -491,6 +579,19 @@ func (a *AddrManager) nextAddresses(dbTransaction db.DBTransaction, checkfunc fu
 
 	addressInfo := make([]*unlockDeriveInfo, 0, numAddresses)
 	for i := uint32(0); i < numAddresses; i++ {
+		if a.multisig != nil {
+			managedAddr, err := a.nextMultisigAddress(branch, &nextIndex, addressClass, net)
+			if err != nil {
+				return nil, err
+			}
+			addressInfo = append(addressInfo, &unlockDeriveInfo{
+				managedAddr: managedAddr,
+				branch:      branch,
+				index:       nextIndex - 1,
+			})
+			continue
+		}
+
 		var nextKey *hdkeychain.ExtendedKey
 		for {
 			indexKey, err := branchKey.Child(nextIndex)
@@ -575,6 +676,31 @@ func (a *AddrManager) nextAddresses(dbTransaction db.DBTransaction, checkfunc fu
 	return managedAddresses, nil
 }
 
+// nextMultisigAddress derives the multisig address at the first usable index
+// from *nextIndex, and advances *nextIndex past it.
+func (a *AddrManager) nextMultisigAddress(branch uint32, nextIndex *uint32, addressClass uint16, net *config.Params) (*ManagedAddress, error) {
+	for {
+		derivationPath := DerivationPath{
+			Account: a.acctInfo.acctType,
+			Branch:  branch,
+			Index:   *nextIndex,
+		}
+		managedAddr, err := a.multisig.deriveAddress(a.keystoreName, derivationPath, addressClass, net)
+		*nextIndex++
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			logging.CPrint(logging.ERROR, "new managedAddress failed",
+				logging.LogFormat{
+					"err": err,
+				})
+			return nil, err
+		}
+		return managedAddr, nil
+	}
+}
+
 func (a *AddrManager) updateManagedAddress(dbTransaction db.DBTransaction, managedAddresses []*ManagedAddress) error {
 	for _, managedAddress := range managedAddresses {
 		a.addrs[managedAddress.address] = managedAddress
@@ -603,6 +729,18 @@ func (a *AddrManager) getPrivKeyBtcec(addr string, password []byte) (*btcec.Priv
 	if mAddr.privKey != nil {
 		return mAddr.privKey, nil
 	}
+	childPrivKeyBtcec, err := a.derivePrivKeyBtcec(internal, mAddr.derivationPath.Index)
+	if err != nil {
+		return nil, err
+	}
+	a.addrs[addr].privKey = childPrivKeyBtcec
+
+	return childPrivKeyBtcec, nil
+}
+
+// derivePrivKeyBtcec derives the private key at branch/index, the branch
+// private keys are decrypted and cached on first use.
+func (a *AddrManager) derivePrivKeyBtcec(internal bool, index uint32) (*btcec.PrivateKey, error) {
 	if a.branchInfo.externalBranchPriv == nil || a.branchInfo.internalBranchPriv == nil {
 		cryptoKeyPrivDec, err := a.masterKeyPriv.Decrypt(a.cryptoKeyPrivEncrypted)
 		if err != nil {
@@ -649,17 +787,12 @@ func (a *AddrManager) getPrivKeyBtcec(addr string, password []byte) (*btcec.Priv
 		branchHDPrivKey = a.branchInfo.externalBranchPriv
 	}
 
-	childHDPrivKey, err := branchHDPrivKey.Child(mAddr.derivationPath.Index)
-	if err != nil {
-		return nil, err
-	}
-	childPrivKeyBtcec, err := childHDPrivKey.ECPrivKey()
+	childHDPrivKey, err := branchHDPrivKey.Child(index)
 	if err != nil {
 		return nil, err
 	}
-	a.addrs[addr].privKey = childPrivKeyBtcec
-
-	return childPrivKeyBtcec, nil
+	defer childHDPrivKey.Zero()
+	return childHDPrivKey.ECPrivKey()
 }
 
 func (a *AddrManager) signBtcec(hash []byte, addr string, password []byte) (signed *btcec.Signature, err error) {
@@ -675,7 +808,7 @@ func (a *AddrManager) signBtcec(hash []byte, addr string, password []byte) (sign
 	}
 
 	// check private passphrase
-	err = a.checkPassword(password)
+	err = a.checkSigningPassword(password)
 	if err != nil {
 		return nil, err
 	}
@@ -709,6 +842,76 @@ func (a *AddrManager) signBtcec(hash []byte, addr string, password []byte) (sign
 	return signed, nil
 }
 
+// signBtcecForPath signs hash with the key at branch/index, which needs not
+// belong to any generated address. It fails with ErrUnexpectedPubKeyToSign if
+// the key does not match pubKey.
+func (a *AddrManager) signBtcecForPath(hash []byte, pubKey *btcec.PublicKey, branch, index uint32, password []byte) (*btcec.Signature, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(hash) != 32 {
+		return nil, ErrInvalidDataHash
+	}
+	if branch != ExternalBranch && branch != InternalBranch {
+		return nil, ErrUnexpectedPubKeyToSign
+	}
+	err := a.checkSigningPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	if !a.unlocked {
+		saltPassphrase := append(a.privPassphraseSalt[:], password...)
+		a.hashedPrivPassphrase = sha512.Sum512(saltPassphrase)
+		zero.Bytes(saltPassphrase)
+		a.unlocked = true
+	}
+
+	privKey, err := a.derivePrivKeyBtcec(branch == InternalBranch, index)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "get privKey failed",
+			logging.LogFormat{
+				"err": err,
+			})
+		return nil, err
+	}
+	defer zero.BigInt(privKey.D)
+	if !privKey.PubKey().IsEqual(pubKey) {
+		return nil, ErrUnexpectedPubKeyToSign
+	}
+	return privKey.Sign(hash)
+}
+
+// signCompact signs hash with the key of addr, producing a compact signature
+// from which the public key is recoverable.
+func (a *AddrManager) signCompact(hash []byte, addr string, password []byte) ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(hash) != 32 {
+		return nil, ErrInvalidDataHash
+	}
+	err := a.checkSigningPassword(password)
+	if err != nil {
+		return nil, err
+	}
+	if !a.unlocked {
+		saltPassphrase := append(a.privPassphraseSalt[:], password...)
+		a.hashedPrivPassphrase = sha512.Sum512(saltPassphrase)
+		zero.Bytes(saltPassphrase)
+		a.unlocked = true
+	}
+
+	privKey, err := a.getPrivKeyBtcec(addr, password)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "get privKey failed",
+			logging.LogFormat{
+				"err": err,
+			})
+		return nil, err
+	}
+	return btcec.SignCompact(btcec.S256(), privKey, hash, true)
+}
+
 func (a *AddrManager) changeRemark(dbTransaction db.DBTransaction, newRemark string) error {
 	amBucket := dbTransaction.FetchBucket(a.storage)
 	if amBucket == nil {
@@ -868,6 +1071,44 @@ func (a *AddrManager) Remarks() string {
 	return a.remark
 }
 
+// IsWatchOnly returns whether the keystore holds only the account public key.
+func (a *AddrManager) IsWatchOnly() bool {
+	return a.watchOnly
+}
+
+// AccountPubKey returns the account extended public key, which can be used to
+// import the keystore as watch-only.
+func (a *AddrManager) AccountPubKey() string {
+	return a.acctInfo.acctKeyPub.String()
+}
+
+// IsMultisig returns whether the keystore is defined by cosigner public keys.
+func (a *AddrManager) IsMultisig() bool {
+	return a.multisig != nil
+}
+
+// Multisig returns the number of required signatures and the account extended
+// public keys of all cosigners, or 0 and nil for single-key keystores.
+func (a *AddrManager) Multisig() (nRequired int, accountPubKeys []string) {
+	if a.multisig == nil {
+		return 0, nil
+	}
+	accountPubKeys = make([]string, 0, len(a.multisig.cosigners))
+	for _, cosigner := range a.multisig.cosigners {
+		accountPubKeys = append(accountPubKeys, cosigner.String())
+	}
+	return a.multisig.nRequired, accountPubKeys
+}
+
+// CosignerIDs returns the account ids of the keystores that sign for the
+// addresses, in the order of ManagedAddress.PubKeys.
+func (a *AddrManager) CosignerIDs() []string {
+	if a.multisig == nil {
+		return []string{a.keystoreName}
+	}
+	return a.multisig.cosignerIDs
+}
+
 func (a *AddrManager) AddrUse() AddrUse {
 	return a.use
 }
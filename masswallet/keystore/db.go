@@ -44,6 +44,8 @@ var (
 	internalBranchPubKeyName = []byte("inbPubKey")
 	externalChildNumName     = []byte("exChildNum")
 	internalChildNumName     = []byte("inChildNum")
+	// multisig threshold and cosigners
+	multisigName = []byte("multisig")
 )
 
 // accountType represents a type of address stored in the database.
@@ -568,6 +570,15 @@ func getChildNum(b db.Bucket, internal bool) (uint32, error) {
 	return binary.LittleEndian.Uint32(childNum), nil
 }
 
+func putMultisig(b db.Bucket, multisigEnc []byte) error {
+	return b.Put(multisigName, multisigEnc)
+}
+
+// fetchMultisig returns nil if the keystore is not multisig.
+func fetchMultisig(b db.Bucket) ([]byte, error) {
+	return b.Get(multisigName)
+}
+
 // put encrypted pubKey into db when new address
 func putEncryptedPubKey(b db.Bucket, branch, index uint32, pubKey []byte) error {
 	key := make([]byte, 8, 8)
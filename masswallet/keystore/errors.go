@@ -30,6 +30,7 @@ var (
 	ErrNilPointer               = errors.New("the pointer is nil")
 	ErrBucketNotFound           = errors.New("bucket not found")
 	ErrInvalidPassphrase        = errors.New("invalid passphrase for master private key")
+	ErrLocked                   = errors.New("keystore locked, private passphrase required")
 	ErrDeriveMasterPrivKey      = errors.New("failed to derive master private key")
 	ErrCoinType                 = errors.New("invalid coinType")
 	ErrAccountType              = errors.New("invalid accountType")
@@ -42,6 +43,12 @@ var (
 	ErrInvalidKeystoreJson = errors.New("invalid keystore json")
 	ErrInvalidDataHash     = errors.New("invalid hash, length is not 32")
 
+	ErrWatchOnly            = errors.New("not allowed for watch-only keystore")
+	ErrInvalidAccountPubKey = errors.New("invalid account extended public key")
+
+	ErrInvalidMultisigParams = errors.New("invalid multisig threshold or cosigners")
+	ErrMultisig              = errors.New("not allowed for multisig keystore")
+
 	ErrUnexpectedPubKeyToSign = errors.New("unexpected pubkey to sign")
 	ErrBuildWitnessScript     = errors.New("failed to build witness script/address")
 )
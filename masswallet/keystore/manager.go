@@ -12,6 +12,7 @@ import (
 	"bytes"
 
 	"math"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/massnetorg/mass-core/logging"
@@ -176,11 +177,6 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 
 	scope := Net2KeyScope[net.HDCoinType]
 
-	accountIDBucket, err := db.GetOrCreateBucket(km, accountIDBucket)
-	if err != nil {
-		return nil, err
-	}
-
 	// Derive the cointype key according to the passed scope.
 	coinTypeKeyPriv, err := deriveCoinTypeKey(root, scope)
 	if err != nil {
@@ -203,8 +199,25 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 	}
 	defer acctKeyPriv.Zero()
 
+	return createAccountKeyScope(km, acctKeyPriv, cryptoKeyPub, cryptoKeyPriv, hdpath, checkfunc, net, addressGapLimit)
+}
+
+// createAccountKeyScope creates the account bucket for acctKey, which is either
+// a private or a public account extended key. Only public material is stored
+// if cryptoKeyPriv is nil, and acctKey must be public in that case.
+func createAccountKeyScope(km db.Bucket, acctKey *hdkeychain.ExtendedKey,
+	cryptoKeyPub, cryptoKeyPriv EncryptorDecryptor, hdpath *hdPath, checkfunc func([]byte) (bool, error),
+	net *config.Params, addressGapLimit uint32) (db.BucketMeta, error) {
+
+	scope := Net2KeyScope[net.HDCoinType]
+
+	accountIDBucket, err := db.GetOrCreateBucket(km, accountIDBucket)
+	if err != nil {
+		return nil, err
+	}
+
 	// The address manager needs the first address for the account.
-	acctKeyPub, err := acctKeyPriv.Neuter()
+	acctKeyPub, err := acctKey.Neuter()
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert private account key to public account key: %v", err)
 	}
@@ -228,7 +241,7 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 
 	// Ensure the branch keys can be derived for the provided seed according
 	// to our BIP0044-like derivation.
-	if err := checkBranchKeys(acctKeyPriv); err != nil {
+	if err := checkBranchKeys(acctKey); err != nil {
 		// The seed is unusable if the any of the children in the
 		// required hierarchy can't be derived due to invalid child.
 		if err == hdkeychain.ErrInvalidChild {
@@ -255,9 +268,12 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 	if err != nil {
 		return nil, fmt.Errorf("failed to encrypt public account key: %v", err)
 	}
-	acctPrivEnc, err := cryptoKeyPriv.Encrypt([]byte(acctKeyPriv.String()))
-	if err != nil {
-		return nil, fmt.Errorf("failed to encrypt private account key: %v", err)
+	var acctPrivEnc []byte
+	if cryptoKeyPriv != nil {
+		acctPrivEnc, err = cryptoKeyPriv.Encrypt([]byte(acctKey.String()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt private account key: %v", err)
+		}
 	}
 
 	err = putCoinType(accountBucket, scope.Coin)
@@ -271,7 +287,7 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 	}
 
 	// new external branch and save the pubkey
-	internalBranchPrivKey, err := acctKeyPriv.Child(InternalBranch)
+	internalBranchKey, err := acctKey.Child(InternalBranch)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "new childKey failed",
 			logging.LogFormat{
@@ -279,8 +295,8 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 			})
 		return nil, err
 	}
-	defer internalBranchPrivKey.Zero()
-	internalBranchPubKey, err := internalBranchPrivKey.Neuter()
+	defer internalBranchKey.Zero()
+	internalBranchPubKey, err := internalBranchKey.Neuter()
 	if err != nil {
 		logging.CPrint(logging.ERROR, "exKey->exPubKey failed",
 			logging.LogFormat{
@@ -289,7 +305,7 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 		return nil, err
 	}
 
-	externalBranchPrivKey, err := acctKeyPriv.Child(ExternalBranch)
+	externalBranchKey, err := acctKey.Child(ExternalBranch)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "new childKey failed",
 			logging.LogFormat{
@@ -297,8 +313,8 @@ func createManagerKeyScope(km db.Bucket, root *hdkeychain.ExtendedKey,
 			})
 		return nil, err
 	}
-	defer externalBranchPrivKey.Zero()
-	externalBranchPubKey, err := externalBranchPrivKey.Neuter()
+	defer externalBranchKey.Zero()
+	externalBranchPubKey, err := externalBranchKey.Neuter()
 	if err != nil {
 		logging.CPrint(logging.ERROR, "exKey->exPubKey failed",
 			logging.LogFormat{
@@ -751,11 +767,16 @@ func loadAddrManager(amBucket db.Bucket, pubPassphrase []byte, net *config.Param
 		return nil, err
 	}
 
-	var masterKeyPriv snacl.SecretKey
-	err = masterKeyPriv.Unmarshal(masterKeyPrivParams)
-	if err != nil {
-		str := "failed to unmarshal master private key"
-		return nil, errors.New(str)
+	// Watch-only keystores have no master private key params stored.
+	watchOnly := masterKeyPrivParams == nil
+	var masterKeyPriv *snacl.SecretKey
+	if !watchOnly {
+		masterKeyPriv = &snacl.SecretKey{}
+		err = masterKeyPriv.Unmarshal(masterKeyPrivParams)
+		if err != nil {
+			str := "failed to unmarshal master private key"
+			return nil, errors.New(str)
+		}
 	}
 
 	// Derive the master public key using the serialized params and provided
@@ -866,6 +887,23 @@ func loadAddrManager(amBucket db.Bucket, pubPassphrase []byte, net *config.Param
 		nextExternalIndex: externalChildNum,
 	}
 
+	var multisig *multisigInfo
+	multisigEnc, err := fetchMultisig(amBucket)
+	if err != nil {
+		return nil, err
+	}
+	if multisigEnc != nil {
+		serializedMultisig, err := cryptoKeyPub.Decrypt(multisigEnc)
+		if err != nil {
+			str := "failed to decrypt multisig info"
+			return nil, errors.New(str)
+		}
+		multisig, err = deserializeMultisigInfo(serializedMultisig, net)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	managedAddresses := make(map[string]*ManagedAddress)
 	index := make(map[uint32]string)
 	pubkeyBucket := amBucket.Bucket(pubKeyBucket)
@@ -880,6 +918,15 @@ func loadAddrManager(amBucket db.Bucket, pubPassphrase []byte, net *config.Param
 				Branch:  pkp.branch,
 				Index:   pkp.index,
 			}
+			if multisig != nil {
+				managedAddress, err := multisig.deriveAddress(amBucketMeta.Name(), path, massutil.AddressClassWitnessStaking, net)
+				if err != nil {
+					return nil, err
+				}
+				managedAddresses[managedAddress.address] = managedAddress
+				index[pkp.index] = managedAddress.address
+				continue
+			}
 			pubkeyBytes, err := cryptoKeyPub.Decrypt(pkp.pubkeyEnc)
 			if err != nil {
 				return nil, err
@@ -909,8 +956,10 @@ func loadAddrManager(amBucket db.Bucket, pubPassphrase []byte, net *config.Param
 		hdScope:                   keyScope,
 		storage:                   amBucketMeta,
 		unlocked:                  false,
+		watchOnly:                 watchOnly,
+		multisig:                  multisig,
 		masterKeyPub:              &masterKeyPub,
-		masterKeyPriv:             &masterKeyPriv,
+		masterKeyPriv:             masterKeyPriv,
 		cryptoKeyPub:              cryptoKeyPub,
 		cryptoKeyPrivEncrypted:    cryptoKeyPrivEnc,
 		cryptoKeyPriv:             &cryptoKey{},
@@ -968,27 +1017,7 @@ func (km *KeystoreManager) allocAddrMgrNamespace(dbTransaction db.DBTransaction,
 		return nil, err
 	}
 
-	cryptoKeyEntropyEncOld, err := hex.DecodeString(kStore.Crypto.CryptoKeyEntropyEnc)
-	if err != nil {
-		return nil, err
-	}
-
-	entropyEnc, err := hex.DecodeString(kStore.Crypto.EntropyEnc)
-	if err != nil {
-		return nil, err
-	}
-
-	cEntropyBytes, err := masterKeyPriv.Decrypt(cryptoKeyEntropyEncOld)
-	if err != nil {
-		return nil, err
-	}
-
-	var cEntropyOld cryptoKey
-	cEntropyOld.CopyBytes(cEntropyBytes)
-	zero.Bytes(cEntropyBytes)
-	defer cEntropyOld.Zero()
-
-	entropy, err := cEntropyOld.Decrypt(entropyEnc)
+	entropy, err := decryptEntropy(kStore, &masterKeyPriv)
 	if err != nil {
 		return nil, err
 	}
@@ -996,21 +1025,11 @@ func (km *KeystoreManager) allocAddrMgrNamespace(dbTransaction db.DBTransaction,
 
 	version := KeystoreVersion(kStore.Crypto.Version)
 
-	mnemonic, err := NewMnemonic(entropy)
+	seed, err := seedFromEntropy(version, entropy, privPassphrase)
 	if err != nil {
-		logging.CPrint(logging.ERROR, "failed to new mnemonic", logging.LogFormat{"error": err})
 		return nil, err
 	}
 
-	var genPass string
-	switch version {
-	case KeystoreVersion0:
-		genPass = string(privPassphrase)
-	default:
-		return nil, ErrKeystoreVersion
-	}
-	seed := NewSeed(mnemonic, genPass)
-
 	rootKey, err := hdkeychain.NewMaster(seed, net)
 	if err != nil {
 		return nil, fmt.Errorf("failed to derive master extended key: %v", err)
@@ -1204,6 +1223,94 @@ func (km *KeystoreManager) ImportKeystoreWithMnemonic(dbTransaction db.DBTransac
 	return addrManager, nil
 }
 
+// ImportWatchOnlyKeystore imports a keystore from the account level extended
+// public key (m/44'/coin'/account'). Addresses are derived the same way as for
+// a full keystore, but nothing can be signed with it.
+func (km *KeystoreManager) ImportWatchOnlyKeystore(dbTransaction db.DBTransaction,
+	checkfunc func([]byte) (bool, error), accountPubKey string, walletParams *WalletParams) (*AddrManager, error) {
+
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	acctKeyPub, err := hdkeychain.NewKeyFromString(accountPubKey)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to parse account public key", logging.LogFormat{"error": err})
+		return nil, ErrInvalidAccountPubKey
+	}
+	if acctKeyPub.IsPrivate() || !acctKeyPub.IsForNet(km.params) || acctKeyPub.Depth() != 3 {
+		return nil, ErrInvalidAccountPubKey
+	}
+
+	kmBucket := dbTransaction.FetchBucket(km.ksMgrMeta)
+	if kmBucket == nil {
+		return nil, ErrBucketNotFound
+	}
+
+	masterKeyPub, err := secretKeyGen(&km.pubPassphrase, &DefaultScryptOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate master public key: %v", err)
+	}
+	cryptoKeyPub, err := newCryptoKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate crypto public key: %v", err)
+	}
+	cryptoKeyPubEnc, err := masterKeyPub.Encrypt(cryptoKeyPub.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt crypto public key: %v", err)
+	}
+
+	hdpath := &hdPath{
+		Account:          uint32(WalletUsage),
+		InternalChildNum: walletParams.InternalIndex,
+		ExternalChildNum: walletParams.ExternalIndex,
+	}
+	if hdpath.ExternalChildNum == 0 {
+		hdpath.ExternalChildNum = 1
+	}
+
+	acctBucketMeta, err := createAccountKeyScope(kmBucket, acctKeyPub,
+		cryptoKeyPub, nil, hdpath, checkfunc, km.params, walletParams.AddressGapLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	acctBucket := dbTransaction.FetchBucket(acctBucketMeta)
+	if acctBucket == nil {
+		return nil, ErrUnexpecteDBError
+	}
+
+	err = putVersion(acctBucket, walletParams.Version.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(walletParams.Remarks) > 0 {
+		err = putRemark(acctBucket, []byte(walletParams.Remarks))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Only public params, the absence of private ones marks it watch-only.
+	err = putMasterKeyParams(acctBucket, masterKeyPub.Marshal(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = putCryptoKeys(acctBucket, cryptoKeyPubEnc, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	addrManager, err := loadAddrManager(acctBucket, km.pubPassphrase, km.params)
+	if err != nil {
+		return nil, err
+	}
+
+	km.managedKeystores[addrManager.keystoreName] = addrManager
+	return addrManager, nil
+}
+
 func (km *KeystoreManager) ExportKeystore(dbTransaction db.ReadTransaction, accountID string, privPassphrase []byte) ([]byte, error) {
 	km.mu.Lock()
 	defer km.mu.Unlock()
@@ -1343,8 +1450,23 @@ func (km *KeystoreManager) NextAddresses(dbTransaction db.DBTransaction, checkfu
 	if km.currentKeystore == nil {
 		return nil, ErrCurrentKeystoreNotFound
 	}
-	accountID := km.currentKeystore.accountName
-	addrManager := km.managedKeystores[accountID]
+	return km.nextAddresses(dbTransaction, km.currentKeystore.accountName, checkfunc, internal, numAddresses, addressGapLimit, addressClass)
+}
+
+// NextAddressesForAccount is the same as NextAddresses, but derives addresses
+// for the given account instead of the current one.
+func (km *KeystoreManager) NextAddressesForAccount(dbTransaction db.DBTransaction, accountID string, checkfunc func([]byte) (bool, error), internal bool, numAddresses, addressGapLimit uint32, addressClass uint16) ([]*ManagedAddress, error) {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	return km.nextAddresses(dbTransaction, accountID, checkfunc, internal, numAddresses, addressGapLimit, addressClass)
+}
+
+func (km *KeystoreManager) nextAddresses(dbTransaction db.DBTransaction, accountID string, checkfunc func([]byte) (bool, error), internal bool, numAddresses, addressGapLimit uint32, addressClass uint16) ([]*ManagedAddress, error) {
+	addrManager, err := km.getAddrManagerByAccountID(accountID)
+	if err != nil {
+		return nil, err
+	}
 	managedAddresses, err := addrManager.nextAddresses(dbTransaction, checkfunc, internal, numAddresses, addressGapLimit, km.params, nRequiredDefault, addressClass)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "new address failed",
@@ -1407,6 +1529,61 @@ func (km *KeystoreManager) SignHash(pubKey *btcec.PublicKey, hash []byte, passwo
 	return sig, nil
 }
 
+// SignHashForPath signs hash with the key of keystore accountID at
+// branch/index, pubKey is checked against the derived key.
+func (km *KeystoreManager) SignHashForPath(accountID string, pubKey *btcec.PublicKey, branch, index uint32,
+	hash []byte, password []byte) (*btcec.Signature, error) {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	addrManager, err := km.getAddrManagerByAccountID(accountID)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := addrManager.signBtcecForPath(hash, pubKey, branch, index, password)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "sign failed",
+			logging.LogFormat{
+				"err": err,
+			})
+		return nil, err
+	}
+	return sig, nil
+}
+
+// SignCompact signs hash with the key of the 1-1 address of scriptHash, which
+// may be in any keystore. The signature is compact so the public key can be
+// recovered from it.
+func (km *KeystoreManager) SignCompact(scriptHash []byte, hash []byte, password []byte) ([]byte, error) {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	witAddress, err := massutil.NewAddressWitnessScriptHash(scriptHash, km.params)
+	if err != nil {
+		return nil, err
+	}
+	addr := witAddress.EncodeAddress()
+	for _, addrManager := range km.managedKeystores {
+		mAddr, ok := addrManager.addrs[addr]
+		if !ok {
+			continue
+		}
+		if mAddr.pubKeys != nil {
+			return nil, ErrMultisig
+		}
+		sig, err := addrManager.signCompact(hash, addr, password)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "sign failed",
+				logging.LogFormat{
+					"err": err,
+				})
+			return nil, err
+		}
+		return sig, nil
+	}
+	return nil, ErrAddressNotFound
+}
+
 func (km *KeystoreManager) ChangePrivPassphrase(dbTransaction db.DBTransaction, oldPrivPass, newPrivPass []byte, scryptConfig *ScryptOptions) error {
 	km.mu.Lock()
 	defer km.mu.Unlock()
@@ -1552,10 +1729,61 @@ func (km *KeystoreManager) ChangeRemark(dbTransaction db.DBTransaction, accountI
 	}
 }
 
+// ClearPrivKey clears the private keys unlocked by signing calls, keystores
+// unlocked for a session by Unlock are left untouched.
 func (km *KeystoreManager) ClearPrivKey() {
 	km.mu.Lock()
 	defer km.mu.Unlock()
 
+	for _, addrManager := range km.managedKeystores {
+		addrManager.mu.Lock()
+		inSession := addrManager.session != nil
+		addrManager.mu.Unlock()
+		if !inSession {
+			addrManager.clearPrivKeys()
+		}
+	}
+}
+
+// Unlock keeps the private keys of keystore accountID unlocked for timeout,
+// meanwhile signing calls can omit the private passphrase, while exporting or
+// removing the keystore still requires it. It returns the time the session
+// expires, when the keystore is locked again.
+func (km *KeystoreManager) Unlock(accountID string, privPass []byte, timeout time.Duration) (time.Time, error) {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	addrManager, err := km.getAddrManagerByAccountID(accountID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return addrManager.unlock(privPass, timeout, func(session *unlockSession) {
+		km.mu.Lock()
+		defer km.mu.Unlock()
+		if addrManager.expireSession(session) {
+			logging.CPrint(logging.INFO, "keystore locked on timeout", logging.LogFormat{"accountID": accountID})
+		}
+	})
+}
+
+// Lock clears the private keys of keystore accountID, ending its unlock session.
+func (km *KeystoreManager) Lock(accountID string) error {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	addrManager, err := km.getAddrManagerByAccountID(accountID)
+	if err != nil {
+		return err
+	}
+	addrManager.clearPrivKeys()
+	return nil
+}
+
+// LockAll clears the private keys of all keystores, ending their unlock sessions.
+func (km *KeystoreManager) LockAll() {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
 	for _, addrManager := range km.managedKeystores {
 		addrManager.clearPrivKeys()
 	}
@@ -1719,6 +1947,30 @@ func (km *KeystoreManager) GetManagedAddressByScriptHashInCurrent(scriptHash []b
 	return nil, ErrAddressNotFound
 }
 
+func (km *KeystoreManager) GetManagedAddressByScriptHashInAccount(accountID string, scriptHash []byte) (*ManagedAddress, error) {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	addrManager, err := km.getAddrManagerByAccountID(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// create common address, because common address as index
+	scriptHashStruct, err := massutil.NewAddressWitnessScriptHash(scriptHash, km.params)
+	if err != nil {
+		return nil, err
+	}
+	encoded := scriptHashStruct.EncodeAddress()
+
+	mAddr, ok := addrManager.addrs[encoded]
+	if ok {
+		return mAddr, nil
+	}
+
+	return nil, ErrAddressNotFound
+}
+
 func NewKeystoreManager(rootBucket db.Bucket, pubPassphrase []byte, net *config.Params) (*KeystoreManager, error) {
 	if rootBucket == nil || net == nil || len(pubPassphrase) == 0 {
 		return nil, ErrNilPointer
@@ -732,6 +732,145 @@ func TestKeystoreManager_ImportKeystoreWithMnemonic(t *testing.T) {
 	return
 }
 
+func TestKeystoreManager_ImportWatchOnlyKeystore(t *testing.T) {
+	ldb, tearDown, err := GetDb("Tst_Manager")
+	if err != nil {
+		t.Fatalf("init db failed: %v", err)
+	}
+	defer tearDown()
+
+	var accountPubKey string
+	expected := make(map[string]struct{})
+	err = mwdb.Update(ldb, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			return fmt.Errorf("failed to get bucket, %v", err)
+		}
+		km, err := NewKeystoreManager(bucket, pubPassphrase, config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore manager, %v", err)
+		}
+		accountID, _, err := km.NewKeystore(tx, defaultBitSize, privPassphrase, "first", config.ChainParams, fastScrypt, addressGapLimit)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore, %v", err)
+		}
+		_, err = km.NextAddressesForAccount(tx, accountID, alwaysTrueCheck, false, 5, addressGapLimit, massutil.AddressClassWitnessV0)
+		if err != nil {
+			return fmt.Errorf("failed to new external address, %v", err)
+		}
+		am := km.managedKeystores[accountID]
+		if am.IsWatchOnly() {
+			return fmt.Errorf("full keystore reported as watch-only")
+		}
+		for _, addr := range am.ListAddresses() {
+			expected[addr] = struct{}{}
+		}
+		accountPubKey = am.AccountPubKey()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ldb1, tearDown1, err := GetDb("Tst_Manager1")
+	if err != nil {
+		t.Fatalf("init db failed: %v", err)
+	}
+	defer tearDown1()
+
+	var watchID string
+	err = mwdb.Update(ldb1, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			return fmt.Errorf("failed to get bucket, %v", err)
+		}
+		km1, err := NewKeystoreManager(bucket, pubPassphrase2, config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore manager, %v", err)
+		}
+		params := &WalletParams{
+			Version:         KeystoreVersionLatest,
+			Remarks:         "watch",
+			AddressGapLimit: addressGapLimit,
+			ExternalIndex:   5,
+		}
+		am, err := km1.ImportWatchOnlyKeystore(tx, alwaysFalseCheck, accountPubKey, params)
+		if err != nil {
+			return fmt.Errorf("failed to import watch-only keystore, %v", err)
+		}
+		watchID = am.Name()
+		if !am.IsWatchOnly() {
+			return fmt.Errorf("expect watch-only keystore")
+		}
+		addrs := am.ListAddresses()
+		if len(addrs) != len(expected) {
+			return fmt.Errorf("expect %d addresses, got %d", len(expected), len(addrs))
+		}
+		for _, addr := range addrs {
+			if _, ok := expected[addr]; !ok {
+				return fmt.Errorf("unexpected address %s", addr)
+			}
+		}
+
+		ma, err := am.Address(addrs[0])
+		if err != nil {
+			return err
+		}
+		hash := sha256.Sum256([]byte("watch-only"))
+		if _, err = km1.SignHash(ma.PubKey(), hash[:], privPassphrase); err != ErrWatchOnly {
+			return fmt.Errorf("SignHash: expect ErrWatchOnly, got %v", err)
+		}
+		if _, _, err = km1.GetMnemonic(tx, watchID, privPassphrase); err != ErrWatchOnly {
+			return fmt.Errorf("GetMnemonic: expect ErrWatchOnly, got %v", err)
+		}
+		if _, err = km1.ExportKeystore(tx, watchID, privPassphrase); err != ErrWatchOnly {
+			return fmt.Errorf("ExportKeystore: expect ErrWatchOnly, got %v", err)
+		}
+
+		// new addresses are derived from the public key
+		if _, err = km1.NextAddressesForAccount(tx, watchID, alwaysTrueCheck, true, 2, addressGapLimit, massutil.AddressClassWitnessV0); err != nil {
+			return fmt.Errorf("failed to new internal address, %v", err)
+		}
+
+		if _, err = km1.ImportWatchOnlyKeystore(tx, alwaysFalseCheck, accountPubKey, params); err != ErrDuplicateSeed {
+			return fmt.Errorf("expect ErrDuplicateSeed, got %v", err)
+		}
+		if _, err = km1.ImportWatchOnlyKeystore(tx, alwaysFalseCheck, accountPubKey[:len(accountPubKey)-1], params); err != ErrInvalidAccountPubKey {
+			return fmt.Errorf("expect ErrInvalidAccountPubKey, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// reload from db
+	err = mwdb.View(ldb1, func(tx mwdb.ReadTransaction) error {
+		bucket := tx.TopLevelBucket(keystoreBucket)
+		km1, err := NewKeystoreManager(bucket, pubPassphrase2, config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to load keystore manager, %v", err)
+		}
+		am, err := km1.GetAddrManagerByAccountID(watchID)
+		if err != nil {
+			return err
+		}
+		if !am.IsWatchOnly() {
+			return fmt.Errorf("expect watch-only keystore after reload")
+		}
+		if external, internal := am.CountAddresses(); external != 5 || internal != 2 {
+			return fmt.Errorf("unexpected address count %d/%d", external, internal)
+		}
+		if am.AccountPubKey() != accountPubKey {
+			return fmt.Errorf("account public key mismatched")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestKeystoreManager_ExportKeystore(t *testing.T) {
 	ldb, tearDown, err := GetDb("Tst_Manager")
 	if err != nil {
@@ -1119,6 +1258,154 @@ func TestKeystoreManager_SignHash(t *testing.T) {
 	return
 }
 
+func TestKeystoreManager_Unlock(t *testing.T) {
+	ldb, tearDown, err := GetDb("Tst_Manager")
+	if err != nil {
+		t.Fatalf("init db failed: %v", err)
+	}
+	defer tearDown()
+
+	km := &KeystoreManager{}
+	var (
+		accountID string
+		pk        *btcec.PublicKey
+	)
+	err = mwdb.Update(ldb, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			return fmt.Errorf("failed to get bucket, %v", err)
+		}
+		km, err = NewKeystoreManager(bucket, pubPassphrase, config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore manager, %v", err)
+		}
+		accountID, _, err = km.NewKeystore(tx, defaultBitSize, privPassphrase, "first", config.ChainParams, fastScrypt, addressGapLimit)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore, %v", err)
+		}
+		if err = km.UseKeystoreForWallet(accountID); err != nil {
+			return err
+		}
+		addrs, err := km.NextAddresses(tx, alwaysTrueCheck, false, 1, addressGapLimit, massutil.AddressClassWitnessV0)
+		if err != nil {
+			return fmt.Errorf("failed to new address, %v", err)
+		}
+		pk = addrs[0].pubKey
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer km.LockAll()
+	dataHash := sha256.Sum256([]byte("test unlock"))
+
+	// locked
+	if _, err = km.SignHash(pk, dataHash[:], nil); err != ErrLocked {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+	if _, err = km.Unlock(accountID, privPassphrase2, time.Hour); err != ErrInvalidPassphrase {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+	if _, err = km.Unlock(accountID, nil, time.Hour); err != ErrInvalidPassphrase {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+
+	// unlocked, the passphrase is omitted
+	expires, err := km.Unlock(accountID, privPassphrase, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Until(expires); d <= 0 || d > time.Hour {
+		t.Fatalf("unexpected expiry %v", expires)
+	}
+	sign, err := km.SignHash(pk, dataHash[:], nil)
+	if err != nil {
+		t.Fatalf("failed to sign hash, %v", err)
+	}
+	if !sign.Verify(dataHash[:], pk) {
+		t.Fatal("failed to verify signature")
+	}
+	// cleared by signing calls, kept by the session
+	km.ClearPrivKey()
+	if _, err = km.SignHash(pk, dataHash[:], privPassphrase2); err != ErrInvalidPassphrase {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+	if _, err = km.SignHash(pk, dataHash[:], nil); err != nil {
+		t.Fatalf("failed to sign hash, %v", err)
+	}
+	if _, err = km.SignHash(pk, dataHash[:], privPassphrase); err != nil {
+		t.Fatalf("failed to sign hash, %v", err)
+	}
+
+	// exporting and removing still require the passphrase
+	if err = km.CheckPrivPassphrase(accountID, nil); err != ErrInvalidPassphrase {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+	if err = km.CheckPrivPassphrase(accountID, privPassphrase); err != nil {
+		t.Fatal(err)
+	}
+	err = mwdb.View(ldb, func(tx mwdb.ReadTransaction) error {
+		if _, _, err := km.GetMnemonic(tx, accountID, nil); err != ErrInvalidPassphrase {
+			return fmt.Errorf("failed to catch error, %v", err)
+		}
+		if _, err := km.ExportKeystore(tx, accountID, nil); err != ErrInvalidPassphrase {
+			return fmt.Errorf("failed to catch error, %v", err)
+		}
+		if _, _, err := km.GetMnemonic(tx, accountID, privPassphrase); err != nil {
+			return err
+		}
+		_, err := km.ExportKeystore(tx, accountID, privPassphrase)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = km.SignHash(pk, dataHash[:], nil); err != nil {
+		t.Fatalf("failed to sign hash, %v", err)
+	}
+
+	// locked explicitly
+	if err = km.Lock(accountID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = km.SignHash(pk, dataHash[:], nil); err != ErrLocked {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+	if err = km.Lock("wrong accountID"); err != ErrAccountNotFound {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+
+	// a renewed session outlives the timer of the previous one
+	if _, err = km.Unlock(accountID, privPassphrase, 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = km.Unlock(accountID, privPassphrase, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if _, err = km.SignHash(pk, dataHash[:], nil); err != nil {
+		t.Fatalf("failed to sign hash, %v", err)
+	}
+
+	// locked on timeout
+	if _, err = km.Unlock(accountID, privPassphrase, 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; ; i++ {
+		_, err = km.SignHash(pk, dataHash[:], nil)
+		if err == ErrLocked {
+			break
+		}
+		if i == 100 {
+			t.Fatalf("not locked on timeout, %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, err = km.SignHash(pk, dataHash[:], privPassphrase); err != nil {
+		t.Fatalf("failed to sign hash, %v", err)
+	}
+}
+
 func TestKeystoreManager_ChangePubPassphrase(t *testing.T) {
 	ldb, tearDown, err := GetDb("Tst_Manager")
 	if err != nil {
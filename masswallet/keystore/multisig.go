@@ -0,0 +1,412 @@
+package keystore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/txscript"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/keystore/hdkeychain"
+)
+
+// multisigInfo describes an nRequired-of-len(cosigners) keystore. The address
+// at branch/index is the witness script hash of the cosigners' keys at the
+// same branch/index, so every cosigner derives the same addresses.
+type multisigInfo struct {
+	nRequired int
+	// account extended public keys, sorted by their string form
+	cosigners   []*hdkeychain.ExtendedKey
+	cosignerIDs []string
+	// branch keys in cosigner order
+	internalBranches []*hdkeychain.ExtendedKey
+	externalBranches []*hdkeychain.ExtendedKey
+}
+
+// sortPubKeys returns a copy of pubKeys in the lexicographical order of
+// their compressed serialization.
+func sortPubKeys(pubKeys []*btcec.PublicKey) []*btcec.PublicKey {
+	sorted := make([]*btcec.PublicKey, len(pubKeys))
+	copy(sorted, pubKeys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].SerializeCompressed(), sorted[j].SerializeCompressed()) < 0
+	})
+	return sorted
+}
+
+func newMultisigInfo(nRequired int, accountPubKeys []string, net *config.Params) (*multisigInfo, error) {
+	if len(accountPubKeys) < 2 || len(accountPubKeys) > txscript.MaxPubKeysPerMultiSig ||
+		nRequired < 1 || nRequired > len(accountPubKeys) {
+		return nil, ErrInvalidMultisigParams
+	}
+
+	sorted := make([]string, len(accountPubKeys))
+	copy(sorted, accountPubKeys)
+	sort.Strings(sorted)
+
+	info := &multisigInfo{
+		nRequired:        nRequired,
+		cosigners:        make([]*hdkeychain.ExtendedKey, 0, len(sorted)),
+		cosignerIDs:      make([]string, 0, len(sorted)),
+		internalBranches: make([]*hdkeychain.ExtendedKey, 0, len(sorted)),
+		externalBranches: make([]*hdkeychain.ExtendedKey, 0, len(sorted)),
+	}
+	for i, accountPubKey := range sorted {
+		if i > 0 && accountPubKey == sorted[i-1] {
+			return nil, ErrInvalidMultisigParams
+		}
+		acctKeyPub, err := hdkeychain.NewKeyFromString(accountPubKey)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to parse cosigner public key", logging.LogFormat{"error": err})
+			return nil, ErrInvalidAccountPubKey
+		}
+		if acctKeyPub.IsPrivate() || !acctKeyPub.IsForNet(net) || acctKeyPub.Depth() != 3 {
+			return nil, ErrInvalidAccountPubKey
+		}
+		acctEcPubKey, err := acctKeyPub.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+		cosignerID, err := pubKeyToAccountID(acctEcPubKey)
+		if err != nil {
+			return nil, err
+		}
+		internalBranch, err := acctKeyPub.Child(InternalBranch)
+		if err != nil {
+			return nil, err
+		}
+		externalBranch, err := acctKeyPub.Child(ExternalBranch)
+		if err != nil {
+			return nil, err
+		}
+		info.cosigners = append(info.cosigners, acctKeyPub)
+		info.cosignerIDs = append(info.cosignerIDs, cosignerID)
+		info.internalBranches = append(info.internalBranches, internalBranch)
+		info.externalBranches = append(info.externalBranches, externalBranch)
+	}
+	return info, nil
+}
+
+// serialize encodes the threshold and cosigners as
+// nRequired(1) | count(1) | [len(1) | account extended public key]...
+func (m *multisigInfo) serialize() []byte {
+	buf := make([]byte, 0, 2+len(m.cosigners)*112)
+	buf = append(buf, byte(m.nRequired), byte(len(m.cosigners)))
+	for _, cosigner := range m.cosigners {
+		str := cosigner.String()
+		buf = append(buf, byte(len(str)))
+		buf = append(buf, str...)
+	}
+	return buf
+}
+
+func deserializeMultisigInfo(serialized []byte, net *config.Params) (*multisigInfo, error) {
+	if len(serialized) < 2 {
+		return nil, errors.New("malformed multisig info")
+	}
+	nRequired, count := int(serialized[0]), int(serialized[1])
+	accountPubKeys := make([]string, 0, count)
+	offset := 2
+	for i := 0; i < count; i++ {
+		if offset >= len(serialized) || offset+1+int(serialized[offset]) > len(serialized) {
+			return nil, errors.New("malformed multisig info")
+		}
+		size := int(serialized[offset])
+		accountPubKeys = append(accountPubKeys, string(serialized[offset+1:offset+1+size]))
+		offset += 1 + size
+	}
+	return newMultisigInfo(nRequired, accountPubKeys, net)
+}
+
+// accountID commits to the threshold and all cosigners, it never collides
+// with the account id of any single cosigner.
+func (m *multisigInfo) accountID() (string, error) {
+	buf := make([]byte, 0, 1+len(m.cosigners)*btcec.PubKeyBytesLenCompressed)
+	buf = append(buf, byte(m.nRequired))
+	for _, cosigner := range m.cosigners {
+		pubKey, err := cosigner.ECPubKey()
+		if err != nil {
+			return "", err
+		}
+		buf = append(buf, pubKey.SerializeCompressed()...)
+	}
+	return encodeSegWitAddress("ac", 15, massutil.Hash160(buf))
+}
+
+// deriveAddress returns the multisig address at derivationPath. It returns
+// hdkeychain.ErrInvalidChild if the index is unusable for any cosigner.
+func (m *multisigInfo) deriveAddress(keystoreName string, derivationPath DerivationPath,
+	addressClass uint16, net *config.Params) (*ManagedAddress, error) {
+	branchKeys := m.externalBranches
+	if derivationPath.Branch == InternalBranch {
+		branchKeys = m.internalBranches
+	}
+	pubKeys := make([]*btcec.PublicKey, 0, len(branchKeys))
+	for _, branchKey := range branchKeys {
+		childKey, err := branchKey.Child(derivationPath.Index)
+		if err != nil {
+			return nil, err
+		}
+		pubKey, err := childKey.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return newManagedAddressForPubKeys(keystoreName, derivationPath, pubKeys, m.nRequired, addressClass, net)
+}
+
+// discoverMultisigAddresses derives at least childNum addresses on branch and
+// keeps going while used addresses are found within addressGapLimit.
+func discoverMultisigAddresses(accountBucket db.Bucket, m *multisigInfo, cryptoKeyPub EncryptorDecryptor,
+	accountID string, account, branch, childNum uint32, checkfunc func([]byte) (bool, error),
+	addressGapLimit uint32, net *config.Params) error {
+
+	var safeUint32Add = func(a, b uint32) uint32 {
+		if (a+b) < a || (a+b) < b {
+			return math.MaxUint32
+		}
+		return a + b
+	}
+
+	nextIndex := uint32(0)
+	addressInfo := make([]*unlockDeriveInfo, 0, childNum)
+	for i := uint32(0); i < safeUint32Add(nextIndex, addressGapLimit) || i < safeUint32Add(childNum, addressGapLimit); i++ {
+		derivationPath := DerivationPath{
+			Account: account,
+			Branch:  branch,
+			Index:   i,
+		}
+		managedAddr, err := m.deriveAddress(accountID, derivationPath, massutil.AddressClassWitnessV0, net)
+		if err != nil {
+			if err == hdkeychain.ErrInvalidChild {
+				continue
+			}
+			logging.CPrint(logging.ERROR, "new managedAddress failed",
+				logging.LogFormat{
+					"err": err,
+				})
+			return err
+		}
+
+		used, err := checkfunc(managedAddr.scriptHash)
+		if err != nil {
+			return err
+		}
+		if used {
+			nextIndex = i + 1
+		}
+		addressInfo = append(addressInfo, &unlockDeriveInfo{
+			managedAddr: managedAddr,
+			branch:      branch,
+			index:       i,
+		})
+	}
+
+	if nextIndex < childNum {
+		nextIndex = childNum
+	}
+	err := updateChildNum(accountBucket, branch == InternalBranch, nextIndex)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "put db failed",
+			logging.LogFormat{
+				"err": err,
+			})
+		return err
+	}
+
+	pkBucket, err := db.GetOrCreateBucket(accountBucket, pubKeyBucket)
+	if err != nil {
+		return err
+	}
+	for _, info := range addressInfo {
+		if info.index >= nextIndex {
+			break
+		}
+		pubKeyEnc, err := cryptoKeyPub.Encrypt(info.managedAddr.pubKey.SerializeCompressed())
+		if err != nil {
+			return err
+		}
+		err = putEncryptedPubKey(pkBucket, info.branch, info.index, pubKeyEnc)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createMultisigKeyScope creates the account bucket of a multisig keystore.
+// The account and branch keys of the first cosigner are stored as those of
+// the keystore, so it loads like any watch-only keystore.
+func createMultisigKeyScope(km db.Bucket, m *multisigInfo, cryptoKeyPub EncryptorDecryptor,
+	hdpath *hdPath, checkfunc func([]byte) (bool, error), net *config.Params, addressGapLimit uint32) (db.BucketMeta, error) {
+
+	scope := Net2KeyScope[net.HDCoinType]
+
+	accountIDBucket, err := db.GetOrCreateBucket(km, accountIDBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := m.accountID()
+	if err != nil {
+		return nil, err
+	}
+	value, _ := accountIDBucket.Get([]byte(accountID))
+	if value != nil {
+		return nil, ErrDuplicateSeed
+	}
+	err = putAccountID(accountIDBucket, []byte(accountID))
+	if err != nil {
+		return nil, err
+	}
+	accountBucket, err := km.NewBucket(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	acctPubEnc, err := cryptoKeyPub.Encrypt([]byte(m.cosigners[0].String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt public account key: %v", err)
+	}
+	err = putCoinType(accountBucket, scope.Coin)
+	if err != nil {
+		return nil, err
+	}
+	err = putAccountInfo(accountBucket, &scope, hdpath.Account, acctPubEnc, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	internalBranchPubEnc, err := cryptoKeyPub.Encrypt([]byte(m.internalBranches[0].String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt internal public branch key: %v", err)
+	}
+	externalBranchPubEnc, err := cryptoKeyPub.Encrypt([]byte(m.externalBranches[0].String()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt external public branch key: %v", err)
+	}
+	err = putBranchPubKeys(accountBucket, internalBranchPubEnc, externalBranchPubEnc)
+	if err != nil {
+		return nil, err
+	}
+	err = initBranchChildNum(accountBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigEnc, err := cryptoKeyPub.Encrypt(m.serialize())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt multisig info: %v", err)
+	}
+	err = putMultisig(accountBucket, multisigEnc)
+	if err != nil {
+		return nil, err
+	}
+
+	if hdpath.InternalChildNum != 0 {
+		err = discoverMultisigAddresses(accountBucket, m, cryptoKeyPub, accountID, hdpath.Account,
+			InternalBranch, hdpath.InternalChildNum, checkfunc, addressGapLimit, net)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if hdpath.ExternalChildNum != 0 {
+		err = discoverMultisigAddresses(accountBucket, m, cryptoKeyPub, accountID, hdpath.Account,
+			ExternalBranch, hdpath.ExternalChildNum, checkfunc, addressGapLimit, net)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return accountBucket.GetBucketMeta(), nil
+}
+
+// ImportMultisigKeystore imports an nRequired-of-N keystore defined by the
+// account extended public keys of its N cosigners. Like a watch-only keystore
+// it signs nothing, each cosigner signs with its own keystore.
+func (km *KeystoreManager) ImportMultisigKeystore(dbTransaction db.DBTransaction,
+	checkfunc func([]byte) (bool, error), nRequired int, accountPubKeys []string, walletParams *WalletParams) (*AddrManager, error) {
+
+	km.mu.Lock()
+	defer km.mu.Unlock()
+
+	ms, err := newMultisigInfo(nRequired, accountPubKeys, km.params)
+	if err != nil {
+		return nil, err
+	}
+
+	kmBucket := dbTransaction.FetchBucket(km.ksMgrMeta)
+	if kmBucket == nil {
+		return nil, ErrBucketNotFound
+	}
+
+	masterKeyPub, err := secretKeyGen(&km.pubPassphrase, &DefaultScryptOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate master public key: %v", err)
+	}
+	cryptoKeyPub, err := newCryptoKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate crypto public key: %v", err)
+	}
+	cryptoKeyPubEnc, err := masterKeyPub.Encrypt(cryptoKeyPub.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt crypto public key: %v", err)
+	}
+
+	hdpath := &hdPath{
+		Account:          uint32(WalletUsage),
+		InternalChildNum: walletParams.InternalIndex,
+		ExternalChildNum: walletParams.ExternalIndex,
+	}
+	if hdpath.ExternalChildNum == 0 {
+		hdpath.ExternalChildNum = 1
+	}
+
+	acctBucketMeta, err := createMultisigKeyScope(kmBucket, ms, cryptoKeyPub, hdpath, checkfunc,
+		km.params, walletParams.AddressGapLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	acctBucket := dbTransaction.FetchBucket(acctBucketMeta)
+	if acctBucket == nil {
+		return nil, ErrUnexpecteDBError
+	}
+
+	err = putVersion(acctBucket, walletParams.Version.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(walletParams.Remarks) > 0 {
+		err = putRemark(acctBucket, []byte(walletParams.Remarks))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = putMasterKeyParams(acctBucket, masterKeyPub.Marshal(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = putCryptoKeys(acctBucket, cryptoKeyPubEnc, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	addrManager, err := loadAddrManager(acctBucket, km.pubPassphrase, km.params)
+	if err != nil {
+		return nil, err
+	}
+
+	km.managedKeystores[addrManager.keystoreName] = addrManager
+	return addrManager, nil
+}
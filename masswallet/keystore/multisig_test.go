@@ -0,0 +1,259 @@
+package keystore
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/txscript"
+	"github.com/massnetorg/mass-core/wire"
+	"massnet.org/mass-wallet/config"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/psbt"
+)
+
+// newMultisigTestPacket returns a packet spending an output of ma, with one
+// derivation per cosigner of am.
+func newMultisigTestPacket(t *testing.T, am *AddrManager, ma *ManagedAddress, redeemScript []byte) *psbt.Packet {
+	pkScript, err := txscript.PayToWitnessScriptHashScript(ma.ScriptAddress())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&wire.Hash{0x01}, 0), nil))
+	tx.AddTxOut(wire.NewTxOut(9000, pkScript))
+
+	p := psbt.New(tx)
+	p.Inputs[0].Value = 10000
+	p.Inputs[0].PkScript = pkScript
+	p.Inputs[0].RedeemScript = redeemScript
+	dp := ma.DerivationPath()
+	for i, cosignerID := range am.CosignerIDs() {
+		p.Inputs[0].Derivations = append(p.Inputs[0].Derivations, &psbt.Derivation{
+			PubKey:   ma.PubKeys()[i].SerializeCompressed(),
+			WalletId: cosignerID,
+			Branch:   dp.Branch,
+			Index:    dp.Index,
+		})
+	}
+	return p
+}
+
+func TestKeystoreManager_ImportMultisigKeystore(t *testing.T) {
+	ldb, tearDown, err := GetDb("Tst_Manager")
+	if err != nil {
+		t.Fatalf("init db failed: %v", err)
+	}
+	defer tearDown()
+
+	var multisigID string
+	var packet *psbt.Packet
+	addresses := make(map[string]struct{})
+	accountPubKeys := make([]string, 0, 3)
+	keystoreJsons := make(map[string][]byte)
+	err = mwdb.Update(ldb, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			return fmt.Errorf("failed to get bucket, %v", err)
+		}
+		km, err := NewKeystoreManager(bucket, pubPassphrase, config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore manager, %v", err)
+		}
+
+		// first address of every cosigner, by account id
+		cosignerAddrs := make(map[string]*ManagedAddress)
+		for i := 0; i < 3; i++ {
+			accountID, _, err := km.NewKeystore(tx, defaultBitSize, privPassphrase, "cosigner", config.ChainParams, fastScrypt, addressGapLimit)
+			if err != nil {
+				return fmt.Errorf("failed to new keystore, %v", err)
+			}
+			addrs, err := km.NextAddressesForAccount(tx, accountID, alwaysTrueCheck, false, 1, addressGapLimit, massutil.AddressClassWitnessV0)
+			if err != nil {
+				return fmt.Errorf("failed to new address, %v", err)
+			}
+			cosignerAddrs[accountID] = addrs[0]
+			accountPubKeys = append(accountPubKeys, km.managedKeystores[accountID].AccountPubKey())
+			keystoreJsons[accountID], err = km.ExportKeystore(tx, accountID, privPassphrase)
+			if err != nil {
+				return fmt.Errorf("failed to export keystore, %v", err)
+			}
+		}
+
+		params := &WalletParams{
+			Version:         KeystoreVersionLatest,
+			Remarks:         "treasury",
+			AddressGapLimit: addressGapLimit,
+			ExternalIndex:   2,
+		}
+		am, err := km.ImportMultisigKeystore(tx, alwaysFalseCheck, 2, accountPubKeys, params)
+		if err != nil {
+			return fmt.Errorf("failed to import multisig keystore, %v", err)
+		}
+		multisigID = am.Name()
+		if !am.IsWatchOnly() || !am.IsMultisig() {
+			return fmt.Errorf("expect watch-only multisig keystore")
+		}
+		if _, ok := cosignerAddrs[multisigID]; ok {
+			return fmt.Errorf("multisig account id collides with a cosigner")
+		}
+		if external, internal := am.CountAddresses(); external != 2 || internal != 0 {
+			return fmt.Errorf("unexpected address count %d/%d", external, internal)
+		}
+
+		var ma *ManagedAddress
+		for _, addr := range am.ManagedAddresses() {
+			addresses[addr.String()] = struct{}{}
+			if addr.DerivationPath().Index == 0 {
+				ma = addr
+			}
+		}
+		if ma.NRequired() != 2 || len(ma.PubKeys()) != 3 {
+			return fmt.Errorf("unexpected multisig address %d-of-%d", ma.NRequired(), len(ma.PubKeys()))
+		}
+
+		// every cosigner key is the one the cosigner derives at the same path
+		hash := sha256.Sum256([]byte("multisig"))
+		dp := ma.DerivationPath()
+		for i, cosignerID := range am.CosignerIDs() {
+			cosignerAddr, ok := cosignerAddrs[cosignerID]
+			if !ok {
+				return fmt.Errorf("unexpected cosigner %s", cosignerID)
+			}
+			if !cosignerAddr.PubKey().IsEqual(ma.PubKeys()[i]) {
+				return fmt.Errorf("pubkey of cosigner %s mismatched", cosignerID)
+			}
+			sig, err := km.SignHashForPath(cosignerID, ma.PubKeys()[i], dp.Branch, dp.Index, hash[:], privPassphrase)
+			if err != nil {
+				return fmt.Errorf("failed to sign for path, %v", err)
+			}
+			if !sig.Verify(hash[:], ma.PubKeys()[i]) {
+				return fmt.Errorf("failed to verify signature")
+			}
+			_, err = km.SignHashForPath(cosignerID, ma.PubKeys()[(i+1)%3], dp.Branch, dp.Index, hash[:], privPassphrase)
+			if err != ErrUnexpectedPubKeyToSign {
+				return fmt.Errorf("expect ErrUnexpectedPubKeyToSign, got %v", err)
+			}
+		}
+
+		redeemScript, err := ma.RedeemScript(config.ChainParams)
+		if err != nil {
+			return err
+		}
+		class, _, pks, nRequired, err := txscript.ExtractPkScriptAddrs(redeemScript, config.ChainParams)
+		if err != nil || class != txscript.MultiSigTy || nRequired != 2 || len(pks) != 3 {
+			return fmt.Errorf("unexpected redeem script, %v", err)
+		}
+		scriptHash := sha256.Sum256(redeemScript)
+		witAddr, err := massutil.NewAddressWitnessScriptHash(scriptHash[:], config.ChainParams)
+		if err != nil || witAddr.EncodeAddress() != ma.String() {
+			return fmt.Errorf("redeem script does not match the address")
+		}
+
+		packet = newMultisigTestPacket(t, am, ma, redeemScript)
+
+		// new addresses are derived from the cosigner keys
+		addrs, err := km.NextAddressesForAccount(tx, multisigID, alwaysTrueCheck, true, 1, addressGapLimit, massutil.AddressClassWitnessV0)
+		if err != nil {
+			return fmt.Errorf("failed to new internal address, %v", err)
+		}
+		if addrs[0].NRequired() != 2 || len(addrs[0].PubKeys()) != 3 {
+			return fmt.Errorf("unexpected internal address %d-of-%d", addrs[0].NRequired(), len(addrs[0].PubKeys()))
+		}
+		addresses[addrs[0].String()] = struct{}{}
+
+		// the order of cosigners doesn't matter
+		reversed := []string{accountPubKeys[2], accountPubKeys[1], accountPubKeys[0]}
+		if _, err = km.ImportMultisigKeystore(tx, alwaysFalseCheck, 2, reversed, params); err != ErrDuplicateSeed {
+			return fmt.Errorf("expect ErrDuplicateSeed, got %v", err)
+		}
+		if _, err = km.ImportMultisigKeystore(tx, alwaysFalseCheck, 4, accountPubKeys, params); err != ErrInvalidMultisigParams {
+			return fmt.Errorf("expect ErrInvalidMultisigParams, got %v", err)
+		}
+		duplicated := []string{accountPubKeys[0], accountPubKeys[1], accountPubKeys[0]}
+		if _, err = km.ImportMultisigKeystore(tx, alwaysFalseCheck, 2, duplicated, params); err != ErrInvalidMultisigParams {
+			return fmt.Errorf("expect ErrInvalidMultisigParams, got %v", err)
+		}
+		invalid := []string{accountPubKeys[0], accountPubKeys[1][1:]}
+		if _, err = km.ImportMultisigKeystore(tx, alwaysFalseCheck, 2, invalid, params); err != ErrInvalidAccountPubKey {
+			return fmt.Errorf("expect ErrInvalidAccountPubKey, got %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// two cosigners sign independently with their exported keystores
+	encoded, err := packet.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := make([]*psbt.Packet, 0, 2)
+	for _, derivation := range packet.Inputs[0].Derivations[:2] {
+		p, err := psbt.Decode(encoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signer, err := NewKeystoreSigner(keystoreJsons[derivation.WalletId], privPassphrase, config.ChainParams)
+		if err != nil {
+			t.Fatalf("failed to new keystore signer, %v", err)
+		}
+		n, err := p.Sign(txscript.SigHashAll, config.ChainParams, func(d *psbt.Derivation, pubKey *btcec.PublicKey, hash []byte) (*btcec.Signature, error) {
+			if d.WalletId != signer.AccountID() {
+				return nil, psbt.ErrKeyNotFound
+			}
+			return signer.SignHash(pubKey, d.Branch, d.Index, hash)
+		})
+		signer.Zero()
+		if err != nil || n != 1 {
+			t.Fatalf("unexpected signing result %d, %v", n, err)
+		}
+		if p.IsComplete(config.ChainParams) {
+			t.Fatalf("packet should not be complete with one signature")
+		}
+		signed = append(signed, p)
+	}
+	combined, err := psbt.Combine(signed...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = combined.Finalize(config.ChainParams); err != nil {
+		t.Fatalf("failed to finalize, %v", err)
+	}
+
+	// reload from db
+	err = mwdb.View(ldb, func(tx mwdb.ReadTransaction) error {
+		bucket := tx.TopLevelBucket(keystoreBucket)
+		km, err := NewKeystoreManager(bucket, pubPassphrase, config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to load keystore manager, %v", err)
+		}
+		am, err := km.GetAddrManagerByAccountID(multisigID)
+		if err != nil {
+			return err
+		}
+		if !am.IsMultisig() {
+			return fmt.Errorf("expect multisig keystore after reload")
+		}
+		nRequired, keys := am.Multisig()
+		if nRequired != 2 || len(keys) != 3 {
+			return fmt.Errorf("unexpected multisig %d-of-%d", nRequired, len(keys))
+		}
+		addrs := am.ListAddresses()
+		if len(addrs) != len(addresses) {
+			return fmt.Errorf("expect %d addresses, got %d", len(addresses), len(addrs))
+		}
+		for _, addr := range addrs {
+			if _, ok := addresses[addr]; !ok {
+				return fmt.Errorf("unexpected address %s", addr)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
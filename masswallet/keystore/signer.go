@@ -0,0 +1,183 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/massnetorg/mass-core/logging"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/keystore/hdkeychain"
+	"massnet.org/mass-wallet/masswallet/keystore/snacl"
+	"massnet.org/mass-wallet/masswallet/keystore/zero"
+)
+
+// KeystoreSigner signs with the keys of an exported keystore json. It needs
+// neither a wallet database nor a synced chain, which makes it usable on an
+// offline machine.
+type KeystoreSigner struct {
+	accountID string
+	acctKey   *hdkeychain.ExtendedKey
+}
+
+// NewKeystoreSigner decrypts the account private key of keystoreJson with
+// privPassphrase. Call Zero once the signer is no longer needed.
+func NewKeystoreSigner(keystoreJson []byte, privPassphrase []byte, net *config.Params) (*KeystoreSigner, error) {
+	kStore, err := getKeystoreFromJson(keystoreJson)
+	if err != nil {
+		return nil, err
+	}
+	if kStore.HDpath.Coin != net.HDCoinType {
+		return nil, ErrCoinType
+	}
+	if kStore.HDpath.Account != uint32(WalletUsage) {
+		return nil, ErrAccountType
+	}
+
+	masterKeyPrivParams, err := hex.DecodeString(kStore.Crypto.PrivParams)
+	if err != nil {
+		return nil, ErrInvalidKeystoreJson
+	}
+	var masterKeyPriv snacl.SecretKey
+	defer masterKeyPriv.Zero()
+	err = unmarshalMasterPrivKey(&masterKeyPriv, privPassphrase, masterKeyPrivParams)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "unmarshalMasterPrivKey failed",
+			logging.LogFormat{
+				"err": err,
+			})
+		return nil, err
+	}
+
+	entropy, err := decryptEntropy(kStore, &masterKeyPriv)
+	if err != nil {
+		return nil, err
+	}
+	defer zero.Bytes(entropy)
+
+	seed, err := seedFromEntropy(KeystoreVersion(kStore.Crypto.Version), entropy, privPassphrase)
+	if err != nil {
+		return nil, err
+	}
+	defer zero.Bytes(seed)
+
+	rootKey, err := hdkeychain.NewMaster(seed, net)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive master extended key: %v", err)
+	}
+	defer rootKey.Zero()
+
+	coinTypeKeyPriv, err := deriveCoinTypeKey(rootKey, Net2KeyScope[net.HDCoinType])
+	if err != nil {
+		return nil, err
+	}
+	defer coinTypeKeyPriv.Zero()
+
+	acctKeyPriv, err := deriveAccountKey(coinTypeKeyPriv, kStore.HDpath.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	acctEcPubKey, err := acctKeyPriv.ECPubKey()
+	if err != nil {
+		acctKeyPriv.Zero()
+		return nil, err
+	}
+	accountID, err := pubKeyToAccountID(acctEcPubKey)
+	if err != nil {
+		acctKeyPriv.Zero()
+		return nil, err
+	}
+
+	return &KeystoreSigner{
+		accountID: accountID,
+		acctKey:   acctKeyPriv,
+	}, nil
+}
+
+// AccountID returns the wallet id of the keystore.
+func (s *KeystoreSigner) AccountID() string {
+	return s.accountID
+}
+
+// SignHash signs hash with the key at branch/index, which must belong to pubKey.
+func (s *KeystoreSigner) SignHash(pubKey *btcec.PublicKey, branch, index uint32, hash []byte) (*btcec.Signature, error) {
+	if len(hash) != 32 {
+		return nil, ErrInvalidDataHash
+	}
+	if branch != ExternalBranch && branch != InternalBranch {
+		return nil, ErrUnexpectedPubKeyToSign
+	}
+
+	branchKey, err := s.acctKey.Child(branch)
+	if err != nil {
+		return nil, err
+	}
+	defer branchKey.Zero()
+	childKey, err := branchKey.Child(index)
+	if err != nil {
+		return nil, err
+	}
+	defer childKey.Zero()
+
+	privKey, err := childKey.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	if !privKey.PubKey().IsEqual(pubKey) {
+		return nil, ErrUnexpectedPubKeyToSign
+	}
+	return privKey.Sign(hash)
+}
+
+// Zero clears the account private key held by the signer.
+func (s *KeystoreSigner) Zero() {
+	if s.acctKey != nil {
+		s.acctKey.Zero()
+		s.acctKey = nil
+	}
+}
+
+// decryptEntropy returns the mnemonic entropy of kStore, masterKeyPriv must
+// already be derived from the private passphrase.
+func decryptEntropy(kStore *Keystore, masterKeyPriv *snacl.SecretKey) ([]byte, error) {
+	cryptoKeyEntropyEnc, err := hex.DecodeString(kStore.Crypto.CryptoKeyEntropyEnc)
+	if err != nil {
+		return nil, err
+	}
+
+	entropyEnc, err := hex.DecodeString(kStore.Crypto.EntropyEnc)
+	if err != nil {
+		return nil, err
+	}
+
+	cEntropyBytes, err := masterKeyPriv.Decrypt(cryptoKeyEntropyEnc)
+	if err != nil {
+		return nil, err
+	}
+
+	var cEntropy cryptoKey
+	cEntropy.CopyBytes(cEntropyBytes)
+	zero.Bytes(cEntropyBytes)
+	defer cEntropy.Zero()
+
+	return cEntropy.Decrypt(entropyEnc)
+}
+
+// seedFromEntropy returns the hd seed of a keystore of the given version.
+func seedFromEntropy(version KeystoreVersion, entropy []byte, privPassphrase []byte) ([]byte, error) {
+	mnemonic, err := NewMnemonic(entropy)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to new mnemonic", logging.LogFormat{"error": err})
+		return nil, err
+	}
+
+	var genPass string
+	switch version {
+	case KeystoreVersion0:
+		genPass = string(privPassphrase)
+	default:
+		return nil, ErrKeystoreVersion
+	}
+	return NewSeed(mnemonic, genPass), nil
+}
@@ -0,0 +1,80 @@
+package keystore
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/massnetorg/mass-core/massutil"
+	"massnet.org/mass-wallet/config"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+)
+
+func TestKeystoreSigner(t *testing.T) {
+	ldb, tearDown, err := GetDb("Tst_Manager")
+	if err != nil {
+		t.Fatalf("init db failed: %v", err)
+	}
+	defer tearDown()
+
+	var accountID string
+	var keystoreJson []byte
+	var addrs []*ManagedAddress
+	err = mwdb.Update(ldb, func(tx mwdb.DBTransaction) error {
+		bucket, err := mwdb.GetOrCreateTopLevelBucket(tx, keystoreBucket)
+		if err != nil {
+			return fmt.Errorf("failed to get bucket, %v", err)
+		}
+		km, err := NewKeystoreManager(bucket, pubPassphrase, config.ChainParams)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore manager, %v", err)
+		}
+		accountID, _, err = km.NewKeystore(tx, defaultBitSize, privPassphrase, "first", config.ChainParams, fastScrypt, addressGapLimit)
+		if err != nil {
+			return fmt.Errorf("failed to new keystore, %v", err)
+		}
+		err = km.UseKeystoreForWallet(accountID)
+		if err != nil {
+			return fmt.Errorf("failed to use keystore, %v", err)
+		}
+		addrs, err = km.NextAddresses(tx, alwaysTrueCheck, false, 2, addressGapLimit, massutil.AddressClassWitnessV0)
+		if err != nil {
+			return fmt.Errorf("failed to new address, %v", err)
+		}
+		keystoreJson, err = km.ExportKeystore(tx, accountID, privPassphrase)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// wrong pass
+	if _, err = NewKeystoreSigner(keystoreJson, privPassphrase2, config.ChainParams); err != ErrInvalidPassphrase {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+
+	signer, err := NewKeystoreSigner(keystoreJson, privPassphrase, config.ChainParams)
+	if err != nil {
+		t.Fatalf("failed to new keystore signer, %v", err)
+	}
+	defer signer.Zero()
+	if signer.AccountID() != accountID {
+		t.Fatalf("account id mismatched, expected %s, got %s", accountID, signer.AccountID())
+	}
+
+	dataHash := sha256.Sum256([]byte("test sign hash"))
+	dp := addrs[0].DerivationPath()
+	sig, err := signer.SignHash(addrs[0].PubKey(), dp.Branch, dp.Index, dataHash[:])
+	if err != nil {
+		t.Fatalf("failed to sign hash, %v", err)
+	}
+	if !sig.Verify(dataHash[:], addrs[0].PubKey()) {
+		t.Fatal("failed to verify signature")
+	}
+
+	// path does not match the public key
+	_, err = signer.SignHash(addrs[1].PubKey(), dp.Branch, dp.Index, dataHash[:])
+	if err != ErrUnexpectedPubKeyToSign {
+		t.Fatalf("failed to catch error, %v", err)
+	}
+}
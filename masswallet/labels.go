@@ -0,0 +1,204 @@
+package masswallet
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/wire"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+// MaxLabelLen is the max length in characters of an address label or a transaction note.
+const MaxLabelLen = 256
+
+// WalletLabels holds the address labels and transaction notes of a wallet. It is
+// also carried by the keystore json of ExportWallet, under the key "labels".
+type WalletLabels struct {
+	Addresses    map[string]string `json:"addresses,omitempty"`    // address -> label
+	Transactions map[string]string `json:"transactions,omitempty"` // tx id -> note
+}
+
+func newWalletLabels() *WalletLabels {
+	return &WalletLabels{
+		Addresses:    make(map[string]string),
+		Transactions: make(map[string]string),
+	}
+}
+
+func (l *WalletLabels) add(label *txmgr.Label) {
+	switch label.Kind {
+	case txmgr.LabelAddress:
+		l.Addresses[label.Target] = label.Text
+	case txmgr.LabelTransaction:
+		l.Transactions[label.Target] = label.Text
+	}
+}
+
+// SetAddressLabel attaches label to an address of the wallet, an empty label
+// removes the existing one.
+func (w *WalletManager) SetAddressLabel(walletId, address, label string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return err
+	}
+	address, err = w.walletAddress(am, address)
+	if err != nil {
+		return err
+	}
+	return w.putLabel(am.Name(), &txmgr.Label{Kind: txmgr.LabelAddress, Target: address, Text: label})
+}
+
+// walletAddress returns the encoded form of address, a standard or staking
+// address of the wallet.
+func (w *WalletManager) walletAddress(am *keystore.AddrManager, address string) (string, error) {
+	addr, err := massutil.DecodeAddress(address, w.chainParams)
+	if err != nil {
+		return "", ErrFailedDecodeAddress
+	}
+	_, err = w.ksmgr.GetManagedAddressByScriptHashInAccount(am.Name(), addr.ScriptAddress())
+	if err != nil {
+		return "", err
+	}
+	return addr.EncodeAddress(), nil
+}
+
+// SetTxNote attaches note to a transaction, an empty note removes the existing one.
+// The transaction is not required to be known to the wallet yet.
+func (w *WalletManager) SetTxNote(walletId, txId, note string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return err
+	}
+	hash, err := wire.NewHashFromStr(txId)
+	if err != nil {
+		return ErrShaHashFromStr
+	}
+	return w.putLabel(am.Name(), &txmgr.Label{Kind: txmgr.LabelTransaction, Target: hash.String(), Text: note})
+}
+
+func (w *WalletManager) putLabel(walletId string, label *txmgr.Label) error {
+	if utf8.RuneCountInString(label.Text) > MaxLabelLen {
+		logging.CPrint(logging.ERROR, "label too long", logging.LogFormat{
+			"len": utf8.RuneCountInString(label.Text),
+		})
+		return ErrInvalidParameter
+	}
+	err := mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
+		return w.utxoStore.PutLabel(tx, walletId, label)
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to put label", logging.LogFormat{
+			"err":    err,
+			"target": label.Target,
+		})
+		return err
+	}
+	return nil
+}
+
+// GetLabels returns all address labels and transaction notes of the wallet.
+func (w *WalletManager) GetLabels(walletId string) (*WalletLabels, error) {
+	return w.SearchLabels(walletId, "")
+}
+
+// SearchLabels returns address labels and transaction notes of the wallet whose
+// text, address or tx id contains query, case-insensitively.
+func (w *WalletManager) SearchLabels(walletId, query string) (*WalletLabels, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
+	}
+	return w.searchLabels(am, query)
+}
+
+func (w *WalletManager) searchLabels(am *keystore.AddrManager, query string) (*WalletLabels, error) {
+	var labels []*txmgr.Label
+	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+		var err error
+		labels, err = w.utxoStore.Labels(tx, am.Name())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	query = strings.ToLower(query)
+	ret := newWalletLabels()
+	for _, label := range labels {
+		if len(query) == 0 ||
+			strings.Contains(strings.ToLower(label.Text), query) ||
+			strings.Contains(strings.ToLower(label.Target), query) {
+			ret.add(label)
+		}
+	}
+	return ret, nil
+}
+
+// putWalletLabels stores labels imported along with a wallet, labels of addresses
+// unknown to the wallet are ignored.
+func (w *WalletManager) putWalletLabels(tx mwdb.DBTransaction, am *keystore.AddrManager, labels *WalletLabels) error {
+	for address, text := range labels.Addresses {
+		encoded, err := w.walletAddress(am, address)
+		if err != nil || utf8.RuneCountInString(text) > MaxLabelLen {
+			continue
+		}
+		err = w.utxoStore.PutLabel(tx, am.Name(), &txmgr.Label{Kind: txmgr.LabelAddress, Target: encoded, Text: text})
+		if err != nil {
+			return err
+		}
+	}
+	for txId, text := range labels.Transactions {
+		hash, err := wire.NewHashFromStr(txId)
+		if err != nil || utf8.RuneCountInString(text) > MaxLabelLen {
+			continue
+		}
+		err = w.utxoStore.PutLabel(tx, am.Name(), &txmgr.Label{Kind: txmgr.LabelTransaction, Target: hash.String(), Text: text})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// keystoreWithLabels returns keystoreJSON with labels added under the key "labels".
+func keystoreWithLabels(keystoreJSON []byte, labels *WalletLabels) ([]byte, error) {
+	if len(labels.Addresses) == 0 && len(labels.Transactions) == 0 {
+		return keystoreJSON, nil
+	}
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(keystoreJSON, &fields); err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(labels)
+	if err != nil {
+		return nil, err
+	}
+	fields["labels"] = raw
+	return json.Marshal(fields)
+}
+
+// labelsFromKeystore returns labels carried by keystoreJSON, or nil if there are none.
+func labelsFromKeystore(keystoreJSON []byte) *WalletLabels {
+	carrier := struct {
+		Labels *WalletLabels `json:"labels"`
+	}{}
+	if err := json.Unmarshal(keystoreJSON, &carrier); err != nil {
+		return nil
+	}
+	return carrier.Labels
+}
@@ -0,0 +1,195 @@
+package masswallet
+
+import (
+	"github.com/massnetorg/mass-core/blockchain"
+	"github.com/massnetorg/mass-core/logging"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/masswallet/utils"
+)
+
+// Directions of ledger records.
+const (
+	LedgerIn   = "in"
+	LedgerOut  = "out"
+	LedgerSelf = "self"
+)
+
+// Classifications of ledger records.
+const (
+	LedgerTransfer        = "transfer"
+	LedgerStaking         = "staking"
+	LedgerStakingWithdraw = "staking_withdraw"
+	LedgerStakingReward   = "staking_reward"
+	LedgerBinding         = "binding"
+	LedgerBindingWithdraw = "binding_withdraw"
+	LedgerMiningReward    = "mining_reward"
+)
+
+// ledgerPageSize is the number of history entries read from db at a time.
+const ledgerPageSize = 500
+
+// LedgerRecord is a row of the accounting ledger of a wallet.
+type LedgerRecord struct {
+	*txmgr.TxHistoryEntry
+	Direction    string
+	Class        string
+	Counterparts []string // addresses out of the wallet paid to, or paying to the wallet
+	Amount       int64    // received minus sent by the wallet, in maxwell
+	Balance      int64    // balance of the wallet after the transaction, in maxwell
+}
+
+// ExportLedger calls fn with the ledger records of walletId within the height
+// and time range of query, in the order of block height and position in block.
+// The running balance is accumulated from the first indexed transaction, so it
+// only matches the wallet balance if the whole history is indexed. It stops at
+// the first error returned by fn.
+func (w *WalletManager) ExportLedger(walletId string, query *txmgr.TxHistoryQuery, fn func(*LedgerRecord) error) error {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return err
+	}
+
+	var (
+		balance int64
+		page    = &txmgr.TxHistoryQuery{ToHeight: query.ToHeight, Limit: ledgerPageSize}
+	)
+	for {
+		var (
+			entries []*txmgr.TxHistoryEntry
+			cursor  []byte
+		)
+		err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+			entries, cursor, err = w.txStore.TxHistory(tx, am.Name(), page)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			amount := entry.Received.IntValue() - entry.Sent.IntValue()
+			balance += amount
+			if entry.BlockHeight < query.FromHeight ||
+				(query.FromTime > 0 && entry.Timestamp.Unix() < query.FromTime) ||
+				(query.ToTime > 0 && entry.Timestamp.Unix() > query.ToTime) {
+				continue
+			}
+			rec, err := w.newLedgerRecord(entry)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "failed to build ledger record", logging.LogFormat{
+					"tx":     entry.TxHash.String(),
+					"height": entry.BlockHeight,
+					"err":    err,
+				})
+				return err
+			}
+			rec.Amount = amount
+			rec.Balance = balance
+			if err = fn(rec); err != nil {
+				return err
+			}
+		}
+		if cursor == nil {
+			return nil
+		}
+		page.Cursor = cursor
+	}
+}
+
+// newLedgerRecord classifies entry and looks up its counterparts from the transaction
+// on chain.
+func (w *WalletManager) newLedgerRecord(entry *txmgr.TxHistoryEntry) (*LedgerRecord, error) {
+	msgTx, err := w.chainFetcher.FetchLastTxUntilHeight(&entry.TxHash, entry.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	owned := make(map[string]struct{}, len(entry.ScriptHashes))
+	for _, scriptHash := range entry.ScriptHashes {
+		owned[string(scriptHash)] = struct{}{}
+	}
+
+	rec := &LedgerRecord{
+		TxHistoryEntry: entry,
+		Direction:      LedgerIn,
+		Class:          LedgerTransfer,
+	}
+	if blockchain.IsCoinBaseTx(msgTx) {
+		// the miner is paid by the last output, stakers by the others
+		rec.Class = LedgerStakingReward
+		last, err := utils.ParsePkScript(msgTx.TxOut[len(msgTx.TxOut)-1].PkScript, w.chainParams)
+		if err == nil {
+			if _, ok := owned[string(last.StdScriptAddress())]; ok {
+				rec.Class = LedgerMiningReward
+			}
+		}
+		return rec, nil
+	}
+
+	var (
+		payees       []string
+		hasStaking   bool
+		hasBinding   bool
+		counterparts = make(map[string]struct{})
+	)
+	for _, txOut := range msgTx.TxOut {
+		pks, err := utils.ParsePkScript(txOut.PkScript, w.chainParams)
+		if err != nil {
+			// not paid to an address
+			continue
+		}
+		hasStaking = hasStaking || pks.IsStaking()
+		hasBinding = hasBinding || pks.IsBinding()
+		if _, ok := owned[string(pks.StdScriptAddress())]; !ok {
+			payees = appendCounterpart(payees, counterparts, pks.StdEncodeAddress())
+		}
+	}
+
+	switch entry.Type {
+	case txmgr.HistoryStaking:
+		rec.Class = LedgerStakingWithdraw
+		if hasStaking {
+			rec.Class = LedgerStaking
+		}
+	case txmgr.HistoryBinding:
+		rec.Class = LedgerBindingWithdraw
+		if hasBinding {
+			rec.Class = LedgerBinding
+		}
+	}
+
+	if !entry.Sent.IsZero() {
+		rec.Direction = LedgerOut
+		if len(payees) == 0 {
+			rec.Direction = LedgerSelf
+		}
+		rec.Counterparts = payees
+		return rec, nil
+	}
+
+	counterparts = make(map[string]struct{})
+	for _, txIn := range msgTx.TxIn {
+		prevTx, err := w.chainFetcher.FetchLastTxUntilHeight(&txIn.PreviousOutPoint.Hash, entry.BlockHeight)
+		if err != nil {
+			return nil, err
+		}
+		if txIn.PreviousOutPoint.Index >= uint32(len(prevTx.TxOut)) {
+			return nil, ErrInvalidIndex
+		}
+		pks, err := utils.ParsePkScript(prevTx.TxOut[txIn.PreviousOutPoint.Index].PkScript, w.chainParams)
+		if err != nil {
+			continue
+		}
+		rec.Counterparts = appendCounterpart(rec.Counterparts, counterparts, pks.StdEncodeAddress())
+	}
+	return rec, nil
+}
+
+func appendCounterpart(addresses []string, seen map[string]struct{}, address string) []string {
+	if _, ok := seen[address]; ok {
+		return addresses
+	}
+	seen[address] = struct{}{}
+	return append(addresses, address)
+}
@@ -0,0 +1,221 @@
+package masswallet
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/massnetorg/mass-core/database/ldb"
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/txscript"
+	"github.com/massnetorg/mass-core/wire"
+	"github.com/stretchr/testify/assert"
+	"massnet.org/mass-wallet/config"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/migration"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+func TestWalletManager_ExportLedger(t *testing.T) {
+	chainDb, close, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("newTestChainDB error:", err)
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testExportLedger")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{chainDb}, walletDb, cfg, config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+	walletId, _, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	if _, err = w.UseWallet(walletId); err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+	addr, err := w.NewAddress("", 0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+	addrInterface, err := massutil.DecodeAddress(addr, w.chainParams)
+	if err != nil {
+		t.Fatal("decode addr error", err.Error())
+	}
+	pkScript, err := txscript.PayToWitnessScriptHashScript(addrInterface.ScriptAddress())
+	if err != nil {
+		t.Fatal("get pkscript error", err.Error())
+	}
+
+	// pay block15T2 and block15T3 to the wallet
+	block15T2.TxOut[0].PkScript = pkScript
+	block15T3.TxOut[0].PkScript = pkScript
+	newBlock15T2Hash := block15T2.TxHash()
+	newBlock15T3Hash := block15T3.TxHash()
+	for i := 1; i <= int(block15Meta.Height); i++ {
+		blk := blks200[i]
+		blk.ResetGenerated()
+		for _, tx := range blk.MsgBlock().Transactions {
+			for _, txin := range tx.TxIn {
+				if bytes.Equal(txin.PreviousOutPoint.Hash[:], b15T2Hash[:]) {
+					txin.PreviousOutPoint.Hash = newBlock15T2Hash
+					continue
+				}
+				if bytes.Equal(txin.PreviousOutPoint.Hash[:], b15T3Hash[:]) {
+					txin.PreviousOutPoint.Hash = newBlock15T3Hash
+				}
+			}
+		}
+		if err = chainDb.SubmitBlock(blk); err != nil {
+			t.Fatal("init db error:", err)
+		}
+		chainDb.(*ldb.ChainDb).Batch(1).Set(blk.MsgBlock().BlockHash())
+		chainDb.(*ldb.ChainDb).Batch(1).Done()
+		if err = chainDb.Commit(blk.MsgBlock().BlockHash()); err != nil {
+			t.Fatal("init db error:", err)
+		}
+	}
+	defer func() {
+		block15T2.TxOut[0].PkScript = b15T2O0PkScript
+		block15T3.TxOut[0].PkScript = b15T3O0PkScript
+		for i := 1; i <= int(block15Meta.Height); i++ {
+			for _, tx := range blks200[i].MsgBlock().Transactions {
+				for _, txin := range tx.TxIn {
+					if bytes.Equal(txin.PreviousOutPoint.Hash[:], newBlock15T2Hash[:]) {
+						txin.PreviousOutPoint.Hash = b15T2Hash
+						continue
+					}
+					if bytes.Equal(txin.PreviousOutPoint.Hash[:], newBlock15T3Hash[:]) {
+						txin.PreviousOutPoint.Hash = b15T3Hash
+					}
+				}
+			}
+		}
+	}()
+
+	blkLoc, err := w.chainFetcher.FetchBlockLocByHeight(block15Meta.Height)
+	if err != nil {
+		t.Fatal("FetchBlockLocByHeight error:", err)
+	}
+	block15Meta.Loc = blkLoc
+	txLocs, err := blks200[block15Meta.Height].TxLoc()
+	if err != nil {
+		t.Fatal("TxLoc error", err)
+	}
+	recs := make([]*txmgr.TxRecord, 0, 2)
+	for i, msgTx := range []*wire.MsgTx{block15T2, block15T3} {
+		rec, err := txmgr.NewTxRecordFromMsgTx(msgTx, block15.Header.Timestamp)
+		if err != nil {
+			t.Fatal("get txRecord error", err.Error())
+		}
+		if rec, err = simpleFilterTx(rec, msgTx, walletId); err != nil {
+			t.Fatal("filter tx error", err.Error())
+		}
+		rec.TxLoc = &txLocs[2+i]
+		recs = append(recs, rec)
+	}
+	allBalances := map[string]massutil.Amount{
+		walletId: massutil.ZeroAmount(),
+	}
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		for _, rec := range recs {
+			if err := w.txStore.AddRelevantTx(tx, allBalances, rec, block15Meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal("add relevantTx error", err)
+	}
+
+	var records []*LedgerRecord
+	collect := func(rec *LedgerRecord) error {
+		records = append(records, rec)
+		return nil
+	}
+
+	// whole history
+	err = w.ExportLedger(walletId, &txmgr.TxHistoryQuery{}, collect)
+	assert.Nil(t, err)
+	if !assert.Equal(t, 2, len(records)) {
+		t.FailNow()
+	}
+	var balance int64
+	for i, rec := range records {
+		var received int64
+		for _, txOut := range recs[i].MsgTx.TxOut {
+			received += txOut.Value
+		}
+		balance += received
+		assert.Equal(t, recs[i].Hash, rec.TxHash)
+		assert.Equal(t, LedgerIn, rec.Direction)
+		assert.Equal(t, LedgerTransfer, rec.Class)
+		assert.Equal(t, received, rec.Amount)
+		assert.Equal(t, balance, rec.Balance)
+		assert.True(t, len(rec.Counterparts) > 0)
+	}
+
+	// the balance is carried over records out of range
+	records = nil
+	err = w.ExportLedger(walletId, &txmgr.TxHistoryQuery{FromHeight: block15Meta.Height, ToHeight: block15Meta.Height}, collect)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(records))
+	assert.Equal(t, balance, records[1].Balance)
+
+	records = nil
+	err = w.ExportLedger(walletId, &txmgr.TxHistoryQuery{FromHeight: block15Meta.Height + 1}, collect)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(records))
+
+	records = nil
+	err = w.ExportLedger(walletId, &txmgr.TxHistoryQuery{FromTime: block15.Header.Timestamp.Unix() + 1}, collect)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(records))
+
+	// stops at the first error of fn
+	errStop := errors.New("stop")
+	calls := 0
+	err = w.ExportLedger(walletId, &txmgr.TxHistoryQuery{}, func(rec *LedgerRecord) error {
+		calls++
+		return errStop
+	})
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, 1, calls)
+
+	// a wallet db of version 0 has no history index, it is rebuilt by the
+	// migration from the tx records and the chain
+	records = nil
+	assert.Nil(t, w.ExportLedger(walletId, &txmgr.TxHistoryQuery{}, collect))
+	want := records
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		if err := w.txStore.RemoveTxHistoryByWalletId(tx, walletId); err != nil {
+			return err
+		}
+		return tx.TopLevelBucket("m").Delete([]byte("version"))
+	})
+	if err != nil {
+		t.Fatal("downgrade wallet db error", err)
+	}
+	records = nil
+	assert.Nil(t, w.ExportLedger(walletId, &txmgr.TxHistoryQuery{}, collect))
+	assert.Equal(t, 0, len(records))
+
+	res, err := migration.DefaultRegistry.Migrate(walletDb, nil)
+	assert.Equal(t, migration.ErrChainRequired, err)
+	assert.Equal(t, uint32(1), res.To)
+	res, err = migration.DefaultRegistry.Migrate(walletDb, &migration.Options{
+		Env: &migration.Env{ChainParams: w.chainParams, ChainFetcher: w.chainFetcher},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), res.From)
+	assert.Equal(t, migration.DefaultRegistry.LatestVersion(), res.To)
+	records = nil
+	assert.Nil(t, w.ExportLedger(walletId, &txmgr.TxHistoryQuery{}, collect))
+	assert.Equal(t, 2, len(records))
+	assert.Equal(t, want, records)
+}
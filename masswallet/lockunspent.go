@@ -0,0 +1,123 @@
+package masswallet
+
+import (
+	"time"
+
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/wire"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+// LockUnspent keeps unspent outputs of the wallet out of automatic coin selection
+// until they are unlocked, spent or expired. Unlike the marks made by MarkUsedUTXO,
+// locks are stored in the wallet db and survive restarts. A zero expiry never expires.
+func (w *WalletManager) LockUnspent(walletId string, outPoints []*wire.OutPoint, name string,
+	expiry int64) ([]*txmgr.LockedUnspent, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	if expiry != 0 && expiry <= now {
+		logging.CPrint(logging.ERROR, "lock expires in the past", logging.LogFormat{
+			"expiry": expiry,
+		})
+		return nil, ErrInvalidParameter
+	}
+
+	locks := make([]*txmgr.LockedUnspent, 0, len(outPoints))
+	err = mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
+		for _, op := range outPoints {
+			lock := &txmgr.LockedUnspent{
+				OutPoint: *op,
+				Name:     name,
+				Expiry:   expiry,
+			}
+			err := w.utxoStore.LockUnspent(tx, am.Name(), lock, now)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "failed to lock unspent", logging.LogFormat{
+					"err":      err,
+					"outpoint": op,
+				})
+				if err == txmgr.ErrNotFound {
+					return ErrUTXONotExists
+				}
+				return err
+			}
+			locks = append(locks, lock)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return locks, nil
+}
+
+// UnlockUnspent removes locks of outPoints, or all locks of the wallet if outPoints
+// is empty. It returns the number of locks removed.
+func (w *WalletManager) UnlockUnspent(walletId string, outPoints []*wire.OutPoint) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return 0, err
+	}
+
+	unlocked := 0
+	err = mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
+		if len(outPoints) == 0 {
+			locks, err := w.utxoStore.LockedUnspents(tx, am.Name(), time.Now().Unix())
+			if err != nil {
+				return err
+			}
+			unlocked = len(locks)
+			return w.utxoStore.RemoveLockedUnspentByWalletId(tx, am.Name())
+		}
+		for _, op := range outPoints {
+			ok, err := w.utxoStore.UnlockUnspent(tx, am.Name(), op)
+			if err != nil {
+				return err
+			}
+			if ok {
+				unlocked++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to unlock unspent", logging.LogFormat{
+			"err": err,
+		})
+		return 0, err
+	}
+	return unlocked, nil
+}
+
+// ListLockUnspent returns locks of the wallet in force.
+func (w *WalletManager) ListLockUnspent(walletId string) ([]*txmgr.LockedUnspent, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
+	}
+
+	var locks []*txmgr.LockedUnspent
+	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+		locks, err = w.utxoStore.LockedUnspents(tx, am.Name(), time.Now().Unix())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return locks, nil
+}
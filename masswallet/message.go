@@ -0,0 +1,100 @@
+package masswallet
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/wire"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/keystore"
+)
+
+// messageMagic separates the hash of a signed message from the hashes of
+// transactions, so that a message signature is never valid for a transaction.
+const messageMagic = "MASS Signed Message:\n"
+
+// compactSigSize is the size of a compact signature, the recovery id followed by
+// R and S.
+const compactSigSize = 65
+
+// MessageHash returns the hash signed for message, the double sha256 of the
+// uvarint length prefixed magic followed by the uvarint length prefixed
+// message.
+func MessageHash(message string) []byte {
+	var buf bytes.Buffer
+	var lenBuf [binary.MaxVarintLen64]byte
+	for _, s := range []string{messageMagic, message} {
+		n := binary.PutUvarint(lenBuf[:], uint64(len(s)))
+		buf.Write(lenBuf[:n])
+		buf.WriteString(s)
+	}
+	return wire.DoubleHashB(buf.Bytes())
+}
+
+// messageScriptHash returns the script hash of a standard or staking address,
+// the keys of both are the same.
+func messageScriptHash(address string, net *config.Params) ([]byte, error) {
+	addr, err := massutil.DecodeAddress(address, net)
+	if err != nil {
+		logging.CPrint(logging.WARN, "Failed to decode address", logging.LogFormat{
+			"err":     err,
+			"address": address,
+		})
+		return nil, ErrFailedDecodeAddress
+	}
+	if !massutil.IsWitnessV0Address(addr) && !massutil.IsWitnessStakingAddress(addr) {
+		return nil, ErrInvalidAddress
+	}
+	if !addr.IsForNet(net) {
+		return nil, ErrNet
+	}
+	return addr.ScriptAddress(), nil
+}
+
+// SignMessage signs message with the key of address, a standard or staking
+// address of a 1-1 wallet, proving control of the address. The base64 encoded
+// signature is compact so that VerifyMessage needs only the address.
+func (w *WalletManager) SignMessage(address, message string, password []byte) (string, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	scriptHash, err := messageScriptHash(address, w.chainParams)
+	if err != nil {
+		return "", err
+	}
+	defer w.ksmgr.ClearPrivKey()
+	sig, err := w.ksmgr.SignCompact(scriptHash, MessageHash(message), password)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// VerifyMessage reports whether signature, as returned by SignMessage, is
+// signed on message by the key of address.
+func VerifyMessage(address, signature, message string, net *config.Params) (bool, error) {
+	scriptHash, err := messageScriptHash(address, net)
+	if err != nil {
+		return false, err
+	}
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(sig) != compactSigSize {
+		return false, ErrInvalidSignature
+	}
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), sig, MessageHash(message))
+	if err != nil {
+		// the signature is well formed but made by another key or on
+		// another message
+		return false, nil
+	}
+	_, witAddress, err := keystore.NewNonPersistentWitSAddrForBtcec([]*btcec.PublicKey{pubKey}, 1,
+		massutil.AddressClassWitnessV0, net)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(witAddress.ScriptAddress(), scriptHash), nil
+}
@@ -0,0 +1,102 @@
+package masswallet
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/stretchr/testify/assert"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/keystore"
+)
+
+func TestWalletManager_SignMessage_VerifyMessage(t *testing.T) {
+	w, err := iniWallet("message")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.close()
+	if _, err = w.mgr.UseWallet(w.walletName); err != nil {
+		t.Fatal(err)
+	}
+	address, err := w.mgr.NewAddress("", massutil.AddressClassWitnessV0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := w.mgr.NewAddress("", massutil.AddressClassWitnessV0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stakingAddress, err := w.mgr.NewAddress("", massutil.AddressClassWitnessStaking)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := "pool operated by alice"
+
+	for _, addr := range []string{address, stakingAddress} {
+		sig, err := w.mgr.SignMessage(addr, message, []byte(walletpass))
+		if err != nil {
+			t.Fatal(addr, err)
+		}
+		valid, err := VerifyMessage(addr, sig, message, config.ChainParams)
+		assert.Nil(t, err, addr)
+		assert.True(t, valid, addr)
+
+		valid, err = VerifyMessage(addr, sig, message+".", config.ChainParams)
+		assert.Nil(t, err, addr)
+		assert.False(t, valid, addr)
+		valid, err = VerifyMessage(other, sig, message, config.ChainParams)
+		assert.Nil(t, err, addr)
+		assert.False(t, valid, addr)
+	}
+
+	// the hash of a message is domain separated
+	assert.NotEqual(t, MessageHash(""), MessageHash(messageMagic))
+	assert.Equal(t, 32, len(MessageHash(message)))
+
+	sig, err := w.mgr.SignMessage(address, message, []byte(walletpass))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the passphrase unlocks the keys for this call only
+	_, err = w.mgr.SignMessage(address, message, nil)
+	assert.Equal(t, keystore.ErrLocked, err)
+	raw, _ := base64.StdEncoding.DecodeString(sig)
+	_, err = VerifyMessage(address, base64.StdEncoding.EncodeToString(raw[1:]), message, config.ChainParams)
+	assert.Equal(t, ErrInvalidSignature, err)
+	_, err = VerifyMessage(address, "not base64!", message, config.ChainParams)
+	assert.Equal(t, ErrInvalidSignature, err)
+	_, err = VerifyMessage("ms1invalid", sig, message, config.ChainParams)
+	assert.Equal(t, ErrFailedDecodeAddress, err)
+
+	// signing requires the passphrase unless the wallet is unlocked
+	_, err = w.mgr.SignMessage(address, message, []byte("wrongpass"))
+	assert.Equal(t, keystore.ErrInvalidPassphrase, err)
+	_, err = w.mgr.SignMessage(address, message, nil)
+	assert.Equal(t, keystore.ErrLocked, err)
+	if _, err = w.mgr.UnlockWallet(w.walletName, walletpass, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	unlockedSig, err := w.mgr.SignMessage(address, message, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, sig, unlockedSig)
+	assert.Nil(t, w.mgr.LockWallet(w.walletName))
+
+	// addresses not in the wallet
+	otherWallet, err := iniWallet("message2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer otherWallet.close()
+	if _, err = otherWallet.mgr.UseWallet(otherWallet.walletName); err != nil {
+		t.Fatal(err)
+	}
+	foreign, err := otherWallet.mgr.NewAddress("", massutil.AddressClassWitnessV0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.mgr.SignMessage(foreign, message, []byte(walletpass))
+	assert.Equal(t, keystore.ErrAddressNotFound, err)
+}
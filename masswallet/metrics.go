@@ -0,0 +1,82 @@
+package masswallet
+
+import (
+	"github.com/massnetorg/mass-core/logging"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/metrics"
+)
+
+// mempoolCounter is implemented by the mempools able to report their size.
+type mempoolCounter interface {
+	Count() int
+}
+
+// RegisterMetrics registers the gauges of sync progress, notification queues,
+// mempool and wallet balances to r, they are read on each scrape.
+func (w *WalletManager) RegisterMetrics(r *metrics.Registry) {
+	r.Register(metrics.NewGaugeFunc("masswallet_synced_height",
+		"Height of the block the wallet has synced to.", func() float64 {
+			height, _ := w.SyncedTo()
+			return float64(height)
+		}))
+	r.Register(metrics.NewGaugeFunc("masswallet_chain_height",
+		"Best height of the chain followed by the wallet.", func() float64 {
+			return float64(w.ChainIndexerSyncedHeight())
+		}))
+	r.Register(metrics.NewGaugeFunc("masswallet_sync_lag_blocks",
+		"Number of blocks the wallet falls behind the chain.", func() float64 {
+			synced, err := w.SyncedTo()
+			best := w.ChainIndexerSyncedHeight()
+			if err != nil || best < synced {
+				return 0
+			}
+			return float64(best - synced)
+		}))
+	r.Register(metrics.NewGaugeVecFunc("masswallet_ntfns_queue_length",
+		"Number of notifications waiting to be handled.", []string{"queue"},
+		func(emit func(float64, ...string)) {
+			emit(float64(len(w.ntfnsHandler.queueMsgTx)), "tx")
+			emit(float64(len(w.ntfnsHandler.queueBlock)), "block")
+		}))
+	r.Register(metrics.NewGaugeFunc("masswallet_task_queue_length",
+		"Number of wallet import, remove and rescan tasks waiting.", func() float64 {
+			if taskChan := w.ntfnsHandler.taskChan; taskChan != nil {
+				return float64(len(taskChan.C))
+			}
+			return 0
+		}))
+	r.Register(metrics.NewGaugeFunc("masswallet_mempool_txs",
+		"Number of transactions in the mempool of the chain.", func() float64 {
+			if pool, ok := w.server.TxMemPool().(mempoolCounter); ok {
+				return float64(pool.Count())
+			}
+			return 0
+		}))
+	r.Register(metrics.NewGaugeVecFunc("masswallet_wallet_balance",
+		"Gross balance of each wallet in Maxwell.", []string{"wallet_id"},
+		w.collectBalances))
+}
+
+func (w *WalletManager) collectBalances(emit func(float64, ...string)) {
+	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+		wss, err := w.syncStore.GetAllWalletStatus(tx)
+		if err != nil {
+			return err
+		}
+		for _, ws := range wss {
+			if ws.IsRemoved() {
+				continue
+			}
+			gross, err := w.utxoStore.GrossBalance(tx, ws.WalletID)
+			if err != nil {
+				return err
+			}
+			emit(float64(gross.UintValue()), ws.WalletID)
+		}
+		return nil
+	})
+	if err != nil {
+		logging.CPrint(logging.WARN, "failed to collect wallet balances", logging.LogFormat{"err": err})
+	}
+}
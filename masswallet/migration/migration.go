@@ -0,0 +1,323 @@
+// Package migration upgrades the schema of the wallet db.
+//
+// The schema version is recorded in the db, each Step of a Registry bumps it
+// by one. Steps are applied in order, each in its own transaction together
+// with the new version, so an interrupted migration resumes from the last
+// committed step. A Step must be idempotent: it may be run again on a db it
+// has partially or fully been applied to.
+package migration
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/massnetorg/mass-core/logging"
+
+	"massnet.org/mass-wallet/config"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/ifc"
+)
+
+const (
+	// metaBucket is the top level bucket holding the schema version
+	metaBucket = "m"
+	versionKey = "version"
+)
+
+var (
+	ErrVersionTooNew     = errors.New("wallet db schema version is newer than supported")
+	ErrInvalidVersion    = errors.New("invalid wallet db schema version")
+	ErrStepOutOfOrder    = errors.New("migration steps must be numbered consecutively from 1")
+	ErrBackupUnsupported = errors.New("backup requires db type and directory")
+	ErrChainRequired     = errors.New("migration step reads the chain, start the wallet to apply it")
+
+	// errDryRun rolls back the transaction of a dry run
+	errDryRun = errors.New("dry run")
+)
+
+// Step migrates the wallet db from Version-1 to Version.
+type Step struct {
+	Version     uint32
+	Description string
+	// Migrate rewrites the db, nil if the version only marks a new format
+	// without touching existing data.
+	Migrate func(tx mwdb.DBTransaction, env *Env) error
+}
+
+// Env is what steps may read besides the db.
+type Env struct {
+	ChainParams *config.Params
+	// ChainFetcher reads the chain the wallet syncs with, nil when the db is
+	// migrated offline. Steps that need it fail with ErrChainRequired.
+	ChainFetcher ifc.ChainFetcher
+}
+
+func (env *Env) chainFetcher() (ifc.ChainFetcher, error) {
+	if env == nil || env.ChainFetcher == nil {
+		return nil, ErrChainRequired
+	}
+	return env.ChainFetcher, nil
+}
+
+// Registry is an ordered list of steps.
+type Registry struct {
+	steps []*Step
+}
+
+// NewRegistry returns a registry of steps, versions must be 1, 2, 3...
+func NewRegistry(steps ...*Step) (*Registry, error) {
+	for i, step := range steps {
+		if step.Version != uint32(i+1) {
+			return nil, ErrStepOutOfOrder
+		}
+	}
+	return &Registry{steps: steps}, nil
+}
+
+// LatestVersion returns the version of the db after all steps are applied.
+func (r *Registry) LatestVersion() uint32 {
+	return uint32(len(r.steps))
+}
+
+// Pending returns the steps to upgrade a db of version from.
+func (r *Registry) Pending(from uint32) []*Step {
+	if from >= r.LatestVersion() {
+		return nil
+	}
+	return r.steps[from:]
+}
+
+// Status describes the schema of a db.
+type Status struct {
+	// Version is 0 for dbs created before versioning
+	Version uint32
+	// Fresh is true if the db is empty, it is stamped with the latest
+	// version instead of being migrated.
+	Fresh   bool
+	Latest  uint32
+	Pending []*Step
+}
+
+// Status reads the schema version of db.
+func (r *Registry) Status(db mwdb.DB) (*Status, error) {
+	var (
+		version uint32
+		fresh   bool
+	)
+	err := mwdb.View(db, func(tx mwdb.ReadTransaction) (err error) {
+		version, fresh, err = readVersion(tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if version > r.LatestVersion() {
+		logging.CPrint(logging.ERROR, "unsupported wallet db schema version", logging.LogFormat{
+			"version": version,
+			"latest":  r.LatestVersion(),
+		})
+		return nil, ErrVersionTooNew
+	}
+	s := &Status{
+		Version: version,
+		Fresh:   fresh,
+		Latest:  r.LatestVersion(),
+	}
+	if !fresh {
+		s.Pending = r.Pending(version)
+	}
+	return s, nil
+}
+
+// Options controls Migrate.
+type Options struct {
+	// DryRun applies the pending steps in a single transaction and rolls it
+	// back, the db and its version are left untouched.
+	DryRun bool
+
+	// BackupDir is the directory a copy of the db is made in before the
+	// first step is applied, empty to skip the backup.
+	BackupDir string
+	// BackupDBType is the driver of the backup db.
+	BackupDBType string
+	// BackupSource is the db copied to the backup, default the migrated db.
+	// It is the underlying db of an encrypted db, so that the backup remains
+	// encrypted.
+	BackupSource mwdb.DB
+
+	// Env is passed to the steps.
+	Env *Env
+}
+
+// Result reports what Migrate did.
+type Result struct {
+	From    uint32
+	To      uint32
+	Applied []*Step
+	// Backup is the path of the backup db, empty if none was made.
+	Backup string
+}
+
+// Migrate upgrades db to the latest version.
+func (r *Registry) Migrate(db mwdb.DB, opts *Options) (*Result, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	status, err := r.Status(db)
+	if err != nil {
+		return nil, err
+	}
+	res := &Result{From: status.Version, To: status.Version}
+
+	if status.Fresh {
+		res.To = status.Latest
+		if opts.DryRun {
+			return res, nil
+		}
+		err = mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+			return writeVersion(tx, status.Latest)
+		})
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to write wallet db schema version", logging.LogFormat{"err": err})
+			return nil, err
+		}
+		return res, nil
+	}
+	if len(status.Pending) == 0 {
+		return res, nil
+	}
+
+	if opts.DryRun {
+		err = mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+			for _, step := range status.Pending {
+				if err := applyStep(tx, step, opts.Env); err != nil {
+					return err
+				}
+				res.Applied = append(res.Applied, step)
+				res.To = step.Version
+			}
+			return errDryRun
+		})
+		if err != errDryRun {
+			return res, err
+		}
+		return res, nil
+	}
+
+	if opts.BackupDir != "" {
+		res.Backup, err = backup(db, status.Version, opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, step := range status.Pending {
+		logging.CPrint(logging.INFO, "migrating wallet db", logging.LogFormat{
+			"version":     step.Version,
+			"description": step.Description,
+		})
+		err = mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+			return applyStep(tx, step, opts.Env)
+		})
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to migrate wallet db", logging.LogFormat{
+				"version": step.Version,
+				"backup":  res.Backup,
+				"err":     err,
+			})
+			return res, err
+		}
+		res.Applied = append(res.Applied, step)
+		res.To = step.Version
+	}
+	logging.CPrint(logging.INFO, "wallet db migrated", logging.LogFormat{
+		"from":   res.From,
+		"to":     res.To,
+		"backup": res.Backup,
+	})
+	return res, nil
+}
+
+func applyStep(tx mwdb.DBTransaction, step *Step, env *Env) error {
+	if step.Migrate != nil {
+		if err := step.Migrate(tx, env); err != nil {
+			return err
+		}
+	}
+	return writeVersion(tx, step.Version)
+}
+
+// backup copies db to a new db named after its version in opts.BackupDir.
+func backup(db mwdb.DB, version uint32, opts *Options) (string, error) {
+	if opts.BackupDBType == "" {
+		return "", ErrBackupUnsupported
+	}
+	path := filepath.Join(opts.BackupDir,
+		fmt.Sprintf("wallet.db.v%d-%s.bak", version, time.Now().Format("20060102150405")))
+	bak, err := mwdb.CreateDB(opts.BackupDBType, path)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to create wallet db backup", logging.LogFormat{
+			"path": path,
+			"err":  err,
+		})
+		return "", err
+	}
+	defer bak.Close()
+	src := db
+	if opts.BackupSource != nil {
+		src = opts.BackupSource
+	}
+	if err = mwdb.CopyDB(bak, src); err != nil {
+		logging.CPrint(logging.ERROR, "failed to back up wallet db", logging.LogFormat{
+			"path": path,
+			"err":  err,
+		})
+		return "", err
+	}
+	logging.CPrint(logging.INFO, "wallet db backed up", logging.LogFormat{
+		"path":    path,
+		"version": version,
+	})
+	return path, nil
+}
+
+// ReadVersion returns the schema version of the db, 0 if it is not recorded.
+func ReadVersion(tx mwdb.ReadTransaction) (uint32, error) {
+	version, _, err := readVersion(tx)
+	return version, err
+}
+
+// readVersion returns the schema version of the db, fresh is true if the db
+// has no bucket at all.
+func readVersion(tx mwdb.ReadTransaction) (version uint32, fresh bool, err error) {
+	if meta := tx.TopLevelBucket(metaBucket); meta != nil {
+		v, err := meta.Get([]byte(versionKey))
+		if err != nil {
+			return 0, false, err
+		}
+		if v != nil {
+			if len(v) != 4 {
+				return 0, false, ErrInvalidVersion
+			}
+			return binary.BigEndian.Uint32(v), false, nil
+		}
+	}
+	names, err := tx.BucketNames()
+	if err != nil {
+		return 0, false, err
+	}
+	return 0, len(names) == 0, nil
+}
+
+func writeVersion(tx mwdb.DBTransaction, version uint32) error {
+	meta, err := mwdb.GetOrCreateTopLevelBucket(tx, metaBucket)
+	if err != nil {
+		return err
+	}
+	var v [4]byte
+	binary.BigEndian.PutUint32(v[:], version)
+	return meta.Put([]byte(versionKey), v[:])
+}
@@ -0,0 +1,283 @@
+package migration
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/db/memdb"
+)
+
+const dbType = "memdb"
+
+// Fixtures are an "items" bucket under top level bucket "x", values are
+// decimal strings in version 0, 8 bytes big endian since version 1, and
+// version 2 adds an "index" bucket mapping values to keys.
+var (
+	stepEncodeItems = &Step{
+		Version:     1,
+		Description: "encode items in binary",
+		Migrate: func(tx mwdb.DBTransaction, env *Env) error {
+			items := tx.TopLevelBucket("x").Bucket("items")
+			entries, err := items.GetByPrefix(nil)
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				if len(e.Value) == 8 {
+					continue // already migrated
+				}
+				n, err := strconv.ParseUint(string(e.Value), 10, 64)
+				if err != nil {
+					return err
+				}
+				v := make([]byte, 8)
+				binary.BigEndian.PutUint64(v, n)
+				if err = items.Put(e.Key, v); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	stepIndexItems = &Step{
+		Version:     2,
+		Description: "index items by value",
+		Migrate: func(tx mwdb.DBTransaction, env *Env) error {
+			x := tx.TopLevelBucket("x")
+			index, err := mwdb.GetOrCreateBucket(x, "index")
+			if err != nil {
+				return err
+			}
+			entries, err := x.Bucket("items").GetByPrefix(nil)
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				if err = index.Put(e.Value, e.Key); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+)
+
+func newFixture(t *testing.T, path string, version uint32) mwdb.DB {
+	memdb.RemoveDB(path)
+	db, err := mwdb.CreateDB(dbType, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		x, err := tx.CreateTopLevelBucket("x")
+		if err != nil {
+			return err
+		}
+		items, err := x.NewBucket("items")
+		if err != nil {
+			return err
+		}
+		for k, v := range map[string]string{"a": "1", "b": "256"} {
+			if err = items.Put([]byte(k), []byte(v)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version > 0 {
+		r, _ := NewRegistry(stepEncodeItems, stepIndexItems)
+		r.steps = r.steps[:version]
+		if _, err = r.Migrate(db, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return db
+}
+
+func dump(t *testing.T, db mwdb.DB) (version uint32, items, index map[string][]byte) {
+	items, index = make(map[string][]byte), make(map[string][]byte)
+	err := mwdb.View(db, func(tx mwdb.ReadTransaction) error {
+		var err error
+		if version, _, err = readVersion(tx); err != nil {
+			return err
+		}
+		x := tx.TopLevelBucket("x")
+		entries, err := x.Bucket("items").GetByPrefix(nil)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			items[string(e.Key)] = e.Value
+		}
+		if b := x.Bucket("index"); b != nil {
+			if entries, err = b.GetByPrefix(nil); err != nil {
+				return err
+			}
+			for _, e := range entries {
+				index[string(e.Key)] = e.Value
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
+func be(n uint64) []byte {
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, n)
+	return v
+}
+
+func TestRegistry_Migrate(t *testing.T) {
+	r, err := NewRegistry(stepEncodeItems, stepIndexItems)
+	assert.Nil(t, err)
+	wantItems := map[string][]byte{"a": be(1), "b": be(256)}
+	wantIndex := map[string][]byte{string(be(1)): []byte("a"), string(be(256)): []byte("b")}
+
+	for from := uint32(0); from <= 2; from++ {
+		path := "Tst_Migrate_v" + strconv.Itoa(int(from))
+		db := newFixture(t, path, from)
+
+		status, err := r.Status(db)
+		assert.Nil(t, err)
+		assert.Equal(t, &Status{Version: from, Latest: 2, Pending: r.Pending(from)}, status)
+
+		res, err := r.Migrate(db, &Options{BackupDir: "bak", BackupDBType: dbType})
+		assert.Nil(t, err)
+		assert.Equal(t, from, res.From)
+		assert.Equal(t, uint32(2), res.To)
+		assert.Equal(t, r.Pending(from), res.Applied)
+		version, items, index := dump(t, db)
+		assert.Equal(t, uint32(2), version)
+		assert.Equal(t, wantItems, items)
+		assert.Equal(t, wantIndex, index)
+
+		// the backup holds the db as it was before migration
+		if from == 2 {
+			assert.Equal(t, "", res.Backup)
+		} else {
+			bak, err := mwdb.OpenDB(dbType, res.Backup)
+			assert.Nil(t, err)
+			version, items, _ := dump(t, bak)
+			assert.Equal(t, from, version)
+			assert.Equal(t, len(wantItems), len(items))
+			bak.Close()
+			memdb.RemoveDB(res.Backup)
+		}
+
+		// rerunning is a no-op
+		res, err = r.Migrate(db, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(res.Applied))
+		db.Close()
+		memdb.RemoveDB(path)
+	}
+}
+
+func TestRegistry_Migrate_Idempotent(t *testing.T) {
+	const path = "Tst_Idempotent"
+	defer memdb.RemoveDB(path)
+	db := newFixture(t, path, 0)
+	defer db.Close()
+
+	// steps rerun after being committed without the version, as if the
+	// process had crashed
+	for i := 0; i < 2; i++ {
+		for _, step := range []*Step{stepEncodeItems, stepIndexItems} {
+			assert.Nil(t, mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+				return step.Migrate(tx, nil)
+			}))
+		}
+	}
+	r, _ := NewRegistry(stepEncodeItems, stepIndexItems)
+	res, err := r.Migrate(db, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(res.Applied))
+	_, items, index := dump(t, db)
+	assert.Equal(t, map[string][]byte{"a": be(1), "b": be(256)}, items)
+	assert.Equal(t, 2, len(index))
+}
+
+func TestRegistry_Migrate_DryRun(t *testing.T) {
+	const path = "Tst_DryRun"
+	defer memdb.RemoveDB(path)
+	db := newFixture(t, path, 0)
+	defer db.Close()
+
+	r, _ := NewRegistry(stepEncodeItems, stepIndexItems)
+	res, err := r.Migrate(db, &Options{DryRun: true, BackupDir: "bak", BackupDBType: dbType})
+	assert.Nil(t, err)
+	assert.Equal(t, &Result{From: 0, To: 2, Applied: r.Pending(0)}, res)
+	version, items, index := dump(t, db)
+	assert.Equal(t, uint32(0), version)
+	assert.Equal(t, map[string][]byte{"a": []byte("1"), "b": []byte("256")}, items)
+	assert.Equal(t, 0, len(index))
+
+	// failures are reported without being committed
+	errBroken := errors.New("broken")
+	broken, _ := NewRegistry(stepEncodeItems, &Step{
+		Version: 2,
+		Migrate: func(tx mwdb.DBTransaction, env *Env) error { return errBroken },
+	})
+	res, err = broken.Migrate(db, &Options{DryRun: true})
+	assert.Equal(t, errBroken, err)
+	assert.Equal(t, []*Step{stepEncodeItems}, res.Applied)
+
+	// a failed step leaves the db at the version of the last step applied
+	res, err = broken.Migrate(db, nil)
+	assert.Equal(t, errBroken, err)
+	assert.Equal(t, uint32(1), res.To)
+	version, items, _ = dump(t, db)
+	assert.Equal(t, uint32(1), version)
+	assert.Equal(t, be(256), items["b"])
+}
+
+func TestRegistry_Migrate_Fresh(t *testing.T) {
+	const path = "Tst_Fresh"
+	defer memdb.RemoveDB(path)
+	memdb.RemoveDB(path)
+	db, err := mwdb.CreateDB(dbType, path)
+	assert.Nil(t, err)
+	defer db.Close()
+
+	// empty dbs are stamped with the latest version
+	r, _ := NewRegistry(stepEncodeItems, stepIndexItems)
+	status, err := r.Status(db)
+	assert.Nil(t, err)
+	assert.True(t, status.Fresh)
+	assert.Equal(t, 0, len(status.Pending))
+	res, err := r.Migrate(db, &Options{BackupDir: "bak", BackupDBType: dbType})
+	assert.Nil(t, err)
+	assert.Equal(t, &Result{From: 0, To: 2}, res)
+	status, err = r.Status(db)
+	assert.Nil(t, err)
+	assert.Equal(t, &Status{Version: 2, Latest: 2}, status)
+
+	// dbs written by a newer release are refused
+	old, _ := NewRegistry(stepEncodeItems)
+	_, err = old.Migrate(db, nil)
+	assert.Equal(t, ErrVersionTooNew, err)
+
+	assert.Nil(t, DefaultRegistry.Pending(DefaultRegistry.LatestVersion()))
+}
+
+func TestNewRegistry(t *testing.T) {
+	_, err := NewRegistry(stepIndexItems)
+	assert.Equal(t, ErrStepOutOfOrder, err)
+	_, err = NewRegistry(stepEncodeItems, stepEncodeItems)
+	assert.Equal(t, ErrStepOutOfOrder, err)
+	r, err := NewRegistry()
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), r.LatestVersion())
+}
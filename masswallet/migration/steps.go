@@ -0,0 +1,54 @@
+package migration
+
+import (
+	"github.com/massnetorg/mass-core/logging"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+// DefaultRegistry holds the migrations of the wallet db, new steps are
+// appended with the next version.
+var DefaultRegistry = mustNewRegistry(
+	// dbs created before versioning are of version 0
+	&Step{
+		Version:     1,
+		Description: "record schema version",
+	},
+	&Step{
+		Version:     2,
+		Description: "index the transaction history mined before the index",
+		Migrate:     backfillTxHistory,
+	},
+)
+
+// top level buckets of the stores, as named by package masswallet
+const (
+	utxoBucket = "u"
+	txBucket   = "t"
+)
+
+func backfillTxHistory(tx mwdb.DBTransaction, env *Env) error {
+	if tx.TopLevelBucket(txBucket) == nil {
+		return nil
+	}
+	fetcher, err := env.chainFetcher()
+	if err != nil {
+		return err
+	}
+	added, err := txmgr.BackfillTxHistory(tx, tx.TopLevelBucket(utxoBucket), tx.TopLevelBucket(txBucket),
+		env.ChainParams, fetcher)
+	if err != nil {
+		return err
+	}
+	logging.CPrint(logging.INFO, "transaction history backfilled", logging.LogFormat{"entries": added})
+	return nil
+}
+
+func mustNewRegistry(steps ...*Step) *Registry {
+	r, err := NewRegistry(steps...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
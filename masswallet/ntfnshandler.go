@@ -5,6 +5,7 @@ import (
 	"container/list"
 	"fmt"
 	"runtime/debug"
+	"sort"
 	"sync"
 	"time"
 
@@ -147,15 +148,23 @@ func (h *NtfnsHandler) Start() error {
 		}
 	}
 
-	h.quitWg.Add(2)
+	h.quitWg.Add(3)
 	go handle(h)
 	go worker(h)
+	go rebroadcaster(h)
+	if b := h.walletMgr.config.Wallet.Backup; b != nil && b.Interval > 0 {
+		h.quitWg.Add(1)
+		go autoBackup(h)
+	}
 	return nil
 }
 
 func (h *NtfnsHandler) Stop() {
 	close(h.quit)
 	h.quitWg.Wait()
+	if err := h.walletMgr.saveFeeStats(); err != nil {
+		logging.CPrint(logging.WARN, "failed to save fee stats", logging.LogFormat{"err": err})
+	}
 	h.walletMgr.CloseDB()
 }
 
@@ -196,9 +205,14 @@ func handle(h *NtfnsHandler) {
 	}
 }
 
-func (h *NtfnsHandler) onRelevantTx(rec *txmgr.TxRecord) error {
+func (h *NtfnsHandler) onRelevantTx(rec *txmgr.TxRecord, batch *eventBatch) error {
 	err := mwdb.Update(h.walletMgr.db, func(tx mwdb.DBTransaction) error {
-		return h.walletMgr.txStore.AddRelevantTx(tx, nil, rec, nil)
+		if err := h.walletMgr.txStore.AddRelevantTx(tx, nil, rec, nil); err != nil {
+			return err
+		}
+		// unmined txs leave the mined balance as is, no balance_changed
+		batch.addTxEvents(EventTxReceived, rec, 0, "")
+		return nil
 	})
 	if err != nil {
 		logging.VPrint(logging.ERROR, "Cannot add relevant transaction",
@@ -211,7 +225,7 @@ func (h *NtfnsHandler) onRelevantTx(rec *txmgr.TxRecord) error {
 }
 
 func (h *NtfnsHandler) onRelevantBlockConnected(tx mwdb.DBTransaction, readyWallets map[string]struct{},
-	blockMeta *txmgr.BlockMeta, relevantTxs []*txmgr.TxRecord) error {
+	blockMeta *txmgr.BlockMeta, relevantTxs []*txmgr.TxRecord, batch *eventBatch) error {
 
 	if len(relevantTxs) == 0 {
 		return nil
@@ -237,7 +251,17 @@ func (h *NtfnsHandler) onRelevantBlockConnected(tx mwdb.DBTransaction, readyWall
 		}
 	}
 
+	blockHash := blockMeta.Hash.String()
 	for _, rec := range relevantTxs {
+		conflicts, err := h.walletMgr.txStore.UnminedDoubleSpends(tx, rec)
+		if err != nil {
+			logging.VPrint(logging.ERROR, "UnminedDoubleSpends error",
+				logging.LogFormat{
+					"tx":  rec.Hash.String(),
+					"err": err,
+				})
+			return err
+		}
 		if err := h.walletMgr.txStore.AddRelevantTx(tx, walletBalances, rec, blockMeta); err != nil {
 			logging.VPrint(logging.ERROR, "Cannot add relevant block",
 				logging.LogFormat{
@@ -246,13 +270,25 @@ func (h *NtfnsHandler) onRelevantBlockConnected(tx mwdb.DBTransaction, readyWall
 				})
 			return err
 		}
+		for hash, walletId := range conflicts {
+			batch.add(&WalletEvent{
+				Type:      EventTxConflicted,
+				WalletId:  walletId,
+				TxId:      hash.String(),
+				BlockHash: blockHash,
+				Height:    blockMeta.Height,
+			})
+		}
+		batch.addTxEvents(EventTxConfirmed, rec, blockMeta.Height, blockHash)
 	}
 
 	err = h.walletMgr.utxoStore.UpdateMinedBalances(tx, walletBalances)
 	if err != nil {
 		logging.VPrint(logging.ERROR, "UpdateMinedBalances error", logging.LogFormat{"err": err})
+		return err
 	}
-	return err
+	batch.addBalanceEvents(all, walletBalances, blockMeta.Height, blockHash)
+	return nil
 }
 
 func (h *NtfnsHandler) filterTxForImporting(tx *wire.MsgTx, blockMeta *txmgr.BlockMeta,
@@ -527,19 +563,23 @@ func (h *NtfnsHandler) filterTx(tx *wire.MsgTx, blockMeta *txmgr.BlockMeta,
 	}
 
 	if blockMeta == nil {
-		if err := h.onRelevantTx(rec); err != nil {
+		batch := &eventBatch{}
+		if err := h.onRelevantTx(rec, batch); err != nil {
 			return false, nil, err
 		}
 		h.memMtx.Lock()
 		h.mempool[rec.Hash] = struct{}{}
+		bestHeight := h.bestBlock.Height
 		h.memMtx.Unlock()
+
+		h.walletMgr.events.publish(bestHeight, batch.events)
 	}
 
 	return true, rec, nil
 }
 
 func (h *NtfnsHandler) filterBlock(dbtx mwdb.DBTransaction, readyWallets map[string]struct{},
-	block *wire.MsgBlock, addedExpireMempool map[uint64]map[wire.Hash]struct{}) (err error) {
+	block *wire.MsgBlock, addedExpireMempool map[uint64]map[wire.Hash]struct{}, batch *eventBatch) (err error) {
 
 	blockMeta := &txmgr.BlockMeta{
 		Hash:      block.BlockHash(),
@@ -590,7 +630,7 @@ func (h *NtfnsHandler) filterBlock(dbtx mwdb.DBTransaction, readyWallets map[str
 
 	addedExpireMempool[block.Header.Height] = confirmedTxs
 
-	err = h.onRelevantBlockConnected(dbtx, readyWallets, blockMeta, relevantTxs)
+	err = h.onRelevantBlockConnected(dbtx, readyWallets, blockMeta, relevantTxs, batch)
 	if err != nil {
 		logging.VPrint(logging.ERROR, "onRelevantBlockConnected error",
 			logging.LogFormat{
@@ -603,7 +643,7 @@ func (h *NtfnsHandler) filterBlock(dbtx mwdb.DBTransaction, readyWallets map[str
 	return h.walletMgr.syncStore.SetSyncedTo(dbtx, blockMeta)
 }
 
-func (h *NtfnsHandler) disconnectBlock(tx mwdb.DBTransaction, height uint64) error {
+func (h *NtfnsHandler) disconnectBlock(tx mwdb.DBTransaction, height uint64, batch *eventBatch) error {
 	if height == 0 {
 		return fmt.Errorf("genesis block cannot be disconnected")
 	}
@@ -615,10 +655,19 @@ func (h *NtfnsHandler) disconnectBlock(tx mwdb.DBTransaction, height uint64) err
 		return nil
 	}
 
+	prevBalances, err := h.walletMgr.utxoStore.FetchAllMinedBalance(tx)
+	if err != nil {
+		return err
+	}
 	err = h.walletMgr.txStore.Rollback(tx, height)
 	if err != nil {
 		return err
 	}
+	balances, err := h.walletMgr.utxoStore.FetchAllMinedBalance(tx)
+	if err != nil {
+		return err
+	}
+	batch.addBalanceEvents(prevBalances, balances, height, "")
 
 	resetHeight := height - 1
 	err = h.walletMgr.syncStore.ResetSyncedTo(tx, resetHeight)
@@ -648,7 +697,7 @@ func (h *NtfnsHandler) disconnectBlock(tx mwdb.DBTransaction, height uint64) err
 }
 
 func (h *NtfnsHandler) reorg(dbtx mwdb.DBTransaction, currentBest txmgr.BlockMeta, newBest *wire.MsgBlock,
-	rollbackBlock map[uint64]struct{}, addedExpireMempool map[uint64]map[wire.Hash]struct{}) error {
+	rollbackBlock map[uint64]struct{}, addedExpireMempool map[uint64]map[wire.Hash]struct{}, batch *eventBatch) error {
 
 	blocksToConnect := list.New()
 
@@ -670,7 +719,7 @@ func (h *NtfnsHandler) reorg(dbtx mwdb.DBTransaction, currentBest txmgr.BlockMet
 	if currentBest.Hash != newBest.BlockHash() {
 
 		for currentBest.Height > newBest.Header.Height {
-			err := h.disconnectBlock(dbtx, currentBest.Height)
+			err := h.disconnectBlock(dbtx, currentBest.Height, batch)
 			if err != nil {
 				return err
 			}
@@ -700,7 +749,7 @@ func (h *NtfnsHandler) reorg(dbtx mwdb.DBTransaction, currentBest txmgr.BlockMet
 
 			newTailBlock := newBest
 			for newTailBlock.Header.Previous != currentPrev.Hash {
-				if err = h.disconnectBlock(dbtx, currentPrev.Height+1); err != nil {
+				if err = h.disconnectBlock(dbtx, currentPrev.Height+1, batch); err != nil {
 					return err
 				}
 				rollbackBlock[currentPrev.Height+1] = struct{}{}
@@ -723,7 +772,7 @@ func (h *NtfnsHandler) reorg(dbtx mwdb.DBTransaction, currentBest txmgr.BlockMet
 					return ErrMaybeChainRevoked
 				}
 			}
-			if err = h.disconnectBlock(dbtx, currentPrev.Height+1); err != nil {
+			if err = h.disconnectBlock(dbtx, currentPrev.Height+1, batch); err != nil {
 				return err
 			}
 
@@ -739,7 +788,7 @@ func (h *NtfnsHandler) reorg(dbtx mwdb.DBTransaction, currentBest txmgr.BlockMet
 	for blocksToConnect.Front() != nil {
 		block := blocksToConnect.Front().Value.(*wire.MsgBlock)
 
-		if err := h.filterBlock(dbtx, readyWallets, block, addedExpireMempool); err != nil {
+		if err := h.filterBlock(dbtx, readyWallets, block, addedExpireMempool, batch); err != nil {
 			return err
 		}
 
@@ -775,6 +824,13 @@ func worker(h *NtfnsHandler) {
 				continue
 			}
 
+			// rescan
+			if ws.IsRescanning() {
+				h.taskChan.PushRescan(ws.WalletID)
+				logging.CPrint(logging.INFO, "restart rescanning", logging.LogFormat{"walletId": ws.WalletID})
+				continue
+			}
+
 			// import
 			if !ws.Ready() {
 				h.taskChan.PushImport(ws.WalletID)
@@ -822,6 +878,21 @@ func worker(h *NtfnsHandler) {
 					continue
 				}
 				logging.CPrint(logging.INFO, "asyncRemove finish", logging.LogFormat{"walletId": task.walletId})
+
+			case WalletTaskRescan:
+				err := h.asyncRescan(task.walletId)
+				if err != nil {
+					logging.CPrint(logging.ERROR, "asyncRescan failed", logging.LogFormat{
+						"walletId": task.walletId,
+						"err":      err,
+					})
+					if err != ErrTaskAbort {
+						h.taskChan.PushRescan(task.walletId)
+					}
+					continue
+				}
+				logging.CPrint(logging.INFO, "asyncRescan cleared, start importing", logging.LogFormat{"walletId": task.walletId})
+				h.taskChan.PushImport(task.walletId)
 			}
 		}
 	}
@@ -1008,6 +1079,26 @@ func (h *NtfnsHandler) asyncRemove(walletId string) error {
 			logging.CPrint(logging.ERROR, "RemoveGameHistoryByWalletId error", logging.LogFormat{"err": err})
 			return err
 		}
+		err = h.walletMgr.utxoStore.RemoveLockedUnspentByWalletId(wtx, walletId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "RemoveLockedUnspentByWalletId error", logging.LogFormat{"err": err})
+			return err
+		}
+		err = h.walletMgr.utxoStore.RemoveLabelsByWalletId(wtx, walletId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "RemoveLabelsByWalletId error", logging.LogFormat{"err": err})
+			return err
+		}
+		err = h.walletMgr.utxoStore.RemovePayoutsByWalletId(wtx, walletId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "RemovePayoutsByWalletId error", logging.LogFormat{"err": err})
+			return err
+		}
+		err = h.walletMgr.txStore.RemoveTxHistoryByWalletId(wtx, walletId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "RemoveTxHistoryByWalletId error", logging.LogFormat{"err": err})
+			return err
+		}
 
 		return h.walletMgr.utxoStore.RemoveMinedBalance(wtx, walletId)
 	})
@@ -1060,6 +1151,125 @@ func (h *NtfnsHandler) asyncRemove(walletId string) error {
 	}
 }
 
+// asyncRescan clears the balance, utxos, staking/binding and tx histories of
+// the wallet, the keystore, addresses, labels and locked utxos are kept. When
+// the wallet is synced to a height above 0 only records of later blocks are
+// rolled back. The wallet is imported again from its synced height once they
+// are cleared.
+func (h *NtfnsHandler) asyncRescan(walletId string) error {
+	am, err := h.walletMgr.ksmgr.GetAddrManagerByAccountID(walletId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "unexpected error", logging.LogFormat{"err": err, "walletId": walletId})
+		return nil
+	}
+
+	var syncedHeight uint64
+	err = mwdb.View(h.walletMgr.db, func(rtx mwdb.ReadTransaction) error {
+		ws, err := h.walletMgr.syncStore.GetWalletStatus(rtx, walletId)
+		if err != nil {
+			return err
+		}
+		syncedHeight = ws.SyncedHeight
+		return nil
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "GetWalletStatus error", logging.LogFormat{"err": err})
+		return err
+	}
+	if syncedHeight > 0 {
+		return h.asyncRollback(am, syncedHeight+1)
+	}
+
+	h.suspend(true, "[asyncRescan-1] deleting balance, staking/binding histories", logging.LogFormat{"walletId": walletId})
+	err = mwdb.Update(h.walletMgr.db, func(wtx mwdb.DBTransaction) error {
+		err := h.walletMgr.utxoStore.RemoveUnspentByWalletId(wtx, walletId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "RemoveUnspentByWalletId error", logging.LogFormat{"err": err})
+			return err
+		}
+		err = h.walletMgr.utxoStore.RemoveGameHistoryByWalletId(wtx, walletId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "RemoveGameHistoryByWalletId error", logging.LogFormat{"err": err})
+			return err
+		}
+		err = h.walletMgr.txStore.RemoveTxHistoryByWalletId(wtx, walletId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "RemoveTxHistoryByWalletId error", logging.LogFormat{"err": err})
+			return err
+		}
+
+		return h.walletMgr.utxoStore.UpdateMinedBalances(wtx, map[string]massutil.Amount{walletId: massutil.ZeroAmount()})
+	})
+	h.resume(true, "[asyncRescan-1] stop", logging.LogFormat{"walletId": walletId})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "[asyncRescan-1] failed", logging.LogFormat{"err": err})
+		return err
+	}
+
+	for {
+		select {
+		case <-h.quit:
+			return ErrTaskAbort
+		default:
+			h.suspend(true, "[asyncRescan-2] deleting credits", logging.LogFormat{"walletId": walletId})
+			finish := false
+			var removedTx []*wire.Hash
+			err := mwdb.Update(h.walletMgr.db, func(wtx mwdb.DBTransaction) (err error) {
+				removedTx, finish, err = h.walletMgr.txStore.RemoveRelevantTx(wtx, am)
+				if err != nil || !finish {
+					return err
+				}
+				ws, err := h.walletMgr.syncStore.GetWalletStatus(wtx, walletId)
+				if err != nil {
+					logging.CPrint(logging.ERROR, "GetWalletStatus error", logging.LogFormat{"err": err})
+					return err
+				}
+				ws.Flags &^= txmgr.WalletFlagsRescan
+				return h.walletMgr.syncStore.PutWalletStatus(wtx, ws)
+			})
+			h.resume(true, "[asyncRescan-2] stop", logging.LogFormat{"walletId": walletId})
+			if err != nil {
+				logging.CPrint(logging.ERROR, "[asyncRescan-2] failed", logging.LogFormat{"err": err})
+				return err
+			}
+			h.RemoveMempoolTx(removedTx)
+
+			if finish {
+				return nil
+			}
+		}
+	}
+}
+
+// asyncRollback removes the records of the wallet of am from height on, the
+// earlier ones are kept.
+func (h *NtfnsHandler) asyncRollback(am *keystore.AddrManager, height uint64) error {
+	walletId := am.Name()
+	h.suspend(true, "[asyncRescan] rolling back", logging.LogFormat{"walletId": walletId, "height": height})
+	var removedTx []*wire.Hash
+	err := mwdb.Update(h.walletMgr.db, func(wtx mwdb.DBTransaction) (err error) {
+		removedTx, err = h.walletMgr.txStore.RollbackWallet(wtx, am, height)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "RollbackWallet error", logging.LogFormat{"err": err})
+			return err
+		}
+		ws, err := h.walletMgr.syncStore.GetWalletStatus(wtx, walletId)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "GetWalletStatus error", logging.LogFormat{"err": err})
+			return err
+		}
+		ws.Flags &^= txmgr.WalletFlagsRescan
+		return h.walletMgr.syncStore.PutWalletStatus(wtx, ws)
+	})
+	h.resume(true, "[asyncRescan] stop", logging.LogFormat{"walletId": walletId})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "[asyncRescan] failed", logging.LogFormat{"err": err})
+		return err
+	}
+	h.RemoveMempoolTx(removedTx)
+	return nil
+}
+
 func (h *NtfnsHandler) OnImportWallet(walletId string) {
 	h.taskChan.PushImport(walletId)
 }
@@ -1082,6 +1292,27 @@ func (h *NtfnsHandler) OnRemoveWallet(walletId string) error {
 	return nil
 }
 
+// OnRescanWallet rescans the ready wallet from the block next to syncedHeight.
+func (h *NtfnsHandler) OnRescanWallet(walletId string, syncedHeight uint64) error {
+	err := mwdb.Update(h.walletMgr.db, func(wtx mwdb.DBTransaction) error {
+		ws, err := h.walletMgr.syncStore.GetWalletStatus(wtx, walletId)
+		if err != nil {
+			return err
+		}
+		if !ws.Ready() {
+			return ErrWalletUnready
+		}
+		ws.SyncedHeight = syncedHeight
+		ws.Flags |= txmgr.WalletFlagsRescan
+		return h.walletMgr.syncStore.PutWalletStatus(wtx, ws)
+	})
+	if err != nil {
+		return err
+	}
+	h.taskChan.PushRescan(walletId)
+	return nil
+}
+
 func (h *NtfnsHandler) IsWorkerBusy() bool {
 	return h.taskChan.IsBusy()
 }
@@ -1102,20 +1333,21 @@ func (h *NtfnsHandler) processConnectedBlock(newBlock *wire.MsgBlock) error {
 
 	rollbackBlock := make(map[uint64]struct{})
 	addedExpireMempool := make(map[uint64]map[wire.Hash]struct{})
+	batch := &eventBatch{}
 	err := mwdb.Update(h.walletMgr.db, func(tx mwdb.DBTransaction) error {
 		if newBlock.Header.Previous == bestBlock.Hash {
 			readyWallets, err := h.getReadyWallets(tx)
 			if err != nil {
 				return err
 			}
-			if err := h.filterBlock(tx, readyWallets, newBlock, addedExpireMempool); err != nil {
+			if err := h.filterBlock(tx, readyWallets, newBlock, addedExpireMempool, batch); err != nil {
 				logging.CPrint(logging.ERROR, "failed to filter block", logging.LogFormat{"err": err})
 				return err
 			}
 			return nil
 		}
 
-		err := h.reorg(tx, bestBlock, newBlock, rollbackBlock, addedExpireMempool)
+		err := h.reorg(tx, bestBlock, newBlock, rollbackBlock, addedExpireMempool, batch)
 		if err != nil {
 			if err != ErrMaybeChainRevoked {
 				logging.CPrint(logging.ERROR, "failed to reorg",
@@ -1165,16 +1397,45 @@ func (h *NtfnsHandler) processConnectedBlock(newBlock *wire.MsgBlock) error {
 		bestBlock.Timestamp = newBlock.Header.Timestamp
 		h.bestBlock = bestBlock
 		h.memMtx.Unlock()
+
+		h.publishBlockEvents(bestBlock.Height, rollbackBlock, batch)
+		h.observeBlockFees(newBlock)
 	}
 
 	return err
 }
 
+// publishBlockEvents publishes events collected while connecting a block,
+// preceded by rollbacks of disconnected heights and followed by sync progress.
+func (h *NtfnsHandler) publishBlockEvents(height uint64, rollbackBlock map[uint64]struct{}, batch *eventBatch) {
+	events := make([]*WalletEvent, 0, len(rollbackBlock)+len(batch.events)+1)
+
+	rollbacks := make([]uint64, 0, len(rollbackBlock))
+	for rh := range rollbackBlock {
+		rollbacks = append(rollbacks, rh)
+	}
+	sort.Slice(rollbacks, func(i, j int) bool { return rollbacks[i] > rollbacks[j] })
+	for _, rh := range rollbacks {
+		events = append(events, &WalletEvent{Type: EventReorgRollback, Height: rh})
+	}
+
+	events = append(events, batch.events...)
+
+	bestHeight := h.walletMgr.ChainIndexerSyncedHeight()
+	if height%syncProgressInterval == 0 || height >= bestHeight {
+		events = append(events, &WalletEvent{
+			Type:       EventSyncProgress,
+			Height:     height,
+			BestHeight: bestHeight,
+		})
+	}
+	h.walletMgr.events.publish(height, events)
+}
+
 func (h *NtfnsHandler) proccessReceivedTx(tx *wire.MsgTx) error {
 	knownBestHeight := h.walletMgr.ChainIndexerSyncedHeight()
-	bestPeer := h.walletMgr.server.SyncManager().BestPeer()
-	if bestPeer != nil && bestPeer.Height > knownBestHeight {
-		knownBestHeight = bestPeer.Height
+	if peerHeight := h.walletMgr.server.BestPeerHeight(); peerHeight > knownBestHeight {
+		knownBestHeight = peerHeight
 	}
 	if syncHeight, _ := h.walletMgr.SyncedTo(); syncHeight < knownBestHeight-1 {
 		return nil
@@ -1184,6 +1445,8 @@ func (h *NtfnsHandler) proccessReceivedTx(tx *wire.MsgTx) error {
 		logging.LogFormat{
 			"tx": tx.TxHash().String(),
 		})
+	h.observeFeeRate(tx)
+
 	var readyWallets map[string]struct{}
 	err := mwdb.View(h.walletMgr.db, func(rtx mwdb.ReadTransaction) (err error) {
 		readyWallets, err = h.getReadyWallets(rtx)
@@ -1203,6 +1466,42 @@ func (h *NtfnsHandler) proccessReceivedTx(tx *wire.MsgTx) error {
 	return nil
 }
 
+// observeBlockFees feeds a connected block to the fee estimator, stats are
+// saved every feeStatsFlushInterval blocks.
+func (h *NtfnsHandler) observeBlockFees(block *wire.MsgBlock) {
+	hashes := make([]wire.Hash, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		hashes[i] = tx.TxHash()
+	}
+	h.walletMgr.fees.processBlock(block.Header.Height, hashes)
+	if block.Header.Height%feeStatsFlushInterval == 0 {
+		if err := h.walletMgr.saveFeeStats(); err != nil {
+			logging.CPrint(logging.WARN, "failed to save fee stats", logging.LogFormat{
+				"height": block.Header.Height,
+				"err":    err,
+			})
+		}
+	}
+}
+
+// observeFeeRate feeds a mempool tx to the fee estimator, the fee is taken from
+// the mempool, txs are skipped on servers whose mempool doesn't know fees.
+func (h *NtfnsHandler) observeFeeRate(tx *wire.MsgTx) {
+	if blockchain.IsCoinBaseTx(tx) {
+		return
+	}
+	fetcher, ok := h.walletMgr.server.(mempoolFeeFetcher)
+	if !ok {
+		return
+	}
+	txHash := tx.TxHash()
+	fee, ok := fetcher.MempoolTxFee(&txHash)
+	if !ok {
+		return
+	}
+	h.walletMgr.fees.processTx(txHash, fee, tx.PlainSize())
+}
+
 func (h *NtfnsHandler) getBlock(hash *wire.Hash) (*wire.MsgBlock, error) {
 	return h.walletMgr.chainFetcher.FetchBlockBySha(hash)
 }
@@ -0,0 +1,413 @@
+package masswallet
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/massnetorg/mass-core/blockchain"
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/txscript"
+	"github.com/massnetorg/mass-core/wire"
+
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+const (
+	// MaxPayoutKeyLen is the max length in bytes of a payout key.
+	MaxPayoutKeyLen = 64
+	// MaxPayoutsPerBatch is the max number of payouts of a batch.
+	MaxPayoutsPerBatch = 5000
+)
+
+// PayoutRequest is a payment requested of CreatePayoutBatch, Key is chosen by
+// the client to make retried requests idempotent.
+type PayoutRequest struct {
+	Key     string
+	Address string
+	Amount  massutil.Amount
+}
+
+// maxPayoutsPerTx returns the most payouts a transaction of a batch pays. Each
+// output is estimated 63 bytes as estimateSignedSize does, and half of a
+// standard transaction is left for inputs.
+func maxPayoutsPerTx() int {
+	return blockchain.GetMaxStandardTxSize() / 2 / 63
+}
+
+// CreatePayoutBatch pays payouts by as few standard transactions as possible,
+// signs and submits them to the mempool. The batch is stored before submitting,
+// so that a repeated request of the same payout keys returns the stored batch
+// instead of paying again, and submits the transactions a crash left pending.
+// Reusing a key for a different payout, or along with other keys, fails with
+// ErrPayoutKeyConflict.
+//
+// A payout no transaction could be made for, e.g. for insufficient funds or a
+// fee over maxTxFee, fails alone, the other payouts of its transaction are
+// retried in smaller ones. It keeps its key, resend it under a new key.
+func (w *WalletManager) CreatePayoutBatch(walletId string, payouts []*PayoutRequest, password []byte,
+	feeMode FeeMode, maxTxFee massutil.Amount) (*txmgr.PayoutBatch, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
+	}
+	if am.IsWatchOnly() {
+		return nil, keystore.ErrWatchOnly
+	}
+	if err = w.checkPayoutRequests(payouts); err != nil {
+		return nil, err
+	}
+
+	id := payoutBatchId(payouts)
+	var batch *txmgr.PayoutBatch
+	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+		batch, err = w.utxoStore.FetchPayoutBatch(tx, am.Name(), id)
+		if err != nil || batch != nil {
+			return err
+		}
+		for _, payout := range payouts {
+			batchId, err := w.utxoStore.PayoutBatchId(tx, am.Name(), payout.Key)
+			if err != nil {
+				return err
+			}
+			if len(batchId) > 0 {
+				logging.CPrint(logging.ERROR, "payout key used by another batch", logging.LogFormat{
+					"key":   payout.Key,
+					"batch": batchId,
+				})
+				return ErrPayoutKeyConflict
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if batch != nil {
+		if !samePayouts(batch, payouts) {
+			logging.CPrint(logging.ERROR, "payout keys used for different payouts", logging.LogFormat{
+				"batch": batch.Id,
+			})
+			return nil, ErrPayoutKeyConflict
+		}
+		logging.CPrint(logging.INFO, "repeated payout batch", logging.LogFormat{
+			"walletId": am.Name(),
+			"batch":    batch.Id,
+		})
+	} else {
+		batch, err = w.makePayoutBatch(am, id, payouts, password, feeMode, maxTxFee)
+		if err != nil {
+			return nil, err
+		}
+		err = mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
+			return w.utxoStore.PutPayoutBatch(tx, am.Name(), batch)
+		})
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to put payout batch", logging.LogFormat{
+				"err":   err,
+				"batch": batch.Id,
+			})
+			w.clearPayoutMarks(batch)
+			return nil, err
+		}
+		logging.CPrint(logging.INFO, "created payout batch", logging.LogFormat{
+			"walletId": am.Name(),
+			"batch":    batch.Id,
+			"payouts":  len(batch.Payouts),
+			"txs":      len(batch.Txs),
+		})
+	}
+
+	if err = w.submitPayoutTxs(am, batch); err != nil {
+		return nil, err
+	}
+	w.refreshPayoutStatus(batch)
+	return batch, nil
+}
+
+// GetPayoutBatch returns the batch of batchId, or the batch holding the payout
+// of payoutKey if batchId is empty.
+func (w *WalletManager) GetPayoutBatch(walletId, batchId, payoutKey string) (*txmgr.PayoutBatch, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
+	}
+
+	var batch *txmgr.PayoutBatch
+	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+		if len(batchId) == 0 {
+			if len(payoutKey) == 0 {
+				return ErrInvalidParameter
+			}
+			batchId, err = w.utxoStore.PayoutBatchId(tx, am.Name(), payoutKey)
+			if err != nil || len(batchId) == 0 {
+				return err
+			}
+		}
+		batch, err = w.utxoStore.FetchPayoutBatch(tx, am.Name(), batchId)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if batch == nil {
+		return nil, ErrPayoutBatchNotFound
+	}
+	w.refreshPayoutStatus(batch)
+	return batch, nil
+}
+
+func (w *WalletManager) checkPayoutRequests(payouts []*PayoutRequest) error {
+	if len(payouts) == 0 || len(payouts) > MaxPayoutsPerBatch {
+		logging.CPrint(logging.ERROR, "invalid number of payouts", logging.LogFormat{
+			"count": len(payouts),
+			"max":   MaxPayoutsPerBatch,
+		})
+		return ErrInvalidParameter
+	}
+	keys := make(map[string]struct{}, len(payouts))
+	for _, payout := range payouts {
+		if len(payout.Key) == 0 || len(payout.Key) > MaxPayoutKeyLen {
+			logging.CPrint(logging.ERROR, "invalid payout key", logging.LogFormat{
+				"key": payout.Key,
+			})
+			return ErrInvalidParameter
+		}
+		if _, ok := keys[payout.Key]; ok {
+			logging.CPrint(logging.ERROR, "duplicate payout key", logging.LogFormat{
+				"key": payout.Key,
+			})
+			return ErrInvalidParameter
+		}
+		keys[payout.Key] = struct{}{}
+
+		if payout.Amount.IsZero() {
+			return ErrInvalidAmount
+		}
+		if _, err := PayToWitnessV0Address(payout.Address, w.chainParams); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// payoutBatchId derives the id of a batch from the keys of its payouts, so that
+// a repeated request maps to the same batch.
+func payoutBatchId(payouts []*PayoutRequest) string {
+	keys := make([]string, 0, len(payouts))
+	for _, payout := range payouts {
+		keys = append(keys, payout.Key)
+	}
+	sort.Strings(keys)
+	h := sha256.New()
+	for _, key := range keys {
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+func samePayouts(batch *txmgr.PayoutBatch, payouts []*PayoutRequest) bool {
+	if len(batch.Payouts) != len(payouts) {
+		return false
+	}
+	stored := make(map[string]*txmgr.Payout, len(batch.Payouts))
+	for _, payout := range batch.Payouts {
+		stored[payout.Key] = payout
+	}
+	for _, payout := range payouts {
+		p, ok := stored[payout.Key]
+		if !ok || p.Address != payout.Address || p.Amount.Cmp(payout.Amount) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// makePayoutBatch makes and signs the transactions paying payouts, the inputs
+// spent are marked used until submitted.
+func (w *WalletManager) makePayoutBatch(am *keystore.AddrManager, id string, payouts []*PayoutRequest,
+	password []byte, feeMode FeeMode, maxTxFee massutil.Amount) (*txmgr.PayoutBatch, error) {
+	batch := &txmgr.PayoutBatch{
+		Id:      id,
+		Created: time.Unix(time.Now().Unix(), 0),
+		Payouts: make([]*txmgr.Payout, 0, len(payouts)),
+	}
+	for _, payout := range payouts {
+		batch.Payouts = append(batch.Payouts, &txmgr.Payout{
+			Key:     payout.Key,
+			Address: payout.Address,
+			Amount:  payout.Amount,
+			TxIndex: -1,
+		})
+	}
+
+	groups := groupPayouts(batch.Payouts, maxPayoutsPerTx())
+	// retry halves the group no transaction could be made for, so that only
+	// the payouts failing by themselves fail
+	retry := func(group []*txmgr.Payout, err error) {
+		if len(group) > 1 {
+			half := len(group) / 2
+			groups = append([][]*txmgr.Payout{group[:half], group[half:]}, groups...)
+		} else {
+			failPayouts(group, err)
+		}
+	}
+	for len(groups) > 0 {
+		group := groups[0]
+		groups = groups[1:]
+
+		amounts := make(map[string]massutil.Amount, len(group))
+		for _, payout := range group {
+			amounts[payout.Address] = payout.Amount
+		}
+		mtx, fee, err := w.EstimateTxFee(am.Name(), amounts, 0, massutil.ZeroAmount(), "", "", nil, nil, feeMode)
+		if err == nil && fee.Cmp(maxTxFee) > 0 {
+			logging.CPrint(logging.ERROR, "big transaction fee", logging.LogFormat{"fee": fee, "max": maxTxFee})
+			err = ErrBigTxFee
+		}
+		if err != nil {
+			retry(group, err)
+			continue
+		}
+		mtx.Version = wire.TxVersion
+		w.MarkUsedUTXO(mtx)
+
+		err = w.signWitnessTx(am, password, mtx, txscript.SigHashAll, w.chainParams)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "Failed to sign the transaction", logging.LogFormat{
+				"err": err,
+			})
+			w.ClearUsedUTXOMark(mtx)
+			w.clearPayoutMarks(batch)
+			return nil, err
+		}
+		if mtx.PlainSize() > blockchain.GetMaxStandardTxSize() {
+			w.ClearUsedUTXOMark(mtx)
+			retry(group, ErrOverfullUtxo)
+			continue
+		}
+		raw, err := mtx.Bytes(wire.Packet)
+		if err != nil {
+			w.ClearUsedUTXOMark(mtx)
+			w.clearPayoutMarks(batch)
+			return nil, err
+		}
+
+		for _, payout := range group {
+			payout.TxIndex = len(batch.Txs)
+		}
+		batch.Txs = append(batch.Txs, &txmgr.PayoutTx{
+			Hash:   mtx.TxHash(),
+			Status: txmgr.PayoutTxPending,
+			Raw:    raw,
+		})
+	}
+	return batch, nil
+}
+
+// groupPayouts puts each payout into the first group with room and no payout
+// to the same address, since a transaction is made of an address-amount map.
+func groupPayouts(payouts []*txmgr.Payout, max int) [][]*txmgr.Payout {
+	var (
+		groups [][]*txmgr.Payout
+		addrs  []map[string]struct{}
+	)
+	for _, payout := range payouts {
+		placed := false
+		for i := range groups {
+			if _, ok := addrs[i][payout.Address]; ok || len(groups[i]) >= max {
+				continue
+			}
+			groups[i] = append(groups[i], payout)
+			addrs[i][payout.Address] = struct{}{}
+			placed = true
+			break
+		}
+		if !placed {
+			groups = append(groups, []*txmgr.Payout{payout})
+			addrs = append(addrs, map[string]struct{}{payout.Address: {}})
+		}
+	}
+	return groups
+}
+
+func failPayouts(payouts []*txmgr.Payout, err error) {
+	logging.CPrint(logging.WARN, "failed to pay payouts", logging.LogFormat{
+		"err":   err,
+		"count": len(payouts),
+	})
+	for _, payout := range payouts {
+		payout.Error = err.Error()
+	}
+}
+
+// submitPayoutTxs submits the pending transactions of batch and stores their
+// new status.
+func (w *WalletManager) submitPayoutTxs(am *keystore.AddrManager, batch *txmgr.PayoutBatch) error {
+	changed := false
+	for _, ptx := range batch.Txs {
+		if ptx.Status != txmgr.PayoutTxPending {
+			continue
+		}
+		mtx := wire.NewMsgTx()
+		if err := mtx.SetBytes(ptx.Raw, wire.Packet); err != nil {
+			return err
+		}
+		// a tx left pending by a crash may have been accepted already
+		err := w.server.SubmitTx(mtx)
+		if err != nil && !w.server.TxMemPool().HaveTransaction(&ptx.Hash) && !w.payoutTxMined(&ptx.Hash) {
+			logging.CPrint(logging.ERROR, "failed to submit payout transaction", logging.LogFormat{
+				"err": err,
+				"tx":  ptx.Hash.String(),
+			})
+			ptx.Status = txmgr.PayoutTxFailed
+			ptx.Error = err.Error()
+			w.ClearUsedUTXOMark(mtx)
+		} else {
+			ptx.Status = txmgr.PayoutTxSubmitted
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
+		return w.utxoStore.PutPayoutBatch(tx, am.Name(), batch)
+	})
+}
+
+// refreshPayoutStatus reports submitted transactions of batch mined in the best
+// chain as PayoutTxMined.
+func (w *WalletManager) refreshPayoutStatus(batch *txmgr.PayoutBatch) {
+	for _, ptx := range batch.Txs {
+		if ptx.Status == txmgr.PayoutTxSubmitted && w.payoutTxMined(&ptx.Hash) {
+			ptx.Status = txmgr.PayoutTxMined
+		}
+	}
+}
+
+func (w *WalletManager) payoutTxMined(hash *wire.Hash) bool {
+	mtx, err := w.chainFetcher.FetchTxBySha(hash)
+	return err == nil && mtx != nil
+}
+
+func (w *WalletManager) clearPayoutMarks(batch *txmgr.PayoutBatch) {
+	for _, ptx := range batch.Txs {
+		mtx := wire.NewMsgTx()
+		if err := mtx.SetBytes(ptx.Raw, wire.Packet); err == nil {
+			w.ClearUsedUTXOMark(mtx)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package masswallet
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/wire"
+
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/psbt"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/masswallet/utils"
+)
+
+// CreatePsbt wraps tx into a partially-signed transaction describing every
+// input, all of which must be spendable by the wallet.
+func (w *WalletManager) CreatePsbt(walletId string, tx *wire.MsgTx) (*psbt.Packet, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
+	}
+
+	var unminedHeight uint64
+	p := psbt.New(tx)
+	for i, txIn := range tx.TxIn {
+		if len(txIn.Witness) > 0 {
+			logging.CPrint(logging.ERROR, "input already signed", logging.LogFormat{"index": i})
+			return nil, ErrInvalidParameter
+		}
+
+		var height uint64
+		prevTx, meta, err := w.existsMsgTx(am.Name(), &txIn.PreviousOutPoint)
+		if err == txmgr.ErrNotFound {
+			prevTx, err = w.existsUnminedTx(&txIn.PreviousOutPoint.Hash)
+			if err == nil {
+				if unminedHeight == 0 {
+					if unminedHeight, err = w.SyncedTo(); err != nil {
+						return nil, err
+					}
+					unminedHeight++
+				}
+				height = unminedHeight
+			}
+		} else if err == nil {
+			height = meta.Height
+		}
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to check previous transaction", logging.LogFormat{
+				"err": err,
+			})
+			return nil, ErrUTXONotExists
+		}
+		if txIn.PreviousOutPoint.Index > uint32(len(prevTx.TxOut)-1) {
+			logging.CPrint(logging.ERROR, "Ouput index number (vout) does not exist for transaction", logging.LogFormat{
+				"index": txIn.PreviousOutPoint.Index,
+			})
+			return nil, ErrInvalidIndex
+		}
+
+		flags, err := w.existsOutPoint(am.Name(), &txIn.PreviousOutPoint)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to check previous output", logging.LogFormat{
+				"err": err,
+			})
+			return nil, ErrUTXONotExists
+		}
+		if flags.Spent {
+			logging.CPrint(logging.ERROR, "Ouput index for txid has been spent", logging.LogFormat{
+				"index": txIn.PreviousOutPoint.Index,
+				"txid":  &txIn.PreviousOutPoint.Hash,
+			})
+			return nil, ErrDoubleSpend
+		}
+
+		prevTxOut := prevTx.TxOut[txIn.PreviousOutPoint.Index]
+		ps, err := utils.ParsePkScript(prevTxOut.PkScript, w.chainParams)
+		if err != nil {
+			return nil, err
+		}
+		mAddr, err := am.Address(ps.StdEncodeAddress())
+		if err != nil {
+			logging.CPrint(logging.ERROR, "input not spendable by wallet", logging.LogFormat{
+				"index":    i,
+				"walletId": am.Name(),
+				"err":      err,
+			})
+			return nil, ErrUTXONotExists
+		}
+		redeemScript, err := mAddr.RedeemScript(w.chainParams)
+		if err != nil {
+			return nil, err
+		}
+
+		dp := mAddr.DerivationPath()
+		input := p.Inputs[i]
+		input.Value = prevTxOut.Value
+		input.PkScript = prevTxOut.PkScript
+		input.RedeemScript = redeemScript
+		input.Height = height
+		// one derivation per cosigner of multisig wallets
+		cosignerIDs := am.CosignerIDs()
+		for j, pubKey := range mAddr.PubKeys() {
+			input.Derivations = append(input.Derivations, &psbt.Derivation{
+				PubKey:   pubKey.SerializeCompressed(),
+				WalletId: cosignerIDs[j],
+				Branch:   dp.Branch,
+				Index:    dp.Index,
+			})
+		}
+	}
+	return p, nil
+}
+
+// SignPsbt adds the signatures the wallet can make to p, returning how many
+// were added.
+func (w *WalletManager) SignPsbt(walletId string, password []byte, flag string, p *psbt.Packet) (int, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return 0, err
+	}
+	if am.IsWatchOnly() {
+		return 0, keystore.ErrWatchOnly
+	}
+	hashType, err := parseSigHashType(flag)
+	if err != nil {
+		return 0, err
+	}
+
+	defer w.ksmgr.ClearPrivKey()
+	return p.Sign(hashType, w.chainParams, func(d *psbt.Derivation, pubKey *btcec.PublicKey, hash []byte) (*btcec.Signature, error) {
+		if d.WalletId != am.Name() {
+			return nil, psbt.ErrKeyNotFound
+		}
+		// signed by path, the wallet may be a cosigner of a multisig
+		// wallet whose addresses it never generated itself
+		return w.ksmgr.SignHashForPath(am.Name(), pubKey, d.Branch, d.Index, hash, password)
+	})
+}
+
+// SignPsbtWithKeystore signs p with the keys of an exported keystore. It uses
+// neither a wallet database nor chain data, so it can run on an offline machine.
+func SignPsbtWithKeystore(keystoreJson, privPassphrase []byte, flag string, p *psbt.Packet, params *config.Params) (int, error) {
+	hashType, err := parseSigHashType(flag)
+	if err != nil {
+		return 0, err
+	}
+	signer, err := keystore.NewKeystoreSigner(keystoreJson, privPassphrase, params)
+	if err != nil {
+		return 0, err
+	}
+	defer signer.Zero()
+
+	return p.Sign(hashType, params, func(d *psbt.Derivation, pubKey *btcec.PublicKey, hash []byte) (*btcec.Signature, error) {
+		if d.WalletId != signer.AccountID() {
+			return nil, psbt.ErrKeyNotFound
+		}
+		return signer.SignHash(pubKey, d.Branch, d.Index, hash)
+	})
+}
@@ -0,0 +1,393 @@
+// Package psbt implements a partially-signed transaction container.
+//
+// A Packet carries an unsigned transaction together with everything needed to
+// sign each of its inputs: the value and pkScript of the previous output, the
+// witness script, the hd derivation of the keys and the signatures collected so
+// far. Packets can be signed on a machine without any chain data, combined from
+// several signers and finalized into a complete transaction.
+package psbt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/massnetorg/mass-core/consensus/forks"
+	"github.com/massnetorg/mass-core/txscript"
+	"github.com/massnetorg/mass-core/wire"
+	"massnet.org/mass-wallet/config"
+)
+
+// Version is the current serialization version of Packet.
+const Version = 1
+
+var (
+	ErrInvalidPacket     = errors.New("invalid partially-signed transaction")
+	ErrUnknownVersion    = errors.New("unknown partially-signed transaction version")
+	ErrMismatchedScript  = errors.New("witness script does not match pkScript")
+	ErrMismatchedPackets = errors.New("partially-signed transactions spend different transactions")
+	ErrIncomplete        = errors.New("partially-signed transaction is not fully signed")
+	ErrKeyNotFound       = errors.New("signing key not found")
+)
+
+// Derivation describes the hd path of a key in a wallet.
+type Derivation struct {
+	PubKey   []byte `json:"pub_key"`
+	WalletId string `json:"wallet_id"`
+	Branch   uint32 `json:"branch"`
+	Index    uint32 `json:"index"`
+}
+
+// PartialSig is a signature, with the sighash type appended, made by PubKey.
+type PartialSig struct {
+	PubKey    []byte `json:"pub_key"`
+	Signature []byte `json:"signature"`
+}
+
+// Input holds the signing information of a transaction input.
+type Input struct {
+	Value        int64  `json:"value"`
+	PkScript     []byte `json:"pk_script"`
+	RedeemScript []byte `json:"redeem_script"`
+	// Height is the height the previous output was mined at, which decides
+	// the script verify flags.
+	Height      uint64        `json:"height"`
+	Derivations []*Derivation `json:"derivations,omitempty"`
+	PartialSigs []*PartialSig `json:"partial_sigs,omitempty"`
+}
+
+// Packet is a partially-signed transaction.
+type Packet struct {
+	Tx     *wire.MsgTx
+	Inputs []*Input
+}
+
+type packetJSON struct {
+	Version int      `json:"version"`
+	Tx      []byte   `json:"tx"`
+	Inputs  []*Input `json:"inputs"`
+}
+
+// New returns a packet for tx with empty input information.
+func New(tx *wire.MsgTx) *Packet {
+	inputs := make([]*Input, len(tx.TxIn))
+	for i := range inputs {
+		inputs[i] = &Input{}
+	}
+	return &Packet{Tx: tx, Inputs: inputs}
+}
+
+// Decode parses a base64 encoded packet and checks that it is well-formed.
+func Decode(encoded string) (*Packet, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidPacket
+	}
+	var pj packetJSON
+	if err := json.Unmarshal(raw, &pj); err != nil {
+		return nil, ErrInvalidPacket
+	}
+	if pj.Version != Version {
+		return nil, ErrUnknownVersion
+	}
+	tx := &wire.MsgTx{}
+	if err := tx.SetBytes(pj.Tx, wire.Packet); err != nil {
+		return nil, ErrInvalidPacket
+	}
+	p := &Packet{Tx: tx, Inputs: pj.Inputs}
+	if err := p.SanityCheck(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Encode returns the base64 encoding of the packet.
+func (p *Packet) Encode() (string, error) {
+	txBytes, err := p.Tx.Bytes(wire.Packet)
+	if err != nil {
+		return "", err
+	}
+	raw, err := json.Marshal(&packetJSON{
+		Version: Version,
+		Tx:      txBytes,
+		Inputs:  p.Inputs,
+	})
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(raw), nil
+}
+
+// SanityCheck makes sure every input is described and that its witness script
+// hashes to the script hash committed by its pkScript.
+func (p *Packet) SanityCheck() error {
+	if p.Tx == nil || len(p.Inputs) != len(p.Tx.TxIn) {
+		return ErrInvalidPacket
+	}
+	for _, in := range p.Inputs {
+		if in == nil || in.Value < 0 || len(in.PkScript) == 0 || len(in.RedeemScript) == 0 {
+			return ErrInvalidPacket
+		}
+		class, pops := txscript.GetScriptInfo(in.PkScript)
+		var scriptHash []byte
+		switch class {
+		case txscript.WitnessV0ScriptHashTy, txscript.StakingScriptHashTy:
+			_, hash, err := txscript.GetParsedOpcode(pops, class)
+			if err != nil {
+				return ErrInvalidPacket
+			}
+			scriptHash = hash[:]
+		case txscript.BindingScriptHashTy:
+			hash, _, err := txscript.GetParsedBindingOpcode(pops)
+			if err != nil {
+				return ErrInvalidPacket
+			}
+			scriptHash = hash
+		default:
+			return ErrInvalidPacket
+		}
+		witnessHash := sha256.Sum256(in.RedeemScript)
+		if !bytes.Equal(scriptHash, witnessHash[:]) {
+			return ErrMismatchedScript
+		}
+	}
+	return nil
+}
+
+// Fee returns the sum of the input values minus the sum of the output values.
+func (p *Packet) Fee() int64 {
+	var fee int64
+	for _, in := range p.Inputs {
+		fee += in.Value
+	}
+	for _, out := range p.Tx.TxOut {
+		fee -= out.Value
+	}
+	return fee
+}
+
+// IsFinalized reports whether input idx already carries a complete witness.
+func (p *Packet) IsFinalized(idx int) bool {
+	return len(p.Tx.TxIn[idx].Witness) > 0
+}
+
+// IsComplete reports whether every input is finalized or has collected
+// enough signatures to be finalized.
+func (p *Packet) IsComplete(params *config.Params) bool {
+	for i := range p.Inputs {
+		if p.IsFinalized(i) {
+			continue
+		}
+		if _, err := p.witness(i, params); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// SignFunc signs hash with the private key of pubKey described by d. It returns
+// ErrKeyNotFound if the signer does not own the key, the key is then left to
+// other signers.
+type SignFunc func(d *Derivation, pubKey *btcec.PublicKey, hash []byte) (*btcec.Signature, error)
+
+// Sign adds the signatures sign is able to make and returns how many were
+// added. Inputs already finalized or signed by a key are skipped.
+func (p *Packet) Sign(hashType txscript.SigHashType, params *config.Params, sign SignFunc) (int, error) {
+	hashCache := txscript.NewTxSigHashes(p.Tx)
+	signed := 0
+	for i, in := range p.Inputs {
+		if p.IsFinalized(i) {
+			continue
+		}
+		// SigHashSingle inputs can only be signed if there's a
+		// corresponding output.
+		if hashType&txscript.SigHashSingle == txscript.SigHashSingle && i >= len(p.Tx.TxOut) {
+			continue
+		}
+		pubKeys, _, err := multiSigInfo(in.RedeemScript, params)
+		if err != nil {
+			return signed, err
+		}
+		for _, pk := range pubKeys {
+			pkBytes := pk.SerializeCompressed()
+			if in.partialSig(pkBytes) != nil {
+				continue
+			}
+			d := in.derivation(pkBytes)
+			if d == nil {
+				continue
+			}
+			getSign := txscript.SignClosure(func(pub *btcec.PublicKey, hash []byte) (*btcec.Signature, error) {
+				return sign(d, pub, hash)
+			})
+			sig, err := txscript.RawTxInWitnessSignature(p.Tx, hashCache, i, in.Value,
+				in.RedeemScript, hashType, pk, getSign)
+			if err == ErrKeyNotFound {
+				continue
+			}
+			if err != nil {
+				return signed, err
+			}
+			in.PartialSigs = append(in.PartialSigs, &PartialSig{PubKey: pkBytes, Signature: sig})
+			signed++
+		}
+	}
+	return signed, nil
+}
+
+// Combine merges the signatures and derivations of packets spending the same
+// transaction into a new packet.
+func Combine(packets ...*Packet) (*Packet, error) {
+	if len(packets) == 0 {
+		return nil, ErrInvalidPacket
+	}
+	tx, err := copyTx(packets[0].Tx)
+	if err != nil {
+		return nil, err
+	}
+	combined := New(tx)
+	txHash := tx.TxHash()
+	for _, p := range packets {
+		if p.Tx.TxHash() != txHash || len(p.Inputs) != len(combined.Inputs) {
+			return nil, ErrMismatchedPackets
+		}
+		for i, in := range p.Inputs {
+			if len(p.Tx.TxIn[i].Witness) > 0 {
+				combined.Tx.TxIn[i].Witness = p.Tx.TxIn[i].Witness
+			}
+			out := combined.Inputs[i]
+			if len(out.PkScript) == 0 {
+				out.Value = in.Value
+				out.PkScript = in.PkScript
+				out.RedeemScript = in.RedeemScript
+				out.Height = in.Height
+			} else if out.Value != in.Value || !bytes.Equal(out.PkScript, in.PkScript) ||
+				!bytes.Equal(out.RedeemScript, in.RedeemScript) {
+				return nil, ErrMismatchedPackets
+			}
+			for _, d := range in.Derivations {
+				if out.derivation(d.PubKey) == nil {
+					out.Derivations = append(out.Derivations, d)
+				}
+			}
+			for _, sig := range in.PartialSigs {
+				if out.partialSig(sig.PubKey) == nil {
+					out.PartialSigs = append(out.PartialSigs, sig)
+				}
+			}
+		}
+	}
+	return combined, nil
+}
+
+// Finalize builds the witness of every input from the collected signatures,
+// verifies the scripts and returns the complete transaction. The packet itself
+// is left untouched.
+func (p *Packet) Finalize(params *config.Params) (*wire.MsgTx, error) {
+	tx, err := copyTx(p.Tx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range p.Inputs {
+		if p.IsFinalized(i) {
+			continue
+		}
+		witness, err := p.witness(i, params)
+		if err != nil {
+			return nil, err
+		}
+		tx.TxIn[i].Witness = witness
+	}
+
+	hashCache := txscript.NewTxSigHashes(tx)
+	for i, in := range p.Inputs {
+		flags := txscript.StandardVerifyFlags
+		if forks.EnforceMASSIP0002WarmUp(in.Height) {
+			flags |= txscript.ScriptMASSip2
+		}
+		vm, err := txscript.NewEngine(in.PkScript, tx, i, flags, nil, hashCache, in.Value)
+		if err != nil {
+			return nil, err
+		}
+		if err := vm.Execute(); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+// witness returns the witness of input idx, ordering the signatures as the
+// keys appear in the witness script.
+func (p *Packet) witness(idx int, params *config.Params) (wire.TxWitness, error) {
+	in := p.Inputs[idx]
+	pubKeys, nRequired, err := multiSigInfo(in.RedeemScript, params)
+	if err != nil {
+		return nil, err
+	}
+	builder := txscript.NewScriptBuilder()
+	found := 0
+	for _, pk := range pubKeys {
+		sig := in.partialSig(pk.SerializeCompressed())
+		if sig == nil {
+			continue
+		}
+		builder.AddData(sig.Signature)
+		found++
+		if found == nRequired {
+			break
+		}
+	}
+	if found < nRequired {
+		return nil, ErrIncomplete
+	}
+	sigScript, err := builder.Script()
+	if err != nil {
+		return nil, err
+	}
+	return wire.TxWitness{sigScript, in.RedeemScript}, nil
+}
+
+// multiSigInfo returns the keys and required signatures of a witness script.
+func multiSigInfo(redeemScript []byte, params *config.Params) ([]*btcec.PublicKey, int, error) {
+	class, _, pubKeys, nRequired, err := txscript.ExtractPkScriptAddrs(redeemScript, params)
+	if err != nil {
+		return nil, 0, err
+	}
+	if class != txscript.MultiSigTy || nRequired <= 0 {
+		return nil, 0, ErrInvalidPacket
+	}
+	return pubKeys, nRequired, nil
+}
+
+func copyTx(tx *wire.MsgTx) (*wire.MsgTx, error) {
+	bs, err := tx.Bytes(wire.Packet)
+	if err != nil {
+		return nil, err
+	}
+	dup := &wire.MsgTx{}
+	if err := dup.SetBytes(bs, wire.Packet); err != nil {
+		return nil, err
+	}
+	return dup, nil
+}
+
+func (in *Input) derivation(pubKey []byte) *Derivation {
+	for _, d := range in.Derivations {
+		if bytes.Equal(d.PubKey, pubKey) {
+			return d
+		}
+	}
+	return nil
+}
+
+func (in *Input) partialSig(pubKey []byte) *PartialSig {
+	for _, sig := range in.PartialSigs {
+		if bytes.Equal(sig.PubKey, pubKey) {
+			return sig
+		}
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package psbt
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/txscript"
+	"github.com/massnetorg/mass-core/wire"
+	"massnet.org/mass-wallet/config"
+)
+
+type testKey struct {
+	priv       *btcec.PrivateKey
+	derivation *Derivation
+}
+
+// newTestPacket returns a packet with one input locked by an nRequired-of-len(keys)
+// witness script.
+func newTestPacket(t *testing.T, keys []*testKey, nRequired int) *Packet {
+	pubKeys := make([]*massutil.AddressPubKey, 0, len(keys))
+	for _, k := range keys {
+		pk, err := massutil.NewAddressPubKey(k.priv.PubKey().SerializeCompressed(), config.ChainParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubKeys = append(pubKeys, pk)
+	}
+	redeemScript, err := txscript.MultiSigScript(pubKeys, nRequired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scriptHash := sha256.Sum256(redeemScript)
+	pkScript, err := txscript.PayToWitnessScriptHashScript(scriptHash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&wire.Hash{0x01}, 0), nil))
+	tx.AddTxOut(wire.NewTxOut(9000, pkScript))
+
+	p := New(tx)
+	p.Inputs[0].Value = 10000
+	p.Inputs[0].PkScript = pkScript
+	p.Inputs[0].RedeemScript = redeemScript
+	for _, k := range keys {
+		p.Inputs[0].Derivations = append(p.Inputs[0].Derivations, k.derivation)
+	}
+	return p
+}
+
+func newTestKeys(t *testing.T, n int) []*testKey {
+	keys := make([]*testKey, 0, n)
+	for i := 0; i < n; i++ {
+		priv, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, &testKey{
+			priv: priv,
+			derivation: &Derivation{
+				PubKey:   priv.PubKey().SerializeCompressed(),
+				WalletId: "ac-test",
+				Index:    uint32(i),
+			},
+		})
+	}
+	return keys
+}
+
+func signWith(keys ...*testKey) SignFunc {
+	return func(d *Derivation, pubKey *btcec.PublicKey, hash []byte) (*btcec.Signature, error) {
+		for _, k := range keys {
+			if k.priv.PubKey().IsEqual(pubKey) {
+				return k.priv.Sign(hash)
+			}
+		}
+		return nil, ErrKeyNotFound
+	}
+}
+
+func TestPacketEncodeDecode(t *testing.T) {
+	keys := newTestKeys(t, 1)
+	p := newTestPacket(t, keys, 1)
+
+	encoded, err := p.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Tx.TxHash() != p.Tx.TxHash() {
+		t.Fatalf("tx mismatch after decoding")
+	}
+	if decoded.Fee() != 1000 {
+		t.Fatalf("unexpected fee %d", decoded.Fee())
+	}
+	if len(decoded.Inputs[0].Derivations) != 1 || decoded.Inputs[0].Derivations[0].Index != 0 {
+		t.Fatalf("derivations mismatch after decoding")
+	}
+
+	// a witness script not committed by the pkScript is rejected
+	p.Inputs[0].RedeemScript = append(p.Inputs[0].RedeemScript, txscript.OP_NOP)
+	encoded, err = p.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Decode(encoded); err != ErrMismatchedScript {
+		t.Fatalf("expected %v, got %v", ErrMismatchedScript, err)
+	}
+
+	if _, err := Decode("not a packet"); err != ErrInvalidPacket {
+		t.Fatalf("expected %v, got %v", ErrInvalidPacket, err)
+	}
+}
+
+func TestPacketSignFinalize(t *testing.T) {
+	keys := newTestKeys(t, 1)
+	p := newTestPacket(t, keys, 1)
+
+	if _, err := p.Finalize(config.ChainParams); err != ErrIncomplete {
+		t.Fatalf("expected %v, got %v", ErrIncomplete, err)
+	}
+
+	n, err := p.Sign(txscript.SigHashAll, config.ChainParams, signWith())
+	if err != nil || n != 0 {
+		t.Fatalf("unexpected signing result %d, %v", n, err)
+	}
+
+	n, err = p.Sign(txscript.SigHashAll, config.ChainParams, signWith(keys...))
+	if err != nil || n != 1 {
+		t.Fatalf("unexpected signing result %d, %v", n, err)
+	}
+	if !p.IsComplete(config.ChainParams) {
+		t.Fatalf("packet should be complete")
+	}
+	// signing twice adds nothing
+	n, err = p.Sign(txscript.SigHashAll, config.ChainParams, signWith(keys...))
+	if err != nil || n != 0 {
+		t.Fatalf("unexpected signing result %d, %v", n, err)
+	}
+
+	tx, err := p.Finalize(config.ChainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.TxIn[0].Witness) != 2 {
+		t.Fatalf("unexpected witness %v", tx.TxIn[0].Witness)
+	}
+	if p.IsFinalized(0) {
+		t.Fatalf("finalize should not modify the packet")
+	}
+}
+
+func TestPacketCombine(t *testing.T) {
+	keys := newTestKeys(t, 3)
+	p := newTestPacket(t, keys, 2)
+
+	encoded, err := p.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p1, _ := Decode(encoded)
+	p2, _ := Decode(encoded)
+
+	if _, err := p1.Sign(txscript.SigHashAll, config.ChainParams, signWith(keys[0])); err != nil {
+		t.Fatal(err)
+	}
+	if p1.IsComplete(config.ChainParams) {
+		t.Fatalf("packet should not be complete")
+	}
+	if _, err := p2.Sign(txscript.SigHashAll, config.ChainParams, signWith(keys[2])); err != nil {
+		t.Fatal(err)
+	}
+
+	combined, err := Combine(p1, p2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(combined.Inputs[0].PartialSigs) != 2 {
+		t.Fatalf("expected 2 signatures, got %d", len(combined.Inputs[0].PartialSigs))
+	}
+	if _, err := combined.Finalize(config.ChainParams); err != nil {
+		t.Fatal(err)
+	}
+
+	other := newTestPacket(t, keys, 2)
+	other.Tx.TxOut[0].Value = 1
+	if _, err := Combine(p1, other); err != ErrMismatchedPackets {
+		t.Fatalf("expected %v, got %v", ErrMismatchedPackets, err)
+	}
+}
@@ -0,0 +1,469 @@
+// Package remote follows a remote MASS full node through its chain api instead
+// of embedding the chain, so that several wallet instances can share one node.
+//
+// The chain api is plain json over http, blocks, headers and transactions are
+// hex strings of their wire.DB encoding:
+//
+//	GET  /v1/chain/best                    {"height", "hash", "chain_id"}
+//	GET  /v1/chain/headers/{height}        {"height", "hash", "header"}
+//	GET  /v1/chain/blocks/{height}         {"height", "hash", "block"}
+//	GET  /v1/chain/blocks/hash/{hash}      {"height", "hash", "block"}
+//	GET  /v1/chain/txs/{hash}?until={h}    {"height", "tx"}, the last one on the best chain until h if given
+//	POST /v1/chain/txs                     {"tx"} -> {"tx_id"}, submits a transaction to the mempool
+//	POST /v1/chain/scripthashes/related    {"script_hashes", "start", "stop"} -> {"heights": [{"height", "locs": [{"start", "len"}]}]}
+//	POST /v1/chain/scripthashes/used       {"script_hash"} -> {"used"}
+//	GET  /v1/chain/mempool                 {"txs"}
+//
+// Objects not found are answered with status 404, failures with status 4xx or
+// 5xx and a body of {"error"}.
+//
+// Server serves the chain api from the chain database of a full node, a wallet
+// running the embedded chain serves it at wallet.chain.listen_address. The
+// ReplayServer stands in for a full node in tests.
+package remote
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/massnetorg/mass-core/database"
+	"github.com/massnetorg/mass-core/database/storage"
+	"github.com/massnetorg/mass-core/wire"
+	"github.com/patrickmn/go-cache"
+	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/ifc"
+)
+
+const (
+	requestTimeout = 30 * time.Second
+	blockCacheTTL  = 30 * time.Second
+)
+
+var errNotFound = errors.New("not found")
+
+type bestResult struct {
+	Height  uint64 `json:"height"`
+	Hash    string `json:"hash"`
+	ChainID string `json:"chain_id"`
+}
+
+type headerResult struct {
+	Height uint64 `json:"height"`
+	Hash   string `json:"hash"`
+	Header string `json:"header"`
+}
+
+type blockResult struct {
+	Height uint64 `json:"height"`
+	Hash   string `json:"hash"`
+	Block  string `json:"block"`
+}
+
+type txResult struct {
+	Height uint64 `json:"height"`
+	Tx     string `json:"tx"`
+}
+
+type submitTxRequest struct {
+	Tx string `json:"tx"`
+}
+
+type submitTxResult struct {
+	TxID string `json:"tx_id"`
+}
+
+type relatedTxRequest struct {
+	ScriptHashes []string `json:"script_hashes"`
+	Start        uint64   `json:"start"`
+	Stop         uint64   `json:"stop"`
+}
+
+type txLoc struct {
+	Start int `json:"start"`
+	Len   int `json:"len"`
+}
+
+type relatedTxHeight struct {
+	Height uint64  `json:"height"`
+	Locs   []txLoc `json:"locs"`
+}
+
+type relatedTxResult struct {
+	Heights []relatedTxHeight `json:"heights"`
+}
+
+type scriptHashUsedRequest struct {
+	ScriptHash string `json:"script_hash"`
+}
+
+type scriptHashUsedResult struct {
+	Used bool `json:"used"`
+}
+
+type mempoolResult struct {
+	Txs []string `json:"txs"`
+}
+
+type errorResult struct {
+	Error string `json:"error"`
+}
+
+// rawBlock is a block along with its wire.DB encoding, which transaction
+// locations are relative to.
+type rawBlock struct {
+	height uint64
+	raw    []byte
+	block  *wire.MsgBlock
+}
+
+// Client implements ifc.ChainFetcher against the chain api of a remote node.
+type Client struct {
+	url    string
+	client *http.Client
+	blocks *cache.Cache
+}
+
+func NewClient(url string) *Client {
+	return &Client{
+		url:    strings.TrimRight(url, "/"),
+		client: &http.Client{Timeout: requestTimeout},
+		blocks: cache.New(blockCacheTTL, 2*blockCacheTTL),
+	}
+}
+
+func (c *Client) do(method, path string, in, out interface{}) error {
+	var body *bytes.Reader
+	if in != nil {
+		buf, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(buf)
+	} else {
+		body = bytes.NewReader(nil)
+	}
+	req, err := http.NewRequest(method, c.url+path, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	buf, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return errNotFound
+	case resp.StatusCode != http.StatusOK:
+		var e errorResult
+		if json.Unmarshal(buf, &e) == nil && len(e.Error) > 0 {
+			return errors.New(e.Error)
+		}
+		return fmt.Errorf("remote node: %s %s: %s", method, path, resp.Status)
+	}
+	return json.Unmarshal(buf, out)
+}
+
+func (c *Client) fetchBest() (*bestResult, error) {
+	var res bestResult
+	if err := c.do(http.MethodGet, "/v1/chain/best", nil, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) header(height uint64) (*wire.Hash, *wire.BlockHeader, error) {
+	var res headerResult
+	if err := c.do(http.MethodGet, "/v1/chain/headers/"+strconv.FormatUint(height, 10), nil, &res); err != nil {
+		return nil, nil, err
+	}
+	buf, err := hex.DecodeString(res.Header)
+	if err != nil {
+		return nil, nil, err
+	}
+	header := wire.NewEmptyBlockHeader()
+	if err = header.SetBytes(buf, wire.DB); err != nil {
+		return nil, nil, err
+	}
+	hash := header.BlockHash()
+	return &hash, header, nil
+}
+
+func (c *Client) block(path string) (*rawBlock, error) {
+	var res blockResult
+	if err := c.do(http.MethodGet, path, nil, &res); err != nil {
+		return nil, err
+	}
+	raw, err := hex.DecodeString(res.Block)
+	if err != nil {
+		return nil, err
+	}
+	block := wire.NewEmptyMsgBlock()
+	if err = block.SetBytes(raw, wire.DB); err != nil {
+		return nil, err
+	}
+	blk := &rawBlock{height: block.Header.Height, raw: raw, block: block}
+	// blocks are cached by hash only, the block at a height changes when the
+	// remote chain reorganizes
+	c.blocks.SetDefault(block.BlockHash().String(), blk)
+	return blk, nil
+}
+
+// blockByHeight returns the block at height on the current best chain of the
+// remote node, looking it up by the hash in its header.
+func (c *Client) blockByHeight(height uint64) (*rawBlock, error) {
+	sha, _, err := c.header(height)
+	if err != nil {
+		return nil, err
+	}
+	return c.blockBySha(sha)
+}
+
+func (c *Client) blockBySha(sha *wire.Hash) (*rawBlock, error) {
+	if blk, ok := c.blocks.Get(sha.String()); ok {
+		return blk.(*rawBlock), nil
+	}
+	blk, err := c.block("/v1/chain/blocks/hash/" + sha.String())
+	if err != nil {
+		return nil, err
+	}
+	if hash := blk.block.BlockHash(); hash != *sha {
+		return nil, fmt.Errorf("remote node: block %s returned for %s", hash, sha)
+	}
+	return blk, nil
+}
+
+func (c *Client) tx(path string) (*wire.MsgTx, uint64, error) {
+	var res txResult
+	err := c.do(http.MethodGet, path, nil, &res)
+	if err == errNotFound {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	tx, err := decodeTx(res.Tx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tx, res.Height, nil
+}
+
+func (c *Client) fetchMempool() ([]*wire.MsgTx, error) {
+	var res mempoolResult
+	if err := c.do(http.MethodGet, "/v1/chain/mempool", nil, &res); err != nil {
+		return nil, err
+	}
+	txs := make([]*wire.MsgTx, 0, len(res.Txs))
+	for _, s := range res.Txs {
+		tx, err := decodeTx(s)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+func (c *Client) submitTx(tx *wire.MsgTx) error {
+	s, err := encodeTx(tx)
+	if err != nil {
+		return err
+	}
+	var res submitTxResult
+	return c.do(http.MethodPost, "/v1/chain/txs", &submitTxRequest{Tx: s}, &res)
+}
+
+// FetchLastTxUntilHeight returns the last tx before a height, inclusive, of specified hash
+func (c *Client) FetchLastTxUntilHeight(txsha *wire.Hash, height uint64) (*wire.MsgTx, error) {
+	tx, _, err := c.tx("/v1/chain/txs/" + txsha.String() + "?until=" + strconv.FormatUint(height, 10))
+	return tx, err
+}
+
+// FetchTxBySha returns the latest tx on best chain of specified hash
+func (c *Client) FetchTxBySha(txsha *wire.Hash) (*wire.MsgTx, error) {
+	tx, _, err := c.FetchTxAndHeightBySha(txsha)
+	return tx, err
+}
+
+// FetchTxAndHeightBySha returns the latest tx on best chain of specified hash
+// along with the height of its block, the tx is nil if not found.
+func (c *Client) FetchTxAndHeightBySha(txsha *wire.Hash) (*wire.MsgTx, uint64, error) {
+	return c.tx("/v1/chain/txs/" + txsha.String())
+}
+
+func (c *Client) FetchTxByLoc(height uint64, loc *wire.TxLoc) (*wire.MsgTx, error) {
+	blk, err := c.blockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return blk.txByLoc(loc)
+}
+
+func (c *Client) FetchTxByFileLoc(blkLoc *database.BlockLoc, loc *wire.TxLoc) (*wire.MsgTx, error) {
+	blk, err := c.blockBySha(&blkLoc.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return blk.txByLoc(loc)
+}
+
+// FetchBlockBySha will not returns error if not exists
+func (c *Client) FetchBlockBySha(sha *wire.Hash) (*wire.MsgBlock, error) {
+	blk, err := c.blockBySha(sha)
+	if err == errNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return blk.block, nil
+}
+
+func (c *Client) FetchBlockByHeight(height uint64) (*wire.MsgBlock, error) {
+	blk, err := c.block("/v1/chain/blocks/" + strconv.FormatUint(height, 10))
+	if err == errNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return blk.block, nil
+}
+
+// FetchBlockHeaderBySha will not returns error if not exists
+func (c *Client) FetchBlockHeaderBySha(sha *wire.Hash) (*wire.BlockHeader, error) {
+	blk, err := c.FetchBlockBySha(sha)
+	if err != nil || blk == nil {
+		return nil, err
+	}
+	return &blk.Header, nil
+}
+
+// FetchBlockHeaderByHeight will not returns error if not exists
+func (c *Client) FetchBlockHeaderByHeight(height uint64) (*wire.BlockHeader, error) {
+	_, header, err := c.header(height)
+	if err == errNotFound {
+		return nil, nil
+	}
+	return header, err
+}
+
+func (c *Client) FetchScriptHashRelatedTx(scriptHashes [][]byte, start, stop uint64, chainParams *config.Params) (*ifc.HeightSortedRelatedTx, error) {
+	req := &relatedTxRequest{
+		ScriptHashes: make([]string, 0, len(scriptHashes)),
+		Start:        start,
+		Stop:         stop,
+	}
+	for _, scriptHash := range scriptHashes {
+		req.ScriptHashes = append(req.ScriptHashes, hex.EncodeToString(scriptHash))
+	}
+	var res relatedTxResult
+	if err := c.do(http.MethodPost, "/v1/chain/scripthashes/related", req, &res); err != nil {
+		return nil, err
+	}
+	m := make(map[uint64][]*wire.TxLoc, len(res.Heights))
+	heights := make([]uint64, 0, len(res.Heights))
+	for _, h := range res.Heights {
+		if _, ok := m[h.Height]; !ok {
+			heights = append(heights, h.Height)
+		}
+		for _, loc := range h.Locs {
+			m[h.Height] = append(m[h.Height], &wire.TxLoc{TxStart: loc.Start, TxLen: loc.Len})
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool {
+		return heights[i] < heights[j]
+	})
+	return &ifc.HeightSortedRelatedTx{
+		Descending:    false,
+		SortedHeights: heights,
+		Data:          m,
+	}, nil
+}
+
+func (c *Client) CheckScriptHashUsed(scriptHash []byte) (bool, error) {
+	var res scriptHashUsedResult
+	err := c.do(http.MethodPost, "/v1/chain/scripthashes/used", &scriptHashUsedRequest{ScriptHash: hex.EncodeToString(scriptHash)}, &res)
+	if err != nil {
+		return false, err
+	}
+	return res.Used, nil
+}
+
+func (c *Client) NewestSha() (*wire.Hash, uint64, error) {
+	best, err := c.fetchBest()
+	if err != nil {
+		return nil, 0, err
+	}
+	sha, err := wire.NewHashFromStr(best.Hash)
+	if err != nil {
+		return nil, 0, err
+	}
+	return sha, best.Height, nil
+}
+
+func (c *Client) FetchBlockShaByHeight(height uint64) (*wire.Hash, error) {
+	sha, _, err := c.header(height)
+	if err == errNotFound {
+		return nil, storage.ErrNotFound
+	}
+	return sha, err
+}
+
+func (c *Client) FetchBlockLocByHeight(height uint64) (*database.BlockLoc, error) {
+	sha, err := c.FetchBlockShaByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	// File, Offset and Length are left zero, FetchTxByFileLoc locates the
+	// block by its hash.
+	return &database.BlockLoc{Height: height, Hash: *sha}, nil
+}
+
+func (b *rawBlock) txByLoc(loc *wire.TxLoc) (*wire.MsgTx, error) {
+	if loc.TxStart < 0 || loc.TxLen <= 0 || loc.TxStart+loc.TxLen > len(b.raw) {
+		return nil, fmt.Errorf("tx location %d+%d out of block %d", loc.TxStart, loc.TxLen, b.height)
+	}
+	tx := wire.NewMsgTx()
+	if err := tx.SetBytes(b.raw[loc.TxStart:loc.TxStart+loc.TxLen], wire.DB); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func encodeTx(tx *wire.MsgTx) (string, error) {
+	buf, err := tx.Bytes(wire.DB)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func decodeTx(s string) (*wire.MsgTx, error) {
+	buf, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	tx := wire.NewMsgTx()
+	if err = tx.SetBytes(buf, wire.DB); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
@@ -0,0 +1,314 @@
+package remote
+
+import (
+	"sync"
+	"time"
+
+	"github.com/massnetorg/mass-core/database/storage"
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/wire"
+	"massnet.org/mass-wallet/masswallet/ifc"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+)
+
+// maxRecentBlocks is the number of connected block hashes kept to find the
+// fork point when the remote chain reorganizes.
+const maxRecentBlocks = 128
+
+// Node is the chain source of the wallet backed by a remote full node. It polls
+// the node for new blocks and mempool transactions and notifies the registered
+// listeners the way the embedded chain does.
+type Node struct {
+	*Client
+	pollInterval time.Duration
+
+	mu        sync.RWMutex
+	listeners map[ifc.ChainListener]struct{}
+	chainID   string
+	bestHash  wire.Hash
+	best      uint64
+	recent    map[uint64]wire.Hash
+	mempool   map[wire.Hash]*wire.MsgTx
+	spent     map[wire.OutPoint]struct{}
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewNode(url string, pollInterval time.Duration) *Node {
+	return &Node{
+		Client:       NewClient(url),
+		pollInterval: pollInterval,
+		listeners:    make(map[ifc.ChainListener]struct{}),
+		recent:       make(map[uint64]wire.Hash),
+		mempool:      make(map[wire.Hash]*wire.MsgTx),
+		spent:        make(map[wire.OutPoint]struct{}),
+		quit:         make(chan struct{}),
+	}
+}
+
+// Start connects to the remote node and starts following its chain from the
+// current best block.
+func (n *Node) Start() error {
+	best, err := n.fetchBest()
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to connect remote node", logging.LogFormat{"url": n.url, "err": err})
+		return err
+	}
+	hash, err := wire.NewHashFromStr(best.Hash)
+	if err != nil {
+		return err
+	}
+	n.mu.Lock()
+	n.chainID = best.ChainID
+	n.best = best.Height
+	n.bestHash = *hash
+	n.recent[best.Height] = *hash
+	n.mu.Unlock()
+
+	if err = n.pollMempool(); err != nil {
+		logging.CPrint(logging.WARN, "failed to poll remote mempool", logging.LogFormat{"err": err})
+	}
+
+	n.wg.Add(1)
+	go n.pollHandler()
+	logging.CPrint(logging.INFO, "remote node connected", logging.LogFormat{
+		"url":    n.url,
+		"height": best.Height,
+		"hash":   best.Hash,
+	})
+	return nil
+}
+
+func (n *Node) Stop() {
+	close(n.quit)
+	n.wg.Wait()
+	logging.CPrint(logging.INFO, "remote node stopped", logging.LogFormat{"url": n.url})
+}
+
+func (n *Node) pollHandler() {
+	defer n.wg.Done()
+	ticker := time.NewTicker(n.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.quit:
+			return
+		case <-ticker.C:
+			if err := n.pollBlocks(); err != nil {
+				logging.CPrint(logging.WARN, "failed to poll remote blocks", logging.LogFormat{"err": err})
+				continue
+			}
+			if err := n.pollMempool(); err != nil {
+				logging.CPrint(logging.WARN, "failed to poll remote mempool", logging.LogFormat{"err": err})
+			}
+		}
+	}
+}
+
+// pollBlocks notifies the blocks connected by the remote node since the last
+// poll, rewinding to the fork point first if the remote chain reorganized.
+func (n *Node) pollBlocks() error {
+	best, err := n.fetchBest()
+	if err != nil {
+		return err
+	}
+	n.mu.RLock()
+	height, hash := n.best, n.bestHash
+	n.mu.RUnlock()
+	if best.Hash == hash.String() {
+		return nil
+	}
+
+	for height > 0 {
+		sha, err := n.FetchBlockShaByHeight(height)
+		if err != nil && err != storage.ErrNotFound {
+			return err
+		}
+		if err == nil && *sha == hash {
+			break
+		}
+		n.mu.Lock()
+		delete(n.recent, height)
+		height--
+		prev, ok := n.recent[height]
+		n.mu.Unlock()
+		if !ok {
+			break
+		}
+		hash = prev
+	}
+
+	for height < best.Height {
+		select {
+		case <-n.quit:
+			return nil
+		default:
+		}
+		block, err := n.FetchBlockByHeight(height + 1)
+		if err != nil {
+			return err
+		}
+		if block == nil {
+			break
+		}
+		height = block.Header.Height
+		n.setBest(height, block.BlockHash())
+		for _, l := range n.getListeners() {
+			if err := l.OnBlockConnected(block); err != nil {
+				logging.CPrint(logging.WARN, "OnBlockConnected error", logging.LogFormat{"height": height, "err": err})
+			}
+		}
+	}
+	return nil
+}
+
+func (n *Node) setBest(height uint64, hash wire.Hash) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.best = height
+	n.bestHash = hash
+	n.recent[height] = hash
+	if height >= maxRecentBlocks {
+		delete(n.recent, height-maxRecentBlocks)
+	}
+}
+
+// pollMempool replaces the mempool snapshot and notifies the transactions not
+// seen before.
+func (n *Node) pollMempool() error {
+	txs, err := n.fetchMempool()
+	if err != nil {
+		return err
+	}
+	mempool := make(map[wire.Hash]*wire.MsgTx, len(txs))
+	spent := make(map[wire.OutPoint]struct{})
+	received := make([]*wire.MsgTx, 0)
+
+	n.mu.Lock()
+	for _, tx := range txs {
+		hash := tx.TxHash()
+		if _, ok := n.mempool[hash]; !ok {
+			received = append(received, tx)
+		}
+		mempool[hash] = tx
+		for _, txIn := range tx.TxIn {
+			spent[txIn.PreviousOutPoint] = struct{}{}
+		}
+	}
+	n.mempool = mempool
+	n.spent = spent
+	n.mu.Unlock()
+
+	n.notifyTxs(received)
+	return nil
+}
+
+func (n *Node) notifyTxs(txs []*wire.MsgTx) {
+	if len(txs) == 0 {
+		return
+	}
+	listeners := n.getListeners()
+	for _, tx := range txs {
+		for _, l := range listeners {
+			if err := l.OnTransactionReceived(tx); err != nil {
+				logging.CPrint(logging.WARN, "OnTransactionReceived error", logging.LogFormat{"tx": tx.TxHash(), "err": err})
+			}
+		}
+	}
+}
+
+func (n *Node) getListeners() []ifc.ChainListener {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	listeners := make([]ifc.ChainListener, 0, len(n.listeners))
+	for l := range n.listeners {
+		listeners = append(listeners, l)
+	}
+	return listeners
+}
+
+// SubmitTx sends a transaction to the mempool of the remote node, it is added
+// to the local mempool snapshot at once so its inputs are not spent again.
+func (n *Node) SubmitTx(tx *wire.MsgTx) error {
+	if err := n.submitTx(tx); err != nil {
+		return err
+	}
+	hash := tx.TxHash()
+	n.mu.Lock()
+	_, known := n.mempool[hash]
+	n.mempool[hash] = tx
+	for _, txIn := range tx.TxIn {
+		n.spent[txIn.PreviousOutPoint] = struct{}{}
+	}
+	n.mu.Unlock()
+	if !known {
+		n.notifyTxs([]*wire.MsgTx{tx})
+	}
+	return nil
+}
+
+// FetchMempoolTx returns the transaction of the hash in the mempool snapshot,
+// or nil if there is none.
+func (n *Node) FetchMempoolTx(hash *wire.Hash) *wire.MsgTx {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.mempool[*hash]
+}
+
+// HaveTransaction returns whether the transaction is in the mempool snapshot.
+func (n *Node) HaveTransaction(hash *wire.Hash) bool {
+	return n.FetchMempoolTx(hash) != nil
+}
+
+// Count returns the number of transactions in the mempool snapshot.
+func (n *Node) Count() int {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return len(n.mempool)
+}
+
+func (n *Node) CheckPoolOutPointSpend(op *wire.OutPoint) bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	_, ok := n.spent[*op]
+	return ok
+}
+
+func (n *Node) ChainFetcher() ifc.ChainFetcher {
+	return n.Client
+}
+
+func (n *Node) TxMemPool() txmgr.TxMemPool {
+	return n
+}
+
+func (n *Node) ChainID() string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.chainID
+}
+
+func (n *Node) BestBlockHeight() uint64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.best
+}
+
+// BestPeerHeight returns the best height of the remote node, the peers of the
+// node itself are not known.
+func (n *Node) BestPeerHeight() uint64 {
+	return n.BestBlockHeight()
+}
+
+func (n *Node) RegisterListener(listener ifc.ChainListener) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.listeners[listener] = struct{}{}
+}
+
+func (n *Node) UnregisterListener(listener ifc.ChainListener) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.listeners, listener)
+}
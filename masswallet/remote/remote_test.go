@@ -0,0 +1,363 @@
+package remote
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/massnetorg/mass-core/database/storage"
+	"github.com/massnetorg/mass-core/txscript"
+	"github.com/massnetorg/mass-core/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+const testBest = 100
+
+type mockListener struct {
+	blocks chan *wire.MsgBlock
+	txs    chan *wire.MsgTx
+}
+
+func (l *mockListener) OnBlockConnected(block *wire.MsgBlock) error {
+	l.blocks <- block
+	return nil
+}
+
+func (l *mockListener) OnTransactionReceived(tx *wire.MsgTx) error {
+	l.txs <- tx
+	return nil
+}
+
+func loadMockBlocks(t *testing.T) []*wire.MsgBlock {
+	f, err := os.Open("../data/mockBlks.dat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	blocks := make([]*wire.MsgBlock, 0)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 1024*1024), 16*1024*1024)
+	for scanner.Scan() {
+		buf, err := hex.DecodeString(scanner.Text())
+		if err != nil {
+			t.Fatal(err)
+		}
+		block := wire.NewEmptyMsgBlock()
+		if err = block.SetBytes(buf, wire.Packet); err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+// exportChain writes the blocks the way the exportchain command of the full
+// node does.
+func exportChain(t *testing.T, path string, blocks []*wire.MsgBlock) {
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var w io.Writer = f
+	if filepath.Ext(path) == ".gz" {
+		gz := gzip.NewWriter(f)
+		defer gz.Close()
+		w = gz
+	}
+	for _, block := range blocks {
+		hash := block.BlockHash()
+		raw, err := block.Bytes(wire.DB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sizeBuf [4]byte
+		binary.BigEndian.PutUint32(sizeBuf[:], uint32(8+len(hash)+len(raw)))
+		var heightBuf [8]byte
+		binary.BigEndian.PutUint64(heightBuf[:], block.Header.Height)
+		for _, b := range [][]byte{sizeBuf[:], heightBuf[:], hash[:], raw} {
+			if _, err = w.Write(b); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func newTestNode(t *testing.T) (*Node, *ReplayServer, []*wire.MsgBlock, func()) {
+	dir, err := ioutil.TempDir("", "remote")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "chain.gz")
+	exportChain(t, path, loadMockBlocks(t))
+	blocks, err := ReadExportedChain(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replay, err := NewReplayServer(blocks, testBest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(replay)
+	node := NewNode(ts.URL, 10*time.Millisecond)
+	return node, replay, blocks, func() {
+		ts.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestReadExportedChain(t *testing.T) {
+	mock := loadMockBlocks(t)
+	dir, err := ioutil.TempDir("", "remote")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"chain.dat", "chain.dat.gz"} {
+		path := filepath.Join(dir, name)
+		exportChain(t, path, mock)
+		blocks, err := ReadExportedChain(path)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		assert.Equal(t, len(mock), len(blocks), name)
+		for i := range blocks {
+			assert.Equal(t, mock[i].BlockHash(), blocks[i].BlockHash(), name)
+		}
+	}
+
+	// truncated file
+	path := filepath.Join(dir, "chain.dat")
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(path, buf[:len(buf)-1], 0600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = ReadExportedChain(path)
+	assert.Error(t, err)
+}
+
+func TestClient(t *testing.T) {
+	node, _, blocks, closeFn := newTestNode(t)
+	defer closeFn()
+	testClient(t, node.Client, blocks)
+}
+
+// testClient checks c against a remote node whose best block is blocks[testBest].
+func testClient(t *testing.T, c *Client, blocks []*wire.MsgBlock) {
+
+	sha, height, err := c.NewestSha()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(testBest), height)
+	assert.Equal(t, blocks[testBest].BlockHash(), *sha)
+
+	block, err := c.FetchBlockByHeight(50)
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[50].BlockHash(), block.BlockHash())
+	hash := blocks[60].BlockHash()
+	block, err = c.FetchBlockBySha(&hash)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, block.BlockHash())
+	header, err := c.FetchBlockHeaderByHeight(70)
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[70].BlockHash(), header.BlockHash())
+
+	// not yet connected by the remote node
+	block, err = c.FetchBlockByHeight(testBest + 1)
+	assert.Nil(t, err)
+	assert.Nil(t, block)
+	header, err = c.FetchBlockHeaderByHeight(testBest + 1)
+	assert.Nil(t, err)
+	assert.Nil(t, header)
+	_, err = c.FetchBlockShaByHeight(testBest + 1)
+	assert.Equal(t, storage.ErrNotFound, err)
+
+	tx := blocks[25].Transactions[2]
+	txHash := tx.TxHash()
+	found, err := c.FetchTxBySha(&txHash)
+	assert.Nil(t, err)
+	assert.Equal(t, txHash, found.TxHash())
+	found, err = c.FetchLastTxUntilHeight(&txHash, 24)
+	assert.Nil(t, err)
+	assert.Nil(t, found)
+	found, err = c.FetchLastTxUntilHeight(&txHash, 25)
+	assert.Nil(t, err)
+	assert.Equal(t, txHash, found.TxHash())
+	missing := blocks[testBest+1].Transactions[0].TxHash()
+	found, err = c.FetchTxBySha(&missing)
+	assert.Nil(t, err)
+	assert.Nil(t, found)
+
+	class, pops := txscript.GetScriptInfo(tx.TxOut[0].PkScript)
+	_, scriptHash, err := txscript.GetParsedOpcode(pops, class)
+	if err != nil {
+		t.Fatal(err)
+	}
+	used, err := c.CheckScriptHashUsed(scriptHash[:])
+	assert.Nil(t, err)
+	assert.True(t, used)
+	used, err = c.CheckScriptHashUsed(make([]byte, 32))
+	assert.Nil(t, err)
+	assert.False(t, used)
+
+	related, err := c.FetchScriptHashRelatedTx([][]byte{scriptHash[:]}, 0, testBest+1, nil)
+	assert.Nil(t, err)
+	assert.Contains(t, related.Heights(), uint64(25))
+	matched := false
+	for _, loc := range related.Get(25) {
+		found, err = c.FetchTxByLoc(25, loc)
+		assert.Nil(t, err)
+		if found.TxHash() == txHash {
+			matched = true
+		}
+		blkLoc, err := c.FetchBlockLocByHeight(25)
+		assert.Nil(t, err)
+		byFileLoc, err := c.FetchTxByFileLoc(blkLoc, loc)
+		assert.Nil(t, err)
+		assert.Equal(t, found.TxHash(), byFileLoc.TxHash())
+	}
+	assert.True(t, matched)
+	heights := related.Heights()
+	for i := 1; i < len(heights); i++ {
+		assert.True(t, heights[i-1] < heights[i])
+	}
+	for _, height := range heights {
+		assert.True(t, height <= testBest)
+	}
+}
+
+func TestNode(t *testing.T) {
+	node, replay, blocks, closeFn := newTestNode(t)
+	defer closeFn()
+
+	l := &mockListener{
+		blocks: make(chan *wire.MsgBlock, len(blocks)),
+		txs:    make(chan *wire.MsgTx, 10),
+	}
+	node.RegisterListener(l)
+	if err := node.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer node.Stop()
+	assert.Equal(t, uint64(testBest), node.BestBlockHeight())
+	assert.Equal(t, blocks[0].Header.ChainID.String(), node.ChainID())
+
+	replay.Advance(testBest + 5)
+	for height := uint64(testBest + 1); height <= testBest+5; height++ {
+		select {
+		case block := <-l.blocks:
+			assert.Equal(t, blocks[height].BlockHash(), block.BlockHash())
+		case <-time.After(5 * time.Second):
+			t.Fatalf("block %d not connected", height)
+		}
+	}
+	assert.Equal(t, uint64(testBest+5), node.BestBlockHeight())
+
+	// submit a transaction of a block not yet connected
+	var tx *wire.MsgTx
+	var height uint64
+	for height = testBest + 6; height < uint64(len(blocks)); height++ {
+		if len(blocks[height].Transactions) > 1 {
+			tx = blocks[height].Transactions[1]
+			break
+		}
+	}
+	if tx == nil {
+		t.Fatal("no transaction to submit")
+	}
+	assert.Nil(t, node.SubmitTx(tx))
+	assert.NotNil(t, node.SubmitTx(tx))
+	select {
+	case received := <-l.txs:
+		assert.Equal(t, tx.TxHash(), received.TxHash())
+	case <-time.After(5 * time.Second):
+		t.Fatal("transaction not received")
+	}
+	txHash := tx.TxHash()
+	op := tx.TxIn[0].PreviousOutPoint
+	assert.True(t, node.CheckPoolOutPointSpend(&op))
+	assert.NotNil(t, node.FetchMempoolTx(&txHash))
+
+	// the transaction leaves the mempool once it is mined
+	replay.Advance(height)
+	for connected := false; !connected; {
+		select {
+		case block := <-l.blocks:
+			connected = block.Header.Height == height
+		case <-time.After(5 * time.Second):
+			t.Fatalf("block %d not connected", height)
+		}
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for node.CheckPoolOutPointSpend(&op) && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.False(t, node.CheckPoolOutPointSpend(&op))
+	assert.Equal(t, height, node.BestBlockHeight())
+
+	node.UnregisterListener(l)
+	replay.Advance(height + 1)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 0, len(l.blocks))
+}
+
+func TestClient_Reorg(t *testing.T) {
+	_, replay, blocks, closeFn := newTestNode(t)
+	defer closeFn()
+
+	// the handler answers for height 25 with block 26 once reorged, and for
+	// the hash of block 30 with block 31
+	reorged := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case reorged && r.URL.Path == "/v1/chain/headers/25":
+			r.URL.Path = "/v1/chain/headers/26"
+		case r.URL.Path == "/v1/chain/blocks/hash/"+blocks[30].BlockHash().String():
+			r.URL.Path = "/v1/chain/blocks/hash/" + blocks[31].BlockHash().String()
+		}
+		replay.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+	c := NewClient(ts.URL)
+
+	coinbaseLoc := func(block *wire.MsgBlock) *wire.TxLoc {
+		raw, err := block.Bytes(wire.DB)
+		if err != nil {
+			t.Fatal(err)
+		}
+		locs, err := wire.NewEmptyMsgBlock().DeserializeTxLoc(bytes.NewBuffer(raw))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &locs[0]
+	}
+	tx, err := c.FetchTxByLoc(25, coinbaseLoc(blocks[25]))
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[25].Transactions[0].TxHash(), tx.TxHash())
+
+	// the block cached for the height is not used once the chain reorganized
+	reorged = true
+	tx, err = c.FetchTxByLoc(25, coinbaseLoc(blocks[26]))
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[26].Transactions[0].TxHash(), tx.TxHash())
+
+	// blocks not matching the requested hash are rejected
+	hash := blocks[30].BlockHash()
+	_, err = c.FetchBlockBySha(&hash)
+	assert.Error(t, err)
+}
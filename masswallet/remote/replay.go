@@ -0,0 +1,400 @@
+package remote
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/massnetorg/mass-core/txscript"
+	"github.com/massnetorg/mass-core/wire"
+)
+
+// ReadExportedChain reads the blocks of a file written by the exportchain
+// command of the full node, the file is gzip compressed if named *.gz.
+func ReadExportedChain(path string) ([]*wire.MsgBlock, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		if r, err = gzip.NewReader(r); err != nil {
+			return nil, err
+		}
+	}
+
+	blocks := make([]*wire.MsgBlock, 0)
+	for {
+		block, err := decodeExportedBlock(r)
+		if err == io.EOF {
+			return blocks, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("at block %d: %v", len(blocks), err)
+		}
+		blocks = append(blocks, block)
+	}
+}
+
+// decodeExportedBlock reads a block of the exportchain format, the size of
+// height, hash and raw block in 4 bytes followed by the height in 8 bytes, the
+// hash and the raw block in wire.DB encoding.
+func decodeExportedBlock(r io.Reader) (*wire.MsgBlock, error) {
+	var sizeBuf [4]byte
+	if _, err := io.ReadFull(r, sizeBuf[:]); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(sizeBuf[:])
+	if size < 8+wire.HashSize {
+		return nil, fmt.Errorf("invalid block size %d", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	height := binary.BigEndian.Uint64(data[0:8])
+	var hash wire.Hash
+	copy(hash[:], data[8:8+wire.HashSize])
+	block := wire.NewEmptyMsgBlock()
+	if err := block.SetBytes(data[8+wire.HashSize:], wire.DB); err != nil {
+		return nil, err
+	}
+	if height != block.Header.Height {
+		return nil, fmt.Errorf("height mismatched %d, %d", height, block.Header.Height)
+	}
+	if hash != block.BlockHash() {
+		return nil, fmt.Errorf("hash mismatched %s, %s", hash, block.BlockHash())
+	}
+	return block, nil
+}
+
+type replayBlock struct {
+	raw   []byte
+	block *wire.MsgBlock
+	locs  []wire.TxLoc
+}
+
+type replayTx struct {
+	height uint64
+	index  int
+}
+
+// ReplayServer serves the chain api from blocks in memory, standing in for a
+// full node in tests and local development. The blocks are revealed one by one
+// with Advance as if the node was connecting them.
+type ReplayServer struct {
+	mu           sync.RWMutex
+	blocks       []*replayBlock
+	best         uint64
+	hashes       map[wire.Hash]uint64
+	txs          map[wire.Hash][]replayTx
+	scriptHashes map[[32]byte]map[uint64][]wire.TxLoc
+	mempool      []*wire.MsgTx
+}
+
+// NewReplayServer returns a server of the blocks, which start from the
+// genesis block, with the best block at height best.
+func NewReplayServer(blocks []*wire.MsgBlock, best uint64) (*ReplayServer, error) {
+	if len(blocks) == 0 {
+		return nil, errors.New("no blocks to replay")
+	}
+	s := &ReplayServer{
+		blocks:       make([]*replayBlock, 0, len(blocks)),
+		hashes:       make(map[wire.Hash]uint64),
+		txs:          make(map[wire.Hash][]replayTx),
+		scriptHashes: make(map[[32]byte]map[uint64][]wire.TxLoc),
+	}
+	outputs := make(map[wire.OutPoint][]byte)
+	for i, block := range blocks {
+		if block.Header.Height != uint64(i) {
+			return nil, fmt.Errorf("unexpected block height %d, expect %d", block.Header.Height, i)
+		}
+		raw, err := block.Bytes(wire.DB)
+		if err != nil {
+			return nil, err
+		}
+		locs, err := wire.NewEmptyMsgBlock().DeserializeTxLoc(bytes.NewBuffer(raw))
+		if err != nil {
+			return nil, err
+		}
+		height := block.Header.Height
+		s.blocks = append(s.blocks, &replayBlock{raw: raw, block: block, locs: locs})
+		s.hashes[block.BlockHash()] = height
+		for idx, tx := range block.Transactions {
+			txHash := tx.TxHash()
+			s.txs[txHash] = append(s.txs[txHash], replayTx{height: height, index: idx})
+			if idx > 0 {
+				for _, txIn := range tx.TxIn {
+					s.indexScript(outputs[txIn.PreviousOutPoint], height, locs[idx])
+				}
+			}
+			for i, txOut := range tx.TxOut {
+				outputs[wire.OutPoint{Hash: txHash, Index: uint32(i)}] = txOut.PkScript
+				s.indexScript(txOut.PkScript, height, locs[idx])
+			}
+		}
+	}
+	s.Advance(best)
+	return s, nil
+}
+
+// indexScript relates the transaction to the script hash of the pkScript, the
+// same way the address index of the full node does.
+func (s *ReplayServer) indexScript(pkScript []byte, height uint64, loc wire.TxLoc) {
+	class, pops := txscript.GetScriptInfo(pkScript)
+	var scriptHash [32]byte
+	switch class {
+	case txscript.WitnessV0ScriptHashTy, txscript.StakingScriptHashTy:
+		_, rsh, err := txscript.GetParsedOpcode(pops, class)
+		if err != nil {
+			return
+		}
+		scriptHash = rsh
+	case txscript.BindingScriptHashTy:
+		holder, _, err := txscript.GetParsedBindingOpcode(pops)
+		if err != nil {
+			return
+		}
+		copy(scriptHash[:], holder)
+	default:
+		return
+	}
+	m, ok := s.scriptHashes[scriptHash]
+	if !ok {
+		m = make(map[uint64][]wire.TxLoc)
+		s.scriptHashes[scriptHash] = m
+	}
+	for _, l := range m[height] {
+		if l == loc {
+			return
+		}
+	}
+	m[height] = append(m[height], loc)
+}
+
+// Advance reveals the blocks up to height, it returns the new best height.
+// The mempool transactions included by the revealed blocks are removed.
+func (s *ReplayServer) Advance(height uint64) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if height >= uint64(len(s.blocks)) {
+		height = uint64(len(s.blocks)) - 1
+	}
+	if height < s.best {
+		return s.best
+	}
+	s.best = height
+	mempool := make([]*wire.MsgTx, 0, len(s.mempool))
+	for _, tx := range s.mempool {
+		if _, ok := s.findTx(tx.TxHash(), height); !ok {
+			mempool = append(mempool, tx)
+		}
+	}
+	s.mempool = mempool
+	return s.best
+}
+
+func (s *ReplayServer) findTx(hash wire.Hash, until uint64) (replayTx, bool) {
+	list := s.txs[hash]
+	for i := len(list) - 1; i >= 0; i-- {
+		if list[i].height <= until {
+			return list[i], true
+		}
+	}
+	return replayTx{}, false
+}
+
+func (s *ReplayServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveChainAPI(w, r, s)
+}
+
+func (s *ReplayServer) serveBest() (*bestResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	block := s.blocks[s.best].block
+	return &bestResult{
+		Height:  s.best,
+		Hash:    block.BlockHash().String(),
+		ChainID: s.blocks[0].block.Header.ChainID.String(),
+	}, nil
+}
+
+func (s *ReplayServer) getBlock(height uint64) (*replayBlock, error) {
+	if height > s.best {
+		return nil, errNotFound
+	}
+	return s.blocks[height], nil
+}
+
+func (s *ReplayServer) serveHeader(heightStr string) (*headerResult, error) {
+	height, err := strconv.ParseUint(heightStr, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	blk, err := s.getBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := blk.block.Header.Bytes(wire.DB)
+	if err != nil {
+		return nil, err
+	}
+	return &headerResult{
+		Height: height,
+		Hash:   blk.block.BlockHash().String(),
+		Header: hex.EncodeToString(buf),
+	}, nil
+}
+
+func (s *ReplayServer) serveBlock(heightStr, hashStr string) (*blockResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var height uint64
+	if len(hashStr) > 0 {
+		hash, err := wire.NewHashFromStr(hashStr)
+		if err != nil {
+			return nil, err
+		}
+		h, ok := s.hashes[*hash]
+		if !ok {
+			return nil, errNotFound
+		}
+		height = h
+	} else {
+		h, err := strconv.ParseUint(heightStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		height = h
+	}
+	blk, err := s.getBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	return &blockResult{
+		Height: height,
+		Hash:   blk.block.BlockHash().String(),
+		Block:  hex.EncodeToString(blk.raw),
+	}, nil
+}
+
+func (s *ReplayServer) serveTx(hashStr, untilStr string) (*txResult, error) {
+	hash, err := wire.NewHashFromStr(hashStr)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	until := s.best
+	if len(untilStr) > 0 {
+		if until, err = strconv.ParseUint(untilStr, 10, 64); err != nil {
+			return nil, err
+		}
+		if until > s.best {
+			until = s.best
+		}
+	}
+	rtx, ok := s.findTx(*hash, until)
+	if !ok {
+		return nil, errNotFound
+	}
+	tx, err := encodeTx(s.blocks[rtx.height].block.Transactions[rtx.index])
+	if err != nil {
+		return nil, err
+	}
+	return &txResult{Height: rtx.height, Tx: tx}, nil
+}
+
+func (s *ReplayServer) serveSubmitTx(req *submitTxRequest) (*submitTxResult, error) {
+	tx, err := decodeTx(req.Tx)
+	if err != nil {
+		return nil, err
+	}
+	hash := tx.TxHash()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.findTx(hash, s.best); ok {
+		return nil, fmt.Errorf("transaction %s already exists", hash)
+	}
+	for _, mtx := range s.mempool {
+		if mtx.TxHash() == hash {
+			return nil, fmt.Errorf("transaction %s already exists", hash)
+		}
+	}
+	s.mempool = append(s.mempool, tx)
+	return &submitTxResult{TxID: hash.String()}, nil
+}
+
+func (s *ReplayServer) serveRelatedTx(req *relatedTxRequest) (*relatedTxResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	stop := req.Stop
+	if stop > s.best+1 {
+		stop = s.best + 1
+	}
+	found := make(map[uint64][]*wire.TxLoc)
+	for _, str := range req.ScriptHashes {
+		scriptHash, err := decodeScriptHash(str)
+		if err != nil {
+			return nil, err
+		}
+		for height, locs := range s.scriptHashes[scriptHash] {
+			if height < req.Start || height >= stop {
+				continue
+			}
+		next:
+			for i := range locs {
+				for _, l := range found[height] {
+					if *l == locs[i] {
+						continue next
+					}
+				}
+				found[height] = append(found[height], &locs[i])
+			}
+		}
+	}
+	return newRelatedTxResult(found), nil
+}
+
+func (s *ReplayServer) serveScriptHashUsed(req *scriptHashUsedRequest) (*scriptHashUsedResult, error) {
+	scriptHash, err := decodeScriptHash(req.ScriptHash)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for height := range s.scriptHashes[scriptHash] {
+		if height <= s.best {
+			return &scriptHashUsedResult{Used: true}, nil
+		}
+	}
+	return &scriptHashUsedResult{Used: false}, nil
+}
+
+func (s *ReplayServer) serveMempool() (*mempoolResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := &mempoolResult{Txs: make([]string, 0, len(s.mempool))}
+	for _, tx := range s.mempool {
+		if str, err := encodeTx(tx); err == nil {
+			res.Txs = append(res.Txs, str)
+		}
+	}
+	return res, nil
+}
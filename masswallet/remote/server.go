@@ -0,0 +1,328 @@
+package remote
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/massnetorg/mass-core/database"
+	"github.com/massnetorg/mass-core/database/storage"
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/wire"
+)
+
+// chainAPI answers the requests of the chain api, parameters are passed as
+// found in the request path.
+type chainAPI interface {
+	serveBest() (*bestResult, error)
+	serveHeader(heightStr string) (*headerResult, error)
+	serveBlock(heightStr, hashStr string) (*blockResult, error)
+	serveTx(hashStr, untilStr string) (*txResult, error)
+	serveSubmitTx(req *submitTxRequest) (*submitTxResult, error)
+	serveRelatedTx(req *relatedTxRequest) (*relatedTxResult, error)
+	serveScriptHashUsed(req *scriptHashUsedRequest) (*scriptHashUsedResult, error)
+	serveMempool() (*mempoolResult, error)
+}
+
+// serveChainAPI routes the request to api and writes its json response.
+func serveChainAPI(w http.ResponseWriter, r *http.Request, api chainAPI) {
+	path := strings.TrimPrefix(r.URL.Path, "/v1/chain/")
+	parts := strings.Split(path, "/")
+	var (
+		res interface{}
+		err error
+	)
+	switch {
+	case r.Method == http.MethodGet && path == "best":
+		res, err = api.serveBest()
+	case r.Method == http.MethodGet && len(parts) == 2 && parts[0] == "headers":
+		res, err = api.serveHeader(parts[1])
+	case r.Method == http.MethodGet && len(parts) == 2 && parts[0] == "blocks":
+		res, err = api.serveBlock(parts[1], "")
+	case r.Method == http.MethodGet && len(parts) == 3 && parts[0] == "blocks" && parts[1] == "hash":
+		res, err = api.serveBlock("", parts[2])
+	case r.Method == http.MethodGet && len(parts) == 2 && parts[0] == "txs":
+		res, err = api.serveTx(parts[1], r.URL.Query().Get("until"))
+	case r.Method == http.MethodPost && path == "txs":
+		var req submitTxRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err == nil {
+			res, err = api.serveSubmitTx(&req)
+		}
+	case r.Method == http.MethodPost && path == "scripthashes/related":
+		var req relatedTxRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err == nil {
+			res, err = api.serveRelatedTx(&req)
+		}
+	case r.Method == http.MethodPost && path == "scripthashes/used":
+		var req scriptHashUsedRequest
+		if err = json.NewDecoder(r.Body).Decode(&req); err == nil {
+			res, err = api.serveScriptHashUsed(&req)
+		}
+	case r.Method == http.MethodGet && path == "mempool":
+		res, err = api.serveMempool()
+	default:
+		err = errNotFound
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case err == errNotFound:
+		w.WriteHeader(http.StatusNotFound)
+		res = &errorResult{Error: err.Error()}
+	case err != nil:
+		w.WriteHeader(http.StatusBadRequest)
+		res = &errorResult{Error: err.Error()}
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+func decodeScriptHash(s string) ([32]byte, error) {
+	var scriptHash [32]byte
+	buf, err := hex.DecodeString(s)
+	if err != nil || len(buf) != len(scriptHash) {
+		return scriptHash, fmt.Errorf("invalid script hash %s", s)
+	}
+	copy(scriptHash[:], buf)
+	return scriptHash, nil
+}
+
+func newRelatedTxResult(found map[uint64][]*wire.TxLoc) *relatedTxResult {
+	res := &relatedTxResult{Heights: make([]relatedTxHeight, 0, len(found))}
+	for height, locs := range found {
+		h := relatedTxHeight{Height: height, Locs: make([]txLoc, 0, len(locs))}
+		for _, loc := range locs {
+			h.Locs = append(h.Locs, txLoc{Start: loc.TxStart, Len: loc.TxLen})
+		}
+		res.Heights = append(res.Heights, h)
+	}
+	sort.Slice(res.Heights, func(i, j int) bool {
+		return res.Heights[i].Height < res.Heights[j].Height
+	})
+	return res
+}
+
+// Mempool is the transaction pool of the node served by Server.
+type Mempool interface {
+	// MempoolTxs returns the transactions in the pool.
+	MempoolTxs() []*wire.MsgTx
+	// SubmitTx validates tx and adds it to the pool.
+	SubmitTx(tx *wire.MsgTx) error
+}
+
+// Server serves the chain api from the chain database of a full node, it is
+// what Client talks to.
+type Server struct {
+	addr    string
+	srv     *http.Server
+	db      database.Db
+	mempool Mempool
+}
+
+func NewServer(addr string, db database.Db, mempool Mempool) *Server {
+	s := &Server{addr: addr, db: db, mempool: mempool}
+	s.srv = &http.Server{Addr: addr, Handler: s}
+	return s
+}
+
+// Start listens on the address of the server and serves in background.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to start chain api server", logging.LogFormat{"address": s.addr, "error": err})
+		return err
+	}
+	go func() {
+		if err := s.srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logging.CPrint(logging.ERROR, "chain api server stopped", logging.LogFormat{"address": s.addr, "error": err})
+		}
+	}()
+	logging.CPrint(logging.INFO, "chain api server started", logging.LogFormat{"address": s.addr})
+	return nil
+}
+
+func (s *Server) Stop() {
+	s.srv.Close()
+	logging.CPrint(logging.INFO, "chain api server stopped", logging.LogFormat{"address": s.addr})
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	serveChainAPI(w, r, s)
+}
+
+func isNotFound(err error) bool {
+	return err == storage.ErrNotFound || err == database.ErrBlockShaMissing || err == database.ErrTxShaMissing
+}
+
+func (s *Server) serveBest() (*bestResult, error) {
+	sha, height, err := s.db.NewestSha()
+	if err != nil {
+		return nil, err
+	}
+	genesis, err := s.headerByHeight(0)
+	if err != nil {
+		return nil, err
+	}
+	return &bestResult{
+		Height:  height,
+		Hash:    sha.String(),
+		ChainID: genesis.ChainID.String(),
+	}, nil
+}
+
+func (s *Server) headerByHeight(height uint64) (*wire.BlockHeader, error) {
+	sha, err := s.db.FetchBlockShaByHeight(height)
+	if isNotFound(err) {
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	header, err := s.db.FetchBlockHeaderBySha(sha)
+	if isNotFound(err) {
+		return nil, errNotFound
+	}
+	return header, err
+}
+
+func (s *Server) serveHeader(heightStr string) (*headerResult, error) {
+	height, err := strconv.ParseUint(heightStr, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	header, err := s.headerByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := header.Bytes(wire.DB)
+	if err != nil {
+		return nil, err
+	}
+	return &headerResult{
+		Height: height,
+		Hash:   header.BlockHash().String(),
+		Header: hex.EncodeToString(buf),
+	}, nil
+}
+
+func (s *Server) serveBlock(heightStr, hashStr string) (*blockResult, error) {
+	var (
+		sha *wire.Hash
+		err error
+	)
+	if len(hashStr) > 0 {
+		sha, err = wire.NewHashFromStr(hashStr)
+	} else {
+		var height uint64
+		if height, err = strconv.ParseUint(heightStr, 10, 64); err != nil {
+			return nil, err
+		}
+		sha, err = s.db.FetchBlockShaByHeight(height)
+	}
+	if isNotFound(err) {
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	block, err := s.db.FetchBlockBySha(sha)
+	if isNotFound(err) {
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	// transaction locations are relative to the wire.DB encoding
+	raw, err := block.MsgBlock().Bytes(wire.DB)
+	if err != nil {
+		return nil, err
+	}
+	return &blockResult{
+		Height: block.Height(),
+		Hash:   block.Hash().String(),
+		Block:  hex.EncodeToString(raw),
+	}, nil
+}
+
+func (s *Server) serveTx(hashStr, untilStr string) (*txResult, error) {
+	hash, err := wire.NewHashFromStr(hashStr)
+	if err != nil {
+		return nil, err
+	}
+	until := ^uint64(0)
+	if len(untilStr) > 0 {
+		if until, err = strconv.ParseUint(untilStr, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	replies, err := s.db.FetchTxBySha(hash)
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+	for i := len(replies) - 1; i >= 0; i-- {
+		if replies[i].Height <= until {
+			tx, err := encodeTx(replies[i].Tx)
+			if err != nil {
+				return nil, err
+			}
+			return &txResult{Height: replies[i].Height, Tx: tx}, nil
+		}
+	}
+	return nil, errNotFound
+}
+
+func (s *Server) serveSubmitTx(req *submitTxRequest) (*submitTxResult, error) {
+	tx, err := decodeTx(req.Tx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.mempool.SubmitTx(tx); err != nil {
+		return nil, err
+	}
+	return &submitTxResult{TxID: tx.TxHash().String()}, nil
+}
+
+func (s *Server) serveRelatedTx(req *relatedTxRequest) (*relatedTxResult, error) {
+	scriptHashes := make([][]byte, 0, len(req.ScriptHashes))
+	for _, str := range req.ScriptHashes {
+		scriptHash, err := decodeScriptHash(str)
+		if err != nil {
+			return nil, err
+		}
+		scriptHashes = append(scriptHashes, scriptHash[:])
+	}
+	found, err := s.db.FetchScriptHashRelatedTx(scriptHashes, req.Start, req.Stop)
+	if err != nil {
+		return nil, err
+	}
+	return newRelatedTxResult(found), nil
+}
+
+func (s *Server) serveScriptHashUsed(req *scriptHashUsedRequest) (*scriptHashUsedResult, error) {
+	scriptHash, err := decodeScriptHash(req.ScriptHash)
+	if err != nil {
+		return nil, err
+	}
+	used, err := s.db.CheckScriptHashUsed(scriptHash[:])
+	if err != nil {
+		return nil, err
+	}
+	return &scriptHashUsedResult{Used: used}, nil
+}
+
+func (s *Server) serveMempool() (*mempoolResult, error) {
+	txs := s.mempool.MempoolTxs()
+	res := &mempoolResult{Txs: make([]string, 0, len(txs))}
+	for _, tx := range txs {
+		str, err := encodeTx(tx)
+		if err != nil {
+			return nil, err
+		}
+		res.Txs = append(res.Txs, str)
+	}
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package remote
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/massnetorg/mass-core/database"
+	"github.com/massnetorg/mass-core/database/memdb"
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/wire"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockMempool struct {
+	mu  sync.Mutex
+	txs []*wire.MsgTx
+}
+
+func (m *mockMempool) MempoolTxs() []*wire.MsgTx {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*wire.MsgTx(nil), m.txs...)
+}
+
+func (m *mockMempool) SubmitTx(tx *wire.MsgTx) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, mtx := range m.txs {
+		if mtx.TxHash() == tx.TxHash() {
+			return fmt.Errorf("transaction %s already exists", tx.TxHash())
+		}
+	}
+	m.txs = append(m.txs, tx)
+	return nil
+}
+
+func TestServer(t *testing.T) {
+	blocks := loadMockBlocks(t)
+	db, err := memdb.NewMemDb()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		db.Close()
+		os.RemoveAll("./blocks")
+	}()
+	if err = db.InitByGenesisBlock(massutil.NewBlock(blocks[0])); err != nil {
+		t.Fatal(err)
+	}
+	// the address index is taken from the replay server, which indexes
+	// scripts the same way as the full node
+	replay, err := NewReplayServer(blocks, testBest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, block := range blocks[1 : testBest+1] {
+		if err = db.SubmitBlock(massutil.NewBlock(block)); err != nil {
+			t.Fatal(err)
+		}
+		height, hash := block.Header.Height, block.BlockHash()
+		index := make(database.TxAddrIndex)
+		for scriptHash, m := range replay.scriptHashes {
+			for i := range m[height] {
+				index[scriptHash] = append(index[scriptHash], &m[height][i])
+			}
+		}
+		if err = db.SubmitAddrIndex(&hash, height, &database.AddrIndexData{TxIndex: index}); err != nil {
+			t.Fatal(err)
+		}
+		if err = db.Commit(hash); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ts := httptest.NewServer(NewServer("", db, &mockMempool{}))
+	defer ts.Close()
+	c := NewClient(ts.URL)
+	testClient(t, c, blocks)
+
+	tx := blocks[testBest+1].Transactions[0]
+	assert.Nil(t, c.submitTx(tx))
+	assert.Error(t, c.submitTx(tx))
+	txs, err := c.fetchMempool()
+	assert.Nil(t, err)
+	if assert.Equal(t, 1, len(txs)) {
+		assert.Equal(t, tx.TxHash(), txs[0].TxHash())
+	}
+	best, err := c.fetchBest()
+	assert.Nil(t, err)
+	assert.Equal(t, blocks[0].Header.ChainID.String(), best.ChainID)
+}
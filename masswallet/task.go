@@ -9,6 +9,7 @@ const (
 
 	WalletTaskImport = iota
 	WalletTaskRemove
+	WalletTaskRescan
 )
 
 type WalletTask struct {
@@ -54,3 +55,14 @@ func (c *WalletTaskChan) PushRemove(walletId string) {
 		logging.CPrint(logging.ERROR, "PushRemove failed", logging.LogFormat{"walletId": walletId})
 	}
 }
+
+func (c *WalletTaskChan) PushRescan(walletId string) {
+	select {
+	case c.C <- WalletTask{
+		taskType: WalletTaskRescan,
+		walletId: walletId,
+	}:
+	default:
+		logging.CPrint(logging.ERROR, "PushRescan failed", logging.LogFormat{"walletId": walletId})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/massnetorg/mass-core/blockchain"
@@ -47,12 +48,7 @@ type BindingOutput struct {
 	Amount        massutil.Amount
 }
 
-func (w *WalletManager) constructTxIn(inputs []*TxIn, lockTime uint64) (*wire.MsgTx, []utils.PkScript, massutil.Amount, error) {
-	am := w.ksmgr.CurrentKeystore()
-	if am == nil {
-		return nil, nil, massutil.ZeroAmount(), ErrNoWalletInUse
-	}
-
+func (w *WalletManager) constructTxIn(am *keystore.AddrManager, inputs []*TxIn, lockTime uint64) (*wire.MsgTx, []utils.PkScript, massutil.Amount, error) {
 	mtx := &wire.MsgTx{}
 	totalValue := massutil.ZeroAmount()
 	senders := make([]utils.PkScript, 0, len(inputs))
@@ -68,7 +64,7 @@ func (w *WalletManager) constructTxIn(inputs []*TxIn, lockTime uint64) (*wire.Ms
 		if lockTime != 0 {
 			txIn.Sequence = wire.MaxTxInSequenceNum - 1 // sequence lock disabled
 		}
-		prevTx, block, err := w.existsMsgTx(&txIn.PreviousOutPoint)
+		prevTx, block, err := w.existsMsgTx(am.Name(), &txIn.PreviousOutPoint)
 		if err == txmgr.ErrNotFound {
 			logging.CPrint(logging.INFO, "mined prev tx not found, check unmined tx", logging.LogFormat{})
 			prevTx, err = w.existsUnminedTx(&txIn.PreviousOutPoint.Hash)
@@ -207,15 +203,22 @@ func messageToHex(msg wire.Message) (string, error) {
 }
 
 func (w *WalletManager) EstimateTxFee(
+	walletId string,
 	amounts map[string]massutil.Amount,
 	lockTime uint64,
 	userTxFee massutil.Amount,
 	fromAddr,
 	changeAddr string,
 	payload []byte,
+	selector CoinSelector,
+	feeMode FeeMode,
 ) (msgTx *wire.MsgTx, fee massutil.Amount, err error) {
 
-	addrs, err := w.prepareFromAddresses(fromAddr)
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	addrs, err := w.prepareFromAddresses(am, fromAddr)
 	if err != nil {
 		return nil, massutil.ZeroAmount(), err
 	}
@@ -230,17 +233,21 @@ func (w *WalletManager) EstimateTxFee(
 		}
 		msgTx.AddTxOut(txOut)
 	}
-	u, err := w.autoConstructTxInAndChangeTxOut(msgTx, lockTime, addrs, userTxFee, changeAddr)
+	u, err := w.autoConstructTxInAndChangeTxOut(am, msgTx, lockTime, addrs, userTxFee, w.feeRate(feeMode), changeAddr, selector)
 	if err != nil {
 		return nil, massutil.ZeroAmount(), err
 	}
 	return msgTx, u, err
 }
 
-func (w *WalletManager) EstimateStakingTxFee(outputs []*StakingTxOut, LockTime uint64, userTxFee massutil.Amount,
-	fromAddr, changeAddr string) (msgTx *wire.MsgTx, fee massutil.Amount, err error) {
+func (w *WalletManager) EstimateStakingTxFee(walletId string, outputs []*StakingTxOut, LockTime uint64, userTxFee massutil.Amount,
+	fromAddr, changeAddr string, feeMode FeeMode) (msgTx *wire.MsgTx, fee massutil.Amount, err error) {
 
-	addrs, err := w.prepareFromAddresses(fromAddr)
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	addrs, err := w.prepareFromAddresses(am, fromAddr)
 	if err != nil {
 		return nil, massutil.ZeroAmount(), err
 	}
@@ -251,17 +258,21 @@ func (w *WalletManager) EstimateStakingTxFee(outputs []*StakingTxOut, LockTime u
 		return nil, massutil.ZeroAmount(), err
 	}
 
-	u, err := w.autoConstructTxInAndChangeTxOut(msgTx, LockTime, addrs, userTxFee, changeAddr)
+	u, err := w.autoConstructTxInAndChangeTxOut(am, msgTx, LockTime, addrs, userTxFee, w.feeRate(feeMode), changeAddr, nil)
 	if err != nil {
 		return nil, massutil.ZeroAmount(), err
 	}
 	return msgTx, u, err
 }
 
-func (w *WalletManager) EstimateBindingTxFee(outputs []*BindingOutput, LockTime uint64, userTxFee massutil.Amount,
-	fromAddr, changeAddr string) (msgTx *wire.MsgTx, fee massutil.Amount, err error) {
+func (w *WalletManager) EstimateBindingTxFee(walletId string, outputs []*BindingOutput, LockTime uint64, userTxFee massutil.Amount,
+	fromAddr, changeAddr string, feeMode FeeMode) (msgTx *wire.MsgTx, fee massutil.Amount, err error) {
 
-	addrs, err := w.prepareFromAddresses(fromAddr)
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	addrs, err := w.prepareFromAddresses(am, fromAddr)
 	if err != nil {
 		return nil, massutil.ZeroAmount(), err
 	}
@@ -282,22 +293,139 @@ func (w *WalletManager) EstimateBindingTxFee(outputs []*BindingOutput, LockTime
 		msgTx.AddTxOut(txOut)
 	}
 
-	u, err := w.autoConstructTxInAndChangeTxOut(msgTx, LockTime, addrs, userTxFee, changeAddr)
+	u, err := w.autoConstructTxInAndChangeTxOut(am, msgTx, LockTime, addrs, userTxFee, w.feeRate(feeMode), changeAddr, nil)
 	if err != nil {
 		return nil, massutil.ZeroAmount(), err
 	}
 	return msgTx, u, err
 }
 
+// EstimateWithdrawTxFee builds a tx spending the withdrawable staking or binding
+// utxos of the wallet, by class, to toAddr. All of them are spent unless inputs
+// are specified. toAddr defaults to the standard address of the first input, and
+// the fee is deducted from the output.
+func (w *WalletManager) EstimateWithdrawTxFee(walletId string, class txmgr.UtxoClass, inputs []*TxIn, toAddr string,
+	userTxFee massutil.Amount) (msgTx *wire.MsgTx, fee massutil.Amount, err error) {
+
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	scriptSet := make(map[string]struct{})
+	for _, ma := range am.ManagedAddresses() {
+		scriptSet[string(ma.ScriptAddress())] = struct{}{}
+	}
+	utxos, _, err := w.getSpendableUtxos(am, scriptSet, class, len(inputs) > 0)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+
+	if len(inputs) > 0 {
+		withdrawable := make(map[wire.OutPoint]*txmgr.Credit, len(utxos))
+		for _, utxo := range utxos {
+			withdrawable[utxo.OutPoint] = utxo
+		}
+		utxos = make([]*txmgr.Credit, 0, len(inputs))
+		for _, input := range inputs {
+			txHash, err := wire.NewHashFromStr(input.TxId)
+			if err != nil {
+				return nil, massutil.ZeroAmount(), ErrShaHashFromStr
+			}
+			op := wire.OutPoint{Hash: *txHash, Index: input.Vout}
+			utxo, ok := withdrawable[op]
+			if !ok {
+				logging.CPrint(logging.ERROR, "utxo not withdrawable", logging.LogFormat{
+					"outpoint": op.String(),
+					"class":    class,
+				})
+				return nil, massutil.ZeroAmount(), ErrUTXONotExists
+			}
+			delete(withdrawable, op)
+			utxos = append(utxos, utxo)
+		}
+	} else {
+		// spend the largest ones if there are too many
+		sort.SliceStable(utxos, func(i, j int) bool {
+			return utxos[i].Amount.Cmp(utxos[j].Amount) > 0
+		})
+		if len(utxos) > maxSelectedInputs() {
+			utxos = utxos[:maxSelectedInputs()]
+		}
+	}
+	if len(utxos) == 0 {
+		return nil, massutil.ZeroAmount(), ErrInsufficientFunds
+	}
+	if len(utxos) > maxSelectedInputs() {
+		return nil, massutil.ZeroAmount(), ErrOverfullUtxo
+	}
+
+	total := massutil.ZeroAmount()
+	for _, utxo := range utxos {
+		total, err = total.Add(utxo.Amount)
+		if err != nil {
+			return nil, massutil.ZeroAmount(), ErrInvalidAmount
+		}
+	}
+	if len(toAddr) == 0 {
+		addr, err := massutil.NewAddressWitnessScriptHash(utxos[0].ScriptHash, w.chainParams)
+		if err != nil {
+			return nil, massutil.ZeroAmount(), err
+		}
+		toAddr = addr.EncodeAddress()
+	}
+
+	signedTxSize, err := w.estimateSignedSize(am, utxos, 1)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "estimate signedSize failed", logging.LogFormat{"err": err})
+		return nil, massutil.ZeroAmount(), ErrInvalidParameter
+	}
+	fee, err = blockchain.CalcMinRequiredTxRelayFee(signedTxSize, massutil.MinRelayTxFee())
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	if fee.Cmp(massutil.MinRelayTxFee()) < 0 {
+		fee = massutil.MinRelayTxFee()
+	}
+	if userTxFee.Cmp(fee) > 0 {
+		fee = userTxFee
+	}
+	amount, err := total.Sub(fee)
+	if err != nil || amount.IsZero() {
+		logging.CPrint(logging.ERROR, "withdrawable amount is not enough to pay fee", logging.LogFormat{
+			"total": total,
+			"fee":   fee,
+		})
+		return nil, massutil.ZeroAmount(), ErrInsufficientFunds
+	}
+
+	msgTx = wire.NewMsgTx()
+	txOut, err := amountToTxOut(toAddr, amount)
+	if err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	isDust, err := blockchain.IsDust(txOut, massutil.MinRelayTxFee())
+	if err != nil {
+		return nil, massutil.ZeroAmount(), ErrInvalidAmount
+	}
+	if isDust {
+		return nil, massutil.ZeroAmount(), ErrDustAmount
+	}
+	msgTx.AddTxOut(txOut)
+	if err = w.addTxIn(am, msgTx, 0, utxos); err != nil {
+		return nil, massutil.ZeroAmount(), err
+	}
+	return msgTx, fee, nil
+}
+
 //
-func (w *WalletManager) estimateSignedSize(utxos []*txmgr.Credit, TxOutLen int) (int64, error) {
+func (w *WalletManager) estimateSignedSize(am *keystore.AddrManager, utxos []*txmgr.Credit, TxOutLen int) (int64, error) {
 	signedSize := 0
 	for _, utx := range utxos {
 		txidx := utx.OutPoint.Index
 		outPoint := &utx.OutPoint
 		txIn := wire.NewTxIn(outPoint, nil)
 
-		mtx, _, err := w.existsMsgTx(&txIn.PreviousOutPoint)
+		mtx, _, err := w.existsMsgTx(am.Name(), &txIn.PreviousOutPoint)
 		if err != nil {
 			return 0, err
 		}
@@ -343,8 +471,8 @@ func (w *WalletManager) estimateSignedSize(utxos []*txmgr.Credit, TxOutLen int)
 	return int64(signedSize + 63*TxOutLen + 12), nil
 }
 
-func (w *WalletManager) findEligibleUtxos(amount massutil.Amount, witnessAddr []string) (
-	[]*txmgr.Credit, string, massutil.Amount, bool, error) {
+func (w *WalletManager) findEligibleUtxos(am *keystore.AddrManager, amount massutil.Amount, witnessAddr []string,
+	selector CoinSelector) ([]*txmgr.Credit, string, massutil.Amount, bool, error) {
 	zeroAmount := massutil.ZeroAmount()
 	if len(witnessAddr) == 0 {
 		logging.CPrint(logging.ERROR, "inputParams can not be nil",
@@ -361,19 +489,30 @@ func (w *WalletManager) findEligibleUtxos(amount massutil.Amount, witnessAddr []
 		return nil, "", zeroAmount, false, ErrInvalidParameter
 	}
 
-	utxos, overfull, err := w.getUtxosExcludeBindingAndStaking(witnessAddr, amount)
+	scriptSet, err := scriptSetOf(am, witnessAddr)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "get utxos failed", logging.LogFormat{"err": err})
+		return nil, "", zeroAmount, false, err
+	}
+	utxos, total, err := w.getSpendableUtxos(am, scriptSet, txmgr.ClassStandardUtxo, false)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "get utxos failed", logging.LogFormat{"err": err})
 		return nil, "", zeroAmount, false, err
 	}
 
-	selections, sumSelection, _, err := optOutputs(amount, utxos)
+	selections, err := selector.Select(amount, utxos, maxSelectedInputs())
 	if err != nil {
 		return nil, "", zeroAmount, false, err
 	}
+	sumSelection := zeroAmount
+	for _, item := range selections {
+		sumSelection, err = sumSelection.Add(item.Amount)
+		if err != nil {
+			return nil, "", zeroAmount, false, err
+		}
+	}
 	firstAddr := ""
 	if len(selections) > 0 {
-		am := w.ksmgr.CurrentKeystore()
 		for _, addr := range witnessAddr {
 			ma, _ := am.Address(addr)
 			if bytes.Equal(ma.ScriptAddress(), selections[0].ScriptHash) {
@@ -391,14 +530,12 @@ func (w *WalletManager) findEligibleUtxos(amount massutil.Amount, witnessAddr []
 		}
 	}
 
-	return selections, firstAddr, sumSelection, overfull && len(utxos) == len(selections), nil
+	// enough funds, but too many inputs to spend them
+	overfull := sumSelection.Cmp(amount) < 0 && total.Cmp(amount) >= 0
+	return selections, firstAddr, sumSelection, overfull, nil
 }
 
-func (w *WalletManager) getUtxos(addrs []string) (map[string][]*txmgr.Credit, []*txmgr.Credit, error) {
-	am := w.ksmgr.CurrentKeystore()
-	if am == nil {
-		return nil, nil, ErrNoWalletInUse
-	}
+func (w *WalletManager) getUtxos(am *keystore.AddrManager, addrs []string) (map[string][]*txmgr.Credit, []*txmgr.Credit, error) {
 	scriptToAddrs := make(map[string][]string)
 	scriptSet := make(map[string]struct{})
 	for _, addr := range addrs {
@@ -422,7 +559,7 @@ func (w *WalletManager) getUtxos(addrs []string) (map[string][]*txmgr.Credit, []
 		if err != nil {
 			return err
 		}
-		m, err := w.utxoStore.ScriptAddressUnspents(tx, scriptSet, syncedTo.Height,
+		m, err := w.utxoStore.ScriptAddressUnspents(tx, am.Name(), scriptSet, syncedTo.Height,
 			func(item *txmgr.Credit) (bool, bool) {
 				if !item.Flags.Spent &&
 					!w.server.TxMemPool().CheckPoolOutPointSpend(&item.OutPoint) {
@@ -454,45 +591,66 @@ func (w *WalletManager) getUtxos(addrs []string) (map[string][]*txmgr.Credit, []
 	return ret, retList, nil
 }
 
-func (w *WalletManager) getUtxosExcludeBindingAndStaking(stdAddresses []string,
-	wantAmt massutil.Amount) ([]*txmgr.Credit, bool, error) {
-
-	am := w.ksmgr.CurrentKeystore()
-	if am == nil {
-		return nil, false, ErrNoWalletInUse
-	}
-
+// scriptSetOf returns the script addresses of stdAddresses of the wallet.
+func scriptSetOf(am *keystore.AddrManager, stdAddresses []string) (map[string]struct{}, error) {
 	scriptSet := make(map[string]struct{})
 	for _, addr := range stdAddresses {
 		ma, err := am.Address(addr)
 		if err != nil {
-			return nil, false, err
+			return nil, err
 		}
 		scriptSet[string(ma.ScriptAddress())] = struct{}{}
 	}
+	return scriptSet, nil
+}
+
+// getSpendableUtxos returns the mature utxos of class paid to scriptSet that are
+// not being spent, and their total amount. Locked utxos are included only if
+// includeLocked is set.
+func (w *WalletManager) getSpendableUtxos(am *keystore.AddrManager, scriptSet map[string]struct{},
+	class txmgr.UtxoClass, includeLocked bool) ([]*txmgr.Credit, massutil.Amount, error) {
 
-	selector := newTopKSelector(wantAmt)
+	total := massutil.ZeroAmount()
+	utxos := make([]*txmgr.Credit, 0)
 	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
 		syncedTo, err := w.syncStore.SyncedTo(tx)
 		if err != nil {
 			return err
 		}
-		_, err = w.utxoStore.ScriptAddressUnspents(tx, scriptSet, syncedTo.Height,
+		locked := make(map[wire.OutPoint]struct{})
+		if !includeLocked {
+			locks, err := w.utxoStore.LockedUnspents(tx, am.Name(), time.Now().Unix())
+			if err != nil {
+				return err
+			}
+			for _, lock := range locks {
+				locked[lock.OutPoint] = struct{}{}
+			}
+		}
+		var sumErr error
+		_, err = w.utxoStore.ScriptAddressUnspents(tx, am.Name(), scriptSet, syncedTo.Height,
 			func(item *txmgr.Credit) (stopIter, selected bool) {
-				if item.Confirmations >= item.Maturity && !item.Flags.SpentByUnmined && !item.Flags.Spent &&
-					item.Flags.Class != txmgr.ClassBindingUtxo && item.Flags.Class != txmgr.ClassStakingUtxo &&
+				if _, ok := locked[item.OutPoint]; ok {
+					return
+				}
+				if item.Flags.Class == class && item.Confirmations >= item.Maturity &&
+					!item.Flags.SpentByUnmined && !item.Flags.Spent &&
 					!w.UTXOUsed(&item.OutPoint) && !w.server.TxMemPool().CheckPoolOutPointSpend(&item.OutPoint) {
-					selector.submit(item)
+					utxos = append(utxos, item)
+					total, sumErr = total.Add(item.Amount)
+					stopIter = sumErr != nil
 				}
 				return
 			})
-		return err
+		if err != nil {
+			return err
+		}
+		return sumErr
 	})
 	if err != nil {
-		return nil, false, err
+		return nil, massutil.ZeroAmount(), err
 	}
-	items := selector.Items()
-	return items, len(items) == selector.K(), nil
+	return utxos, total, nil
 }
 
 func optOutputs(amount massutil.Amount, utxos []*txmgr.Credit) ([]*txmgr.Credit, massutil.Amount, massutil.Amount, error) {
@@ -569,7 +727,7 @@ func (w *WalletManager) SignHash(pub *btcec.PublicKey, hash, password []byte) (*
 	return w.ksmgr.SignHash(pub, hash, password)
 }
 
-func (w *WalletManager) signWitnessTx(password []byte, tx *wire.MsgTx, hashType txscript.SigHashType, params *config.Params) error {
+func (w *WalletManager) signWitnessTx(am *keystore.AddrManager, password []byte, tx *wire.MsgTx, hashType txscript.SigHashType, params *config.Params) error {
 
 	hashCache := txscript.NewTxSigHashes(tx)
 
@@ -593,8 +751,7 @@ func (w *WalletManager) signWitnessTx(password []byte, tx *wire.MsgTx, hashType
 		}
 		addrStr := address.EncodeAddress()
 
-		acctM := w.ksmgr.CurrentKeystore()
-		mAddr, err := acctM.Address(addrStr)
+		mAddr, err := am.Address(addrStr)
 		if err != nil {
 			logging.CPrint(logging.ERROR, "ScriptClosure error", logging.LogFormat{"err": err})
 			return nil, keystore.ErrUnexpectedPubKeyToSign
@@ -613,7 +770,7 @@ func (w *WalletManager) signWitnessTx(password []byte, tx *wire.MsgTx, hashType
 	for i, txIn := range tx.TxIn {
 		prevTx, ok := cache[txIn.PreviousOutPoint.Hash]
 		if !ok {
-			prevTx, cacheMeta[txIn.PreviousOutPoint.Hash], err = w.existsMsgTx(&txIn.PreviousOutPoint)
+			prevTx, cacheMeta[txIn.PreviousOutPoint.Hash], err = w.existsMsgTx(am.Name(), &txIn.PreviousOutPoint)
 			if err == txmgr.ErrNotFound {
 				prevTx, err = w.existsUnminedTx(&txIn.PreviousOutPoint.Hash)
 			}
@@ -634,7 +791,7 @@ func (w *WalletManager) signWitnessTx(password []byte, tx *wire.MsgTx, hashType
 			return ErrInvalidIndex
 		}
 
-		flags, err := w.existsOutPoint(&txIn.PreviousOutPoint)
+		flags, err := w.existsOutPoint(am.Name(), &txIn.PreviousOutPoint)
 		if err != nil {
 			logging.CPrint(logging.ERROR, "failed to check previous output", logging.LogFormat{
 				"err": err,
@@ -692,7 +849,11 @@ func (w *WalletManager) signWitnessTx(password []byte, tx *wire.MsgTx, hashType
 	return nil
 }
 
-func (w *WalletManager) EstimateManualTxFee(txins []*TxIn, txoutLen int) (massutil.Amount, error) {
+func (w *WalletManager) EstimateManualTxFee(walletId string, txins []*TxIn, txoutLen int) (massutil.Amount, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return massutil.ZeroAmount(), err
+	}
 	credits := make([]*txmgr.Credit, 0)
 	for _, txin := range txins {
 		hash, err := wire.NewHashFromStr(txin.TxId)
@@ -707,17 +868,17 @@ func (w *WalletManager) EstimateManualTxFee(txins []*TxIn, txoutLen int) (massut
 		}
 		credits = append(credits, input)
 	}
-	size, err := w.estimateSignedSize(credits, txoutLen)
+	size, err := w.estimateSignedSize(am, credits, txoutLen)
 	if err != nil {
 		return massutil.ZeroAmount(), err
 	}
 	return blockchain.CalcMinRequiredTxRelayFee(size, massutil.MinRelayTxFee())
 }
 
-func (w *WalletManager) GetTxHistory(wanted int, addr string) ([]*pb.TxHistoryDetails, error) {
-	am := w.ksmgr.CurrentKeystore()
-	if am == nil {
-		return nil, ErrNoWalletInUse
+func (w *WalletManager) GetTxHistory(walletId string, wanted int, addr string) ([]*pb.TxHistoryDetails, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
 	}
 
 	if wanted == 0 {
@@ -731,7 +892,7 @@ func (w *WalletManager) GetTxHistory(wanted int, addr string) ([]*pb.TxHistoryDe
 			return nil, err
 		}
 		script := address.ScriptAddress()
-		mAddr, err := w.ksmgr.GetManagedAddressByScriptHashInCurrent(script)
+		mAddr, err := w.ksmgr.GetManagedAddressByScriptHashInAccount(am.Name(), script)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,219 @@
+package txmgr
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/massnetorg/mass-core/database"
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/wire"
+	"massnet.org/mass-wallet/config"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/utils"
+)
+
+// TxFetcher reads transactions of the chain by their location.
+type TxFetcher interface {
+	FetchTxByFileLoc(blkLoc *database.BlockLoc, txLoc *wire.TxLoc) (*wire.MsgTx, error)
+}
+
+// BackfillTxHistory indexes the transaction history of the tx records in the
+// existing buckets utxoStore and txStore, so that transactions mined before the
+// index was introduced are listed as well. It reads the stores and the chain
+// only, without the keystores: the wallet of each credit is the one its address
+// is recorded for. Entries already indexed are kept, so it may be run again.
+// It returns the number of entries added.
+func BackfillTxHistory(tx mwdb.DBTransaction, utxoStore, txStore mwdb.Bucket,
+	chainParams *config.Params, fetcher TxFetcher) (int, error) {
+	if utxoStore == nil || txStore == nil {
+		// no wallet was ever loaded
+		return 0, nil
+	}
+	bm := &StoreBucketMeta{}
+	for _, b := range []struct {
+		store mwdb.Bucket
+		name  string
+		meta  *mwdb.BucketMeta
+	}{
+		{utxoStore, bucketCredits, &bm.nsCredits},
+		{utxoStore, bucketDebits, &bm.nsDebits},
+		{utxoStore, bucketAddresses, &bm.nsAddresses},
+		{txStore, bucketTxRecords, &bm.nsTxRecords},
+		{txStore, bucketBlocks, &bm.nsBlocks},
+		{txStore, bucketTxHistory, &bm.nsTxHistory},
+	} {
+		bucket, err := mwdb.GetOrCreateBucket(b.store, b.name)
+		if err != nil {
+			return 0, err
+		}
+		*b.meta = bucket.GetBucketMeta()
+	}
+	return backfillTxHistory(tx, bm, chainParams, fetcher)
+}
+
+func backfillTxHistory(tx mwdb.DBTransaction, bm *StoreBucketMeta,
+	chainParams *config.Params, fetcher TxFetcher) (int, error) {
+	nsCredits := tx.FetchBucket(bm.nsCredits)
+	nsDebits := tx.FetchBucket(bm.nsDebits)
+	nsAddresses := tx.FetchBucket(bm.nsAddresses)
+	nsTxRecords := tx.FetchBucket(bm.nsTxRecords)
+	nsBlocks := tx.FetchBucket(bm.nsBlocks)
+	nsTxHistory := tx.FetchBucket(bm.nsTxHistory)
+
+	// address class | encoded address -> wallet id
+	walletOfAddress := make(map[string]string)
+	entries, err := nsAddresses.GetByPrefix(nil)
+	if err != nil {
+		return 0, err
+	}
+	for _, e := range entries {
+		if len(e.Key) <= 44 {
+			return 0, fmt.Errorf("invalid address record (key %d bytes)", len(e.Key))
+		}
+		walletOfAddress[string(e.Key[42:])] = string(e.Key[:42])
+	}
+	walletOf := func(pkScript []byte) (string, utils.PkScript, error) {
+		ps, err := utils.ParsePkScript(pkScript, chainParams)
+		if err != nil {
+			return "", nil, err
+		}
+		addr := ps.StdEncodeAddress()
+		if ps.IsStaking() {
+			addr = ps.SecondEncodeAddress()
+		}
+		k := make([]byte, 2+len(addr))
+		binary.BigEndian.PutUint16(k, ps.AddressClass())
+		copy(k[2:], addr)
+		return walletOfAddress[string(k)], ps, nil
+	}
+
+	// the key of a tx record is also the prefix of the keys of its credits
+	fetchTx := func(recKey []byte) (*wire.MsgTx, *wire.TxLoc, error) {
+		v, err := nsTxRecords.Get(recKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		if v == nil {
+			return nil, nil, ErrNotFound
+		}
+		blkLoc, txLoc, err := readTxRecordLoc(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		msgTx, err := fetcher.FetchTxByFileLoc(blkLoc, txLoc)
+		if err != nil {
+			return nil, nil, err
+		}
+		return msgTx, txLoc, nil
+	}
+
+	blocks, err := nsBlocks.GetByPrefix(nil)
+	if err != nil {
+		return 0, err
+	}
+	added := 0
+	for _, b := range blocks {
+		var block blockRecord
+		if err = readRawBlockRecord(b.Key, b.Value, &block); err != nil {
+			return added, err
+		}
+		for i := range block.transactions {
+			txHash := &block.transactions[i]
+			recKey := keyTxRecord(txHash, &block.BlockMeta)
+			msgTx, txLoc, err := fetchTx(recKey)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "failed to read tx to backfill history", logging.LogFormat{
+					"tx":     txHash.String(),
+					"height": block.Height,
+					"err":    err,
+				})
+				return added, err
+			}
+			rec := &TxRecord{MsgTx: *msgTx, Hash: *txHash, TxLoc: txLoc}
+			spent := make(map[string]massutil.Amount)
+
+			for index, txOut := range msgTx.TxOut {
+				_, v, err := existsCredit(nsCredits, txHash, uint32(index), &block.BlockMeta)
+				if err != nil {
+					return added, err
+				}
+				if v == nil {
+					continue
+				}
+				walletId, ps, err := walletOf(txOut.PkScript)
+				if err != nil {
+					return added, err
+				}
+				if len(walletId) == 0 {
+					continue
+				}
+				rec.RelevantTxOut = append(rec.RelevantTxOut, &RelevantMeta{Index: index, PkScript: ps, WalletId: walletId})
+			}
+
+			for index := range msgTx.TxIn {
+				v, err := nsDebits.Get(keyDebit(txHash, uint32(index), &block.BlockMeta))
+				if err != nil {
+					return added, err
+				}
+				if v == nil {
+					continue
+				}
+				if len(v) < 84 {
+					return added, fmt.Errorf("%s: short read (expected 84 bytes, read %v)", bucketDebits, len(v))
+				}
+				credKey := v[8:84]
+				prevTx, _, err := fetchTx(credKey[:72])
+				if err != nil {
+					return added, err
+				}
+				prevIndex := binary.BigEndian.Uint32(credKey[72:76])
+				if int(prevIndex) >= len(prevTx.TxOut) {
+					return added, fmt.Errorf("credit %d out of range of tx %s", prevIndex, prevTx.TxHash())
+				}
+				walletId, ps, err := walletOf(prevTx.TxOut[prevIndex].PkScript)
+				if err != nil {
+					return added, err
+				}
+				if len(walletId) == 0 {
+					continue
+				}
+				rec.RelevantTxIn = append(rec.RelevantTxIn, &RelevantMeta{Index: index, PkScript: ps, WalletId: walletId})
+				amt, err := massutil.NewAmountFromUint(binary.BigEndian.Uint64(v[0:8]))
+				if err != nil {
+					return added, err
+				}
+				total, ok := spent[walletId]
+				if !ok {
+					total = massutil.ZeroAmount()
+				}
+				if spent[walletId], err = total.Add(amt); err != nil {
+					return added, err
+				}
+			}
+
+			if len(rec.RelevantTxIn) == 0 && len(rec.RelevantTxOut) == 0 {
+				continue
+			}
+			histories, err := newTxHistoryEntries(rec, &block.BlockMeta, spent)
+			if err != nil {
+				return added, err
+			}
+			for walletId, entry := range histories {
+				k := keyTxHistory(walletId, entry.BlockHeight, entry.TxStart, &entry.TxHash)
+				exists, err := nsTxHistory.Get(k)
+				if err != nil {
+					return added, err
+				}
+				if exists != nil {
+					continue
+				}
+				if err = nsTxHistory.Put(k, valueTxHistory(entry)); err != nil {
+					return added, err
+				}
+				added++
+			}
+		}
+	}
+	return added, nil
+}
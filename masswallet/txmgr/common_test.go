@@ -17,6 +17,7 @@ import (
 	"github.com/massnetorg/mass-core/blockchain"
 	"massnet.org/mass-wallet/config"
 	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/db/memdb"
 	"massnet.org/mass-wallet/masswallet/ifc"
 	"massnet.org/mass-wallet/masswallet/keystore"
 
@@ -36,7 +37,8 @@ var (
 )
 
 var (
-	DbType = "leveldb"
+	DbType       = "leveldb"
+	walletDbType = "memdb"
 
 	pubPassphrase  = []byte("@DJr@fL4H0O#$%0^n@V1")
 	privPassphrase = []byte("@#XXd7O9xyDIWIbXX$lj")
@@ -254,20 +256,9 @@ func fileExists(name string) bool {
 }
 
 func testTxStore(txStoreName string, databaseDb database.Db) (txstore *TxStore, walletDb mwdb.DB, tearDown func(), err error) {
-
-	if !fileExists(testDbRoot) {
-		if err := os.MkdirAll(testDbRoot, 0700); err != nil {
-			err := fmt.Errorf("unable to create test db "+
-				"root: %v", err)
-			return nil, nil, nil, err
-		}
-	}
 	dbPath := filepath.Join(testDbRoot, txStoreName)
-	if err := os.RemoveAll(dbPath); err != nil {
-		err := fmt.Errorf("cannot remove old db: %v", err)
-		return nil, nil, nil, err
-	}
-	walletDb, err = mwdb.CreateDB(DbType, dbPath)
+	memdb.RemoveDB(dbPath)
+	walletDb, err = mwdb.CreateDB(walletDbType, dbPath)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -382,6 +373,6 @@ func testTxStore(txStoreName string, databaseDb database.Db) (txstore *TxStore,
 	}
 	return s, walletDb, func() {
 		walletDb.Close()
-		os.Remove(dbPath)
+		memdb.RemoveDB(dbPath)
 	}, nil
 }
@@ -0,0 +1,710 @@
+package txmgr
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/wire"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+)
+
+// DumpKinds lists the kinds of records decoded by Inspector.Dump.
+var DumpKinds = []string{
+	"walletstatus",
+	"balances",
+	"addresses",
+	"unspent",
+	"credits",
+	"debits",
+	"txrecords",
+	"blocks",
+	"gamehistory",
+}
+
+// kinds of records keyed by wallet id, which can be dumped for one wallet
+var walletKeyedKinds = map[string]bool{
+	"walletstatus": true,
+	"balances":     true,
+	"addresses":    true,
+	"unspent":      true,
+	"gamehistory":  true,
+}
+
+var utxoClassNames = map[UtxoClass]string{
+	ClassStandardUtxo: "standard",
+	ClassStakingUtxo:  "staking",
+	ClassBindingUtxo:  "binding",
+}
+
+// Inspector decodes the records of the stores and checks that they are
+// consistent with each other. It reads the stores of a wallet db without the
+// keystores and the chain, so it serves offline tools.
+type Inspector struct {
+	bucketMeta *StoreBucketMeta
+	utxoStore  *UtxoStore
+}
+
+// NewInspector returns the inspector of the stores in the existing buckets
+// utxoStore, txStore and syncStore, nothing is created.
+func NewInspector(utxoStore, txStore, syncStore mwdb.Bucket) (*Inspector, error) {
+	bm := &StoreBucketMeta{}
+	for _, b := range []struct {
+		store mwdb.Bucket
+		name  string
+		meta  *mwdb.BucketMeta
+	}{
+		{utxoStore, bucketUnspent, &bm.nsUnspent},
+		{utxoStore, bucketMinedBalance, &bm.nsMinedBalance},
+		{utxoStore, bucketCredits, &bm.nsCredits},
+		{utxoStore, bucketDebits, &bm.nsDebits},
+		{utxoStore, bucketAddresses, &bm.nsAddresses},
+		{txStore, bucketTxRecords, &bm.nsTxRecords},
+		{txStore, bucketBlocks, &bm.nsBlocks},
+		{txStore, bucketGameHistory, &bm.nsGameHistory},
+		{txStore, bucketUnminedGameHistory, &bm.nsUnminedGameHistory},
+		{syncStore, bucketWalletStatus, &bm.nsWalletStatus},
+	} {
+		if b.store == nil {
+			return nil, mwdb.ErrBucketNotFound
+		}
+		bucket := b.store.Bucket(b.name)
+		if bucket == nil {
+			return nil, fmt.Errorf("bucket %s of the stores not found", b.name)
+		}
+		*b.meta = bucket.GetBucketMeta()
+	}
+	return &Inspector{
+		bucketMeta: bm,
+		utxoStore:  &UtxoStore{bucketMeta: bm},
+	}, nil
+}
+
+type dumpedBlock struct {
+	Height    uint64 `json:"height"`
+	BlockHash string `json:"block_hash"`
+}
+
+func newDumpedBlock(height uint64, hash []byte) dumpedBlock {
+	var h wire.Hash
+	copy(h[:], hash)
+	return dumpedBlock{Height: height, BlockHash: h.String()}
+}
+
+type dumpedIncidence struct {
+	TxId string `json:"tx_id"`
+	dumpedBlock
+	Index uint32 `json:"index"`
+}
+
+// readDumpedIncidence decodes the tx hash | height | block hash | index of
+// credit keys, debit keys and spenders.
+func readDumpedIncidence(k []byte) (*dumpedIncidence, error) {
+	if len(k) < 76 {
+		return nil, fmt.Errorf("short incidence (expected 76 bytes, read %d)", len(k))
+	}
+	var h wire.Hash
+	copy(h[:], k[0:32])
+	return &dumpedIncidence{
+		TxId:        h.String(),
+		dumpedBlock: newDumpedBlock(binary.BigEndian.Uint64(k[32:40]), k[40:72]),
+		Index:       binary.BigEndian.Uint32(k[72:76]),
+	}, nil
+}
+
+// Dump decodes the records of kind, one of DumpKinds, and calls fn with each
+// of them. Records keyed by wallet id are only those of walletId if it is not
+// empty.
+func (i *Inspector) Dump(tx mwdb.ReadTransaction, kind, walletId string, fn func(record interface{}) error) error {
+	var prefix []byte
+	if len(walletId) > 0 {
+		if !walletKeyedKinds[kind] {
+			return fmt.Errorf("%s are not kept by wallet", kind)
+		}
+		prefix = []byte(walletId)
+	}
+
+	switch kind {
+	case "walletstatus":
+		return dumpBucket(tx.FetchBucket(i.bucketMeta.nsWalletStatus), prefix, func(k, v []byte) (interface{}, error) {
+			var ws WalletStatus
+			if err := readWalletStatus(k, v, &ws); err != nil {
+				return nil, err
+			}
+			return &struct {
+				WalletId     string `json:"wallet_id"`
+				SyncedHeight uint64 `json:"synced_height"`
+				Ready        bool   `json:"ready"`
+				Removed      bool   `json:"removed"`
+				Rescanning   bool   `json:"rescanning"`
+			}{ws.WalletID, ws.SyncedHeight, ws.Ready(), ws.IsRemoved(), ws.IsRescanning()}, nil
+		}, fn)
+
+	case "balances":
+		return dumpBucket(tx.FetchBucket(i.bucketMeta.nsMinedBalance), prefix, func(k, v []byte) (interface{}, error) {
+			if len(v) != 8 {
+				return nil, fmt.Errorf("balance: short read (expected 8 bytes, read %v)", len(v))
+			}
+			return &struct {
+				WalletId string `json:"wallet_id"`
+				Amount   uint64 `json:"amount"`
+			}{string(k), binary.BigEndian.Uint64(v)}, nil
+		}, fn)
+
+	case "addresses":
+		return dumpBucket(tx.FetchBucket(i.bucketMeta.nsAddresses), prefix, func(k, v []byte) (interface{}, error) {
+			if len(k) <= 44 || len(v) < 8 {
+				return nil, fmt.Errorf("invalid address record (key %d bytes, value %d bytes)", len(k), len(v))
+			}
+			return &struct {
+				WalletId        string `json:"wallet_id"`
+				AddressClass    uint16 `json:"address_class"`
+				Address         string `json:"address"`
+				FirstUsedHeight uint64 `json:"first_used_height"`
+			}{string(k[:42]), binary.BigEndian.Uint16(k[42:44]), string(k[44:]), readAddressHeight(v)}, nil
+		}, fn)
+
+	case "unspent":
+		return dumpBucket(tx.FetchBucket(i.bucketMeta.nsUnspent), prefix, func(k, v []byte) (interface{}, error) {
+			var op wire.OutPoint
+			if err := readCanonicalUnspentKey(k, &op); err != nil {
+				return nil, err
+			}
+			if len(v) < 40 {
+				return nil, fmt.Errorf("short unspent value (expect %d bytes, read %d)", 40, len(v))
+			}
+			return &struct {
+				WalletId string `json:"wallet_id"`
+				TxId     string `json:"tx_id"`
+				Vout     uint32 `json:"vout"`
+				dumpedBlock
+			}{string(k[:42]), op.Hash.String(), op.Index, newDumpedBlock(binary.BigEndian.Uint64(v), v[8:40])}, nil
+		}, fn)
+
+	case "credits":
+		return dumpBucket(tx.FetchBucket(i.bucketMeta.nsCredits), prefix, func(k, v []byte) (interface{}, error) {
+			out, err := readDumpedIncidence(k)
+			if err != nil {
+				return nil, err
+			}
+			cred := credit{block: &BlockMeta{}}
+			if err = readCreditValue(v, &cred); err != nil {
+				return nil, err
+			}
+			var spentBy *dumpedIncidence
+			if cred.flags.Spent {
+				if spentBy, err = readDumpedIncidence(readCreditSpender(v)); err != nil {
+					return nil, err
+				}
+			}
+			return &struct {
+				*dumpedIncidence
+				Amount     uint64           `json:"amount"`
+				Class      string           `json:"class"`
+				Change     bool             `json:"change"`
+				Maturity   uint32           `json:"maturity"`
+				ScriptHash string           `json:"script_hash"`
+				Spent      bool             `json:"spent"`
+				SpentBy    *dumpedIncidence `json:"spent_by,omitempty"`
+			}{out, cred.amount.UintValue(), utxoClassNames[cred.flags.Class], cred.flags.Change, cred.maturity,
+				hex.EncodeToString(cred.scriptHash), cred.flags.Spent, spentBy}, nil
+		}, fn)
+
+	case "debits":
+		return dumpBucket(tx.FetchBucket(i.bucketMeta.nsDebits), prefix, func(k, v []byte) (interface{}, error) {
+			in, err := readDumpedIncidence(k)
+			if err != nil {
+				return nil, err
+			}
+			if len(v) < 84 {
+				return nil, fmt.Errorf("%s: short read (expected 84 bytes, read %v)", bucketDebits, len(v))
+			}
+			cred, err := readDumpedIncidence(v[8:84])
+			if err != nil {
+				return nil, err
+			}
+			return &struct {
+				*dumpedIncidence
+				Amount uint64           `json:"amount"`
+				Credit *dumpedIncidence `json:"credit"`
+			}{in, binary.BigEndian.Uint64(v[0:8]), cred}, nil
+		}, fn)
+
+	case "txrecords":
+		return dumpBucket(tx.FetchBucket(i.bucketMeta.nsTxRecords), prefix, func(k, v []byte) (interface{}, error) {
+			height, blkHash, err := readTxRecordKey(k)
+			if err != nil {
+				return nil, err
+			}
+			blkLoc, txLoc, err := readTxRecordLoc(v)
+			if err != nil {
+				return nil, err
+			}
+			var h wire.Hash
+			copy(h[:], k[0:32])
+			return &struct {
+				TxId string `json:"tx_id"`
+				dumpedBlock
+				BlockFile   uint32 `json:"block_file"`
+				BlockOffset uint64 `json:"block_offset"`
+				BlockLength uint64 `json:"block_length"`
+				TxOffset    int    `json:"tx_offset"`
+				TxLength    int    `json:"tx_length"`
+			}{h.String(), newDumpedBlock(height, blkHash), blkLoc.File, blkLoc.Offset, blkLoc.Length,
+				txLoc.TxStart, txLoc.TxLen}, nil
+		}, fn)
+
+	case "blocks":
+		return dumpBucket(tx.FetchBucket(i.bucketMeta.nsBlocks), prefix, func(k, v []byte) (interface{}, error) {
+			var block blockRecord
+			if err := readRawBlockRecord(k, v, &block); err != nil {
+				return nil, err
+			}
+			txs := make([]string, len(block.transactions))
+			for j := range block.transactions {
+				txs[j] = block.transactions[j].String()
+			}
+			return &struct {
+				dumpedBlock
+				Timestamp int64    `json:"timestamp"`
+				Txs       []string `json:"txs"`
+			}{newDumpedBlock(block.Height, block.Hash[:]), block.Timestamp.Unix(), txs}, nil
+		}, fn)
+
+	case "gamehistory":
+		for _, b := range []struct {
+			meta      mwdb.BucketMeta
+			isUnmined bool
+		}{
+			{i.bucketMeta.nsGameHistory, false},
+			{i.bucketMeta.nsUnminedGameHistory, true},
+		} {
+			isUnmined := b.isUnmined
+			err := dumpBucket(tx.FetchBucket(b.meta), prefix, func(k, v []byte) (interface{}, error) {
+				var history gameHistory
+				if err := readGameHistory(isUnmined, k, v, &history); err != nil {
+					return nil, err
+				}
+				typ := "staking"
+				if history.isBinding {
+					typ = "binding"
+				}
+				return &struct {
+					WalletId  string `json:"wallet_id"`
+					Type      string `json:"type"`
+					TxId      string `json:"tx_id"`
+					Vout      uint32 `json:"vout"`
+					Height    uint64 `json:"height"`
+					Unmined   bool   `json:"unmined"`
+					Withdrawn bool   `json:"withdrawn"`
+				}{history.walletId, typ, history.txhash.String(), history.vout, history.blockHeight,
+					isUnmined, history.withdrawn}, nil
+			}, fn)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown kind of records %s", kind)
+	}
+}
+
+func dumpBucket(ns mwdb.Bucket, prefix []byte, decode func(k, v []byte) (interface{}, error),
+	fn func(record interface{}) error) error {
+	it := ns.NewIterator(mwdb.BytesPrefix(prefix))
+	defer it.Release()
+	for it.Next() {
+		record, err := decode(it.Key(), it.Value())
+		if err != nil {
+			return fmt.Errorf("failed to decode record %x: %v", it.Key(), err)
+		}
+		if err = fn(record); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// Inconsistency is a record conflicting with other records of the stores.
+type Inconsistency struct {
+	// Check is the check finding it: "unspent", "debits", "balances" or
+	// "blocks".
+	Check  string
+	Detail string
+
+	repair func(tx mwdb.DBTransaction) error
+}
+
+// Repairable returns whether Repair resolves the inconsistency. Others are
+// resolved by rescanning the wallets.
+func (c *Inconsistency) Repairable() bool {
+	return c.repair != nil
+}
+
+// IntegrityReport is the result of CheckIntegrity.
+type IntegrityReport struct {
+	// numbers of the records checked
+	Unspent   int
+	Credits   int
+	Debits    int
+	TxRecords int
+	Blocks    int
+	Wallets   int
+
+	// wallets not synced, or removed, their balances are not checked
+	SkippedWallets []string
+
+	Inconsistencies []*Inconsistency
+}
+
+// Repairable returns the number of repairable inconsistencies.
+func (r *IntegrityReport) Repairable() int {
+	n := 0
+	for _, c := range r.Inconsistencies {
+		if c.Repairable() {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *IntegrityReport) add(check string, repair func(tx mwdb.DBTransaction) error, format string, a ...interface{}) {
+	r.Inconsistencies = append(r.Inconsistencies, &Inconsistency{
+		Check:  check,
+		Detail: fmt.Sprintf(format, a...),
+		repair: repair,
+	})
+}
+
+// CheckIntegrity cross validates the records of the stores:
+//   - unspent records against the credits they refer to, and unspent credits
+//     against unspent records
+//   - debits against the credits they spend
+//   - mined balances of the synced wallets against the balances recomputed
+//     from their unspent credits
+//   - block records against tx records, and credits against tx records
+func (i *Inspector) CheckIntegrity(tx mwdb.ReadTransaction) (*IntegrityReport, error) {
+	r := &IntegrityReport{}
+	nsUnspent := tx.FetchBucket(i.bucketMeta.nsUnspent)
+	nsCredits := tx.FetchBucket(i.bucketMeta.nsCredits)
+	nsDebits := tx.FetchBucket(i.bucketMeta.nsDebits)
+	nsTxRecords := tx.FetchBucket(i.bucketMeta.nsTxRecords)
+	nsBlocks := tx.FetchBucket(i.bucketMeta.nsBlocks)
+
+	// unspent records against credits, balances are recomputed from the
+	// unspent credits
+	recomputed := make(map[string]massutil.Amount)
+	unspentCredits := make(map[string]struct{})
+	it := nsUnspent.NewIterator(mwdb.BytesPrefix(nil))
+	for it.Next() {
+		r.Unspent++
+		k := append([]byte(nil), it.Key()...)
+		if len(k) != 78 || len(it.Value()) != 40 {
+			r.add("unspent", deleteFn(i.bucketMeta.nsUnspent, k), "invalid unspent record %x", k)
+			continue
+		}
+		credKey := make([]byte, 76)
+		copy(credKey, k[42:74])
+		copy(credKey[32:72], it.Value())
+		copy(credKey[72:76], k[74:78])
+		walletId := string(k[:42])
+		out, _ := readDumpedIncidence(credKey)
+		v, err := nsCredits.Get(credKey)
+		if err != nil {
+			it.Release()
+			return nil, err
+		}
+		if v == nil {
+			r.add("unspent", deleteFn(i.bucketMeta.nsUnspent, k),
+				"unspent %s:%d of wallet %s at height %d refers to a missing credit",
+				out.TxId, out.Index, walletId, out.Height)
+			continue
+		}
+		amt, spent, err := fetchRawCreditAmountSpent(v)
+		if err != nil {
+			r.add("unspent", nil, "invalid credit %x: %v", credKey, err)
+			continue
+		}
+		if spent {
+			r.add("unspent", deleteFn(i.bucketMeta.nsUnspent, k),
+				"unspent %s:%d of wallet %s at height %d refers to a spent credit",
+				out.TxId, out.Index, walletId, out.Height)
+			continue
+		}
+		unspentCredits[string(credKey)] = struct{}{}
+		bal, ok := recomputed[walletId]
+		if !ok {
+			bal = massutil.ZeroAmount()
+		}
+		if recomputed[walletId], err = bal.Add(amt); err != nil {
+			it.Release()
+			return nil, err
+		}
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	// credits against unspent records and tx records
+	it = nsCredits.NewIterator(mwdb.BytesPrefix(nil))
+	for it.Next() {
+		r.Credits++
+		k, v := it.Key(), it.Value()
+		out, err := readDumpedIncidence(k)
+		if err != nil {
+			r.add("unspent", nil, "invalid credit key %x", k)
+			continue
+		}
+		_, spent, err := fetchRawCreditAmountSpent(v)
+		if err != nil {
+			r.add("unspent", nil, "invalid credit %s:%d at height %d: %v", out.TxId, out.Index, out.Height, err)
+			continue
+		}
+		if !spent {
+			if _, ok := unspentCredits[string(k)]; !ok {
+				r.add("unspent", nil, "unspent credit %s:%d at height %d has no unspent record",
+					out.TxId, out.Index, out.Height)
+			}
+		} else if debitKey := readCreditSpender(v); debitKey == nil {
+			r.add("debits", nil, "spent credit %s:%d at height %d has no spender",
+				out.TxId, out.Index, out.Height)
+		} else if dv, err := nsDebits.Get(debitKey); err != nil {
+			it.Release()
+			return nil, err
+		} else if dv == nil {
+			in, _ := readDumpedIncidence(debitKey)
+			r.add("debits", nil, "credit %s:%d at height %d is spent by a missing debit %s:%d at height %d",
+				out.TxId, out.Index, out.Height, in.TxId, in.Index, in.Height)
+		}
+		if tv, err := nsTxRecords.Get(k[:72]); err != nil {
+			it.Release()
+			return nil, err
+		} else if tv == nil {
+			r.add("blocks", nil, "credit %s:%d refers to a missing tx record at height %d",
+				out.TxId, out.Index, out.Height)
+		}
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	// debits against the credits they spend
+	it = nsDebits.NewIterator(mwdb.BytesPrefix(nil))
+	for it.Next() {
+		r.Debits++
+		k, v := it.Key(), it.Value()
+		in, err := readDumpedIncidence(k)
+		if err != nil || len(v) < 84 {
+			r.add("debits", nil, "invalid debit %x", k)
+			continue
+		}
+		credKey := v[8:84]
+		out, _ := readDumpedIncidence(credKey)
+		cv, err := nsCredits.Get(credKey)
+		if err != nil {
+			it.Release()
+			return nil, err
+		}
+		if cv == nil {
+			r.add("debits", nil, "debit %s:%d at height %d spends a missing credit %s:%d at height %d",
+				in.TxId, in.Index, in.Height, out.TxId, out.Index, out.Height)
+			continue
+		}
+		if !bytes.Equal(readCreditSpender(cv), k) {
+			r.add("debits", nil, "debit %s:%d at height %d spends credit %s:%d at height %d not spent by it",
+				in.TxId, in.Index, in.Height, out.TxId, out.Index, out.Height)
+		}
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	// mined balances against the recomputed ones
+	if err := i.checkBalances(tx, recomputed, r); err != nil {
+		return nil, err
+	}
+
+	// block records against tx records
+	listed := make(map[string]struct{})
+	it = nsBlocks.NewIterator(mwdb.BytesPrefix(nil))
+	for it.Next() {
+		r.Blocks++
+		var block blockRecord
+		if err := readRawBlockRecord(it.Key(), it.Value(), &block); err != nil {
+			r.add("blocks", nil, "invalid block record %x: %v", it.Key(), err)
+			continue
+		}
+		for j := range block.transactions {
+			txHash := block.transactions[j]
+			k := keyTxRecord(&txHash, &block.BlockMeta)
+			listed[string(k)] = struct{}{}
+			v, err := nsTxRecords.Get(k)
+			if err != nil {
+				it.Release()
+				return nil, err
+			}
+			if v == nil {
+				r.add("blocks", i.unlistTxFn(block.Height, txHash),
+					"block record at height %d lists tx %s without tx record", block.Height, txHash)
+			}
+		}
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	it = nsTxRecords.NewIterator(mwdb.BytesPrefix(nil))
+	for it.Next() {
+		r.TxRecords++
+		if _, ok := listed[string(it.Key())]; ok {
+			continue
+		}
+		height, recBlkHash, err := readTxRecordKey(it.Key())
+		if err != nil {
+			r.add("blocks", nil, "invalid tx record key %x", it.Key())
+			continue
+		}
+		var txHash wire.Hash
+		copy(txHash[:], it.Key()[:32])
+		_, bv, err := existsBlockRecord(nsBlocks, height)
+		if err != nil {
+			it.Release()
+			return nil, err
+		}
+		blkHash, err := readBlockHashFromValue(bv)
+		if err != nil || !bytes.Equal(blkHash[:], recBlkHash) {
+			r.add("blocks", nil, "tx record %s at height %d has no block record of its block",
+				txHash, height)
+			continue
+		}
+		r.add("blocks", i.listTxFn(height, txHash),
+			"tx record %s at height %d is not listed by the block record", txHash, height)
+	}
+	it.Release()
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (i *Inspector) checkBalances(tx mwdb.ReadTransaction, recomputed map[string]massutil.Amount, r *IntegrityReport) error {
+	stored, err := i.utxoStore.FetchAllMinedBalance(tx)
+	if err != nil {
+		return err
+	}
+	entries, err := tx.FetchBucket(i.bucketMeta.nsWalletStatus).GetByPrefix(nil)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]struct{})
+	for _, entry := range entries {
+		var ws WalletStatus
+		if err = readWalletStatus(entry.Key, entry.Value, &ws); err != nil {
+			return err
+		}
+		r.Wallets++
+		known[ws.WalletID] = struct{}{}
+		if !ws.Ready() || ws.IsRemoved() || ws.IsRescanning() {
+			r.SkippedWallets = append(r.SkippedWallets, ws.WalletID)
+			continue
+		}
+		want, ok := recomputed[ws.WalletID]
+		if !ok {
+			want = massutil.ZeroAmount()
+		}
+		have, ok := stored[ws.WalletID]
+		if !ok {
+			have = massutil.ZeroAmount()
+		}
+		if have.Cmp(want) != 0 {
+			r.add("balances", i.putBalanceFn(ws.WalletID, want),
+				"mined balance of wallet %s is %s, its unspent credits sum up to %s", ws.WalletID, have, want)
+		}
+	}
+
+	var unknown []string
+	for walletId := range stored {
+		if _, ok := known[walletId]; !ok {
+			unknown = append(unknown, walletId)
+		}
+	}
+	sort.Strings(unknown)
+	for _, walletId := range unknown {
+		r.add("balances", deleteFn(i.bucketMeta.nsMinedBalance, []byte(walletId)),
+			"mined balance of unknown wallet %s", walletId)
+	}
+	return nil
+}
+
+func deleteFn(meta mwdb.BucketMeta, k []byte) func(tx mwdb.DBTransaction) error {
+	return func(tx mwdb.DBTransaction) error {
+		return tx.FetchBucket(meta).Delete(k)
+	}
+}
+
+func (i *Inspector) putBalanceFn(walletId string, amt massutil.Amount) func(tx mwdb.DBTransaction) error {
+	return func(tx mwdb.DBTransaction) error {
+		return putMinedBalance(tx.FetchBucket(i.bucketMeta.nsMinedBalance), walletId, amt)
+	}
+}
+
+// unlistTxFn removes txHash from the block record at height, and the block
+// record if no tx remains.
+func (i *Inspector) unlistTxFn(height uint64, txHash wire.Hash) func(tx mwdb.DBTransaction) error {
+	return func(tx mwdb.DBTransaction) error {
+		ns := tx.FetchBucket(i.bucketMeta.nsBlocks)
+		block, err := fetchBlockRecord(ns, height)
+		if err != nil || block == nil {
+			return err
+		}
+		txHashes := make([]wire.Hash, 0, len(block.transactions))
+		for _, h := range block.transactions {
+			if h != txHash {
+				txHashes = append(txHashes, h)
+			}
+		}
+		if len(txHashes) == 0 {
+			return deleteBlockRecord(ns, height)
+		}
+		return updateBlockRecord(ns, &block.BlockMeta, txHashes)
+	}
+}
+
+// listTxFn appends txHash to the block record at height.
+func (i *Inspector) listTxFn(height uint64, txHash wire.Hash) func(tx mwdb.DBTransaction) error {
+	return func(tx mwdb.DBTransaction) error {
+		ns := tx.FetchBucket(i.bucketMeta.nsBlocks)
+		k, v, err := existsBlockRecord(ns, height)
+		if err != nil {
+			return err
+		}
+		if v, err = appendRawBlockRecord(v, &txHash); err != nil {
+			return err
+		}
+		return putRawBlockRecord(ns, k, v)
+	}
+}
+
+// Repair resolves the repairable inconsistencies of r, found by
+// CheckIntegrity in tx, and returns the number of them.
+func (i *Inspector) Repair(tx mwdb.DBTransaction, r *IntegrityReport) (int, error) {
+	n := 0
+	for _, c := range r.Inconsistencies {
+		if !c.Repairable() {
+			continue
+		}
+		if err := c.repair(tx); err != nil {
+			return n, fmt.Errorf("failed to repair %s: %v", c.Detail, err)
+		}
+		n++
+	}
+	return n, nil
+}
@@ -0,0 +1,244 @@
+package txmgr
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/massnetorg/mass-core/database"
+	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/wire"
+	"github.com/stretchr/testify/assert"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/db/memdb"
+)
+
+func testInspectBlock(height uint64) *BlockMeta {
+	return &BlockMeta{
+		Height:    height,
+		Hash:      wire.Hash{byte(height), 0xbb},
+		Timestamp: time.Unix(1600000000+int64(height), 0),
+		Loc:       &database.BlockLoc{},
+	}
+}
+
+func testAmount(n uint64) massutil.Amount {
+	amt, err := massutil.NewAmountFromUint(n)
+	if err != nil {
+		panic(err)
+	}
+	return amt
+}
+
+func putTestTxRecord(t *testing.T, nsTxRecords, nsBlocks mwdb.Bucket, txHash wire.Hash, block *BlockMeta, listed bool) {
+	rec := &TxRecord{Hash: txHash, TxLoc: &wire.TxLoc{}}
+	if err := putTxRecord(nsTxRecords, rec, block); err != nil {
+		t.Fatal(err)
+	}
+	if listed {
+		if err := putBlockRecord(nsBlocks, block, &txHash); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// newTestInspectDB writes records of a wallet holding a credit of tx a mined
+// at height 1, and a credit of tx b at height 2 spent by tx c at height 3.
+func newTestInspectDB(t *testing.T, name string) (mwdb.DB, *Inspector) {
+	memdb.RemoveDB(name)
+	db, err := memdb.CreateDB(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var insp *Inspector
+	err = mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		var stores []mwdb.Bucket
+		for _, s := range []struct {
+			name    string
+			buckets []string
+		}{
+			{"u", []string{bucketUnspent, bucketMinedBalance, bucketCredits, bucketDebits, bucketAddresses}},
+			{"t", []string{bucketTxRecords, bucketBlocks, bucketGameHistory, bucketUnminedGameHistory}},
+			{"s", []string{bucketWalletStatus}},
+		} {
+			store, err := tx.CreateTopLevelBucket(s.name)
+			if err != nil {
+				return err
+			}
+			for _, b := range s.buckets {
+				if _, err = store.NewBucket(b); err != nil {
+					return err
+				}
+			}
+			stores = append(stores, store)
+		}
+		insp, err = NewInspector(stores[0], stores[1], stores[2])
+		if err != nil {
+			return err
+		}
+		bm := insp.bucketMeta
+		nsUnspent := tx.FetchBucket(bm.nsUnspent)
+		nsCredits := tx.FetchBucket(bm.nsCredits)
+		nsTxRecords := tx.FetchBucket(bm.nsTxRecords)
+		nsBlocks := tx.FetchBucket(bm.nsBlocks)
+
+		a, b, c := wire.Hash{0xa}, wire.Hash{0xb}, wire.Hash{0xc}
+		putTestTxRecord(t, nsTxRecords, nsBlocks, a, testInspectBlock(1), true)
+		putTestTxRecord(t, nsTxRecords, nsBlocks, b, testInspectBlock(2), true)
+		putTestTxRecord(t, nsTxRecords, nsBlocks, c, testInspectBlock(3), true)
+
+		for _, cred := range []*credit{
+			{outPoint: wire.OutPoint{Hash: a}, block: testInspectBlock(1), amount: testAmount(100)},
+			{outPoint: wire.OutPoint{Hash: b}, block: testInspectBlock(2), amount: testAmount(50)},
+		} {
+			cred.scriptHash = make([]byte, 32)
+			v, err := valueUnspentCredit(cred)
+			if err != nil {
+				return err
+			}
+			if err = putRawCredit(nsCredits, keyCredit(&cred.outPoint.Hash, 0, cred.block), v); err != nil {
+				return err
+			}
+		}
+		if err = putUnspent(nsUnspent, walletID, &wire.OutPoint{Hash: a}, testInspectBlock(1)); err != nil {
+			return err
+		}
+		credKey := keyCredit(&b, 0, testInspectBlock(2))
+		spender := &indexedIncidence{incidence: incidence{txHash: c, block: *testInspectBlock(3)}}
+		amt, err := spendCredit(nsCredits, credKey, spender)
+		if err != nil {
+			return err
+		}
+		if err = putDebit(tx.FetchBucket(bm.nsDebits), &c, 0, amt, testInspectBlock(3), credKey); err != nil {
+			return err
+		}
+
+		if err = putMinedBalance(tx.FetchBucket(bm.nsMinedBalance), walletID, testAmount(100)); err != nil {
+			return err
+		}
+		v := make([]byte, 9)
+		binary.BigEndian.PutUint64(v, math.MaxUint64)
+		return tx.FetchBucket(bm.nsWalletStatus).Put([]byte(walletID), v)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, insp
+}
+
+func TestInspectorDump(t *testing.T) {
+	db, insp := newTestInspectDB(t, "TstInspectDump")
+	defer memdb.RemoveDB("TstInspectDump")
+	defer db.Close()
+
+	err := mwdb.View(db, func(tx mwdb.ReadTransaction) error {
+		count := func(kind, walletId string) (int, error) {
+			n := 0
+			err := insp.Dump(tx, kind, walletId, func(record interface{}) error {
+				n++
+				return nil
+			})
+			return n, err
+		}
+		for kind, want := range map[string]int{
+			"walletstatus": 1,
+			"balances":     1,
+			"addresses":    0,
+			"unspent":      1,
+			"credits":      2,
+			"debits":       1,
+			"txrecords":    3,
+			"blocks":       3,
+			"gamehistory":  0,
+		} {
+			n, err := count(kind, "")
+			assert.Nil(t, err, kind)
+			assert.Equal(t, want, n, kind)
+		}
+		n, err := count("unspent", walletID)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, n)
+		_, err = count("credits", walletID)
+		assert.NotNil(t, err)
+		_, err = count("unknown", "")
+		assert.NotNil(t, err)
+		return nil
+	})
+	assert.Nil(t, err)
+}
+
+func TestInspectorCheckIntegrity(t *testing.T) {
+	db, insp := newTestInspectDB(t, "TstInspectCheck")
+	defer memdb.RemoveDB("TstInspectCheck")
+	defer db.Close()
+
+	check := func() *IntegrityReport {
+		var r *IntegrityReport
+		err := mwdb.View(db, func(tx mwdb.ReadTransaction) (err error) {
+			r, err = insp.CheckIntegrity(tx)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	r := check()
+	assert.Equal(t, 0, len(r.Inconsistencies))
+	assert.Equal(t, []int{1, 2, 1, 3, 3, 1}, []int{r.Unspent, r.Credits, r.Debits, r.TxRecords, r.Blocks, r.Wallets})
+
+	bm := insp.bucketMeta
+	a, c, d := wire.Hash{0xa}, wire.Hash{0xc}, wire.Hash{0xd}
+	err := mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		// the unspent credit is lost, the mined balance is not recomputed
+		if err := deleteRawCredit(tx.FetchBucket(bm.nsCredits), keyCredit(&a, 0, testInspectBlock(1))); err != nil {
+			return err
+		}
+		if err := putMinedBalance(tx.FetchBucket(bm.nsMinedBalance), walletID, testAmount(70)); err != nil {
+			return err
+		}
+		// tx c is listed without tx record, tx d is not listed
+		nsTxRecords := tx.FetchBucket(bm.nsTxRecords)
+		if err := nsTxRecords.Delete(keyTxRecord(&c, testInspectBlock(3))); err != nil {
+			return err
+		}
+		putTestTxRecord(t, nsTxRecords, tx.FetchBucket(bm.nsBlocks), d, testInspectBlock(1), false)
+		// the spender of the credit of tx b is lost
+		return tx.FetchBucket(bm.nsDebits).Delete(keyDebit(&c, 0, testInspectBlock(3)))
+	})
+	assert.Nil(t, err)
+
+	r = check()
+	checks := make(map[string]int)
+	for _, c := range r.Inconsistencies {
+		checks[c.Check]++
+	}
+	assert.Equal(t, map[string]int{"unspent": 1, "balances": 1, "blocks": 2, "debits": 1}, checks)
+	assert.Equal(t, 4, r.Repairable())
+
+	err = mwdb.Update(db, func(tx mwdb.DBTransaction) error {
+		n, err := insp.Repair(tx, r)
+		assert.Equal(t, 4, n)
+		return err
+	})
+	assert.Nil(t, err)
+
+	r = check()
+	assert.Equal(t, 1, len(r.Inconsistencies))
+	assert.Equal(t, "debits", r.Inconsistencies[0].Check)
+	assert.False(t, r.Inconsistencies[0].Repairable())
+	err = mwdb.View(db, func(tx mwdb.ReadTransaction) error {
+		block, err := fetchBlockRecord(tx.FetchBucket(bm.nsBlocks), 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []wire.Hash{a, d}, block.transactions)
+		block, err = fetchBlockRecord(tx.FetchBucket(bm.nsBlocks), 3)
+		assert.Nil(t, err)
+		assert.Nil(t, block)
+		balances, err := insp.utxoStore.FetchAllMinedBalance(tx)
+		assert.Nil(t, err)
+		assert.Equal(t, massutil.ZeroAmount(), balances[walletID])
+		return err
+	})
+	assert.Nil(t, err)
+}
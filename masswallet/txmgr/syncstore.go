@@ -57,7 +57,7 @@ func NewSyncStore(store mwdb.Bucket, bucketMeta *StoreBucketMeta, chainParams *c
 }
 
 // BlockHash ...
-func (s *SyncStore) SyncedBlock(tx mwdb.DBTransaction, height uint64) (*BlockMeta, error) {
+func (s *SyncStore) SyncedBlock(tx mwdb.ReadTransaction, height uint64) (*BlockMeta, error) {
 	nsSyncBucketName := tx.FetchBucket(s.bucketMeta.nsSyncBucketName)
 	return fetchSyncedBlock(nsSyncBucketName, height)
 }
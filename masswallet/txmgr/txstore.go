@@ -2,8 +2,10 @@ package txmgr
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/massnetorg/mass-core/blockchain"
 	"github.com/massnetorg/mass-core/debug"
@@ -75,6 +77,13 @@ func NewTxStore(chainFetcher ifc.ChainFetcher, store mwdb.Bucket, utxoStore *Utx
 		return nil, err
 	}
 	t.bucketMeta.nsUnminedGameHistory = bucket.GetBucketMeta()
+
+	//bucketTxHistory
+	bucket, err = mwdb.GetOrCreateBucket(store, bucketTxHistory)
+	if err != nil {
+		return nil, err
+	}
+	t.bucketMeta.nsTxHistory = bucket.GetBucketMeta()
 	return
 }
 
@@ -82,8 +91,10 @@ func checkAddress(addr string) error {
 	return nil
 }
 
+// updateMinedBalance spends the utxos consumed by rec, and returns the amount
+// spent of each wallet.
 func (s *TxStore) updateMinedBalance(tx mwdb.DBTransaction,
-	allBalances map[string]massutil.Amount, rec *TxRecord, block *BlockMeta) error {
+	allBalances map[string]massutil.Amount, rec *TxRecord, block *BlockMeta) (map[string]massutil.Amount, error) {
 
 	spender := indexedIncidence{
 		incidence: incidence{
@@ -97,11 +108,13 @@ func (s *TxStore) updateMinedBalance(tx mwdb.DBTransaction,
 	nsDebits := tx.FetchBucket(s.bucketMeta.nsDebits)
 	nsGameHistory := tx.FetchBucket(s.bucketMeta.nsGameHistory)
 
+	spent := make(map[string]massutil.Amount)
+
 	for _, rel := range rec.RelevantTxIn {
 		prevOut := &rec.MsgTx.TxIn[rel.Index].PreviousOutPoint
 		unspentKey, credKey, err := existsUnspent(nsUnspent, rel.WalletId, prevOut)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if credKey == nil {
 			logging.CPrint(logging.ERROR, "unexpected: utxo related to input not found",
@@ -113,13 +126,13 @@ func (s *TxStore) updateMinedBalance(tx mwdb.DBTransaction,
 					"prevTx":    prevOut.Hash.String(),
 					"prevOut":   prevOut.Index,
 				})
-			return ErrUnexpectedCreditNotFound
+			return nil, ErrUnexpectedCreditNotFound
 		}
 
 		spender.index = uint32(rel.Index)
 		amt, err := spendCredit(nsCredits, credKey, &spender)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		if rel.PkScript.IsBinding() || rel.PkScript.IsStaking() {
@@ -128,7 +141,7 @@ func (s *TxStore) updateMinedBalance(tx mwdb.DBTransaction,
 			}
 			err = readRawCreditKey(credKey, &cred)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			err = withdrawGame(nsGameHistory, gameHistory{
 				walletId:    rel.WalletId,
@@ -138,25 +151,33 @@ func (s *TxStore) updateMinedBalance(tx mwdb.DBTransaction,
 				blockHeight: cred.block.Height,
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 
 		err = putDebit(nsDebits, &rec.Hash, uint32(rel.Index), amt, block, credKey)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if err := deleteRawUnspent(nsUnspent, unspentKey); err != nil {
-			return err
+			return nil, err
 		}
 
 		newBal, err := allBalances[rel.WalletId].Sub(amt)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		allBalances[rel.WalletId] = newBal
+
+		total, ok := spent[rel.WalletId]
+		if !ok {
+			total = massutil.ZeroAmount()
+		}
+		if spent[rel.WalletId], err = total.Add(amt); err != nil {
+			return nil, err
+		}
 	}
-	return nil
+	return spent, nil
 }
 
 // AddRelevantTx ...
@@ -233,7 +254,11 @@ func (s *TxStore) insertMinedTx(tx mwdb.DBTransaction, allBalances map[string]ma
 		return err
 	}
 
-	if err := s.updateMinedBalance(tx, allBalances, rec, block); err != nil {
+	spent, err := s.updateMinedBalance(tx, allBalances, rec, block)
+	if err != nil {
+		return err
+	}
+	if err := s.putTxHistory(tx, rec, block, spent); err != nil {
 		return err
 	}
 
@@ -324,7 +349,11 @@ func (s *TxStore) insertMinedTxForImporting(tx mwdb.DBTransaction,
 		}
 	}
 
-	if err := s.updateMinedBalance(tx, allBalances, rec, block); err != nil {
+	spent, err := s.updateMinedBalance(tx, allBalances, rec, block)
+	if err != nil {
+		return err
+	}
+	if err := s.putTxHistory(tx, rec, block, spent); err != nil {
 		return err
 	}
 
@@ -352,6 +381,232 @@ func (s *TxStore) insertMinedTxForImporting(tx mwdb.DBTransaction,
 	return nil
 }
 
+// putTxHistory indexes rec into the transaction history of each wallet it is
+// relevant to. spent is the amount spent of each wallet by rec.
+func (s *TxStore) putTxHistory(tx mwdb.DBTransaction, rec *TxRecord, block *BlockMeta,
+	spent map[string]massutil.Amount) error {
+	entries, err := newTxHistoryEntries(rec, block, spent)
+	if err != nil {
+		return err
+	}
+	nsTxHistory := tx.FetchBucket(s.bucketMeta.nsTxHistory)
+	for walletId, entry := range entries {
+		if err := putTxHistory(nsTxHistory, walletId, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newTxHistoryEntries(rec *TxRecord, block *BlockMeta,
+	spent map[string]massutil.Amount) (map[string]*TxHistoryEntry, error) {
+	isCoinbase := blockchain.IsCoinBaseTx(&rec.MsgTx)
+	entries := make(map[string]*TxHistoryEntry)
+	numInputs := make(map[string]int)
+
+	entryOf := func(rel *RelevantMeta) *TxHistoryEntry {
+		entry, ok := entries[rel.WalletId]
+		if !ok {
+			entry = &TxHistoryEntry{
+				TxHash:      rec.Hash,
+				BlockHeight: block.Height,
+				TxStart:     uint32(rec.TxLoc.TxStart),
+				Timestamp:   block.Timestamp,
+				Type:        HistoryTransfer,
+				Received:    massutil.ZeroAmount(),
+				Sent:        massutil.ZeroAmount(),
+				Fee:         massutil.ZeroAmount(),
+			}
+			if isCoinbase {
+				entry.Type = HistoryCoinbase
+			}
+			entries[rel.WalletId] = entry
+		}
+		if entry.Type == HistoryTransfer {
+			if rel.PkScript.IsStaking() {
+				entry.Type = HistoryStaking
+			} else if rel.PkScript.IsBinding() {
+				entry.Type = HistoryBinding
+			}
+		}
+		scriptHash := rel.PkScript.StdScriptAddress()
+		for _, exist := range entry.ScriptHashes {
+			if bytes.Equal(exist, scriptHash) {
+				return entry
+			}
+		}
+		entry.ScriptHashes = append(entry.ScriptHashes, scriptHash)
+		return entry
+	}
+
+	for _, rel := range rec.RelevantTxIn {
+		entryOf(rel)
+		numInputs[rel.WalletId]++
+	}
+	totalOut := massutil.ZeroAmount()
+	for _, txOut := range rec.MsgTx.TxOut {
+		amt, err := massutil.NewAmountFromInt(txOut.Value)
+		if err != nil {
+			return nil, err
+		}
+		if totalOut, err = totalOut.Add(amt); err != nil {
+			return nil, err
+		}
+	}
+	for _, rel := range rec.RelevantTxOut {
+		entry := entryOf(rel)
+		amt, err := massutil.NewAmountFromInt(rec.MsgTx.TxOut[rel.Index].Value)
+		if err != nil {
+			return nil, err
+		}
+		if entry.Received, err = entry.Received.Add(amt); err != nil {
+			return nil, err
+		}
+	}
+
+	for walletId, entry := range entries {
+		if amt, ok := spent[walletId]; ok {
+			entry.Sent = amt
+		}
+		// the fee is known to, and paid by, the wallet only if it funds all inputs
+		if !isCoinbase && numInputs[walletId] == len(rec.MsgTx.TxIn) && entry.Sent.Cmp(totalOut) >= 0 {
+			fee, err := entry.Sent.Sub(totalOut)
+			if err != nil {
+				return nil, err
+			}
+			entry.Fee = fee
+		}
+	}
+	return entries, nil
+}
+
+// TxHistory returns up to query.Limit entries of the transaction history index
+// of walletId matching query, in the order of block height and position in block.
+// The returned cursor continues the query, it's nil if there are no more entries.
+func (s *TxStore) TxHistory(tx mwdb.ReadTransaction, walletId string, query *TxHistoryQuery) ([]*TxHistoryEntry, []byte, error) {
+	if query.Limit <= 0 {
+		return nil, nil, nil
+	}
+	if len(query.Cursor) != 0 && len(query.Cursor) != 44 {
+		return nil, nil, ErrInvalidHistoryCursor
+	}
+
+	prefix := []byte(walletId)
+	start := make([]byte, len(prefix)+8)
+	copy(start, prefix)
+	binary.BigEndian.PutUint64(start[len(prefix):], query.FromHeight)
+	limit := mwdb.BytesPrefix(prefix).Limit
+	if query.ToHeight > 0 && query.ToHeight < math.MaxUint64 {
+		limit = make([]byte, len(prefix)+8)
+		copy(limit, prefix)
+		binary.BigEndian.PutUint64(limit[len(prefix):], query.ToHeight+1)
+	}
+	if len(query.Cursor) > 0 {
+		at := append(append([]byte{}, prefix...), query.Cursor...)
+		if query.Descending {
+			if bytes.Compare(at, limit) < 0 {
+				limit = at
+			}
+		} else {
+			// the smallest key after the cursor
+			at = append(at, 0)
+			if bytes.Compare(at, start) > 0 {
+				start = at
+			}
+		}
+	}
+	if bytes.Compare(start, limit) >= 0 {
+		return nil, nil, nil
+	}
+
+	nsTxHistory := tx.FetchBucket(s.bucketMeta.nsTxHistory)
+	iter := nsTxHistory.NewIterator(&mwdb.Range{Start: start, Limit: limit})
+	defer iter.Release()
+
+	// one more entry than wanted tells whether there are more
+	entries := make([]*TxHistoryEntry, 0, query.Limit+1)
+	for iter.Next() {
+		entry, err := readTxHistory(iter.Key(), iter.Value())
+		if err != nil {
+			return nil, nil, err
+		}
+		if !query.match(entry) {
+			continue
+		}
+		entries = append(entries, entry)
+		if query.Descending {
+			// the iterator goes forward only, keep the latest ones
+			if len(entries) > query.Limit+1 {
+				entries = entries[1:]
+			}
+		} else if len(entries) > query.Limit {
+			break
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, nil, err
+	}
+
+	if query.Descending {
+		for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+			entries[i], entries[j] = entries[j], entries[i]
+		}
+	}
+	if len(entries) <= query.Limit {
+		return entries, nil, nil
+	}
+	entries = entries[:query.Limit]
+	return entries, entries[query.Limit-1].Cursor(), nil
+}
+
+// RemoveTxHistoryByWalletId removes the transaction history index of walletId.
+func (s *TxStore) RemoveTxHistoryByWalletId(tx mwdb.DBTransaction, walletId string) error {
+	if len(walletId) == 0 {
+		return nil
+	}
+	nsTxHistory := tx.FetchBucket(s.bucketMeta.nsTxHistory)
+	return deleteByPrefix(nsTxHistory, []byte(walletId))
+}
+
+// rollbackTxHistory removes history entries of blocks from height on.
+func (s *TxStore) rollbackTxHistory(tx mwdb.DBTransaction, height uint64) error {
+	nsTxHistory := tx.FetchBucket(s.bucketMeta.nsTxHistory)
+	for _, walletId := range s.ksmgr.ListKeystoreNames() {
+		if err := rollbackWalletTxHistory(nsTxHistory, walletId, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollbackWalletTxHistory removes history entries of walletId of blocks from
+// height on.
+func rollbackWalletTxHistory(nsTxHistory mwdb.Bucket, walletId string, height uint64) error {
+	prefix := []byte(walletId)
+	start := make([]byte, len(prefix)+8)
+	copy(start, prefix)
+	binary.BigEndian.PutUint64(start[len(prefix):], height)
+
+	keys := make([][]byte, 0)
+	iter := nsTxHistory.NewIterator(&mwdb.Range{Start: start, Limit: mwdb.BytesPrefix(prefix).Limit})
+	for iter.Next() {
+		k := make([]byte, len(iter.Key()))
+		copy(k, iter.Key())
+		keys = append(keys, k)
+	}
+	err := iter.Error()
+	iter.Release()
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if err := nsTxHistory.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *TxStore) removeDoubleSpends(tx mwdb.DBTransaction, rec *TxRecord) error {
 
 	nsUnminedInputs := tx.FetchBucket(s.bucketMeta.nsUnminedInputs)
@@ -446,6 +701,34 @@ func (s *TxStore) removeConflict(tx mwdb.DBTransaction, rec *TxRecord) error {
 	return deleteRawUnmined(nsUnmined, rec.Hash[:])
 }
 
+// UnminedDoubleSpends returns the unmined transactions that spend any relevant
+// input of rec, mapped to the wallet owning that input. rec itself is excluded.
+func (s *TxStore) UnminedDoubleSpends(tx mwdb.ReadTransaction, rec *TxRecord) (map[wire.Hash]string, error) {
+	nsUnminedInputs := tx.FetchBucket(s.bucketMeta.nsUnminedInputs)
+	nsUnmined := tx.FetchBucket(s.bucketMeta.nsUnmined)
+
+	conflicts := make(map[wire.Hash]string)
+	for _, rel := range rec.RelevantTxIn {
+		prevOut := &rec.MsgTx.TxIn[rel.Index].PreviousOutPoint
+		prevOutKey := canonicalOutPoint(&prevOut.Hash, prevOut.Index)
+
+		for _, hash := range fetchUnminedInputSpendTxHashes(nsUnminedInputs, prevOutKey) {
+			if hash == rec.Hash {
+				continue
+			}
+			v, err := existsRawUnmined(nsUnmined, hash[:])
+			if err != nil {
+				return nil, err
+			}
+			if len(v) == 0 {
+				continue
+			}
+			conflicts[hash] = rel.WalletId
+		}
+	}
+	return conflicts, nil
+}
+
 func (s *TxStore) ExistUnminedTx(tx mwdb.ReadTransaction, hash *wire.Hash) (mtx *wire.MsgTx, err error) {
 	nsUnmined := tx.FetchBucket(s.bucketMeta.nsUnmined)
 	var rec TxRecord
@@ -463,8 +746,89 @@ func (s *TxStore) ExistUnminedTx(tx mwdb.ReadTransaction, hash *wire.Hash) (mtx
 	return &rec.MsgTx, nil
 }
 
-// for current wallet
-func (s *TxStore) ExistsTx(tx mwdb.ReadTransaction, out *wire.OutPoint) (mtx *wire.MsgTx, meta *BlockMeta, err error) {
+// UnminedTxs returns all unmined transactions in the store.
+func (s *TxStore) UnminedTxs(tx mwdb.ReadTransaction) ([]*TxRecord, error) {
+	nsUnmined := tx.FetchBucket(s.bucketMeta.nsUnmined)
+	iter := nsUnmined.NewIterator(nil)
+	defer iter.Release()
+
+	recs := make([]*TxRecord, 0)
+	for iter.Next() {
+		rec := &TxRecord{}
+		copy(rec.Hash[:], iter.Key())
+		if err := readRawUnmined(iter.Value(), rec); err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+	return recs, nil
+}
+
+// RemoveUnminedTx removes the unmined transaction along with the unmined
+// transactions spending its outputs, the outputs they spent are released. The
+// removed transactions are returned, ErrNotFound if hash is not unmined.
+func (s *TxStore) RemoveUnminedTx(tx mwdb.DBTransaction, hash *wire.Hash) ([]*TxRecord, error) {
+	nsUnmined := tx.FetchBucket(s.bucketMeta.nsUnmined)
+	v, err := existsRawUnmined(nsUnmined, hash[:])
+	if err != nil {
+		return nil, err
+	}
+	if len(v) == 0 {
+		return nil, ErrNotFound
+	}
+	rec := &TxRecord{Hash: *hash}
+	if err = readRawUnmined(v, rec); err != nil {
+		return nil, err
+	}
+
+	removed := make(map[wire.Hash]*TxRecord)
+	if err = s.collectUnminedSpenders(tx, rec, removed); err != nil {
+		return nil, err
+	}
+	if err = s.removeConflict(tx, rec); err != nil {
+		return nil, err
+	}
+	recs := make([]*TxRecord, 0, len(removed))
+	for _, r := range removed {
+		recs = append(recs, r)
+	}
+	return recs, nil
+}
+
+// collectUnminedSpenders adds rec and the unmined transactions spending its
+// outputs recursively to recs, the same set removeConflict removes.
+func (s *TxStore) collectUnminedSpenders(tx mwdb.DBTransaction, rec *TxRecord, recs map[wire.Hash]*TxRecord) error {
+	if _, ok := recs[rec.Hash]; ok {
+		return nil
+	}
+	recs[rec.Hash] = rec
+
+	nsUnminedInputs := tx.FetchBucket(s.bucketMeta.nsUnminedInputs)
+	nsUnmined := tx.FetchBucket(s.bucketMeta.nsUnmined)
+	for i := range rec.MsgTx.TxOut {
+		k := canonicalOutPoint(&rec.Hash, uint32(i))
+		for _, spenderHash := range fetchUnminedInputSpendTxHashes(nsUnminedInputs, k) {
+			spenderVal, err := existsRawUnmined(nsUnmined, spenderHash[:])
+			if err != nil {
+				return err
+			}
+			if len(spenderVal) == 0 {
+				continue
+			}
+			spender := &TxRecord{Hash: spenderHash}
+			if err = readRawUnmined(spenderVal, spender); err != nil {
+				return err
+			}
+			if err = s.collectUnminedSpenders(tx, spender, recs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ExistsTx returns the transaction creating out, which must be a credit of walletId
+func (s *TxStore) ExistsTx(tx mwdb.ReadTransaction, walletId string, out *wire.OutPoint) (mtx *wire.MsgTx, meta *BlockMeta, err error) {
 	nsUnspent := tx.FetchBucket(s.bucketMeta.nsUnspent)
 	nsCredits := tx.FetchBucket(s.bucketMeta.nsCredits)
 	nsTxRecords := tx.FetchBucket(s.bucketMeta.nsTxRecords)
@@ -473,7 +837,7 @@ func (s *TxStore) ExistsTx(tx mwdb.ReadTransaction, out *wire.OutPoint) (mtx *wi
 		block: &BlockMeta{},
 	}
 
-	_, credKey, err := existsUnspent(nsUnspent, s.ksmgr.CurrentKeystore().Name(), out)
+	_, credKey, err := existsUnspent(nsUnspent, walletId, out)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -532,8 +896,8 @@ func (s *TxStore) ExistsTx(tx mwdb.ReadTransaction, out *wire.OutPoint) (mtx *wi
 }
 
 // ExistsUtxo returns ErrNotFound if not exists
-// for current wallet
-func (s *TxStore) ExistsUtxo(tx mwdb.ReadTransaction, out *wire.OutPoint) (flags *UtxoFlags, err error) {
+// for the wallet specified by walletId
+func (s *TxStore) ExistsUtxo(tx mwdb.ReadTransaction, walletId string, out *wire.OutPoint) (flags *UtxoFlags, err error) {
 	nsUnspent := tx.FetchBucket(s.bucketMeta.nsUnspent)
 	nsCredits := tx.FetchBucket(s.bucketMeta.nsCredits)
 	nsUnminedInputs := tx.FetchBucket(s.bucketMeta.nsUnminedInputs)
@@ -543,7 +907,7 @@ func (s *TxStore) ExistsUtxo(tx mwdb.ReadTransaction, out *wire.OutPoint) (flags
 	}
 
 	// unspent exists
-	uspKey, credKey, err := existsUnspent(nsUnspent, s.ksmgr.CurrentKeystore().Name(), out)
+	uspKey, credKey, err := existsUnspent(nsUnspent, walletId, out)
 	if err != nil {
 		return nil, err
 	}
@@ -1059,6 +1423,10 @@ func (s *TxStore) Rollback(tx mwdb.DBTransaction, height uint64) error {
 		}
 	}
 
+	if err = s.rollbackTxHistory(tx, height); err != nil {
+		return err
+	}
+
 	// delete block record
 	for _, h := range heightsToRemove {
 		err = deleteBlockRecord(nsBlocks, h)
@@ -1190,8 +1558,6 @@ func (s *TxStore) RemoveRelevantTx(tx mwdb.DBTransaction, addrmgr *keystore.Addr
 	var rec TxRecord
 	deletedTx := make([]*wire.Hash, 0)
 	nsUnmined := tx.FetchBucket(s.bucketMeta.nsUnmined)
-	nsBlocks := tx.FetchBucket(s.bucketMeta.nsBlocks)
-	nsTxRecords := tx.FetchBucket(s.bucketMeta.nsTxRecords)
 
 	// unmined tx
 	unminedHashes, err := s.utxoStore.removeRelevantUnminedCredit(tx, scriptHashSet)
@@ -1246,11 +1612,28 @@ func (s *TxStore) RemoveRelevantTx(tx mwdb.DBTransaction, addrmgr *keystore.Addr
 	}
 
 	// delete tx/block if possible
+	removed, err := s.removeTxRecords(tx, heightOfTx, scriptHashSet)
+	if err != nil {
+		return nil, false, err
+	}
+	return append(deletedTx, removed...), finish, nil
+
+}
+
+// removeTxRecords deletes the records of the mined transactions in heightOfTx
+// which are not relevant to other wallets, along with the block records left
+// empty. It returns the deleted transactions.
+func (s *TxStore) removeTxRecords(tx mwdb.DBTransaction, heightOfTx map[wire.Hash]uint64,
+	scriptHashSet map[string]struct{}) ([]*wire.Hash, error) {
+	nsBlocks := tx.FetchBucket(s.bucketMeta.nsBlocks)
+	nsTxRecords := tx.FetchBucket(s.bucketMeta.nsTxRecords)
+
+	deletedTx := make([]*wire.Hash, 0)
 	blkDeleted := make(map[uint64]map[wire.Hash]struct{})
 	for txHash, txHeight := range heightOfTx {
 		item, err := fetchRawTxRecordByHashHeight(nsTxRecords, &txHash, txHeight)
 		if err != nil {
-			return nil, false, err
+			return nil, err
 		}
 		if item == nil {
 			logging.CPrint(logging.WARN, "tx not found, maybe already deleted",
@@ -1262,26 +1645,26 @@ func (s *TxStore) RemoveRelevantTx(tx mwdb.DBTransaction, addrmgr *keystore.Addr
 		}
 		blkLoc, txLoc, err := readTxRecordLoc(item.Value)
 		if err != nil {
-			return nil, false, err
+			return nil, err
 		}
 		msgtx, err := s.chainFetcher.FetchTxByFileLoc(blkLoc, txLoc)
 		if err != nil {
-			return nil, false, err
+			return nil, err
 		}
 		removable, err := s.removableTxForRemoveWallet(msgtx, scriptHashSet)
 		if err != nil {
-			return nil, false, err
+			return nil, err
 		}
 		if removable {
 			err = nsTxRecords.Delete(item.Key)
 			if err != nil {
-				return nil, false, err
+				return nil, err
 			}
 
 			// check & remove blockrecord
 			height, _, err := readTxRecordKey(item.Key)
 			if err != nil {
-				return nil, false, err
+				return nil, err
 			}
 			s, ok := blkDeleted[height]
 			if !ok {
@@ -1293,10 +1676,159 @@ func (s *TxStore) RemoveRelevantTx(tx mwdb.DBTransaction, addrmgr *keystore.Addr
 			deletedTx = append(deletedTx, &cpHash)
 		}
 	}
-	err = s.checkBlockRecordAfterTxRemoved(nsBlocks, blkDeleted)
+	err := s.checkBlockRecordAfterTxRemoved(nsBlocks, blkDeleted)
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
-	return deletedTx, finish, nil
+	return deletedTx, nil
+}
 
+// RollbackWallet removes the mined records of the wallet of addrmgr from
+// height on, so that it can be imported again from height. Credits of earlier
+// blocks spent from height on are unspent again and the mined balance is
+// updated accordingly, unmined records are kept. It returns the deleted
+// transactions.
+func (s *TxStore) RollbackWallet(tx mwdb.DBTransaction, addrmgr *keystore.AddrManager, height uint64) ([]*wire.Hash, error) {
+	walletId := addrmgr.Name()
+	scriptHashSet := make(map[string]struct{})
+	for _, ma := range addrmgr.ManagedAddresses() {
+		scriptHashSet[string(ma.ScriptAddress())] = struct{}{}
+	}
+
+	allMined, err := s.utxoStore.FetchAllMinedBalance(tx)
+	if err != nil {
+		return nil, err
+	}
+	balance := allMined[walletId]
+
+	nsCredits := tx.FetchBucket(s.bucketMeta.nsCredits)
+	nsDebits := tx.FetchBucket(s.bucketMeta.nsDebits)
+	nsUnspent := tx.FetchBucket(s.bucketMeta.nsUnspent)
+	nsGameHistory := tx.FetchBucket(s.bucketMeta.nsGameHistory)
+
+	// collect the credits first, they're updated while iterating otherwise
+	var credKeys, credValues [][]byte
+	iter := nsCredits.NewIterator(nil)
+	cred := credit{block: &BlockMeta{}}
+	for iter.Next() {
+		if err = readRawCreditKey(iter.Key(), &cred); err != nil {
+			break
+		}
+		if err = readCreditValue(iter.Value(), &cred); err != nil {
+			break
+		}
+		if _, ok := scriptHashSet[string(cred.scriptHash)]; !ok {
+			continue
+		}
+		spender := readCreditSpender(iter.Value())
+		if cred.block.Height < height && (spender == nil || binary.BigEndian.Uint64(spender[32:40]) < height) {
+			continue
+		}
+		credKeys = append(credKeys, append([]byte(nil), iter.Key()...))
+		credValues = append(credValues, append([]byte(nil), iter.Value()...))
+	}
+	if err == nil {
+		err = iter.Error()
+	}
+	iter.Release()
+	if err != nil {
+		return nil, err
+	}
+
+	heightOfTx := make(map[wire.Hash]uint64)
+	for i, credKey := range credKeys {
+		cred := credit{block: &BlockMeta{}}
+		if err = readRawCreditKey(credKey, &cred); err != nil {
+			return nil, err
+		}
+		if err = readCreditValue(credValues[i], &cred); err != nil {
+			return nil, err
+		}
+		op := cred.outPoint
+		unspentKey := canonicalUnspentKey(walletId, &op.Hash, op.Index)
+
+		if debitKey := readCreditSpender(credValues[i]); debitKey != nil {
+			debitHeight := binary.BigEndian.Uint64(debitKey[32:40])
+			if debitHeight >= height {
+				if err = deleteRawDebit(nsDebits, debitKey); err != nil {
+					return nil, err
+				}
+				var spender wire.Hash
+				copy(spender[:], debitKey[0:32])
+				heightOfTx[spender] = debitHeight
+			}
+			if cred.block.Height < height {
+				// spent from height on, unspent again
+				if _, err = unspendRawCredit(nsCredits, credKey); err != nil {
+					return nil, err
+				}
+				unspentVal, err := fetchNsUnspentValueFromRawCredit(credKey)
+				if err != nil {
+					return nil, err
+				}
+				if err = putRawUnspent(nsUnspent, unspentKey, unspentVal); err != nil {
+					return nil, err
+				}
+				if balance, err = balance.Add(cred.amount); err != nil {
+					return nil, err
+				}
+				if cred.isStaking() || cred.isBinding() {
+					err = unwithdrawGame(nsGameHistory, gameHistory{
+						walletId:    walletId,
+						txhash:      op.Hash,
+						vout:        op.Index,
+						isBinding:   cred.isBinding(),
+						blockHeight: cred.block.Height,
+					})
+					if err != nil {
+						return nil, err
+					}
+				}
+				continue
+			}
+		}
+
+		// credited from height on
+		if err = deleteRawCredit(nsCredits, credKey); err != nil {
+			return nil, err
+		}
+		unspentCredKey, err := existsRawUnspent(nsUnspent, unspentKey)
+		if err != nil {
+			return nil, err
+		}
+		if unspentCredKey != nil {
+			if err = deleteRawUnspent(nsUnspent, unspentKey); err != nil {
+				return nil, err
+			}
+			if balance, err = balance.Sub(cred.amount); err != nil {
+				return nil, err
+			}
+		}
+		if cred.isStaking() || cred.isBinding() {
+			history := &gameHistory{
+				walletId:    walletId,
+				txhash:      op.Hash,
+				vout:        op.Index,
+				isBinding:   cred.isBinding(),
+				blockHeight: cred.block.Height,
+			}
+			for _, withdrawn := range []bool{false, true} {
+				history.withdrawn = withdrawn
+				if err = nsGameHistory.Delete(keyGameHistory(history)); err != nil {
+					return nil, err
+				}
+			}
+		}
+		heightOfTx[op.Hash] = cred.block.Height
+	}
+
+	removed, err := s.removeTxRecords(tx, heightOfTx, scriptHashSet)
+	if err != nil {
+		return nil, err
+	}
+	nsTxHistory := tx.FetchBucket(s.bucketMeta.nsTxHistory)
+	if err = rollbackWalletTxHistory(nsTxHistory, walletId, height); err != nil {
+		return nil, err
+	}
+	return removed, s.utxoStore.UpdateMinedBalances(tx, map[string]massutil.Amount{walletId: balance})
 }
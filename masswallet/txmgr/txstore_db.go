@@ -7,6 +7,7 @@ import (
 
 	"github.com/massnetorg/mass-core/database"
 	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/massutil"
 	"github.com/massnetorg/mass-core/wire"
 	mwdb "massnet.org/mass-wallet/masswallet/db"
 )
@@ -281,3 +282,67 @@ func fetchBlockRecord(ns mwdb.Bucket, height uint64) (block *blockRecord, err er
 	}
 	return
 }
+
+// keyTxHistory returns the key of a history entry, or its cursor if walletId is empty.
+func keyTxHistory(walletId string, height uint64, txStart uint32, txHash *wire.Hash) []byte {
+	k := make([]byte, len(walletId)+44)
+	off := copy(k, walletId)
+	binary.BigEndian.PutUint64(k[off:off+8], height)
+	binary.BigEndian.PutUint32(k[off+8:off+12], txStart)
+	copy(k[off+12:off+44], txHash[:])
+	return k
+}
+
+func valueTxHistory(entry *TxHistoryEntry) []byte {
+	v := make([]byte, 33+32*len(entry.ScriptHashes))
+	binary.BigEndian.PutUint64(v[0:8], uint64(entry.Timestamp.Unix()))
+	v[8] = byte(entry.Type)
+	binary.BigEndian.PutUint64(v[9:17], uint64(entry.Received.IntValue()))
+	binary.BigEndian.PutUint64(v[17:25], uint64(entry.Sent.IntValue()))
+	binary.BigEndian.PutUint64(v[25:33], uint64(entry.Fee.IntValue()))
+	off := 33
+	for _, scriptHash := range entry.ScriptHashes {
+		copy(v[off:off+32], scriptHash)
+		off += 32
+	}
+	return v
+}
+
+func putTxHistory(ns mwdb.Bucket, walletId string, entry *TxHistoryEntry) error {
+	k := keyTxHistory(walletId, entry.BlockHeight, entry.TxStart, &entry.TxHash)
+	return ns.Put(k, valueTxHistory(entry))
+}
+
+func readTxHistory(k, v []byte) (*TxHistoryEntry, error) {
+	if len(k) != 86 {
+		return nil, fmt.Errorf("%s: invalid key length %d", bucketTxHistory, len(k))
+	}
+	if len(v) < 33 || (len(v)-33)%32 != 0 {
+		return nil, fmt.Errorf("%s: invalid value length %d", bucketTxHistory, len(v))
+	}
+	entry := &TxHistoryEntry{
+		BlockHeight: binary.BigEndian.Uint64(k[42:50]),
+		TxStart:     binary.BigEndian.Uint32(k[50:54]),
+		Timestamp:   time.Unix(int64(binary.BigEndian.Uint64(v[0:8])), 0),
+		Type:        HistoryTxType(v[8]),
+	}
+	copy(entry.TxHash[:], k[54:86])
+
+	var err error
+	if entry.Received, err = massutil.NewAmountFromUint(binary.BigEndian.Uint64(v[9:17])); err != nil {
+		return nil, err
+	}
+	if entry.Sent, err = massutil.NewAmountFromUint(binary.BigEndian.Uint64(v[17:25])); err != nil {
+		return nil, err
+	}
+	if entry.Fee, err = massutil.NewAmountFromUint(binary.BigEndian.Uint64(v[25:33])); err != nil {
+		return nil, err
+	}
+	entry.ScriptHashes = make([][]byte, 0, (len(v)-33)/32)
+	for off := 33; off < len(v); off += 32 {
+		scriptHash := make([]byte, 32)
+		copy(scriptHash, v[off:off+32])
+		entry.ScriptHashes = append(entry.ScriptHashes, scriptHash)
+	}
+	return entry, nil
+}
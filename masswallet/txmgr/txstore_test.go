@@ -9,10 +9,12 @@ import (
 	"github.com/massnetorg/mass-core/blockchain"
 	"github.com/massnetorg/mass-core/logging"
 	"github.com/massnetorg/mass-core/massutil"
+	"github.com/massnetorg/mass-core/txscript"
 	"github.com/massnetorg/mass-core/wire"
 	"github.com/stretchr/testify/assert"
+	"massnet.org/mass-wallet/config"
 	mwdb "massnet.org/mass-wallet/masswallet/db"
-	_ "massnet.org/mass-wallet/masswallet/db/ldb"
+	"massnet.org/mass-wallet/masswallet/ifc"
 	"massnet.org/mass-wallet/masswallet/keystore"
 	"massnet.org/mass-wallet/masswallet/utils"
 )
@@ -283,6 +285,176 @@ func TestRemoveRelevantTx(t *testing.T) {
 	})
 }
 
+func TestTxHistory(t *testing.T) {
+	chainDb, chainDbTearDown, err := GetDb("TstTxHistoryChainDb")
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer chainDbTearDown()
+	err = initBlocks(chainDb, 10)
+	if err != nil {
+		t.Fatal("initBlocks failed:", err)
+	}
+
+	s, walletDb, teardown, err := testTxStore("TstTxHistory", chainDb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	wIds := s.ksmgr.ListKeystoreNames()
+	var expected []wire.Hash // in order of height and position in block
+	numCoinbase := 0
+	err = mwdb.Update(walletDb, func(ns mwdb.DBTransaction) error {
+		allMinedBalances := map[string]massutil.Amount{
+			wIds[0]: massutil.ZeroAmount(),
+		}
+		for _, block := range blks200[1:10] {
+			blockMeta := &BlockMeta{
+				Height:    block.MsgBlock().Header.Height,
+				Hash:      *block.Hash(),
+				Timestamp: block.MsgBlock().Header.Timestamp,
+			}
+			blockMeta.Loc, err = chainDb.FetchBlockLocByHeight(blockMeta.Height)
+			if err != nil {
+				return err
+			}
+			txlocs, err := block.TxLoc()
+			if err != nil {
+				return err
+			}
+			for i, tx := range block.Transactions() {
+				rec, err := NewTxRecordFromMsgTx(tx.MsgTx(), time.Now())
+				if err != nil {
+					return err
+				}
+				rec, err = simpleFilterTx(rec, tx.MsgTx(), s, blockMeta, wIds[0])
+				if err != nil {
+					return err
+				}
+				rec.TxLoc = &txlocs[i]
+				err = s.AddRelevantTx(ns, allMinedBalances, rec, blockMeta)
+				if err != nil {
+					return err
+				}
+				expected = append(expected, *tx.Hash())
+				if blockchain.IsCoinBaseTx(tx.MsgTx()) {
+					numCoinbase++
+				}
+			}
+		}
+		return nil
+	})
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	query := func(q *TxHistoryQuery) (entries []*TxHistoryEntry, cursor []byte) {
+		err := mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+			var err error
+			entries, cursor, err = s.TxHistory(tx, wIds[0], q)
+			return err
+		})
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+		return
+	}
+	paginate := func(descending bool, pageSize int) []wire.Hash {
+		hashes := make([]wire.Hash, 0)
+		q := &TxHistoryQuery{Descending: descending, Limit: pageSize}
+		for {
+			entries, cursor := query(q)
+			assert.True(t, len(entries) <= pageSize)
+			for _, entry := range entries {
+				hashes = append(hashes, entry.TxHash)
+			}
+			if cursor == nil {
+				return hashes
+			}
+			q.Cursor = cursor
+		}
+	}
+
+	// all
+	entries, cursor := query(&TxHistoryQuery{Limit: 1000})
+	assert.Nil(t, cursor)
+	assert.Equal(t, len(expected), len(entries))
+	for i, entry := range entries {
+		assert.Equal(t, expected[i], entry.TxHash)
+		assert.True(t, len(entry.ScriptHashes) > 0)
+		if entry.Type == HistoryCoinbase {
+			assert.True(t, entry.Received.Cmp(massutil.ZeroAmount()) > 0)
+			assert.True(t, entry.Sent.IsZero())
+			assert.True(t, entry.Fee.IsZero())
+		} else {
+			net, err := entry.Sent.Sub(entry.Received)
+			assert.Nil(t, err)
+			assert.Equal(t, net, entry.Fee)
+		}
+	}
+
+	// pagination
+	assert.Equal(t, expected, paginate(false, 3))
+	reversed := paginate(true, 4)
+	assert.Equal(t, len(expected), len(reversed))
+	for i := range reversed {
+		assert.Equal(t, expected[len(expected)-1-i], reversed[i])
+	}
+
+	// filters
+	entries, _ = query(&TxHistoryQuery{FromHeight: 3, ToHeight: 5, Limit: 1000})
+	assert.True(t, len(entries) > 0)
+	for _, entry := range entries {
+		assert.True(t, entry.BlockHeight >= 3 && entry.BlockHeight <= 5)
+	}
+	entries, _ = query(&TxHistoryQuery{Types: []HistoryTxType{HistoryCoinbase}, Limit: 1000})
+	assert.Equal(t, numCoinbase, len(entries))
+	entries, _ = query(&TxHistoryQuery{ScriptHash: make([]byte, 32), Limit: 1000})
+	assert.Equal(t, 0, len(entries))
+
+	err = mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+		_, _, err := s.TxHistory(tx, wIds[0], &TxHistoryQuery{Cursor: []byte{1}, Limit: 10})
+		return err
+	})
+	assert.Equal(t, ErrInvalidHistoryCursor, err)
+
+	// backfill of a db indexed from no block on
+	all, _ := query(&TxHistoryQuery{Limit: 1000})
+	backfill := func() int {
+		var added int
+		err := mwdb.Update(walletDb, func(tx mwdb.DBTransaction) (err error) {
+			added, err = backfillTxHistory(tx, s.bucketMeta, config.ChainParams, ifc.NewChainFetcher(chainDb))
+			return err
+		})
+		if !assert.Nil(t, err) {
+			t.FailNow()
+		}
+		return added
+	}
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		return s.RemoveTxHistoryByWalletId(tx, wIds[0])
+	})
+	assert.Nil(t, err)
+	entries, _ = query(&TxHistoryQuery{Limit: 1000})
+	assert.Equal(t, 0, len(entries))
+	assert.Equal(t, len(all), backfill())
+	entries, _ = query(&TxHistoryQuery{Limit: 1000})
+	assert.Equal(t, all, entries)
+	assert.Equal(t, 0, backfill())
+
+	// rollback
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		return s.rollbackTxHistory(tx, 6)
+	})
+	assert.Nil(t, err)
+	entries, _ = query(&TxHistoryQuery{Limit: 1000})
+	assert.True(t, len(entries) > 0)
+	for _, entry := range entries {
+		assert.True(t, entry.BlockHeight < 6)
+	}
+}
+
 //
 func TestExistUtxo(t *testing.T) {
 	chainDb, chainDbTearDown, err := GetDb("TstExistUtoxChainDb")
@@ -301,8 +473,8 @@ func TestExistUtxo(t *testing.T) {
 	}
 	defer teardown()
 
+	wIds := s.ksmgr.ListKeystoreNames()
 	err = mwdb.Update(walletDb, func(ns mwdb.DBTransaction) error {
-		wIds := s.ksmgr.ListKeystoreNames()
 		err = s.ksmgr.UseKeystoreForWallet(wIds[0])
 		if err != nil {
 			return err
@@ -351,7 +523,7 @@ func TestExistUtxo(t *testing.T) {
 				txhash := tx.Hash()
 				for i := range tx.MsgTx().TxOut {
 					outPoint := wire.OutPoint{Hash: *txhash, Index: uint32(i)}
-					f, err := s.ExistsUtxo(ns, &outPoint)
+					f, err := s.ExistsUtxo(ns, wIds[0], &outPoint)
 					if j >= 10 {
 						assert.Equal(t, ErrNotFound, err)
 					} else {
@@ -366,3 +538,84 @@ func TestExistUtxo(t *testing.T) {
 		return nil
 	})
 }
+
+func TestRemoveUnminedTx(t *testing.T) {
+	chainDb, chainDbTearDown, err := GetDb("TstRemoveUnminedTxChainDb")
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer chainDbTearDown()
+
+	s, walletDb, teardown, err := testTxStore("TstRemoveUnminedTx", chainDb)
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer teardown()
+	walletId := s.ksmgr.ListKeystoreNames()[0]
+	pkScript, err := txscript.PayToWitnessScriptHashScript(make([]byte, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// parent spends a mined output, child spends the output of parent
+	minedOut := wire.OutPoint{Hash: wire.Hash{1}, Index: 0}
+	parent := wire.NewMsgTx()
+	parent.AddTxIn(wire.NewTxIn(&minedOut, nil))
+	parent.AddTxOut(wire.NewTxOut(1e8, pkScript))
+	parentHash := parent.TxHash()
+	child := wire.NewMsgTx()
+	child.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&parentHash, 0), nil))
+	child.AddTxOut(wire.NewTxOut(1e7, pkScript))
+	childHash := child.TxHash()
+
+	err = mwdb.Update(walletDb, func(ns mwdb.DBTransaction) error {
+		for _, mtx := range []*wire.MsgTx{parent, child} {
+			rec, err := NewTxRecordFromMsgTx(mtx, time.Now())
+			if err != nil {
+				return err
+			}
+			rec.RelevantTxIn = []*RelevantMeta{{Index: 0, WalletId: walletId}}
+			if err = s.InsertTx(ns, nil, rec, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+
+	mwdb.View(walletDb, func(ns mwdb.ReadTransaction) error {
+		recs, err := s.UnminedTxs(ns)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(recs))
+		return nil
+	})
+
+	err = mwdb.Update(walletDb, func(ns mwdb.DBTransaction) error {
+		removed, err := s.RemoveUnminedTx(ns, &parentHash)
+		if err != nil {
+			return err
+		}
+		hashes := make(map[wire.Hash]struct{})
+		for _, rec := range removed {
+			hashes[rec.Hash] = struct{}{}
+		}
+		assert.Equal(t, map[wire.Hash]struct{}{parentHash: {}, childHash: {}}, hashes)
+
+		_, err = s.RemoveUnminedTx(ns, &childHash)
+		assert.Equal(t, ErrNotFound, err)
+		return nil
+	})
+	assert.Nil(t, err)
+
+	mwdb.View(walletDb, func(ns mwdb.ReadTransaction) error {
+		recs, err := s.UnminedTxs(ns)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(recs))
+		nsUnminedInputs := ns.FetchBucket(s.bucketMeta.nsUnminedInputs)
+		assert.Nil(t, existsRawUnminedInput(nsUnminedInputs, canonicalOutPoint(&minedOut.Hash, minedOut.Index)))
+		assert.Nil(t, existsRawUnminedInput(nsUnminedInputs, canonicalOutPoint(&parentHash, 0)))
+		return nil
+	})
+}
@@ -1,6 +1,7 @@
 package txmgr
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"math"
@@ -85,6 +86,24 @@ const (
 	//	  [0]         	- 0
 	bucketUnminedGameHistory = "LG"
 
+	// Key:
+	//    [0:42]  - wallet id
+	//    [42:50] - block height
+	//    [50:54] - tx offset in block
+	//    [54:86] - txid
+	// Value:
+	//    [0:8]   - block timestamp
+	//    [8:9]   - tx type
+	//                 0: transfer
+	//                 1: staking
+	//                 2: binding
+	//                 3: coinbase
+	//    [9:17]  - amount received by the wallet
+	//    [17:25] - amount sent by the wallet
+	//    [25:33] - fee, 0 if not all inputs are spent by the wallet
+	//    [33:33+32*N] - witness script hash of wallet addresses involved
+	bucketTxHistory = "h"
+
 	//-----------------utxo buckets-----------------
 
 	// Key:
@@ -138,6 +157,37 @@ const (
 	//    [8:40]  - block.hash
 	bucketUnspent = "u"
 
+	// Key:
+	//   [0:42]	   - wallet id(bech32 string)
+	//   [42:74]   - Transaction hash (32 bytes)
+	//   [74:78]   - Output index (4 bytes)
+	// Value:
+	//    [0:8]   - expiry, unix seconds, 0 if never expires
+	//    [8:]    - name of the lock
+	bucketLockedUnspent = "lu"
+
+	// Key:
+	//   [0:42]	   - wallet id(bech32 string)
+	//   [42:43]   - label kind
+	//                 0: address
+	//                 1: transaction
+	//   [43:]     - address (bech32 string) or tx hash (hex string)
+	// Value:
+	//    [0:]    - text of the label
+	bucketLabels = "lb"
+
+	// Key:
+	//   [0:42]	   - wallet id(bech32 string)
+	//   [42:43]   - record kind
+	//                 0: payout batch
+	//                 1: payout key
+	//   [43:]     - batch id or payout key
+	// Value of payout batch:
+	//    see putPayoutBatch
+	// Value of payout key:
+	//    [0:]    - id of the batch holding the payout
+	bucketPayouts = "po"
+
 	// Key:
 	//    [0:32]  - hash of txrecord
 	//    [32:40] - block.height
@@ -286,6 +336,143 @@ type Credit struct {
 	ScriptHash    []byte // for ScriptAddressUnspents
 }
 
+// LabelKind is the kind of object a Label is attached to.
+type LabelKind byte
+
+const (
+	LabelAddress LabelKind = iota
+	LabelTransaction
+)
+
+// Label is a user-defined text attached to an address or a transaction of a wallet.
+type Label struct {
+	Kind   LabelKind
+	Target string // encoded address, or tx hash string
+	Text   string
+}
+
+// PayoutTxStatus is the state of a transaction made by a payout batch.
+type PayoutTxStatus byte
+
+const (
+	// PayoutTxPending is signed but not yet accepted by the mempool.
+	PayoutTxPending PayoutTxStatus = iota
+	PayoutTxSubmitted
+	PayoutTxFailed
+	// PayoutTxMined is reported for a submitted tx mined in the best chain,
+	// it is never stored.
+	PayoutTxMined
+)
+
+// PayoutTx is a signed transaction paying some payouts of a batch.
+type PayoutTx struct {
+	Hash   wire.Hash
+	Status PayoutTxStatus
+	Error  string // reason of PayoutTxFailed
+	Raw    []byte // serialized signed tx
+}
+
+// Payout is a payment of a batch, identified by a key unique in the wallet.
+type Payout struct {
+	Key     string
+	Address string
+	Amount  massutil.Amount
+	TxIndex int    // index of the paying tx in PayoutBatch.Txs, -1 if no tx was made
+	Error   string // reason no tx was made
+}
+
+// PayoutBatch is a list of payouts paid by one or more transactions.
+type PayoutBatch struct {
+	Id      string
+	Created time.Time
+	Payouts []*Payout
+	Txs     []*PayoutTx
+}
+
+// HistoryTxType classifies an indexed transaction from the view of a wallet.
+type HistoryTxType byte
+
+const (
+	HistoryTransfer HistoryTxType = iota
+	HistoryStaking
+	HistoryBinding
+	HistoryCoinbase
+)
+
+// TxHistoryEntry is a mined transaction relevant to a wallet, indexed when the
+// transaction is synced.
+type TxHistoryEntry struct {
+	TxHash       wire.Hash
+	BlockHeight  uint64
+	TxStart      uint32 // offset of the tx in block
+	Timestamp    time.Time
+	Type         HistoryTxType
+	Received     massutil.Amount // sum of outputs to the wallet
+	Sent         massutil.Amount // sum of utxos of the wallet spent
+	Fee          massutil.Amount // zero if not all inputs are spent by the wallet
+	ScriptHashes [][]byte        // witness script hashes of wallet addresses involved
+}
+
+// Cursor returns the position of the entry, to continue a TxHistoryQuery from.
+func (e *TxHistoryEntry) Cursor() []byte {
+	return keyTxHistory("", e.BlockHeight, e.TxStart, &e.TxHash)
+}
+
+// TxHistoryQuery selects entries of the transaction history index.
+type TxHistoryQuery struct {
+	Cursor     []byte // optional, returns entries after (before if Descending) it
+	Descending bool
+	FromHeight uint64
+	ToHeight   uint64 // 0 means unbounded
+	FromTime   int64  // unix seconds, 0 means unbounded
+	ToTime     int64  // unix seconds, 0 means unbounded
+	ScriptHash []byte // optional, only entries involving the address
+	Types      []HistoryTxType
+	Limit      int
+}
+
+func (q *TxHistoryQuery) match(e *TxHistoryEntry) bool {
+	if q.FromTime > 0 && e.Timestamp.Unix() < q.FromTime {
+		return false
+	}
+	if q.ToTime > 0 && e.Timestamp.Unix() > q.ToTime {
+		return false
+	}
+	if len(q.Types) > 0 {
+		found := false
+		for _, typ := range q.Types {
+			if typ == e.Type {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(q.ScriptHash) > 0 {
+		for _, scriptHash := range e.ScriptHashes {
+			if bytes.Equal(scriptHash, q.ScriptHash) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// LockedUnspent is an unspent output excluded from automatic coin selection.
+type LockedUnspent struct {
+	wire.OutPoint
+	Name   string
+	Expiry int64 // unix seconds, 0 if never expires
+}
+
+// Expired returns whether the lock is no longer in force at now.
+func (l *LockedUnspent) Expired(now int64) bool {
+	return l.Expiry != 0 && l.Expiry <= now
+}
+
 type UtxoFlags struct {
 	Spent          bool
 	SpentByUnmined bool
@@ -395,6 +582,7 @@ const (
 	WalletSyncedDone = math.MaxUint64
 
 	WalletFlagsRemove byte = 0x01
+	WalletFlagsRescan byte = 0x02
 )
 
 type WalletStatus struct {
@@ -411,6 +599,12 @@ func (s *WalletStatus) IsRemoved() bool {
 	return s.Flags&WalletFlagsRemove != 0
 }
 
+// IsRescanning reports whether the relevant records of the wallet are being
+// cleared for a rescan, the wallet is importing again once they are cleared.
+func (s *WalletStatus) IsRescanning() bool {
+	return s.Flags&WalletFlagsRescan != 0
+}
+
 type StoreBucketMeta struct {
 	// TxStore
 	nsUnmined            mwdb.BucketMeta
@@ -419,6 +613,7 @@ type StoreBucketMeta struct {
 	nsAddresses          mwdb.BucketMeta
 	nsGameHistory        mwdb.BucketMeta
 	nsUnminedGameHistory mwdb.BucketMeta
+	nsTxHistory          mwdb.BucketMeta
 
 	// UtxoStore
 	nsUnspent        mwdb.BucketMeta
@@ -427,6 +622,9 @@ type StoreBucketMeta struct {
 	nsMinedBalance   mwdb.BucketMeta
 	nsCredits        mwdb.BucketMeta
 	nsDebits         mwdb.BucketMeta
+	nsLockedUnspent  mwdb.BucketMeta
+	nsLabels         mwdb.BucketMeta
+	nsPayouts        mwdb.BucketMeta
 
 	// SyncStore
 	nsSyncBucketName mwdb.BucketMeta
@@ -452,6 +650,9 @@ func (s *StoreBucketMeta) CheckInit() error {
 	if s.nsUnminedGameHistory == nil {
 		return errors.New("StoreBucketMeta.nsUnminedGameHistory not initialized")
 	}
+	if s.nsTxHistory == nil {
+		return errors.New("StoreBucketMeta.nsTxHistory not initialized")
+	}
 	if s.nsUnspent == nil {
 		return errors.New("StoreBucketMeta.nsUnspent not initialized")
 	}
@@ -470,6 +671,15 @@ func (s *StoreBucketMeta) CheckInit() error {
 	if s.nsDebits == nil {
 		return errors.New("StoreBucketMeta.nsDebits not initialized")
 	}
+	if s.nsLockedUnspent == nil {
+		return errors.New("StoreBucketMeta.nsLockedUnspent not initialized")
+	}
+	if s.nsLabels == nil {
+		return errors.New("StoreBucketMeta.nsLabels not initialized")
+	}
+	if s.nsPayouts == nil {
+		return errors.New("StoreBucketMeta.nsPayouts not initialized")
+	}
 	if s.nsSyncBucketName == nil {
 		return errors.New("StoreBucketMeta.nsSyncBucketName not initialized")
 	}
@@ -481,4 +691,5 @@ func (s *StoreBucketMeta) CheckInit() error {
 
 type TxMemPool interface {
 	CheckPoolOutPointSpend(op *wire.OutPoint) bool
+	HaveTransaction(hash *wire.Hash) bool
 }
@@ -75,6 +75,24 @@ func NewUtxoStore(chainFetcher ifc.ChainFetcher, store mwdb.Bucket, ksmgr *keyst
 		return nil, err
 	}
 	s.bucketMeta.nsDebits = bucket.GetBucketMeta()
+	// locked unspent
+	bucket, err = mwdb.GetOrCreateBucket(store, bucketLockedUnspent)
+	if err != nil {
+		return nil, err
+	}
+	s.bucketMeta.nsLockedUnspent = bucket.GetBucketMeta()
+	// labels
+	bucket, err = mwdb.GetOrCreateBucket(store, bucketLabels)
+	if err != nil {
+		return nil, err
+	}
+	s.bucketMeta.nsLabels = bucket.GetBucketMeta()
+	// payouts
+	bucket, err = mwdb.GetOrCreateBucket(store, bucketPayouts)
+	if err != nil {
+		return nil, err
+	}
+	s.bucketMeta.nsPayouts = bucket.GetBucketMeta()
 
 	//bucketAddresses
 	bucket, err = mwdb.GetOrCreateBucket(store, bucketAddresses)
@@ -487,6 +505,202 @@ func (s *UtxoStore) RemoveUnspentByWalletId(tx mwdb.DBTransaction, walletId stri
 	return deleteByPrefix(nsUnspent, []byte(walletId))
 }
 
+func (s *UtxoStore) RemoveLockedUnspentByWalletId(tx mwdb.DBTransaction, walletId string) error {
+	if len(walletId) == 0 {
+		return nil
+	}
+	nsLockedUnspent := tx.FetchBucket(s.bucketMeta.nsLockedUnspent)
+	return deleteByPrefix(nsLockedUnspent, []byte(walletId))
+}
+
+// LockUnspent excludes an unspent output of walletId from automatic coin selection,
+// an existing lock of the same output is replaced. It returns ErrNotFound if the
+// output is not an unspent of walletId.
+//
+// Expired locks and locks of spent outputs are dropped meanwhile.
+func (s *UtxoStore) LockUnspent(tx mwdb.DBTransaction, walletId string, lock *LockedUnspent, now int64) error {
+	nsUnspent := tx.FetchBucket(s.bucketMeta.nsUnspent)
+	nsLockedUnspent := tx.FetchBucket(s.bucketMeta.nsLockedUnspent)
+
+	entries, err := nsLockedUnspent.GetByPrefix([]byte(walletId))
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		var old LockedUnspent
+		if err = readLockedUnspent(entry.Key, entry.Value, &old); err != nil {
+			return err
+		}
+		credKey, err := existsRawUnspent(nsUnspent, entry.Key)
+		if err != nil {
+			return err
+		}
+		if credKey == nil || old.Expired(now) {
+			if err = deleteKey(nsLockedUnspent, entry.Key); err != nil {
+				return err
+			}
+		}
+	}
+
+	k, credKey, err := existsUnspent(nsUnspent, walletId, &lock.OutPoint)
+	if err != nil {
+		return err
+	}
+	if credKey == nil {
+		return ErrNotFound
+	}
+	return putLockedUnspent(nsLockedUnspent, k, lock)
+}
+
+// UnlockUnspent removes the lock of op, it returns false if op is not locked.
+func (s *UtxoStore) UnlockUnspent(tx mwdb.DBTransaction, walletId string, op *wire.OutPoint) (bool, error) {
+	if len(walletId) != 42 {
+		return false, fmt.Errorf("short walletId value (expect 42 bytes, actual %d bytes)", len(walletId))
+	}
+	nsLockedUnspent := tx.FetchBucket(s.bucketMeta.nsLockedUnspent)
+	k := canonicalUnspentKey(walletId, &op.Hash, op.Index)
+	v, err := existsValue(nsLockedUnspent, k)
+	if err != nil || v == nil {
+		return false, err
+	}
+	return true, deleteKey(nsLockedUnspent, k)
+}
+
+// LockedUnspents returns locks of walletId in force at now, that is neither
+// expired nor of spent outputs.
+func (s *UtxoStore) LockedUnspents(tx mwdb.ReadTransaction, walletId string, now int64) ([]*LockedUnspent, error) {
+	nsUnspent := tx.FetchBucket(s.bucketMeta.nsUnspent)
+	nsLockedUnspent := tx.FetchBucket(s.bucketMeta.nsLockedUnspent)
+
+	entries, err := nsLockedUnspent.GetByPrefix([]byte(walletId))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*LockedUnspent, 0, len(entries))
+	for _, entry := range entries {
+		lock := &LockedUnspent{}
+		if err = readLockedUnspent(entry.Key, entry.Value, lock); err != nil {
+			return nil, err
+		}
+		if lock.Expired(now) {
+			continue
+		}
+		credKey, err := existsRawUnspent(nsUnspent, entry.Key)
+		if err != nil {
+			return nil, err
+		}
+		if credKey == nil {
+			continue
+		}
+		ret = append(ret, lock)
+	}
+	return ret, nil
+}
+
+func (s *UtxoStore) RemoveLabelsByWalletId(tx mwdb.DBTransaction, walletId string) error {
+	if len(walletId) == 0 {
+		return nil
+	}
+	nsLabels := tx.FetchBucket(s.bucketMeta.nsLabels)
+	return deleteByPrefix(nsLabels, []byte(walletId))
+}
+
+// PutLabel attaches label to its target, replacing the existing one. A label
+// with empty text removes the existing one.
+func (s *UtxoStore) PutLabel(tx mwdb.DBTransaction, walletId string, label *Label) error {
+	k, err := keyLabel(walletId, label.Kind, label.Target)
+	if err != nil {
+		return err
+	}
+	nsLabels := tx.FetchBucket(s.bucketMeta.nsLabels)
+	if len(label.Text) == 0 {
+		return deleteKey(nsLabels, k)
+	}
+	return putLabel(nsLabels, k, label.Text)
+}
+
+// Labels returns all labels of walletId.
+func (s *UtxoStore) Labels(tx mwdb.ReadTransaction, walletId string) ([]*Label, error) {
+	nsLabels := tx.FetchBucket(s.bucketMeta.nsLabels)
+	entries, err := nsLabels.GetByPrefix([]byte(walletId))
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]*Label, 0, len(entries))
+	for _, entry := range entries {
+		label := &Label{}
+		if err = readLabel(entry.Key, entry.Value, label); err != nil {
+			return nil, err
+		}
+		ret = append(ret, label)
+	}
+	return ret, nil
+}
+
+func (s *UtxoStore) RemovePayoutsByWalletId(tx mwdb.DBTransaction, walletId string) error {
+	if len(walletId) == 0 {
+		return nil
+	}
+	nsPayouts := tx.FetchBucket(s.bucketMeta.nsPayouts)
+	return deleteByPrefix(nsPayouts, []byte(walletId))
+}
+
+// PutPayoutBatch stores batch, replacing the existing one of the same id, and
+// maps the key of each payout to the batch.
+func (s *UtxoStore) PutPayoutBatch(tx mwdb.DBTransaction, walletId string, batch *PayoutBatch) error {
+	nsPayouts := tx.FetchBucket(s.bucketMeta.nsPayouts)
+	k, err := keyPayoutRecord(walletId, payoutBatchRecord, batch.Id)
+	if err != nil {
+		return err
+	}
+	if err = putPayoutBatch(nsPayouts, k, batch); err != nil {
+		return err
+	}
+	for _, payout := range batch.Payouts {
+		k, err = keyPayoutRecord(walletId, payoutKeyRecord, payout.Key)
+		if err != nil {
+			return err
+		}
+		if err = nsPayouts.Put(k, []byte(batch.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FetchPayoutBatch returns the batch of id, or nil if not found.
+func (s *UtxoStore) FetchPayoutBatch(tx mwdb.ReadTransaction, walletId, id string) (*PayoutBatch, error) {
+	nsPayouts := tx.FetchBucket(s.bucketMeta.nsPayouts)
+	k, err := keyPayoutRecord(walletId, payoutBatchRecord, id)
+	if err != nil {
+		return nil, err
+	}
+	v, err := existsValue(nsPayouts, k)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	batch := &PayoutBatch{}
+	if err = readPayoutBatch(k, v, batch); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// PayoutBatchId returns the id of the batch holding the payout of key, or an
+// empty string if not found.
+func (s *UtxoStore) PayoutBatchId(tx mwdb.ReadTransaction, walletId, key string) (string, error) {
+	nsPayouts := tx.FetchBucket(s.bucketMeta.nsPayouts)
+	k, err := keyPayoutRecord(walletId, payoutKeyRecord, key)
+	if err != nil {
+		return "", err
+	}
+	v, err := existsValue(nsPayouts, k)
+	if err != nil {
+		return "", err
+	}
+	return string(v), nil
+}
+
 func (s *UtxoStore) RemoveGameHistoryByWalletId(tx mwdb.DBTransaction, walletId string) error {
 	if len(walletId) == 0 {
 		return nil
@@ -571,7 +785,7 @@ func (s *UtxoStore) UpdateMinedBalances(tx mwdb.DBTransaction, balances map[stri
 }
 
 // FetchAllMinedBalance ...
-func (s *UtxoStore) FetchAllMinedBalance(tx mwdb.DBTransaction) (map[string]massutil.Amount, error) {
+func (s *UtxoStore) FetchAllMinedBalance(tx mwdb.ReadTransaction) (map[string]massutil.Amount, error) {
 	nsMinedBalance := tx.FetchBucket(s.bucketMeta.nsMinedBalance)
 	entries, err := fetchMinedBalance(nsMinedBalance, "")
 	if err != nil {
@@ -630,7 +844,7 @@ func (s *UtxoStore) WalletBalance(tx mwdb.ReadTransaction, addrMgr *keystore.Add
 	if len(filteredScripts) == 0 {
 		return ret, nil
 	}
-	bal, err := s.ScriptAddressBalance(tx, filteredScripts, minConf, syncHeight, txpool)
+	bal, err := s.ScriptAddressBalance(tx, addrMgr.Name(), filteredScripts, minConf, syncHeight, txpool)
 	if err != nil {
 		return nil, fmt.Errorf("error to get address Balance: %v", err)
 	}
@@ -657,7 +871,7 @@ func (s *UtxoStore) WalletBalance(tx mwdb.ReadTransaction, addrMgr *keystore.Add
 }
 
 // ScriptAddressBalance scripts -- script address in string format
-func (s *UtxoStore) ScriptAddressBalance(tx mwdb.ReadTransaction, scripts map[string]struct{},
+func (s *UtxoStore) ScriptAddressBalance(tx mwdb.ReadTransaction, walletId string, scripts map[string]struct{},
 	minConf uint32, syncHeight uint64, txpool TxMemPool) (map[string]*BalanceDetail, error) {
 
 	s.muUtxo.Lock()
@@ -676,7 +890,7 @@ func (s *UtxoStore) ScriptAddressBalance(tx mwdb.ReadTransaction, scripts map[st
 	nsUnspent := tx.FetchBucket(s.bucketMeta.nsUnspent)
 	nsCredits := tx.FetchBucket(s.bucketMeta.nsCredits)
 
-	iter := nsUnspent.NewIterator(mwdb.BytesPrefix([]byte(s.ksmgr.CurrentKeystore().Name())))
+	iter := nsUnspent.NewIterator(mwdb.BytesPrefix([]byte(walletId)))
 	defer iter.Release()
 
 	cred := &credit{
@@ -764,7 +978,7 @@ func (s *UtxoStore) ScriptAddressBalance(tx mwdb.ReadTransaction, scripts map[st
 
 // AddressUnspents returns all spendable UTXOs of specified addresses, including those spent by unmined transaction
 // return scriptHash->*Credit
-func (s *UtxoStore) ScriptAddressUnspents(tx mwdb.ReadTransaction, scriptAddrs map[string]struct{},
+func (s *UtxoStore) ScriptAddressUnspents(tx mwdb.ReadTransaction, walletId string, scriptAddrs map[string]struct{},
 	syncHeight uint64, filter CreditIterationFilter) (map[string][]*Credit, error) {
 
 	s.muUtxo.Lock()
@@ -782,7 +996,7 @@ func (s *UtxoStore) ScriptAddressUnspents(tx mwdb.ReadTransaction, scriptAddrs m
 	var op wire.OutPoint
 	var block BlockMeta
 
-	iter := nsUnspent.NewIterator(mwdb.BytesPrefix([]byte(s.ksmgr.CurrentKeystore().Name())))
+	iter := nsUnspent.NewIterator(mwdb.BytesPrefix([]byte(walletId)))
 	defer iter.Release()
 
 	for iter.Next() {
@@ -4,6 +4,8 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/massnetorg/mass-core/massutil"
 	"github.com/massnetorg/mass-core/wire"
@@ -562,6 +564,230 @@ func readBlockOfUnspent(v []byte, block *BlockMeta) error {
 	return nil
 }
 
+func putLockedUnspent(ns mwdb.Bucket, k []byte, lock *LockedUnspent) error {
+	v := make([]byte, 8+len(lock.Name))
+	binary.BigEndian.PutUint64(v, uint64(lock.Expiry))
+	copy(v[8:], lock.Name)
+	err := ns.Put(k, v)
+	if err != nil {
+		return fmt.Errorf("cannot put locked unspent: %v", err)
+	}
+	return nil
+}
+
+func readLockedUnspent(k, v []byte, lock *LockedUnspent) error {
+	if len(v) < 8 {
+		return fmt.Errorf("short locked unspent value (expect at least 8 bytes, read %d)", len(v))
+	}
+	err := readCanonicalUnspentKey(k, &lock.OutPoint)
+	if err != nil {
+		return err
+	}
+	lock.Expiry = int64(binary.BigEndian.Uint64(v))
+	lock.Name = string(v[8:])
+	return nil
+}
+
+func keyLabel(walletId string, kind LabelKind, target string) ([]byte, error) {
+	if len(walletId) != 42 {
+		return nil, fmt.Errorf("short walletId value (expect 42 bytes, actual %d bytes)", len(walletId))
+	}
+	if kind != LabelAddress && kind != LabelTransaction {
+		return nil, fmt.Errorf("unexpected label kind %d", kind)
+	}
+	if len(target) == 0 {
+		return nil, errors.New("empty label target")
+	}
+	k := make([]byte, 43+len(target))
+	copy(k, walletId)
+	k[42] = byte(kind)
+	copy(k[43:], target)
+	return k, nil
+}
+
+func putLabel(ns mwdb.Bucket, k []byte, text string) error {
+	err := ns.Put(k, []byte(text))
+	if err != nil {
+		return fmt.Errorf("cannot put label: %v", err)
+	}
+	return nil
+}
+
+func readLabel(k, v []byte, label *Label) error {
+	if len(k) <= 43 {
+		return fmt.Errorf("short label key (expect more than 43 bytes, read %d)", len(k))
+	}
+	label.Kind = LabelKind(k[42])
+	label.Target = string(k[43:])
+	label.Text = string(v)
+	return nil
+}
+
+const (
+	payoutBatchRecord byte = iota
+	payoutKeyRecord
+)
+
+func keyPayoutRecord(walletId string, kind byte, id string) ([]byte, error) {
+	if len(walletId) != 42 {
+		return nil, fmt.Errorf("short walletId value (expect 42 bytes, actual %d bytes)", len(walletId))
+	}
+	if len(id) == 0 {
+		return nil, errors.New("empty payout record id")
+	}
+	k := make([]byte, 43+len(id))
+	copy(k, walletId)
+	k[42] = kind
+	copy(k[43:], id)
+	return k, nil
+}
+
+// putPayoutBatch writes batch under k, the value is
+//
+//	[0:8]   - created, unix seconds
+//	[8:12]  - number of txs, followed by txs of
+//	        [0:32]  - tx hash
+//	        [32:33] - status
+//	        [33:35] - length of error, followed by error
+//	        [..+4]  - length of raw tx, followed by raw tx
+//	[..+4]  - number of payouts, followed by payouts of
+//	        [0:1]   - length of key, followed by key
+//	        [..+1]  - length of address, followed by address
+//	        [..+8]  - amount
+//	        [..+4]  - tx index, 0xffffffff if no tx was made
+//	        [..+2]  - length of error, followed by error
+func putPayoutBatch(ns mwdb.Bucket, k []byte, batch *PayoutBatch) error {
+	v := make([]byte, 12, 512)
+	binary.BigEndian.PutUint64(v, uint64(batch.Created.Unix()))
+	binary.BigEndian.PutUint32(v[8:], uint32(len(batch.Txs)))
+	for _, ptx := range batch.Txs {
+		v = append(v, ptx.Hash[:]...)
+		v = append(v, byte(ptx.Status))
+		v = appendPayoutBytes(v, 2, []byte(ptx.Error))
+		v = appendPayoutBytes(v, 4, ptx.Raw)
+	}
+	v = appendPayoutUint(v, 4, uint64(len(batch.Payouts)))
+	for _, payout := range batch.Payouts {
+		if len(payout.Key) > math.MaxUint8 || len(payout.Address) > math.MaxUint8 {
+			return errors.New("payout key or address too long")
+		}
+		v = appendPayoutBytes(v, 1, []byte(payout.Key))
+		v = appendPayoutBytes(v, 1, []byte(payout.Address))
+		v = appendPayoutUint(v, 8, payout.Amount.UintValue())
+		txIndex := uint64(math.MaxUint32)
+		if payout.TxIndex >= 0 {
+			txIndex = uint64(payout.TxIndex)
+		}
+		v = appendPayoutUint(v, 4, txIndex)
+		v = appendPayoutBytes(v, 2, []byte(payout.Error))
+	}
+	err := ns.Put(k, v)
+	if err != nil {
+		return fmt.Errorf("cannot put payout batch: %v", err)
+	}
+	return nil
+}
+
+func appendPayoutUint(v []byte, size int, n uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, n)
+	return append(v, b[8-size:]...)
+}
+
+// appendPayoutBytes appends data prefixed by its length in size bytes, data
+// longer than the length can hold is truncated.
+func appendPayoutBytes(v []byte, size int, data []byte) []byte {
+	max := uint64(1)<<(uint(size)*8) - 1
+	if uint64(len(data)) > max {
+		data = data[:max]
+	}
+	v = appendPayoutUint(v, size, uint64(len(data)))
+	return append(v, data...)
+}
+
+// payoutReader reads a payout batch value, the first error is kept in err.
+type payoutReader struct {
+	v   []byte
+	err error
+}
+
+func (r *payoutReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if len(r.v) < n {
+		r.err = fmt.Errorf("short payout batch value (expect %d more bytes, read %d)", n, len(r.v))
+		return nil
+	}
+	b := r.v[:n]
+	r.v = r.v[n:]
+	return b
+}
+
+func (r *payoutReader) uint(size int) uint64 {
+	b := r.next(size)
+	if b == nil {
+		return 0
+	}
+	var n uint64
+	for _, c := range b {
+		n = n<<8 | uint64(c)
+	}
+	return n
+}
+
+func (r *payoutReader) bytes(size int) []byte {
+	return r.next(int(r.uint(size)))
+}
+
+func readPayoutBatch(k, v []byte, batch *PayoutBatch) error {
+	if len(k) <= 43 {
+		return fmt.Errorf("short payout batch key (expect more than 43 bytes, read %d)", len(k))
+	}
+	batch.Id = string(k[43:])
+
+	r := &payoutReader{v: v}
+	batch.Created = time.Unix(int64(r.uint(8)), 0)
+	txCount := int(r.uint(4))
+	batch.Txs = make([]*PayoutTx, 0, txCount)
+	for i := 0; i < txCount && r.err == nil; i++ {
+		ptx := &PayoutTx{}
+		copy(ptx.Hash[:], r.next(wire.HashSize))
+		ptx.Status = PayoutTxStatus(r.uint(1))
+		ptx.Error = string(r.bytes(2))
+		ptx.Raw = append([]byte(nil), r.bytes(4)...)
+		batch.Txs = append(batch.Txs, ptx)
+	}
+	payoutCount := int(r.uint(4))
+	batch.Payouts = make([]*Payout, 0, payoutCount)
+	for i := 0; i < payoutCount && r.err == nil; i++ {
+		payout := &Payout{
+			Key:     string(r.bytes(1)),
+			Address: string(r.bytes(1)),
+		}
+		amount, err := massutil.NewAmountFromUint(r.uint(8))
+		if err != nil {
+			return err
+		}
+		payout.Amount = amount
+		payout.TxIndex = -1
+		if txIndex := r.uint(4); txIndex != math.MaxUint32 {
+			payout.TxIndex = int(txIndex)
+		}
+		payout.Error = string(r.bytes(2))
+		batch.Payouts = append(batch.Payouts, payout)
+	}
+	if r.err != nil {
+		return r.err
+	}
+	for _, payout := range batch.Payouts {
+		if payout.TxIndex >= len(batch.Txs) {
+			return fmt.Errorf("payout tx index %d out of range", payout.TxIndex)
+		}
+	}
+	return nil
+}
+
 func keyAddressRecord(rec *addressRecord) ([]byte, error) {
 	widLen := len(rec.walletId)
 	if widLen != 42 {
@@ -431,3 +431,258 @@ func TestAddRelevantTx(t *testing.T) {
 		return nil
 	})
 }
+
+func TestLockUnspent(t *testing.T) {
+	chainDb, chainDbTearDown, err := GetDb("TstLockUnspentChainDb")
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer chainDbTearDown()
+
+	s, walletDb, teardown, err := testTxStore("TstLockUnspent", chainDb)
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	walletId1 := "ac10tcdcmxcatq0dp0ceucgdjc5m7azujzfenzzwfp"
+	walletId2 := "ac10jv5xfkywm9fu2elcjyqyq4gyz6yu6jzm7fq8fz"
+	ops := []*wire.OutPoint{
+		wire.NewOutPoint(&wire.Hash{0x01}, 0),
+		wire.NewOutPoint(&wire.Hash{0x01}, 1),
+		wire.NewOutPoint(&wire.Hash{0x02}, 0),
+	}
+	now := time.Now().Unix()
+
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		nsUnspent := tx.FetchBucket(s.bucketMeta.nsUnspent)
+		for _, op := range ops {
+			if err := putUnspent(nsUnspent, walletId1, op, &BlockMeta{Height: 1}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		assert.Nil(t, s.utxoStore.LockUnspent(tx, walletId1, &LockedUnspent{OutPoint: *ops[0], Name: "cold"}, now))
+		assert.Nil(t, s.utxoStore.LockUnspent(tx, walletId1, &LockedUnspent{OutPoint: *ops[1], Name: "payout", Expiry: now + 60}, now))
+		assert.Nil(t, s.utxoStore.LockUnspent(tx, walletId1, &LockedUnspent{OutPoint: *ops[2], Expiry: now - 1}, now))
+		// not an unspent of walletId2
+		assert.Equal(t, ErrNotFound, s.utxoStore.LockUnspent(tx, walletId2, &LockedUnspent{OutPoint: *ops[0]}, now))
+		return nil
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+
+	err = mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+		locks, err := s.utxoStore.LockedUnspents(tx, walletId1, now)
+		if err != nil {
+			return err
+		}
+		// the expired one is excluded
+		assert.Equal(t, 2, len(locks))
+		assert.Equal(t, LockedUnspent{OutPoint: *ops[0], Name: "cold"}, *locks[0])
+		assert.Equal(t, LockedUnspent{OutPoint: *ops[1], Name: "payout", Expiry: now + 60}, *locks[1])
+
+		locks, err = s.utxoStore.LockedUnspents(tx, walletId1, now+60)
+		assert.Equal(t, 1, len(locks))
+
+		locks, err = s.utxoStore.LockedUnspents(tx, walletId2, now)
+		assert.Equal(t, 0, len(locks))
+		return err
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+
+	// spent outputs are not locked anymore
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		nsUnspent := tx.FetchBucket(s.bucketMeta.nsUnspent)
+		if err := deleteRawUnspent(nsUnspent, canonicalUnspentKey(walletId1, &ops[1].Hash, ops[1].Index)); err != nil {
+			return err
+		}
+		locks, err := s.utxoStore.LockedUnspents(tx, walletId1, now)
+		assert.Equal(t, 1, len(locks))
+
+		unlocked, err := s.utxoStore.UnlockUnspent(tx, walletId1, ops[0])
+		assert.Nil(t, err)
+		assert.True(t, unlocked)
+		unlocked, err = s.utxoStore.UnlockUnspent(tx, walletId1, ops[0])
+		assert.Nil(t, err)
+		assert.False(t, unlocked)
+
+		locks, err = s.utxoStore.LockedUnspents(tx, walletId1, now)
+		assert.Equal(t, 0, len(locks))
+		return err
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		assert.Nil(t, s.utxoStore.LockUnspent(tx, walletId1, &LockedUnspent{OutPoint: *ops[2]}, now))
+		// stale locks are dropped by LockUnspent
+		nsLockedUnspent := tx.FetchBucket(s.bucketMeta.nsLockedUnspent)
+		entries, err := fetchAllEntry(nsLockedUnspent)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(entries))
+
+		assert.Nil(t, s.utxoStore.RemoveLockedUnspentByWalletId(tx, walletId1))
+		entries, err = fetchAllEntry(nsLockedUnspent)
+		assert.Equal(t, 0, len(entries))
+		return err
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+}
+
+func TestLabels(t *testing.T) {
+	chainDb, chainDbTearDown, err := GetDb("TstLabelsChainDb")
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer chainDbTearDown()
+
+	s, walletDb, teardown, err := testTxStore("TstLabels", chainDb)
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	walletId1 := "ac10tcdcmxcatq0dp0ceucgdjc5m7azujzfenzzwfp"
+	walletId2 := "ac10jv5xfkywm9fu2elcjyqyq4gyz6yu6jzm7fq8fz"
+	address := "ms1qqgq750hhj0lcem3pmv6gymvvmfmrzmvhyxqwyp4fey2cmec372pjq3uw7yg"
+	txId := wire.Hash{0x01}.String()
+
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		assert.Nil(t, s.utxoStore.PutLabel(tx, walletId1, &Label{Kind: LabelAddress, Target: address, Text: "alice"}))
+		assert.Nil(t, s.utxoStore.PutLabel(tx, walletId1, &Label{Kind: LabelAddress, Target: address, Text: "bob"}))
+		assert.Nil(t, s.utxoStore.PutLabel(tx, walletId1, &Label{Kind: LabelTransaction, Target: txId, Text: "invoice 42"}))
+		assert.Nil(t, s.utxoStore.PutLabel(tx, walletId2, &Label{Kind: LabelTransaction, Target: txId, Text: "refund"}))
+		assert.NotNil(t, s.utxoStore.PutLabel(tx, walletId1, &Label{Kind: LabelAddress, Text: "no target"}))
+		assert.NotNil(t, s.utxoStore.PutLabel(tx, walletId1, &Label{Kind: 2, Target: address, Text: "unknown kind"}))
+		return nil
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+
+	err = mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+		labels, err := s.utxoStore.Labels(tx, walletId1)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, []*Label{
+			{Kind: LabelAddress, Target: address, Text: "bob"},
+			{Kind: LabelTransaction, Target: txId, Text: "invoice 42"},
+		}, labels)
+
+		labels, err = s.utxoStore.Labels(tx, walletId2)
+		assert.Equal(t, []*Label{{Kind: LabelTransaction, Target: txId, Text: "refund"}}, labels)
+		return err
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		// empty text removes the label
+		assert.Nil(t, s.utxoStore.PutLabel(tx, walletId1, &Label{Kind: LabelAddress, Target: address}))
+		labels, err := s.utxoStore.Labels(tx, walletId1)
+		assert.Equal(t, 1, len(labels))
+
+		assert.Nil(t, s.utxoStore.RemoveLabelsByWalletId(tx, walletId1))
+		labels, err = s.utxoStore.Labels(tx, walletId1)
+		assert.Equal(t, 0, len(labels))
+		labels, err = s.utxoStore.Labels(tx, walletId2)
+		assert.Equal(t, 1, len(labels))
+		return err
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+}
+
+func TestPayoutBatch(t *testing.T) {
+	chainDb, chainDbTearDown, err := GetDb("TstPayoutBatchChainDb")
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer chainDbTearDown()
+
+	s, walletDb, teardown, err := testTxStore("TstPayoutBatch", chainDb)
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	walletId1 := "ac10tcdcmxcatq0dp0ceucgdjc5m7azujzfenzzwfp"
+	walletId2 := "ac10jv5xfkywm9fu2elcjyqyq4gyz6yu6jzm7fq8fz"
+	address := "ms1qqgq750hhj0lcem3pmv6gymvvmfmrzmvhyxqwyp4fey2cmec372pjq3uw7yg"
+	amount, err := massutil.NewAmountFromUint(1e8)
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+
+	batch := &PayoutBatch{
+		Id:      "0123456789abcdef0123456789abcdef",
+		Created: time.Unix(time.Now().Unix(), 0),
+		Payouts: []*Payout{
+			{Key: "w-1", Address: address, Amount: amount, TxIndex: 0},
+			{Key: "w-2", Address: address, Amount: amount, TxIndex: 1},
+			{Key: "w-3", Address: address, Amount: amount, TxIndex: -1, Error: "Insufficient funds"},
+		},
+		Txs: []*PayoutTx{
+			{Hash: wire.Hash{0x01}, Status: PayoutTxSubmitted, Raw: []byte{0x01, 0x02}},
+			{Hash: wire.Hash{0x02}, Status: PayoutTxFailed, Error: "rejected", Raw: []byte{0x03}},
+		},
+	}
+
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		return s.utxoStore.PutPayoutBatch(tx, walletId1, batch)
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+
+	err = mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+		stored, err := s.utxoStore.FetchPayoutBatch(tx, walletId1, batch.Id)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, batch, stored)
+		stored, err = s.utxoStore.FetchPayoutBatch(tx, walletId2, batch.Id)
+		assert.Nil(t, stored)
+		if err != nil {
+			return err
+		}
+
+		id, err := s.utxoStore.PayoutBatchId(tx, walletId1, "w-3")
+		assert.Equal(t, batch.Id, id)
+		if err != nil {
+			return err
+		}
+		id, err = s.utxoStore.PayoutBatchId(tx, walletId1, "w-4")
+		assert.Equal(t, "", id)
+		return err
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		assert.Nil(t, s.utxoStore.RemovePayoutsByWalletId(tx, walletId1))
+		stored, err := s.utxoStore.FetchPayoutBatch(tx, walletId1, batch.Id)
+		assert.Nil(t, stored)
+		return err
+	})
+	if !assert.Nil(t, err) {
+		t.Fatal(err)
+	}
+}
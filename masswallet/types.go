@@ -3,10 +3,9 @@ package masswallet
 import (
 	"errors"
 
-	"github.com/massnetorg/mass-core/blockchain"
-	"github.com/massnetorg/mass-core/database"
 	"github.com/massnetorg/mass-core/massutil"
-	"github.com/massnetorg/mass-core/netsync"
+	"github.com/massnetorg/mass-core/wire"
+	"massnet.org/mass-wallet/masswallet/ifc"
 	"massnet.org/mass-wallet/masswallet/txmgr"
 )
 
@@ -33,11 +32,12 @@ type AddressBalance struct {
 }
 
 type WalletSummary struct {
-	WalletID string
-	Type     uint32
-	Version  uint8
-	Remarks  string
-	Status   *txmgr.WalletStatus
+	WalletID  string
+	Type      uint32
+	Version   uint8
+	Remarks   string
+	WatchOnly bool
+	Status    *txmgr.WalletStatus
 }
 
 type WalletInfo struct {
@@ -49,6 +49,7 @@ type WalletInfo struct {
 	ExternalKeyCount int32           `json:"external_key_count"`
 	InternalKeyCount int32           `json:"internal_key_count"`
 	Remarks          string          `json:"remarks"`
+	WatchOnly        bool            `json:"watch_only"`
 }
 
 type UnspentDetail struct {
@@ -62,9 +63,14 @@ type UnspentDetail struct {
 	// IsCoinbase bool `json"is_coinbase"`
 }
 
+// Server is the chain source the wallet follows, the embedded full node or a
+// remote one.
 type Server interface {
-	Blockchain() *blockchain.Blockchain
-	ChainDB() database.Db
-	TxMemPool() *blockchain.TxPool
-	SyncManager() *netsync.SyncManager
+	ChainFetcher() ifc.ChainFetcher
+	TxMemPool() txmgr.TxMemPool
+	BestBlockHeight() uint64
+	BestPeerHeight() uint64
+	SubmitTx(tx *wire.MsgTx) error
+	RegisterListener(listener ifc.ChainListener)
+	UnregisterListener(listener ifc.ChainListener)
 }
@@ -0,0 +1,256 @@
+package masswallet
+
+import (
+	"sort"
+	"time"
+
+	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/wire"
+
+	"massnet.org/mass-wallet/config"
+	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/masswallet/utils"
+)
+
+// UnconfirmedTx is an unmined transaction sending from or to a wallet.
+type UnconfirmedTx struct {
+	TxId      string
+	Received  time.Time
+	Size      int
+	InMempool bool
+}
+
+// ListUnconfirmed returns the unmined transactions of the wallet in the order
+// received, InMempool reports whether the mempool still holds each of them.
+func (w *WalletManager) ListUnconfirmed(walletId string) ([]*UnconfirmedTx, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
+	}
+
+	var recs []*txmgr.TxRecord
+	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+		all, err := w.txStore.UnminedTxs(tx)
+		if err != nil {
+			return err
+		}
+		for _, rec := range all {
+			relevant, err := w.isUnminedTxOf(tx, am, rec)
+			if err != nil {
+				return err
+			}
+			if relevant {
+				recs = append(recs, rec)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pool := w.server.TxMemPool()
+	txs := make([]*UnconfirmedTx, 0, len(recs))
+	for _, rec := range sortUnmined(recs) {
+		txs = append(txs, &UnconfirmedTx{
+			TxId:      rec.Hash.String(),
+			Received:  rec.Received,
+			Size:      rec.MsgTx.PlainSize(),
+			InMempool: pool.HaveTransaction(&rec.Hash),
+		})
+	}
+	return txs, nil
+}
+
+// AbandonTransaction removes an unmined transaction of the wallet, along with
+// the unmined transactions spending its outputs, so that the outputs they spent
+// become spendable again. A transaction still in the mempool may yet be mined
+// and is not abandoned. It returns the ids of the removed transactions.
+func (w *WalletManager) AbandonTransaction(walletId string, txId string) ([]string, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := wire.NewHashFromStr(txId)
+	if err != nil {
+		return nil, ErrShaHashFromStr
+	}
+	if w.server.TxMemPool().HaveTransaction(hash) {
+		return nil, ErrTxInMempool
+	}
+
+	var removed []*txmgr.TxRecord
+	err = mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
+		mtx, err := w.txStore.ExistUnminedTx(tx, hash)
+		if err != nil {
+			if err == txmgr.ErrNotFound {
+				return ErrUnconfirmedNotFound
+			}
+			return err
+		}
+		relevant, err := w.isUnminedTxOf(tx, am, &txmgr.TxRecord{Hash: *hash, MsgTx: *mtx})
+		if err != nil {
+			return err
+		}
+		if !relevant {
+			return ErrUnconfirmedNotFound
+		}
+		removed, err = w.txStore.RemoveUnminedTx(tx, hash)
+		return err
+	})
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to abandon transaction", logging.LogFormat{
+			"err":      err,
+			"tx":       txId,
+			"walletId": am.Name(),
+		})
+		return nil, err
+	}
+
+	hashes := make([]*wire.Hash, 0, len(removed))
+	txIds := make([]string, 0, len(removed))
+	for _, rec := range sortUnmined(removed) {
+		w.ClearUsedUTXOMark(&rec.MsgTx)
+		hashes = append(hashes, &rec.Hash)
+		txIds = append(txIds, rec.Hash.String())
+	}
+	w.ntfnsHandler.RemoveMempoolTx(hashes)
+
+	logging.CPrint(logging.INFO, "abandoned transaction", logging.LogFormat{
+		"tx":       txId,
+		"walletId": am.Name(),
+		"removed":  txIds,
+	})
+	return txIds, nil
+}
+
+// rebroadcastUnmined resubmits the unmined transactions missing from the
+// mempool, parents ahead of the transactions spending them. It returns the
+// number of transactions accepted.
+func (w *WalletManager) rebroadcastUnmined() int {
+	var recs []*txmgr.TxRecord
+	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) (err error) {
+		recs, err = w.txStore.UnminedTxs(tx)
+		return err
+	})
+	if err != nil {
+		logging.CPrint(logging.WARN, "failed to fetch unmined transactions", logging.LogFormat{"err": err})
+		return 0
+	}
+
+	pool := w.server.TxMemPool()
+	submitted := 0
+	for _, rec := range sortUnmined(recs) {
+		if pool.HaveTransaction(&rec.Hash) {
+			continue
+		}
+		if err := w.server.SubmitTx(&rec.MsgTx); err != nil {
+			logging.CPrint(logging.DEBUG, "failed to rebroadcast transaction", logging.LogFormat{
+				"tx":  rec.Hash.String(),
+				"err": err,
+			})
+			continue
+		}
+		submitted++
+	}
+	if submitted > 0 {
+		logging.CPrint(logging.INFO, "rebroadcast unconfirmed transactions", logging.LogFormat{
+			"submitted": submitted,
+			"unmined":   len(recs),
+		})
+	}
+	return submitted
+}
+
+// rebroadcaster resubmits unmined transactions every rebroadcast interval, in
+// case the mempool has dropped them.
+func rebroadcaster(h *NtfnsHandler) {
+	defer Recover()
+	defer h.quitWg.Done()
+
+	interval := h.walletMgr.config.Wallet.Settings.RebroadcastInterval
+	if interval == 0 {
+		interval = config.DefaultRebroadcastInterval
+	}
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.quit:
+			return
+		case <-ticker.C:
+			h.walletMgr.rebroadcastUnmined()
+		}
+	}
+}
+
+// isUnminedTxOf returns whether rec pays to or spends from an address of am.
+func (w *WalletManager) isUnminedTxOf(tx mwdb.ReadTransaction, am *keystore.AddrManager,
+	rec *txmgr.TxRecord) (bool, error) {
+	for _, txOut := range rec.MsgTx.TxOut {
+		if w.ownsPkScript(am, txOut.PkScript) {
+			return true, nil
+		}
+	}
+	for _, txIn := range rec.MsgTx.TxIn {
+		prevOut := &txIn.PreviousOutPoint
+		prevTx, _, err := w.txStore.ExistsTx(tx, am.Name(), prevOut)
+		if err == txmgr.ErrNotFound {
+			prevTx, err = w.txStore.ExistUnminedTx(tx, &prevOut.Hash)
+			if err == txmgr.ErrNotFound {
+				continue
+			}
+		}
+		if err != nil {
+			return false, err
+		}
+		if int(prevOut.Index) < len(prevTx.TxOut) && w.ownsPkScript(am, prevTx.TxOut[prevOut.Index].PkScript) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (w *WalletManager) ownsPkScript(am *keystore.AddrManager, pkScript []byte) bool {
+	ps, err := utils.ParsePkScript(pkScript, w.chainParams)
+	if err != nil {
+		return false
+	}
+	_, err = am.Address(ps.StdEncodeAddress())
+	return err == nil
+}
+
+// sortUnmined orders recs by the time received, with each transaction after
+// the unmined transactions it spends.
+func sortUnmined(recs []*txmgr.TxRecord) []*txmgr.TxRecord {
+	sort.SliceStable(recs, func(i, j int) bool {
+		return recs[i].Received.Before(recs[j].Received)
+	})
+	byHash := make(map[wire.Hash]*txmgr.TxRecord, len(recs))
+	for _, rec := range recs {
+		byHash[rec.Hash] = rec
+	}
+
+	sorted := make([]*txmgr.TxRecord, 0, len(recs))
+	visited := make(map[wire.Hash]struct{}, len(recs))
+	var visit func(rec *txmgr.TxRecord)
+	visit = func(rec *txmgr.TxRecord) {
+		if _, ok := visited[rec.Hash]; ok {
+			return
+		}
+		visited[rec.Hash] = struct{}{}
+		for _, txIn := range rec.MsgTx.TxIn {
+			if parent, ok := byHash[txIn.PreviousOutPoint.Hash]; ok {
+				visit(parent)
+			}
+		}
+		sorted = append(sorted, rec)
+	}
+	for _, rec := range recs {
+		visit(rec)
+	}
+	return sorted
+}
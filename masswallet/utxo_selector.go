@@ -1,7 +1,6 @@
 package masswallet
 
 import (
-	"github.com/massnetorg/mass-core/blockchain"
 	"github.com/massnetorg/mass-core/massutil"
 	"massnet.org/mass-wallet/masswallet/txmgr"
 )
@@ -13,9 +12,7 @@ type topKSelector struct {
 	requireAmt massutil.Amount
 }
 
-func newTopKSelector(requireAmt massutil.Amount) *topKSelector {
-	// See policy.go isDust(...)
-	k := blockchain.GetMaxStandardTxSize() / 154
+func newTopKSelector(k int, requireAmt massutil.Amount) *topKSelector {
 	return &topKSelector{
 		k:          k,
 		base:       make([]*txmgr.Credit, 0, k),
@@ -1,6 +1,7 @@
 package masswallet
 
 import (
+	"bytes"
 	"fmt"
 	"math"
 	"sort"
@@ -10,13 +11,15 @@ import (
 
 	"github.com/massnetorg/mass-core/logging"
 	"github.com/massnetorg/mass-core/massutil"
-	"github.com/massnetorg/mass-core/txscript"
 	"github.com/massnetorg/mass-core/wire"
 	"massnet.org/mass-wallet/config"
 	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/db/encdb"
 	"massnet.org/mass-wallet/masswallet/ifc"
 	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/migration"
 	"massnet.org/mass-wallet/masswallet/txmgr"
+	"massnet.org/mass-wallet/masswallet/utils"
 
 	cache "github.com/patrickmn/go-cache"
 )
@@ -26,6 +29,7 @@ const (
 	utxoBucket     = "u"
 	txBucket       = "t"
 	syncBucket     = "s"
+	feeBucket      = "f"
 )
 
 type WalletManager struct {
@@ -41,6 +45,8 @@ type WalletManager struct {
 	syncStore  *txmgr.SyncStore
 
 	ntfnsHandler *NtfnsHandler
+	events       *eventHub
+	fees         *feeEstimator
 
 	server Server
 
@@ -59,15 +65,34 @@ func NewWalletManager(server Server, db mwdb.DB, config *config.Config,
 		return nil, ErrNilDB
 	}
 
+	// upgrade the schema before the stores are loaded
+	opts := &migration.Options{
+		Env: &migration.Env{ChainParams: chainParams, ChainFetcher: server.ChainFetcher()},
+	}
+	if config.Core != nil && config.Core.Datastore != nil {
+		opts.BackupDir = config.Core.Datastore.Dir
+		opts.BackupDBType = config.Core.Datastore.DBType
+	}
+	if edb, ok := db.(*encdb.DB); ok {
+		opts.BackupSource = edb.Inner()
+	}
+	if _, err := migration.DefaultRegistry.Migrate(db, opts); err != nil {
+		logging.CPrint(logging.ERROR, "failed to migrate wallet db", logging.LogFormat{
+			"err": err,
+		})
+		return nil, err
+	}
+
 	w := &WalletManager{
 		config:       config,
 		db:           db,
 		chainParams:  chainParams,
-		chainFetcher: ifc.NewChainFetcher(server.ChainDB()),
+		chainFetcher: server.ChainFetcher(),
 		bucketMeta:   &txmgr.StoreBucketMeta{},
 		server:       server,
 		usedCache:    cache.New(5*time.Minute, 10*time.Minute),
 	}
+	w.events = newEventHub(w.replayEvents)
 
 	err := mwdb.Update(db, func(tx mwdb.DBTransaction) error {
 		// init KeystoreManager
@@ -134,6 +159,32 @@ func NewWalletManager(server Server, db mwdb.DB, config *config.Config,
 			})
 			return err
 		}
+
+		// init fee estimator
+		bucket, err = mwdb.GetOrCreateTopLevelBucket(tx, feeBucket)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to get bucket", logging.LogFormat{
+				"err": err,
+			})
+			return err
+		}
+		w.fees, err = loadFeeEstimator(bucket)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to load fee estimator", logging.LogFormat{
+				"err": err,
+			})
+			return err
+		}
+		// stats are saved every feeStatsFlushInterval blocks, mempool txs are
+		// seen at the synced height until the next block
+		syncedTo, err := w.syncStore.SyncedTo(tx)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to fetch synced height", logging.LogFormat{
+				"err": err,
+			})
+			return err
+		}
+		w.fees.bestHeight = syncedTo.Height
 		return nil
 	})
 	if err != nil {
@@ -235,10 +286,43 @@ func (w *WalletManager) UseWallet(name string) (*WalletInfo, error) {
 		ExternalKeyCount: int32(external),
 		InternalKeyCount: int32(internal),
 		Remarks:          am.Remarks(),
+		WatchOnly:        am.IsWatchOnly(),
 	}
 	return wi, nil
 }
 
+// getAddrManager returns the keystore of the wallet specified by walletId,
+// or the one selected by UseWallet if walletId is empty.
+func (w *WalletManager) getAddrManager(walletId string) (*keystore.AddrManager, error) {
+	if len(walletId) == 0 {
+		am := w.ksmgr.CurrentKeystore()
+		if am == nil {
+			logging.CPrint(logging.ERROR, "no wallet in use", logging.LogFormat{
+				"err": ErrNoWalletInUse,
+			})
+			return nil, ErrNoWalletInUse
+		}
+		return am, nil
+	}
+
+	am, err := w.ksmgr.GetAddrManagerByAccountID(walletId)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "wallet not found", logging.LogFormat{
+			"err":      err,
+			"walletId": walletId,
+		})
+		return nil, ErrWalletNotFound
+	}
+	ready, err := w.CheckReady(walletId)
+	if err != nil {
+		return nil, err
+	}
+	if !ready {
+		return nil, ErrWalletUnready
+	}
+	return am, nil
+}
+
 func (w *WalletManager) Wallets() ([]*WalletSummary, error) {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
@@ -255,11 +339,12 @@ func (w *WalletManager) Wallets() ([]*WalletSummary, error) {
 				return fmt.Errorf("%s: %v", status.WalletID, err)
 			}
 			summary := &WalletSummary{
-				WalletID: mgr.Name(),
-				Type:     uint32(mgr.AddrUse()),
-				Version:  mgr.Version().Value(),
-				Remarks:  mgr.Remarks(),
-				Status:   status,
+				WalletID:  mgr.Name(),
+				Type:      uint32(mgr.AddrUse()),
+				Version:   mgr.Version().Value(),
+				Remarks:   mgr.Remarks(),
+				WatchOnly: mgr.IsWatchOnly(),
+				Status:    status,
 			}
 			ret = append(ret, summary)
 		}
@@ -346,6 +431,16 @@ func (w *WalletManager) ImportWallet(keystoreJSON, pass string) (*WalletSummary,
 				return err
 			}
 		}
+
+		if labels := labelsFromKeystore([]byte(keystoreJSON)); labels != nil {
+			err = w.putWalletLabels(tx, am, labels)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "failed to put labels", logging.LogFormat{
+					"err": err,
+				})
+				return err
+			}
+		}
 		return nil
 	})
 	if err != nil {
@@ -431,6 +526,117 @@ func (w *WalletManager) ImportWalletWithMnemonic(walletParams *keystore.WalletPa
 	}, nil
 }
 
+// ImportWatchOnlyWallet imports a wallet from the account extended public key.
+// It syncs and builds transactions like any other wallet but cannot sign.
+func (w *WalletManager) ImportWatchOnlyWallet(accountPubKey string, walletParams *keystore.WalletParams) (*WalletSummary, error) {
+	return w.importPublicWallet(func(tx mwdb.DBTransaction) (*keystore.AddrManager, error) {
+		am, err := w.ksmgr.ImportWatchOnlyKeystore(tx, w.chainFetcher.CheckScriptHashUsed, accountPubKey, walletParams)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to import watch-only keystore", logging.LogFormat{
+				"err": err,
+			})
+		}
+		return am, err
+	})
+}
+
+// ImportMultisigWallet imports an nRequired-of-N wallet from the account
+// extended public keys of its N cosigners. Like a watch-only wallet it cannot
+// sign, its transactions are signed by each cosigner through a psbt.
+func (w *WalletManager) ImportMultisigWallet(nRequired int, accountPubKeys []string, walletParams *keystore.WalletParams) (*WalletSummary, error) {
+	return w.importPublicWallet(func(tx mwdb.DBTransaction) (*keystore.AddrManager, error) {
+		am, err := w.ksmgr.ImportMultisigKeystore(tx, w.chainFetcher.CheckScriptHashUsed, nRequired, accountPubKeys, walletParams)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to import multisig keystore", logging.LogFormat{
+				"err": err,
+			})
+		}
+		return am, err
+	})
+}
+
+// importPublicWallet imports a wallet holding no private keys, the keystore
+// is created by importKeystore.
+func (w *WalletManager) importPublicWallet(importKeystore func(tx mwdb.DBTransaction) (*keystore.AddrManager, error)) (*WalletSummary, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.ntfnsHandler.IsWorkerBusy() {
+		return nil, ErrTooManyTask
+	}
+	var am *keystore.AddrManager
+	var ws *txmgr.WalletStatus
+	err := mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
+		var err error
+		am, err = importKeystore(tx)
+		if err != nil {
+			return err
+		}
+		if err = w.utxoStore.InitNewWallet(tx, am); err != nil {
+			return err
+		}
+		ws = &txmgr.WalletStatus{
+			WalletID: am.Name(),
+		}
+		addrs := am.ManagedAddresses()
+		if len(addrs) == 0 {
+			ws.SyncedHeight = txmgr.WalletSyncedDone
+		}
+		err = w.syncStore.PutWalletStatus(tx, ws)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to put wallet status", logging.LogFormat{
+				"err": err,
+			})
+			return err
+		}
+
+		for _, managedAddr := range addrs {
+			err = w.utxoStore.PutNewAddress(tx, am.Name(), managedAddr.String(), massutil.AddressClassWitnessV0)
+			if err != nil {
+				logging.CPrint(logging.ERROR, "failed to put new address", logging.LogFormat{
+					"err": err,
+				})
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if am != nil {
+			w.ksmgr.RemoveCachedKeystore(am.Name())
+		}
+		return nil, err
+	}
+
+	if !ws.Ready() {
+		w.ntfnsHandler.OnImportWallet(am.Name())
+	}
+	return &WalletSummary{
+		WalletID:  am.Name(),
+		Type:      uint32(am.AddrUse()),
+		Version:   am.Version().Value(),
+		Remarks:   am.Remarks(),
+		WatchOnly: true,
+	}, nil
+}
+
+// ExportWatchOnly returns the account extended public key of the wallet, to be
+// imported by ImportWatchOnlyWallet elsewhere.
+func (w *WalletManager) ExportWatchOnly(walletId string) (string, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return "", err
+	}
+	// a multisig wallet has no account key of its own
+	if am.IsMultisig() {
+		return "", keystore.ErrMultisig
+	}
+	return am.AccountPubKey(), nil
+}
+
 func (w *WalletManager) ExportWallet(name, pass string) (string, error) {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
@@ -444,6 +650,21 @@ func (w *WalletManager) ExportWallet(name, pass string) (string, error) {
 			})
 			return err
 		}
+		labels, err := w.utxoStore.Labels(tx, name)
+		if err != nil {
+			return err
+		}
+		walletLabels := newWalletLabels()
+		for _, label := range labels {
+			walletLabels.add(label)
+		}
+		buf, err = keystoreWithLabels(buf, walletLabels)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to export labels", logging.LogFormat{
+				"err": err,
+			})
+			return err
+		}
 		ret = string(buf)
 		return nil
 	})
@@ -462,13 +683,185 @@ func (w *WalletManager) RemoveWallet(walletId, pass string) error {
 		return ErrTooManyTask
 	}
 
-	err := w.ksmgr.CheckPrivPassphrase(walletId, []byte(pass))
+	am, err := w.ksmgr.GetAddrManagerByAccountID(walletId)
 	if err != nil {
 		return err
 	}
+	// watch-only wallets have no passphrase to check
+	if !am.IsWatchOnly() {
+		err = w.ksmgr.CheckPrivPassphrase(walletId, []byte(pass))
+		if err != nil {
+			return err
+		}
+		// the keystore is deleted asynchronously, end the unlock session now
+		if err = w.ksmgr.Lock(walletId); err != nil {
+			return err
+		}
+	}
 	return w.ntfnsHandler.OnRemoveWallet(walletId)
 }
 
+// RescanWallet derives the addresses of the wallet until gapLimit unused ones
+// follow the last used one, then rebuilds its utxos, balance and histories from
+// the block at fromHeight in the background, the progress is reported by
+// Wallets. Records below fromHeight are kept as they are. It returns the
+// number of newly derived addresses.
+func (w *WalletManager) RescanWallet(walletId string, fromHeight uint64, gapLimit uint32) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.ntfnsHandler.IsWorkerBusy() {
+		return 0, ErrTooManyTask
+	}
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return 0, err
+	}
+	syncedTo, err := w.SyncedTo()
+	if err != nil {
+		return 0, err
+	}
+	if fromHeight > syncedTo {
+		logging.CPrint(logging.ERROR, "rescan height beyond synced height", logging.LogFormat{
+			"fromHeight": fromHeight,
+			"syncedTo":   syncedTo,
+		})
+		return 0, ErrInvalidParameter
+	}
+	if gapLimit == 0 {
+		gapLimit = w.config.Wallet.Settings.AddressGapLimit
+	}
+
+	var discovered int
+	err = mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
+		discovered, err = w.discoverAddresses(tx, am, gapLimit)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	syncedHeight := uint64(0)
+	if fromHeight > 0 {
+		syncedHeight = fromHeight - 1
+	}
+	if err = w.ntfnsHandler.OnRescanWallet(am.Name(), syncedHeight); err != nil {
+		return 0, err
+	}
+	return discovered, nil
+}
+
+// discoverAddresses derives addresses of am on the external and internal
+// branches until gapLimit unused ones follow the last used one. A derived
+// address is of the staking class if it has been paid by a staking output.
+func (w *WalletManager) discoverAddresses(tx mwdb.DBTransaction, am *keystore.AddrManager, gapLimit uint32) (int, error) {
+	discovered := 0
+	for _, internal := range []bool{false, true} {
+		n, err := w.discoverBranchAddresses(tx, am, internal, gapLimit)
+		if err != nil {
+			return 0, err
+		}
+		discovered += n
+	}
+	return discovered, nil
+}
+
+func (w *WalletManager) discoverBranchAddresses(tx mwdb.DBTransaction, am *keystore.AddrManager, internal bool, gapLimit uint32) (int, error) {
+	checkfunc := w.chainFetcher.CheckScriptHashUsed
+
+	next, lastUsed := uint32(0), int64(-1)
+	for _, ma := range am.ManagedAddresses() {
+		if ma.IsChangeAddr() != internal {
+			continue
+		}
+		index := ma.DerivationPath().Index
+		if index >= next {
+			next = index + 1
+		}
+		if int64(index) <= lastUsed {
+			continue
+		}
+		used, err := checkfunc(ma.ScriptAddress())
+		if err != nil {
+			return 0, err
+		}
+		if used {
+			lastUsed = int64(index)
+		}
+	}
+
+	discovered := 0
+	for int64(next) < lastUsed+1+int64(gapLimit) {
+		// the gap is bounded here, the keystore looks up the used addresses
+		// by index regardless of the branch
+		mas, err := w.ksmgr.NextAddressesForAccount(tx, am.Name(), checkfunc, internal, 1,
+			keystore.MaxAddressesPerAccount, massutil.AddressClassWitnessStaking)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to nextAddress", logging.LogFormat{
+				"err": err,
+			})
+			return 0, err
+		}
+		ma := mas[0]
+		used, err := checkfunc(ma.ScriptAddress())
+		if err != nil {
+			return 0, err
+		}
+		addrClass, address := massutil.AddressClassWitnessV0, ma.String()
+		if used {
+			lastUsed = int64(ma.DerivationPath().Index)
+			staking, err := w.isStakingScriptHash(ma.ScriptAddress())
+			if err != nil {
+				return 0, err
+			}
+			if staking {
+				addrClass, address = massutil.AddressClassWitnessStaking, ma.StakingAddress()
+			}
+		}
+		err = w.utxoStore.PutNewAddress(tx, am.Name(), address, addrClass)
+		if err != nil {
+			logging.CPrint(logging.ERROR, "failed to put new address in utxoStore", logging.LogFormat{
+				"err": err,
+			})
+			return 0, err
+		}
+		next = ma.DerivationPath().Index + 1
+		discovered++
+	}
+	return discovered, nil
+}
+
+// isStakingScriptHash returns whether scriptHash has been paid by a staking
+// output on chain.
+func (w *WalletManager) isStakingScriptHash(scriptHash []byte) (bool, error) {
+	_, best, err := w.chainFetcher.NewestSha()
+	if err != nil {
+		return false, err
+	}
+	related, err := w.chainFetcher.FetchScriptHashRelatedTx([][]byte{scriptHash}, 0, best+1, w.chainParams)
+	if err != nil {
+		return false, err
+	}
+	for _, height := range related.Heights() {
+		for _, loc := range related.Get(height) {
+			msgTx, err := w.chainFetcher.FetchTxByLoc(height, loc)
+			if err != nil {
+				return false, err
+			}
+			for _, txOut := range msgTx.TxOut {
+				ps, err := utils.ParsePkScript(txOut.PkScript, w.chainParams)
+				if err != nil {
+					continue
+				}
+				if ps.IsStaking() && bytes.Equal(ps.StdScriptAddress(), scriptHash) {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
 func (w *WalletManager) ChangePrivPassphrase(oldPass, newPass string) error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
@@ -577,17 +970,37 @@ func (w *WalletManager) GetMnemonic(name, pass string) (string, uint8, error) {
 	return mnemonic, version, nil
 }
 
-//WalletBalance returns total balance of current wallet
-func (w *WalletManager) WalletBalance(confs uint32, queryDetail bool) (*WalletBalance, error) {
+// UnlockWallet keeps walletId unlocked for timeout, so that signing with it can
+// omit the private passphrase. It returns the time the session expires, when
+// the wallet is locked again.
+func (w *WalletManager) UnlockWallet(walletId, pass string, timeout time.Duration) (time.Time, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if am.IsWatchOnly() {
+		return time.Time{}, keystore.ErrWatchOnly
+	}
+	return w.ksmgr.Unlock(am.Name(), []byte(pass), timeout)
+}
+
+// LockWallet clears the private keys unlocked by UnlockWallet.
+func (w *WalletManager) LockWallet(walletId string) error {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return err
+	}
+	return w.ksmgr.Lock(am.Name())
+}
+
+//WalletBalance returns total balance of specified wallet
+func (w *WalletManager) WalletBalance(walletId string, confs uint32, queryDetail bool) (*WalletBalance, error) {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
-	am := w.ksmgr.CurrentKeystore()
-	if am == nil {
-		logging.CPrint(logging.ERROR, "no wallet in use", logging.LogFormat{
-			"err": ErrNoWalletInUse,
-		})
-		return nil, ErrNoWalletInUse
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
 	}
 	wb := &WalletBalance{
 		WalletID:            am.Name(),
@@ -596,7 +1009,7 @@ func (w *WalletManager) WalletBalance(confs uint32, queryDetail bool) (*WalletBa
 		WithdrawableBinding: massutil.ZeroAmount(),
 		WithdrawableStaking: massutil.ZeroAmount(),
 	}
-	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
 		if queryDetail {
 			syncedTo, err := w.syncStore.SyncedTo(tx)
 			if err != nil {
@@ -641,17 +1054,14 @@ func (w *WalletManager) WalletBalance(confs uint32, queryDetail bool) (*WalletBa
 	return wb, nil
 }
 
-//AddressBalance if addrs is empty, balances of all addresses of current wallet will be returned
-func (w *WalletManager) AddressBalance(confs uint32, addrs []string) ([]*AddressBalance, error) {
+//AddressBalance if addrs is empty, balances of all addresses of specified wallet will be returned
+func (w *WalletManager) AddressBalance(walletId string, confs uint32, addrs []string) ([]*AddressBalance, error) {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
-	am := w.ksmgr.CurrentKeystore()
-	if am == nil {
-		logging.CPrint(logging.ERROR, "no wallet in use", logging.LogFormat{
-			"err": ErrNoWalletInUse,
-		})
-		return nil, ErrNoWalletInUse
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
 	}
 	if len(addrs) == 0 {
 		addrs = am.ListAddresses()
@@ -680,7 +1090,7 @@ func (w *WalletManager) AddressBalance(confs uint32, addrs []string) ([]*Address
 			if err != nil {
 				return err
 			}
-			m, err := w.utxoStore.ScriptAddressBalance(tx, scriptSet, confs, syncedTo.Height, w.server.TxMemPool())
+			m, err := w.utxoStore.ScriptAddressBalance(tx, am.Name(), scriptSet, confs, syncedTo.Height, w.server.TxMemPool())
 			if err != nil {
 				logging.CPrint(logging.ERROR, "failed to get scriptAddress balance", logging.LogFormat{
 					"err": err,
@@ -708,19 +1118,19 @@ func (w *WalletManager) AddressBalance(confs uint32, addrs []string) ([]*Address
 	return ret, nil
 }
 
-func (w *WalletManager) GetUtxo(addrs []string) (map[string][]*UnspentDetail, error) {
+func (w *WalletManager) GetUtxo(walletId string, addrs []string) (map[string][]*UnspentDetail, error) {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
-	am := w.ksmgr.CurrentKeystore()
-	if am == nil {
-		return nil, ErrNoWalletInUse
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
 	}
 	ret := make(map[string][]*UnspentDetail)
 	if len(addrs) == 0 {
 		addrs = am.ListAddresses()
 	}
-	creditsMap, _, err := w.getUtxos(addrs)
+	creditsMap, _, err := w.getUtxos(am, addrs)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "get utxos failed", logging.LogFormat{
 			"err": err,
@@ -747,21 +1157,18 @@ func (w *WalletManager) GetUtxo(addrs []string) (map[string][]*UnspentDetail, er
 }
 
 // TODO: only generate external address default
-func (w *WalletManager) NewAddress(addrClass uint16) (string, error) {
+func (w *WalletManager) NewAddress(walletId string, addrClass uint16) (string, error) {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	am := w.ksmgr.CurrentKeystore()
-	if am == nil {
-		logging.CPrint(logging.ERROR, "no wallet in use", logging.LogFormat{
-			"err": ErrNoWalletInUse,
-		})
-		return "", ErrNoWalletInUse
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return "", err
 	}
 
 	var address string
-	err := mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
-		mas, err := w.ksmgr.NextAddresses(tx, w.chainFetcher.CheckScriptHashUsed, false, 1, w.config.Wallet.Settings.AddressGapLimit, addrClass)
+	err = mwdb.Update(w.db, func(tx mwdb.DBTransaction) error {
+		mas, err := w.ksmgr.NextAddressesForAccount(tx, am.Name(), w.chainFetcher.CheckScriptHashUsed, false, 1, w.config.Wallet.Settings.AddressGapLimit, addrClass)
 		if err != nil {
 			logging.CPrint(logging.ERROR, "failed to nextAddress", logging.LogFormat{
 				"err": err,
@@ -796,7 +1203,7 @@ func (w *WalletManager) NewAddress(addrClass uint16) (string, error) {
 
 // for testing purpose
 func (w *WalletManager) GetAllAddressesWithPubkey() ([]*txmgr.AddressDetail, error) {
-	list, err := w.GetAddresses(math.MaxUint16)
+	list, err := w.GetAddresses("", math.MaxUint16)
 	if err != nil {
 		return nil, err
 	}
@@ -824,20 +1231,17 @@ func (w *WalletManager) GetAllAddressesWithPubkey() ([]*txmgr.AddressDetail, err
 	return list, nil
 }
 
-func (w *WalletManager) GetAddresses(addressClass uint16) ([]*txmgr.AddressDetail, error) {
+func (w *WalletManager) GetAddresses(walletId string, addressClass uint16) ([]*txmgr.AddressDetail, error) {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
-	acct := w.ksmgr.CurrentKeystore()
-	if acct == nil {
-		logging.CPrint(logging.ERROR, "no wallet in use", logging.LogFormat{
-			"err": ErrNoWalletInUse,
-		})
-		return nil, ErrNoWalletInUse
+	acct, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
 	}
 
 	var result, all []*txmgr.AddressDetail
-	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) (err error) {
+	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) (err error) {
 		all, err = w.utxoStore.GetAddresses(tx, acct.Name())
 		if err != nil {
 			logging.CPrint(logging.ERROR, "failed to get address from utxoStore",
@@ -918,14 +1322,20 @@ func (w *WalletManager) GetAddresses(addressClass uint16) ([]*txmgr.AddressDetai
 }
 
 func (w *WalletManager) CreateRawTransaction(
+	walletId string,
 	inputs []*TxIn,
 	amounts map[string]massutil.Amount,
 	lockTime uint64,
 	changeAddr string,
 	subtractfeefrom map[string]struct{},
 ) (string, massutil.Amount, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return "", massutil.ZeroAmount(), err
+	}
+
 	// senders are transfer addresses corresponding to inputs
-	mtx, senders, totalIn, err := w.constructTxIn(inputs, lockTime)
+	mtx, senders, totalIn, err := w.constructTxIn(am, inputs, lockTime)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "Failed to constructTxIn", logging.LogFormat{
 			"err": err,
@@ -939,7 +1349,7 @@ func (w *WalletManager) CreateRawTransaction(
 	}
 
 	// no change
-	noChangeRequiredFee, err := w.EstimateManualTxFee(inputs, len(amounts))
+	noChangeRequiredFee, err := w.EstimateManualTxFee(walletId, inputs, len(amounts))
 	if err != nil {
 		return "", massutil.ZeroAmount(), err
 	}
@@ -959,7 +1369,7 @@ func (w *WalletManager) CreateRawTransaction(
 			totalOutAndFee massutil.Amount
 		)
 		// change
-		withChangeRequiredFee, err := w.EstimateManualTxFee(inputs, len(amounts)+1)
+		withChangeRequiredFee, err := w.EstimateManualTxFee(walletId, inputs, len(amounts)+1)
 		if err != nil {
 			return "", massutil.ZeroAmount(), err
 		}
@@ -1016,18 +1426,21 @@ func (w *WalletManager) CreateRawTransaction(
 }
 
 func (w *WalletManager) AutoCreateRawTransaction(
+	walletId string,
 	amounts map[string]massutil.Amount,
 	lockTime uint64,
 	userTxFee massutil.Amount,
 	fromAddr,
 	changeAddr string,
 	payload []byte,
+	selector CoinSelector,
+	feeMode FeeMode,
 ) (string, massutil.Amount, error) {
 
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
-	mtx, txFee, err := w.EstimateTxFee(amounts, lockTime, userTxFee, fromAddr, changeAddr, payload)
+	mtx, txFee, err := w.EstimateTxFee(walletId, amounts, lockTime, userTxFee, fromAddr, changeAddr, payload, selector, feeMode)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "estimate txFee failed", logging.LogFormat{
 			"err": err,
@@ -1049,16 +1462,18 @@ func (w *WalletManager) AutoCreateRawTransaction(
 }
 
 func (w *WalletManager) CreateStakingTransaction(
+	walletId string,
 	fromAddr string,
 	Outputs []*StakingTxOut,
 	lockTime uint64,
 	userTxFee massutil.Amount,
+	feeMode FeeMode,
 ) (string, massutil.Amount, error) {
 
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
-	msgTx, fee, err := w.EstimateStakingTxFee(Outputs, lockTime, userTxFee, fromAddr, "")
+	msgTx, fee, err := w.EstimateStakingTxFee(walletId, Outputs, lockTime, userTxFee, fromAddr, "", feeMode)
 	if err != nil {
 		return "", massutil.ZeroAmount(), err
 	}
@@ -1077,15 +1492,17 @@ func (w *WalletManager) CreateStakingTransaction(
 }
 
 func (w *WalletManager) CreateBindingTransaction(
+	walletId string,
 	fromAddress string,
 	txFee massutil.Amount,
 	output []*BindingOutput,
+	feeMode FeeMode,
 ) (string, massutil.Amount, error) {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
 	locktime := uint64(0)
-	msgTx, fee, err := w.EstimateBindingTxFee(output, locktime, txFee, fromAddress, "")
+	msgTx, fee, err := w.EstimateBindingTxFee(walletId, output, locktime, txFee, fromAddress, "", feeMode)
 	if err != nil {
 		return "", massutil.ZeroAmount(), err
 	}
@@ -1103,6 +1520,42 @@ func (w *WalletManager) CreateBindingTransaction(
 	return mtxHex, fee, nil
 }
 
+// CreateWithdrawStakingTransaction creates a tx withdrawing the mature staking
+// utxos of the wallet, see EstimateWithdrawTxFee.
+func (w *WalletManager) CreateWithdrawStakingTransaction(walletId string, inputs []*TxIn, toAddr string,
+	txFee massutil.Amount) (string, massutil.Amount, error) {
+	return w.createWithdrawTransaction(walletId, txmgr.ClassStakingUtxo, inputs, toAddr, txFee)
+}
+
+// CreateWithdrawBindingTransaction creates a tx withdrawing the mature binding
+// utxos of the wallet, see EstimateWithdrawTxFee.
+func (w *WalletManager) CreateWithdrawBindingTransaction(walletId string, inputs []*TxIn, toAddr string,
+	txFee massutil.Amount) (string, massutil.Amount, error) {
+	return w.createWithdrawTransaction(walletId, txmgr.ClassBindingUtxo, inputs, toAddr, txFee)
+}
+
+func (w *WalletManager) createWithdrawTransaction(walletId string, class txmgr.UtxoClass, inputs []*TxIn,
+	toAddr string, txFee massutil.Amount) (string, massutil.Amount, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	msgTx, fee, err := w.EstimateWithdrawTxFee(walletId, class, inputs, toAddr, txFee)
+	if err != nil {
+		return "", massutil.ZeroAmount(), err
+	}
+
+	msgTx.Version = wire.TxVersion
+	mtxHex, err := messageToHex(msgTx)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "Error in messageToHex(mtx)", logging.LogFormat{
+			"err": err,
+		})
+		return "", massutil.ZeroAmount(), err
+	}
+	w.MarkUsedUTXO(msgTx)
+	return mtxHex, fee, nil
+}
+
 // MarkUsed marks utxo used in cache
 func (w *WalletManager) MarkUsedUTXO(msgTx *wire.MsgTx) {
 	for _, txIn := range msgTx.TxIn {
@@ -1122,39 +1575,25 @@ func (w *WalletManager) ClearUsedUTXOMark(msgTx *wire.MsgTx) {
 	}
 }
 
-func (w *WalletManager) SignRawTx(password []byte, flag string, tx *wire.MsgTx) ([]byte, error) {
-	ks := w.ksmgr.CurrentKeystore()
-	if ks == nil {
-		logging.CPrint(logging.ERROR, "no wallet in use", logging.LogFormat{
-			"err": ErrNoWalletInUse,
-		})
-		return nil, ErrNoWalletInUse
-	}
-
-	var hashType txscript.SigHashType
-	switch flag {
-	case "ALL":
-		hashType = txscript.SigHashAll
-	case "NONE":
-		hashType = txscript.SigHashNone
-	case "SINGLE":
-		hashType = txscript.SigHashSingle
-	case "ALL|ANYONECANPAY":
-		hashType = txscript.SigHashAll | txscript.SigHashAnyOneCanPay
-	case "NONE|ANYONECANPAY":
-		hashType = txscript.SigHashNone | txscript.SigHashAnyOneCanPay
-	case "SINGLE|ANYONECANPAY":
-		hashType = txscript.SigHashSingle | txscript.SigHashAnyOneCanPay
-	default:
-		logging.CPrint(logging.ERROR, "Invalid sighash parameter", logging.LogFormat{"flag": flag})
-		return nil, ErrInvalidFlag
+func (w *WalletManager) SignRawTx(walletId string, password []byte, flag string, tx *wire.MsgTx) ([]byte, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
+	}
+	if am.IsWatchOnly() {
+		return nil, keystore.ErrWatchOnly
+	}
+
+	hashType, err := parseSigHashType(flag)
+	if err != nil {
+		return nil, err
 	}
 
 	// All args collected. Now we can sign all the inputs that we can.
 	// `complete' denotes that we successfully signed all outputs and that
 	// all scripts will run to completion. This is returned as part of the
 	// reply.
-	err := w.signWitnessTx(password, tx, hashType, w.chainParams)
+	err = w.signWitnessTx(am, password, tx, hashType, w.chainParams)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "Failed to sign the transaction", logging.LogFormat{
 			"err": err,
@@ -1175,15 +1614,14 @@ func (w *WalletManager) SignRawTx(password []byte, flag string, tx *wire.MsgTx)
 	return bs, nil
 }
 
-func (w *WalletManager) GetStakingHistory(excludeWithdrawn bool) ([]*txmgr.StakingHistoryDetail, error) {
-	am := w.ksmgr.CurrentKeystore()
-	if am == nil {
-		logging.CPrint(logging.ERROR, "no wallet in use", logging.LogFormat{})
-		return nil, ErrNoWalletInUse
+func (w *WalletManager) GetStakingHistory(walletId string, excludeWithdrawn bool) ([]*txmgr.StakingHistoryDetail, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
 	}
 
 	ret := make([]*txmgr.StakingHistoryDetail, 0)
-	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
 		unmined, err := w.utxoStore.GetUnminedStakingHistoryDetail(tx, am)
 		if err != nil {
 			return err
@@ -1202,13 +1640,13 @@ func (w *WalletManager) GetStakingHistory(excludeWithdrawn bool) ([]*txmgr.Staki
 	return ret, nil
 }
 
-func (w *WalletManager) GetBindingHistory(excludeWithdrawn bool) ([]*txmgr.BindingHistoryDetail, error) {
-	am := w.ksmgr.CurrentKeystore()
-	if am == nil {
-		return nil, ErrNoWalletInUse
+func (w *WalletManager) GetBindingHistory(walletId string, excludeWithdrawn bool) ([]*txmgr.BindingHistoryDetail, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, err
 	}
 	ret := make([]*txmgr.BindingHistoryDetail, 0)
-	err := mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
 		unmined, err := w.utxoStore.GetUnminedBindingHistoryDetail(tx, am)
 		if err != nil {
 			return err
@@ -1228,8 +1666,42 @@ func (w *WalletManager) GetBindingHistory(excludeWithdrawn bool) ([]*txmgr.Bindi
 	return ret, nil
 }
 
+// ListTxHistory returns a page of the transaction history indexed by the wallet,
+// and the cursor of the next page, nil if there are no more. If address is not
+// empty, only transactions involving it are returned.
+func (w *WalletManager) ListTxHistory(walletId, address string, query *txmgr.TxHistoryQuery) ([]*txmgr.TxHistoryEntry, []byte, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(address) > 0 {
+		addr, err := massutil.DecodeAddress(address, w.chainParams)
+		if err != nil {
+			return nil, nil, ErrFailedDecodeAddress
+		}
+		mAddr, err := w.ksmgr.GetManagedAddressByScriptHashInAccount(am.Name(), addr.ScriptAddress())
+		if err != nil {
+			return nil, nil, err
+		}
+		query.ScriptHash = mAddr.ScriptAddress()
+	}
+
+	var (
+		entries []*txmgr.TxHistoryEntry
+		cursor  []byte
+	)
+	err = mwdb.View(w.db, func(tx mwdb.ReadTransaction) error {
+		entries, cursor, err = w.txStore.TxHistory(tx, am.Name(), query)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, cursor, nil
+}
+
 func (w *WalletManager) Start() error {
-	w.server.Blockchain().RegisterListener(w.ntfnsHandler)
+	w.server.RegisterListener(w.ntfnsHandler)
 	err := w.ntfnsHandler.Start()
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to start ntfnsHandler", logging.LogFormat{
@@ -1241,9 +1713,10 @@ func (w *WalletManager) Start() error {
 }
 
 func (w *WalletManager) Stop() {
-	w.server.Blockchain().UnregisterListener(w.ntfnsHandler)
+	w.server.UnregisterListener(w.ntfnsHandler)
 	w.wg.Add(1)
 	w.ntfnsHandler.Stop()
+	w.ksmgr.LockAll()
 	w.wg.Wait()
 	logging.CPrint(logging.INFO, "WalletManager stopped", logging.LogFormat{})
 	return
@@ -1261,7 +1734,7 @@ func (w *WalletManager) CloseDB() {
 }
 
 func (w *WalletManager) ChainIndexerSyncedHeight() uint64 {
-	return w.server.Blockchain().BestBlockHeight()
+	return w.server.BestBlockHeight()
 }
 
 func (w *WalletManager) CurrentWallet() string {
@@ -1287,7 +1760,14 @@ func (w *WalletManager) SyncedTo() (uint64, error) {
 	return height, nil
 }
 
-func (w *WalletManager) IsAddressInCurrent(addr string) (massutil.Address, bool, error) {
+// IsAddressInWallet checks whether addr belongs to the specified wallet,
+// or the one selected by UseWallet if walletId is empty.
+func (w *WalletManager) IsAddressInWallet(walletId, addr string) (massutil.Address, bool, error) {
+	am, err := w.getAddrManager(walletId)
+	if err != nil {
+		return nil, false, err
+	}
+
 	address, err := massutil.DecodeAddress(addr, w.chainParams)
 	if err != nil {
 		logging.CPrint(logging.ERROR, "failed to decode address", logging.LogFormat{
@@ -1297,7 +1777,7 @@ func (w *WalletManager) IsAddressInCurrent(addr string) (massutil.Address, bool,
 		return nil, false, nil
 	}
 
-	_, err = w.ksmgr.GetManagedAddressByScriptHashInCurrent(address.ScriptAddress())
+	_, err = w.ksmgr.GetManagedAddressByScriptHashInAccount(am.Name(), address.ScriptAddress())
 	if err != nil {
 		if err == keystore.ErrAddressNotFound {
 			return address, false, nil
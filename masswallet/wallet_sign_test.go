@@ -103,7 +103,7 @@ func generateAddress(w *wallet, n int) ([]*txmgr.AddressDetail, error) {
 
 	addrs := make(map[string]struct{})
 	for n > 0 {
-		addr, err := w.mgr.NewAddress(0)
+		addr, err := w.mgr.NewAddress("", 0)
 		if err != nil {
 			return nil, err
 		}
@@ -4,30 +4,38 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"io/ioutil"
 	"math"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"encoding/hex"
 
 	"github.com/massnetorg/mass-core/blockchain"
+	"github.com/massnetorg/mass-core/consensus"
 	"github.com/massnetorg/mass-core/database"
 	"github.com/massnetorg/mass-core/database/ldb"
 	"github.com/massnetorg/mass-core/database/memdb"
 	"github.com/massnetorg/mass-core/massutil"
-	"github.com/massnetorg/mass-core/netsync"
 	"github.com/massnetorg/mass-core/txscript"
 	"github.com/massnetorg/mass-core/wire"
 	"github.com/massnetorg/mass-core/wire/mock"
 	"massnet.org/mass-wallet/config"
+	"massnet.org/mass-wallet/masswallet/backup"
 	mwdb "massnet.org/mass-wallet/masswallet/db"
+	"massnet.org/mass-wallet/masswallet/db/encdb"
 	_ "massnet.org/mass-wallet/masswallet/db/ldb"
+	"massnet.org/mass-wallet/masswallet/ifc"
 	"massnet.org/mass-wallet/masswallet/keystore"
+	"massnet.org/mass-wallet/masswallet/migration"
 	"massnet.org/mass-wallet/masswallet/txmgr"
 	"massnet.org/mass-wallet/masswallet/utils"
+	"massnet.org/mass-wallet/metrics"
 )
 
 const (
@@ -112,20 +120,29 @@ type mockServer struct {
 	db database.Db
 }
 
-func (s *mockServer) Blockchain() *blockchain.Blockchain {
-	return nil
-}
-func (s *mockServer) ChainDB() database.Db {
-	return s.db
+func (s *mockServer) ChainFetcher() ifc.ChainFetcher {
+	return ifc.NewChainFetcher(s.db)
 }
-func (s *mockServer) TxMemPool() *blockchain.TxPool {
+func (s *mockServer) TxMemPool() txmgr.TxMemPool {
 	return blockchain.NewTxPool(nil, nil, nil)
 }
 
-func (s *mockServer) SyncManager() *netsync.SyncManager {
+func (s *mockServer) BestBlockHeight() uint64 {
+	_, height, _ := s.db.NewestSha()
+	return height
+}
+
+func (s *mockServer) BestPeerHeight() uint64 {
+	return 0
+}
+
+func (s *mockServer) SubmitTx(tx *wire.MsgTx) error {
 	return nil
 }
 
+func (s *mockServer) RegisterListener(listener ifc.ChainListener)   {}
+func (s *mockServer) UnregisterListener(listener ifc.ChainListener) {}
+
 // filesExists returns whether or not the named file or directory exists.
 func fileExists(name string) bool {
 	if _, err := os.Stat(name); err != nil {
@@ -266,13 +283,22 @@ func TestWalletManager_CreateWallet_UseWallet_Wallets_WalletBalance(t *testing.T
 	t.Log("wInfo_externalKeyCount: ", wInfo.ExternalKeyCount)
 	t.Log("wInfo_internalKeyCount: ", wInfo.InternalKeyCount)
 	t.Log("wInfo_balance: ", wInfo.TotalBalance)
-	wBal, err := w.WalletBalance(0, true)
+	wBal, err := w.WalletBalance("", 0, true)
 	if err != nil {
 		t.Fatal("get wallet balance error", err.Error())
 	}
 	t.Log("walletBalance_id: ", wBal.WalletID)
 	t.Log("walletBalance_total: ", wBal.Total)
 	t.Log("walletBalance_spendable: ", wBal.Spendable)
+
+	registry := metrics.NewRegistry()
+	w.RegisterMetrics(registry)
+	var buf bytes.Buffer
+	_, err = registry.WriteTo(&buf)
+	assert.Nil(t, err)
+	assert.Contains(t, buf.String(), fmt.Sprintf("masswallet_wallet_balance{wallet_id=%q} 0\n", walletId1))
+	assert.Contains(t, buf.String(), fmt.Sprintf("masswallet_wallet_balance{wallet_id=%q} 0\n", walletId2))
+	assert.Contains(t, buf.String(), "masswallet_ntfns_queue_length{queue=\"block\"} 0\n")
 	//err = w.RemoveWallet(walletId1, privPassphrase)
 	//if err != nil {
 	//	t.Fatal("remove wallet error", err.Error())
@@ -318,7 +344,7 @@ func TestWalletManager_NewAddress_GetAddresses(t *testing.T) {
 	t.Log("wallet_2_Id: ", walletId2)
 
 	// error_test_1 ErrCurrentKeystoreNotFound
-	_, err = w.NewAddress(0)
+	_, err = w.NewAddress("", 0)
 	if err != ErrNoWalletInUse {
 		t.Fatalf("NewAddress: mismatched error -- got: %v, want: %v", err, ErrNoWalletInUse)
 	}
@@ -328,11 +354,11 @@ func TestWalletManager_NewAddress_GetAddresses(t *testing.T) {
 	if err != nil {
 		t.Fatal("use wallet error", err.Error())
 	}
-	addr2, err := w.NewAddress(0)
+	addr2, err := w.NewAddress("", 0)
 	if err != nil {
 		t.Fatal("new addr error", err.Error())
 	}
-	lockAddr2, err := w.NewAddress(1)
+	lockAddr2, err := w.NewAddress("", 1)
 	if err != nil {
 		t.Fatal("new addr error", err.Error())
 	}
@@ -340,7 +366,7 @@ func TestWalletManager_NewAddress_GetAddresses(t *testing.T) {
 	t.Log("walletId:", walletId2)
 	t.Log("Test_NewAddress_GetAddress")
 	t.Logf("generate addr:%v,lockAddr:%v", addr2, lockAddr2)
-	getAddrs2, err := w.GetAddresses(math.MaxUint16)
+	getAddrs2, err := w.GetAddresses("", math.MaxUint16)
 	if err != nil {
 		t.Fatal("get addresses error", err.Error())
 	}
@@ -357,11 +383,11 @@ func TestWalletManager_NewAddress_GetAddresses(t *testing.T) {
 		t.Fatal("use wallet error", err.Error())
 	}
 
-	addr1, err := w.NewAddress(0)
+	addr1, err := w.NewAddress("", 0)
 	if err != nil {
 		t.Fatal("new addr error", err.Error())
 	}
-	stakingAddr, err := w.NewAddress(1)
+	stakingAddr, err := w.NewAddress("", 1)
 	if err != nil {
 		t.Fatal("new addr error", err.Error())
 	}
@@ -369,7 +395,7 @@ func TestWalletManager_NewAddress_GetAddresses(t *testing.T) {
 	t.Log("walletId:", walletId1)
 	t.Log("Test_NewAddress_GetAddress")
 	t.Logf("generate addr:%v,lockAddr:%v", addr1, stakingAddr)
-	getAddrs1, err := w.GetAddresses(math.MaxUint16)
+	getAddrs1, err := w.GetAddresses("", math.MaxUint16)
 	if err != nil {
 		t.Fatal("get addresses error", err.Error())
 	}
@@ -382,6 +408,78 @@ func TestWalletManager_NewAddress_GetAddresses(t *testing.T) {
 
 }
 
+func TestWalletManager_SpecifiedWallet(t *testing.T) {
+	databaseDb, close, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("new databaseDb error")
+	}
+	defer close()
+	walletDb, teardown, err := testDB("testSpecifiedWallet")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{databaseDb}, walletDb, cfg, config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+
+	walletId1, _, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	walletId2, _, _, err := w.CreateWallet(privPassphrase2, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+
+	// unknown wallet
+	_, err = w.NewAddress("ac10nge9ufvyqsdfdkx7y5lz3wlmqw3tlnhrwd5k", 0)
+	if err != ErrWalletNotFound {
+		t.Fatalf("NewAddress: mismatched error -- got: %v, want: %v", err, ErrWalletNotFound)
+	}
+
+	// no wallet in use, but specified wallet works
+	addr2, err := w.NewAddress(walletId2, 0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+	assert.Equal(t, "", w.CurrentWallet())
+
+	_, err = w.UseWallet(walletId1)
+	if err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+	addr1, err := w.NewAddress("", 0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+
+	// addresses of wallet2 are still reachable after UseWallet(walletId1)
+	addrs2, err := w.GetAddresses(walletId2, massutil.AddressClassWitnessV0)
+	if err != nil {
+		t.Fatal("get addresses error", err.Error())
+	}
+	assert.Equal(t, 1, len(addrs2))
+	assert.Equal(t, addr2, addrs2[0].Address)
+
+	_, isMine, err := w.IsAddressInWallet(walletId2, addr1)
+	assert.Nil(t, err)
+	assert.False(t, isMine)
+	_, isMine, err = w.IsAddressInWallet(walletId2, addr2)
+	assert.Nil(t, err)
+	assert.True(t, isMine)
+	_, isMine, err = w.IsAddressInWallet("", addr1)
+	assert.Nil(t, err)
+	assert.True(t, isMine)
+
+	bal, err := w.WalletBalance(walletId2, 0, false)
+	if err != nil {
+		t.Fatal("wallet balance error", err.Error())
+	}
+	assert.Equal(t, walletId2, bal.WalletID)
+}
+
 //TODO: importWallet
 func TestWalletManager_ExportWallet_ImportWallet(t *testing.T) {
 	databaseDb, close, err := newTestChainDB(0)
@@ -408,16 +506,33 @@ func TestWalletManager_ExportWallet_ImportWallet(t *testing.T) {
 	if err != nil {
 		t.Fatal("use wallet error", err.Error())
 	}
-	addr1, err := w.NewAddress(0)
+	addr1, err := w.NewAddress("", 0)
 	if err != nil {
 		t.Fatal("new addr error", err.Error())
 	}
-	stakingAddr, err := w.NewAddress(1)
+	stakingAddr, err := w.NewAddress("", 1)
 	if err != nil {
 		t.Fatal("new addr error", err.Error())
 	}
 	t.Log("wallet1_addr1:", addr1)
 	t.Log("wallet1_lockAddr1:", stakingAddr)
+	txId := wire.Hash{0x01}.String()
+	assert.Nil(t, w.SetAddressLabel(walletId1, addr1, "Customer Alice"))
+	assert.Nil(t, w.SetAddressLabel(walletId1, stakingAddr, "staking"))
+	assert.Nil(t, w.SetTxNote(walletId1, txId, "invoice 42 of alice"))
+	assert.Equal(t, keystore.ErrAddressNotFound, w.SetAddressLabel(walletId1, "ms1qqgq750hhj0lcem3pmv6gymvvmfmrzmvhyxqwyp4fey2cmec372pjq3uw7yg", "unknown"))
+	assert.Equal(t, ErrShaHashFromStr, w.SetTxNote(walletId1, "xyz", "invalid"))
+	assert.Equal(t, ErrInvalidParameter, w.SetTxNote(walletId1, txId, strings.Repeat("a", MaxLabelLen+1)))
+
+	labels, err := w.SearchLabels(walletId1, "ALICE")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{addr1: "Customer Alice"}, labels.Addresses)
+	assert.Equal(t, map[string]string{txId: "invoice 42 of alice"}, labels.Transactions)
+	assert.Nil(t, w.SetAddressLabel(walletId1, stakingAddr, ""))
+	labels, err = w.GetLabels(walletId1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(labels.Addresses))
+
 	//export
 	wJson, err := w.ExportWallet(walletId1, privPassphrase)
 	if err != nil {
@@ -446,10 +561,351 @@ func TestWalletManager_ExportWallet_ImportWallet(t *testing.T) {
 		t.Fatal("use wallet error", err.Error())
 	}
 
-	_, err = w.NewAddress(0)
+	_, err = w.NewAddress("", 0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+
+	// labels are carried by the exported keystore
+	w.ntfnsHandler.taskChan = NewWalletTaskChan(1)
+	summary, err := w.ImportWallet(wJson, privPassphrase)
+	if err != nil {
+		t.Fatal("import wallet error", err.Error())
+	}
+	assert.Equal(t, walletId1, summary.WalletID)
+	// the imported wallet is unready until synced
+	am, err := w.ksmgr.GetAddrManagerByAccountID(walletId1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	labels, err = w.searchLabels(am, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, map[string]string{addr1: "Customer Alice"}, labels.Addresses)
+	assert.Equal(t, map[string]string{txId: "invoice 42 of alice"}, labels.Transactions)
+}
+
+func TestWalletManager_RescanWallet(t *testing.T) {
+	w, err := iniWallet("rescan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.close()
+	if _, err = w.mgr.UseWallet(w.walletName); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err = w.mgr.NewAddress("", massutil.AddressClassWitnessV0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h := w.mgr.ntfnsHandler
+	h.taskChan = NewWalletTaskChan(1)
+
+	_, err = w.mgr.RescanWallet("", math.MaxUint32, 5)
+	assert.Equal(t, ErrInvalidParameter, err)
+
+	// none of the addresses is used, derive up to the gap limit on both
+	// branches
+	discovered, err := w.mgr.RescanWallet("", 0, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, 8, discovered)
+	am, err := w.mgr.ksmgr.GetAddrManagerByAccountID(w.walletName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	external, internal := am.CountAddresses()
+	assert.Equal(t, 5, external)
+	assert.Equal(t, 5, internal)
+	task := <-h.taskChan.C
+	assert.Equal(t, WalletTaskRescan, task.taskType)
+	assert.Equal(t, w.walletName, task.walletId)
+
+	checkStatus := func(rescanning bool) {
+		err := mwdb.View(w.mgr.db, func(tx mwdb.ReadTransaction) error {
+			ws, err := w.mgr.syncStore.GetWalletStatus(tx, w.walletName)
+			if err != nil {
+				return err
+			}
+			assert.False(t, ws.Ready())
+			assert.Equal(t, rescanning, ws.IsRescanning())
+			assert.Equal(t, uint64(0), ws.SyncedHeight)
+			return nil
+		})
+		assert.Nil(t, err)
+	}
+	checkStatus(true)
+	_, err = w.mgr.RescanWallet(w.walletName, 0, 5)
+	assert.Equal(t, ErrWalletUnready, err)
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-h.sigSuspend:
+			case <-h.sigResume:
+			case <-done:
+				return
+			}
+		}
+	}()
+	assert.Nil(t, h.asyncRescan(w.walletName))
+	// the wallet imports again from the synced height
+	checkStatus(false)
+
+	// records below the height to rescan from are kept
+	chainDb, closeChain, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("newTestChainDB error:", err)
+	}
+	defer closeChain()
+	walletDb, teardown, err := testDB("testRescanWallet")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	mgr, err := NewWalletManager(&mockServer{chainDb}, walletDb, cfg, config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+	walletId, _, _, err := mgr.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	if _, err = mgr.UseWallet(walletId); err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+	addr, err := mgr.NewAddress("", 0)
 	if err != nil {
 		t.Fatal("new addr error", err.Error())
 	}
+	addrInterface, err := massutil.DecodeAddress(addr, mgr.chainParams)
+	if err != nil {
+		t.Fatal("decode addr error", err.Error())
+	}
+	pkScript, err := txscript.PayToWitnessScriptHashScript(addrInterface.ScriptAddress())
+	if err != nil {
+		t.Fatal("get pkscript error", err.Error())
+	}
+
+	// pay block15T2 to the wallet
+	block15T2.TxOut[0].PkScript = pkScript
+	newBlock15T2Hash := block15T2.TxHash()
+	for i := 1; i <= int(block15Meta.Height); i++ {
+		blk := blks200[i]
+		blk.ResetGenerated()
+		for _, tx := range blk.MsgBlock().Transactions {
+			for _, txin := range tx.TxIn {
+				if bytes.Equal(txin.PreviousOutPoint.Hash[:], b15T2Hash[:]) {
+					txin.PreviousOutPoint.Hash = newBlock15T2Hash
+				}
+			}
+		}
+		if err = chainDb.SubmitBlock(blk); err != nil {
+			t.Fatal("init db error:", err)
+		}
+		chainDb.(*ldb.ChainDb).Batch(1).Set(blk.MsgBlock().BlockHash())
+		chainDb.(*ldb.ChainDb).Batch(1).Done()
+		if err = chainDb.Commit(blk.MsgBlock().BlockHash()); err != nil {
+			t.Fatal("init db error:", err)
+		}
+	}
+	defer func() {
+		block15T2.TxOut[0].PkScript = b15T2O0PkScript
+		for i := 1; i <= int(block15Meta.Height); i++ {
+			for _, tx := range blks200[i].MsgBlock().Transactions {
+				for _, txin := range tx.TxIn {
+					if bytes.Equal(txin.PreviousOutPoint.Hash[:], newBlock15T2Hash[:]) {
+						txin.PreviousOutPoint.Hash = b15T2Hash
+					}
+				}
+			}
+		}
+	}()
+
+	block15Meta.Loc, err = mgr.chainFetcher.FetchBlockLocByHeight(block15Meta.Height)
+	if err != nil {
+		t.Fatal("FetchBlockLocByHeight error:", err)
+	}
+	txLocs, err := blks200[block15Meta.Height].TxLoc()
+	if err != nil {
+		t.Fatal("TxLoc error", err)
+	}
+	err = mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+		rec, err := txmgr.NewTxRecordFromMsgTx(block15T2, block15.Header.Timestamp)
+		if err != nil {
+			return err
+		}
+		if rec, err = simpleFilterTx(rec, block15T2, walletId); err != nil {
+			return err
+		}
+		rec.TxLoc = &txLocs[2]
+		allBalances := map[string]massutil.Amount{walletId: massutil.ZeroAmount()}
+		if err = mgr.txStore.AddRelevantTx(tx, allBalances, rec, block15Meta); err != nil {
+			return err
+		}
+		if err = mgr.utxoStore.UpdateMinedBalances(tx, allBalances); err != nil {
+			return err
+		}
+		for h := uint64(1); h <= block15Meta.Height+1; h++ {
+			if err = mgr.syncStore.SetSyncedTo(tx, &txmgr.BlockMeta{Height: h}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h = mgr.ntfnsHandler
+	h.taskChan = NewWalletTaskChan(1)
+	go func() {
+		for {
+			select {
+			case <-h.sigSuspend:
+			case <-h.sigResume:
+			case <-done:
+				return
+			}
+		}
+	}()
+	rescan := func(fromHeight uint64) {
+		if _, err := mgr.RescanWallet(walletId, fromHeight, 5); err != nil {
+			t.Fatal("rescan wallet error", err.Error())
+		}
+		<-h.taskChan.C
+		assert.Nil(t, h.asyncRescan(walletId))
+		// mark the wallet imported
+		err := mwdb.Update(walletDb, func(tx mwdb.DBTransaction) error {
+			ws, err := mgr.syncStore.GetWalletStatus(tx, walletId)
+			if err != nil {
+				return err
+			}
+			ws.SyncedHeight = txmgr.WalletSyncedDone
+			return mgr.syncStore.PutWalletStatus(tx, ws)
+		})
+		assert.Nil(t, err)
+	}
+	checkCredit := func(amount int64, utxos int) {
+		err := mwdb.View(walletDb, func(tx mwdb.ReadTransaction) error {
+			balances, err := mgr.utxoStore.FetchAllMinedBalance(tx)
+			if err != nil {
+				return err
+			}
+			assert.Equal(t, amount, balances[walletId].IntValue())
+			return nil
+		})
+		assert.Nil(t, err)
+		unspents, err := mgr.GetUtxo(walletId, []string{addr})
+		assert.Nil(t, err)
+		assert.Equal(t, utxos, len(unspents[addr]))
+	}
+	checkCredit(block15T2.TxOut[0].Value, 1)
+
+	rescan(block15Meta.Height + 1)
+	checkCredit(block15T2.TxOut[0].Value, 1)
+
+	rescan(block15Meta.Height)
+	checkCredit(0, 0)
+}
+
+func TestWalletManager_BackupWallet(t *testing.T) {
+	w, err := iniWallet("backup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.close()
+	dir, err := ioutil.TempDir("", "mwbackup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_, err = w.mgr.BackupWallet("")
+	assert.Equal(t, ErrInvalidParameter, err)
+
+	path := filepath.Join(dir, "wallet"+backup.Ext)
+	s, err := w.mgr.BackupWallet(path)
+	assert.Nil(t, err)
+	assert.Equal(t, path, s.Path)
+	assert.Equal(t, []string{w.walletName}, s.Metadata.Wallets)
+	assert.Equal(t, migration.DefaultRegistry.LatestVersion(), s.Metadata.SchemaVersion)
+	assert.Equal(t, w.mgr.chainParams.ChainID.String(), s.Metadata.ChainID)
+
+	verified, err := backup.VerifyFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, s, verified)
+
+	_, err = w.mgr.BackupWallet(path)
+	assert.Equal(t, backup.ErrFileExists, err)
+}
+
+func TestWalletManager_EncryptedDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mwencdb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cfg := &config.Config{
+		Core:   config.NewDefCoreConfig(),
+		Wallet: config.NewDefWalletConfig(),
+	}
+	dbPath := filepath.Join(dir, "wallet.db")
+	db, err := mwdb.CreateDB(encdb.DBType, dbPath, DbType, pubpass, &encdb.Options{
+		EncryptKeys: true,
+		ScryptN:     1024,
+		ScryptR:     8,
+		ScryptP:     1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mgr, err := NewWalletManager(iniServer(), db, cfg, config.ChainParams, pubpass)
+	if err != nil {
+		t.Fatal(err)
+	}
+	walletId, _, _, err := mgr.CreateWallet(walletpass, "encrypted", 128)
+	assert.Nil(t, err)
+
+	// the archive holds the encrypted entries, restored as is
+	path := filepath.Join(dir, "wallet"+backup.Ext)
+	s, err := mgr.BackupWallet(path)
+	assert.Nil(t, err)
+	assert.True(t, s.Metadata.Encrypted)
+	assert.Nil(t, s.Metadata.Wallets)
+	db.Close()
+
+	restored := filepath.Join(dir, "restored.db")
+	_, _, err = backup.RestoreFile(path, DbType, restored)
+	assert.Nil(t, err)
+	raw, err := mwdb.OpenDB(DbType, restored)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := encdb.IsEncrypted(raw)
+	assert.Nil(t, err)
+	assert.True(t, encrypted)
+	raw.Close()
+	for _, dbPath := range []string{dbPath, restored} {
+		_, err = mwdb.OpenDB(encdb.DBType, dbPath, DbType, "wrong")
+		assert.Equal(t, encdb.ErrInvalidPassphrase, err)
+		db, err = mwdb.OpenDB(encdb.DBType, dbPath, DbType, pubpass)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mgr, err = NewWalletManager(iniServer(), db, cfg, config.ChainParams, pubpass)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wallets, err := mgr.Wallets()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(wallets))
+		assert.Equal(t, walletId, wallets[0].WalletID)
+		db.Close()
+	}
 }
 
 func decodeHexStr(hexStr string) ([]byte, error) {
@@ -535,11 +991,11 @@ func TestWalletManager_EstimateTxFee(t *testing.T) {
 		t.Fatal("use wallet error", err.Error())
 	}
 
-	addr1, err := w.NewAddress(0)
+	addr1, err := w.NewAddress("", 0)
 	if err != nil {
 		t.Fatal("new addr error", err.Error())
 	}
-	addr2, err := w.NewAddress(0)
+	addr2, err := w.NewAddress("", 0)
 	if err != nil {
 		t.Fatal("new addr error", err.Error())
 	}
@@ -647,7 +1103,7 @@ func TestWalletManager_EstimateTxFee(t *testing.T) {
 
 	addrs := make([]string, 0)
 	addrs = append(addrs, addr1)
-	addrBals, err := w.AddressBalance(0, addrs)
+	addrBals, err := w.AddressBalance("", 0, addrs)
 	if err != nil {
 		t.Fatal("get addresses balance error", err.Error())
 	}
@@ -669,7 +1125,7 @@ func TestWalletManager_EstimateTxFee(t *testing.T) {
 		addr1: amt1,
 	}
 	//minTxFee
-	incompleteTx, txFee, err := w.EstimateTxFee(txOuts, 0, massutil.ZeroAmount(), "", "", nil)
+	incompleteTx, txFee, err := w.EstimateTxFee("", txOuts, 0, massutil.ZeroAmount(), "", "", nil, nil, FeeModeMinimum)
 	if err != nil {
 		t.Fatal("estimate txFee error", err.Error())
 	}
@@ -682,7 +1138,7 @@ func TestWalletManager_EstimateTxFee(t *testing.T) {
 	//SetTxFee
 	amt, err := massutil.NewAmountFromUint(10e8)
 	assert.Nil(t, err)
-	incompleteTx0, txFee0, err := w.EstimateTxFee(txOuts, 0, amt, "", "", nil)
+	incompleteTx0, txFee0, err := w.EstimateTxFee("", txOuts, 0, amt, "", "", nil, nil, FeeModeMinimum)
 	if err != nil {
 		t.Fatal("estimate txFee error", err.Error())
 	}
@@ -694,6 +1150,25 @@ func TestWalletManager_EstimateTxFee(t *testing.T) {
 		t.Logf("txOut_%v_value:%v", index0, inctx0.Value)
 	}
 
+	// fee mode without enough mempool data pays the minimum
+	_, txFee1, err := w.EstimateTxFee("", txOuts, 0, massutil.ZeroAmount(), "", "", nil, nil, FeeModePriority)
+	if err != nil {
+		t.Fatal("estimate txFee error", err.Error())
+	}
+	assert.Equal(t, txFee, txFee1)
+	highRate, err := massutil.MinRelayTxFee().MulF64(10)
+	assert.Nil(t, err)
+	feedFeeRounds(w.fees, 20, 10, highRate, massutil.MinRelayTxFee())
+	_, txFee1, err = w.EstimateTxFee("", txOuts, 0, massutil.ZeroAmount(), "", "", nil, nil, FeeModePriority)
+	if err != nil {
+		t.Fatal("estimate txFee error", err.Error())
+	}
+	assert.True(t, txFee1.Cmp(txFee) > 0)
+	_, txFee1, err = w.EstimateTxFee("", txOuts, 0, massutil.ZeroAmount(), "", "", nil, nil, FeeModeEconomical)
+	if err != nil {
+		t.Fatal("estimate txFee error", err.Error())
+	}
+	assert.Equal(t, txFee, txFee1)
 }
 
 func TestWalletManager_AutoConstructTx(t *testing.T) {
@@ -722,11 +1197,11 @@ func TestWalletManager_AutoConstructTx(t *testing.T) {
 		t.Fatal("use wallet error", err.Error())
 	}
 
-	addr1, err := w.NewAddress(0)
+	addr1, err := w.NewAddress("", 0)
 	if err != nil {
 		t.Fatal("new addr error", err.Error())
 	}
-	addr2, err := w.NewAddress(0)
+	addr2, err := w.NewAddress("", 0)
 	if err != nil {
 		t.Fatal("new addr error", err.Error())
 	}
@@ -834,7 +1309,7 @@ func TestWalletManager_AutoConstructTx(t *testing.T) {
 
 	addrs := make([]string, 0)
 	addrs = append(addrs, addr1)
-	addrBals, err := w.AddressBalance(0, addrs)
+	addrBals, err := w.AddressBalance("", 0, addrs)
 	if err != nil {
 		t.Fatal("get addresses balance error", err.Error())
 	}
@@ -846,6 +1321,37 @@ func TestWalletManager_AutoConstructTx(t *testing.T) {
 		t.Logf("%v_spendable: %v", index, addrBal.Spendable)
 	}
 
+	// locked unspents are excluded from automatic coin selection
+	unspents, err := w.GetUtxo("", addrs)
+	if err != nil {
+		t.Fatal("get utxo error", err.Error())
+	}
+	lockOps := make([]*wire.OutPoint, 0)
+	for _, u := range unspents[addr1] {
+		hash, err := wire.NewHashFromStr(u.TxId)
+		assert.Nil(t, err)
+		lockOps = append(lockOps, wire.NewOutPoint(hash, u.Vout))
+	}
+	_, err = w.LockUnspent("", []*wire.OutPoint{wire.NewOutPoint(&wire.Hash{}, 0)}, "cold", 0)
+	assert.Equal(t, ErrUTXONotExists, err)
+	_, err = w.LockUnspent("", lockOps, "cold", 1)
+	assert.Equal(t, ErrInvalidParameter, err)
+	locks, err := w.LockUnspent("", lockOps, "cold", 0)
+	if err != nil {
+		t.Fatal("lock unspent error", err.Error())
+	}
+	assert.Equal(t, len(lockOps), len(locks))
+	locks, err = w.ListLockUnspent(walletId1)
+	assert.Nil(t, err)
+	assert.Equal(t, len(lockOps), len(locks))
+	amt, err := massutil.NewAmountFromUint(1e8)
+	assert.Nil(t, err)
+	_, _, err = w.AutoCreateRawTransaction("", map[string]massutil.Amount{addr2: amt}, 0, massutil.ZeroAmount(), "", "", nil, nil, FeeModeMinimum)
+	assert.Equal(t, ErrInsufficientFunds, err)
+	unlocked, err := w.UnlockUnspent("", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, len(lockOps), unlocked)
+
 	amt2, err := massutil.NewAmountFromUint(20e8)
 	assert.Nil(t, err)
 	amt1, err := massutil.NewAmountFromUint(4e8)
@@ -855,9 +1361,9 @@ func TestWalletManager_AutoConstructTx(t *testing.T) {
 		addr2: amt2,
 		addr1: amt1,
 	}
-	amt, err := massutil.NewAmountFromUint(10e8)
+	amt, err = massutil.NewAmountFromUint(10e8)
 	assert.Nil(t, err)
-	txHex, txFee, err := w.AutoCreateRawTransaction(txOuts, 0, amt, "", "", nil)
+	txHex, txFee, err := w.AutoCreateRawTransaction("", txOuts, 0, amt, "", "", nil, nil, FeeModeMinimum)
 	if err != nil {
 		t.Fatal("AutoCreateRawTransaction error", err.Error())
 	}
@@ -874,10 +1380,355 @@ func TestWalletManager_AutoConstructTx(t *testing.T) {
 	if err != nil {
 		t.Fatal("deserialize tx error", err.Error())
 	}
-	signedTx, err := w.SignRawTx([]byte(privPassphrase), "ALL", &mtx)
+	signedTx, err := w.SignRawTx("", []byte(privPassphrase), "ALL", &mtx)
 	if err != nil {
 		t.Fatal("sign tx error", err.Error())
 	}
 	t.Log("signedTx:", signedTx)
 
+	// credits of walletId1 stay reachable after switching to another wallet
+	walletId2, _, _, err := w.CreateWallet(privPassphrase2, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	_, err = w.UseWallet(walletId2)
+	if err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+	specifiedBals, err := w.AddressBalance(walletId1, 0, addrs)
+	if err != nil {
+		t.Fatal("get addresses balance error", err.Error())
+	}
+	assert.Equal(t, addrBals, specifiedBals)
+	utxos, err := w.GetUtxo(walletId1, addrs)
+	if err != nil {
+		t.Fatal("get utxo error", err.Error())
+	}
+	assert.Equal(t, 2, len(utxos[addr1]))
+	_, err = w.SignRawTx(walletId1, []byte(privPassphrase), "ALL", &mtx)
+	if err != nil {
+		t.Fatal("sign tx error", err.Error())
+	}
+
+	// the signed tx is recorded unmined but dropped by the mempool
+	unminedTx := wire.NewMsgTx()
+	if err = unminedTx.SetBytes(signedTx, wire.Packet); err != nil {
+		t.Fatal("deserialize tx error", err.Error())
+	}
+	unminedRec, err := txmgr.NewTxRecordFromMsgTx(unminedTx, time.Now())
+	if err != nil {
+		t.Fatal("get txRecord error", err.Error())
+	}
+	unminedRec, err = simpleFilterTx(unminedRec, unminedTx, walletId1)
+	assert.Nil(t, err)
+	ps, err := utils.ParsePkScript(pkScript, config.ChainParams)
+	assert.Nil(t, err)
+	for i := range unminedTx.TxIn {
+		unminedRec.RelevantTxIn = append(unminedRec.RelevantTxIn, &txmgr.RelevantMeta{
+			Index:    i,
+			PkScript: ps,
+			WalletId: walletId1,
+		})
+	}
+	err = mwdb.Update(walletDb1, func(tx mwdb.DBTransaction) error {
+		return w.txStore.AddRelevantTx(tx, nil, unminedRec, nil)
+	})
+	if err != nil {
+		t.Fatal("add unmined tx error", err.Error())
+	}
+	unminedId := unminedTx.TxHash().String()
+
+	unconfirmed, err := w.ListUnconfirmed(walletId1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(unconfirmed))
+	assert.Equal(t, unminedId, unconfirmed[0].TxId)
+	assert.False(t, unconfirmed[0].InMempool)
+	unconfirmed, err = w.ListUnconfirmed(walletId2)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(unconfirmed))
+	assert.Equal(t, 1, w.rebroadcastUnmined())
+
+	_, err = w.AbandonTransaction(walletId2, unminedId)
+	assert.Equal(t, ErrUnconfirmedNotFound, err)
+	removed, err := w.AbandonTransaction(walletId1, unminedId)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{unminedId}, removed)
+	_, err = w.AbandonTransaction(walletId1, unminedId)
+	assert.Equal(t, ErrUnconfirmedNotFound, err)
+	unconfirmed, err = w.ListUnconfirmed(walletId1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(unconfirmed))
+
+	// inputs of the abandoned tx are spendable again
+	for _, txIn := range unminedTx.TxIn {
+		assert.False(t, w.UTXOUsed(&txIn.PreviousOutPoint))
+		flags, err := w.existsOutPoint(walletId1, &txIn.PreviousOutPoint)
+		assert.Nil(t, err)
+		assert.False(t, flags.SpentByUnmined)
+	}
+
+	// payouts to the same address are paid by different txs
+	maxFee, err := massutil.NewAmountFromUint(1e8)
+	assert.Nil(t, err)
+	payouts := []*PayoutRequest{
+		{Key: "w-1", Address: addr2, Amount: amt1},
+		{Key: "w-2", Address: addr2, Amount: amt1},
+		{Key: "w-3", Address: addr1, Amount: amt1},
+	}
+
+	// a payout no transaction could be made for fails alone
+	tooMuch, err := massutil.NewAmountFromUint(1000e8)
+	assert.Nil(t, err)
+	batch, err := w.CreatePayoutBatch(walletId1, []*PayoutRequest{
+		{Key: "w-5", Address: addr1, Amount: amt1},
+		{Key: "w-6", Address: addr2, Amount: tooMuch},
+	}, []byte(privPassphrase), FeeModeMinimum, maxFee)
+	if err != nil {
+		t.Fatal("create payout batch error", err.Error())
+	}
+	assert.Equal(t, 1, len(batch.Txs))
+	assert.Equal(t, 0, batch.Payouts[0].TxIndex)
+	assert.Equal(t, "", batch.Payouts[0].Error)
+	assert.Equal(t, -1, batch.Payouts[1].TxIndex)
+	assert.NotEqual(t, "", batch.Payouts[1].Error)
+	// release the inputs of the tx for the payouts below
+	w.clearPayoutMarks(batch)
+
+	_, err = w.CreatePayoutBatch(walletId1, payouts, []byte("wrong passphrase"), FeeModeMinimum, maxFee)
+	assert.NotNil(t, err)
+	for _, txIn := range unminedTx.TxIn {
+		assert.False(t, w.UTXOUsed(&txIn.PreviousOutPoint))
+	}
+	batch, err = w.CreatePayoutBatch(walletId1, payouts, []byte(privPassphrase), FeeModeMinimum, maxFee)
+	if err != nil {
+		t.Fatal("create payout batch error", err.Error())
+	}
+	assert.Equal(t, 3, len(batch.Payouts))
+	assert.Equal(t, 2, len(batch.Txs))
+	assert.NotEqual(t, batch.Payouts[0].TxIndex, batch.Payouts[1].TxIndex)
+	assert.Equal(t, batch.Payouts[0].TxIndex, batch.Payouts[2].TxIndex)
+	for _, ptx := range batch.Txs {
+		assert.Equal(t, txmgr.PayoutTxSubmitted, ptx.Status)
+	}
+
+	// repeated keys return the stored batch rather than paying again
+	repeated, err := w.CreatePayoutBatch(walletId1, []*PayoutRequest{payouts[2], payouts[0], payouts[1]},
+		[]byte(privPassphrase), FeeModeMinimum, maxFee)
+	assert.Nil(t, err)
+	assert.Equal(t, batch, repeated)
+	_, err = w.CreatePayoutBatch(walletId1, []*PayoutRequest{{Key: "w-1", Address: addr2, Amount: amt2}},
+		[]byte(privPassphrase), FeeModeMinimum, maxFee)
+	assert.Equal(t, ErrPayoutKeyConflict, err)
+	_, err = w.CreatePayoutBatch(walletId1, []*PayoutRequest{payouts[0], payouts[1], payouts[2], {Key: "w-4", Address: addr2, Amount: amt1}},
+		[]byte(privPassphrase), FeeModeMinimum, maxFee)
+	assert.Equal(t, ErrPayoutKeyConflict, err)
+	_, err = w.CreatePayoutBatch(walletId1, []*PayoutRequest{payouts[0], payouts[0]}, []byte(privPassphrase), FeeModeMinimum, maxFee)
+	assert.Equal(t, ErrInvalidParameter, err)
+
+	stored, err := w.GetPayoutBatch(walletId1, "", "w-2")
+	assert.Nil(t, err)
+	assert.Equal(t, batch, stored)
+	stored, err = w.GetPayoutBatch(walletId1, batch.Id, "")
+	assert.Nil(t, err)
+	assert.Equal(t, batch, stored)
+	_, err = w.GetPayoutBatch(walletId2, batch.Id, "")
+	assert.Equal(t, ErrPayoutBatchNotFound, err)
+
+}
+
+func TestWalletManager_CreateWithdrawTransaction(t *testing.T) {
+	chainDb, close, err := newTestChainDB(0)
+	if err != nil {
+		t.Fatal("new chainDb error")
+	}
+	defer close()
+	walletDb1, teardown, err := testDB("testNewWallet1")
+	if err != nil {
+		t.Fatal("new walletDb error")
+	}
+	defer teardown()
+	w, err := NewWalletManager(&mockServer{chainDb}, walletDb1, cfg, config.ChainParams, pubPassphrase)
+	if err != nil {
+		t.Fatal("new wallet error", err.Error())
+	}
+	walletId1, _, _, err := w.CreateWallet(privPassphrase, "", defaultBitSize)
+	if err != nil {
+		t.Fatal("create wallet error", err.Error())
+	}
+	_, err = w.UseWallet(walletId1)
+	if err != nil {
+		t.Fatal("use wallet error", err.Error())
+	}
+	addr1, err := w.NewAddress("", massutil.AddressClassWitnessV0)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+	stakingAddr, err := w.NewAddress("", massutil.AddressClassWitnessStaking)
+	if err != nil {
+		t.Fatal("new addr error", err.Error())
+	}
+
+	// block15T2 stakes to stakingAddr, block15T3 pays to addr1
+	addr, err := massutil.DecodeAddress(stakingAddr, w.chainParams)
+	if err != nil {
+		t.Fatal("decode addr error", err.Error())
+	}
+	stakingScript, err := txscript.PayToStakingAddrScript(addr, consensus.MinFrozenPeriod)
+	if err != nil {
+		t.Fatal("get pkscript error", err.Error())
+	}
+	addr, err = massutil.DecodeAddress(addr1, w.chainParams)
+	if err != nil {
+		t.Fatal("decode addr error", err.Error())
+	}
+	pkScript, err := txscript.PayToWitnessScriptHashScript(addr.ScriptAddress())
+	if err != nil {
+		t.Fatal("get pkscript error", err.Error())
+	}
+	block15T2.TxOut[0].PkScript = stakingScript
+	block15T3.TxOut[0].PkScript = pkScript
+	newBlock15T2Hash := block15T2.TxHash()
+	newBlock15T3Hash := block15T3.TxHash()
+	for i := 1; i <= int(block15Meta.Height); i++ {
+		blk := blks200[i]
+		blk.ResetGenerated()
+		for _, tx := range blk.MsgBlock().Transactions {
+			for _, txin := range tx.TxIn {
+				if bytes.Equal(txin.PreviousOutPoint.Hash[:], b15T2Hash[:]) {
+					txin.PreviousOutPoint.Hash = newBlock15T2Hash
+					continue
+				}
+				if bytes.Equal(txin.PreviousOutPoint.Hash[:], b15T3Hash[:]) {
+					txin.PreviousOutPoint.Hash = newBlock15T3Hash
+				}
+			}
+		}
+		// the staking script changes the block size, drop cached serializations
+		blk = massutil.NewBlock(blk.MsgBlock())
+		err = chainDb.SubmitBlock(blk)
+		if err != nil {
+			t.Fatal("init db error:", err)
+		}
+		chainDb.(*ldb.ChainDb).Batch(1).Set(blk.MsgBlock().BlockHash())
+		chainDb.(*ldb.ChainDb).Batch(1).Done()
+		err = chainDb.Commit(blk.MsgBlock().BlockHash())
+		if err != nil {
+			t.Fatal("init db error:", err)
+		}
+	}
+	defer func() {
+		block15T2.TxOut[0].PkScript = b15T2O0PkScript
+		block15T3.TxOut[0].PkScript = b15T3O0PkScript
+		for i := 1; i <= int(block15Meta.Height); i++ {
+			blk := blks200[i]
+			for _, tx := range blk.MsgBlock().Transactions {
+				for _, txin := range tx.TxIn {
+					if bytes.Equal(txin.PreviousOutPoint.Hash[:], newBlock15T2Hash[:]) {
+						txin.PreviousOutPoint.Hash = b15T2Hash
+						continue
+					}
+					if bytes.Equal(txin.PreviousOutPoint.Hash[:], newBlock15T3Hash[:]) {
+						txin.PreviousOutPoint.Hash = b15T3Hash
+					}
+				}
+			}
+		}
+	}()
+
+	block15Meta.Loc, err = w.chainFetcher.FetchBlockLocByHeight(block15Meta.Height)
+	if err != nil {
+		t.Fatal("FetchBlockLocByHeight error:", err)
+	}
+	txLocs, err := massutil.NewBlock(blks200[block15Meta.Height].MsgBlock()).TxLoc()
+	if err != nil {
+		t.Fatal("TxLoc error", err)
+	}
+	syncTo := func(height uint64) error {
+		return mwdb.Update(walletDb1, func(tx mwdb.DBTransaction) error {
+			syncedTo, err := w.syncStore.SyncedTo(tx)
+			if err != nil {
+				return err
+			}
+			for h := syncedTo.Height + 1; h <= height; h++ {
+				if err = w.syncStore.SetSyncedTo(tx, &txmgr.BlockMeta{Height: h}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	allBalances := map[string]massutil.Amount{
+		walletId1: massutil.ZeroAmount(),
+	}
+	err = mwdb.Update(walletDb1, func(tx mwdb.DBTransaction) error {
+		for i, msgTx := range []*wire.MsgTx{block15T2, block15T3} {
+			rec, err := txmgr.NewTxRecordFromMsgTx(msgTx, block15.Header.Timestamp)
+			if err != nil {
+				return err
+			}
+			if rec, err = simpleFilterTx(rec, msgTx, walletId1); err != nil {
+				return err
+			}
+			rec.TxLoc = &txLocs[2+i]
+			if err = w.txStore.AddRelevantTx(tx, allBalances, rec, block15Meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = syncTo(block15Meta.Height); err != nil {
+		t.Fatal(err)
+	}
+
+	// the staking utxo is not mature yet
+	_, _, err = w.CreateWithdrawStakingTransaction("", nil, "", massutil.ZeroAmount())
+	assert.Equal(t, ErrInsufficientFunds, err)
+
+	if err = syncTo(block15Meta.Height + consensus.MinFrozenPeriod); err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = w.CreateWithdrawBindingTransaction("", nil, "", massutil.ZeroAmount())
+	assert.Equal(t, ErrInsufficientFunds, err)
+	// only staking utxos are withdrawn
+	_, _, err = w.CreateWithdrawStakingTransaction("", []*TxIn{{TxId: newBlock15T3Hash.String(), Vout: 0}}, "", massutil.ZeroAmount())
+	assert.Equal(t, ErrUTXONotExists, err)
+
+	txHex, fee, err := w.CreateWithdrawStakingTransaction("", nil, "", massutil.ZeroAmount())
+	if err != nil {
+		t.Fatal("CreateWithdrawStakingTransaction error", err.Error())
+	}
+	assert.True(t, fee.Cmp(massutil.MinRelayTxFee()) >= 0)
+	serializedTx, err := decodeHexStr(txHex)
+	if err != nil {
+		t.Fatal("decode hexStr error", err.Error())
+	}
+	var mtx wire.MsgTx
+	if err = mtx.SetBytes(serializedTx, wire.Packet); err != nil {
+		t.Fatal("deserialize tx error", err.Error())
+	}
+	ps, err := utils.ParsePkScript(stakingScript, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(mtx.TxIn))
+	assert.Equal(t, *wire.NewOutPoint(&newBlock15T2Hash, 0), mtx.TxIn[0].PreviousOutPoint)
+	assert.Equal(t, ps.Maturity(), mtx.TxIn[0].Sequence)
+	assert.Equal(t, 1, len(mtx.TxOut))
+	assert.Equal(t, block15T2.TxOut[0].Value-fee.IntValue(), mtx.TxOut[0].Value)
+	// paid to the standard address of the staking address
+	outPs, err := utils.ParsePkScript(mtx.TxOut[0].PkScript, w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, ps.StdEncodeAddress(), outPs.StdEncodeAddress())
+
+	// the utxo is marked used until the mark is cleared
+	_, _, err = w.CreateWithdrawStakingTransaction("", nil, "", massutil.ZeroAmount())
+	assert.Equal(t, ErrInsufficientFunds, err)
+	w.ClearUsedUTXOMark(&mtx)
+	_, _, err = w.CreateWithdrawStakingTransaction("", nil, addr1, massutil.ZeroAmount())
+	assert.Nil(t, err)
 }
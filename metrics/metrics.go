@@ -0,0 +1,323 @@
+// Package metrics collects the metrics of the wallet and serves them in the
+// Prometheus text exposition format.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefBuckets are the default upper bounds of histogram buckets, in seconds.
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// DefaultRegistry is the registry served by the wallet.
+var DefaultRegistry = NewRegistry()
+
+// Metric is a named metric written by a Registry.
+type Metric interface {
+	Name() string
+	write(w *bufio.Writer)
+}
+
+// Registry holds metrics in the order registered.
+type Registry struct {
+	mu      sync.Mutex
+	metrics []Metric
+}
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register adds m to the registry, replacing the metric of the same name if
+// any, so that collectors of a reloaded wallet can be registered again.
+func (r *Registry) Register(m Metric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, old := range r.metrics {
+		if old.Name() == m.Name() {
+			r.metrics[i] = m
+			return
+		}
+	}
+	r.metrics = append(r.metrics, m)
+}
+
+// WriteTo writes all metrics in the Prometheus text format.
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	metrics := make([]Metric, len(r.metrics))
+	copy(metrics, r.metrics)
+	r.mu.Unlock()
+
+	cw := &countWriter{w: w}
+	bw := bufio.NewWriter(cw)
+	for _, m := range metrics {
+		m.write(bw)
+	}
+	err := bw.Flush()
+	return cw.n, err
+}
+
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// series is the value of a metric with one set of label values.
+type series struct {
+	labelValues []string
+	value       float64
+	buckets     []uint64 // histogram only, not cumulative
+	sum         float64  // histogram only
+	count       uint64   // histogram only
+}
+
+// vec holds the series of a metric by label values.
+type vec struct {
+	name   string
+	help   string
+	labels []string
+
+	mu     sync.Mutex
+	series map[string]*series
+}
+
+func newVec(name, help string, labels []string) vec {
+	return vec{
+		name:   name,
+		help:   help,
+		labels: labels,
+		series: make(map[string]*series),
+	}
+}
+
+func (v *vec) Name() string {
+	return v.name
+}
+
+// get returns the series of labelValues, requires v.mu to be locked.
+func (v *vec) get(labelValues []string) *series {
+	if len(labelValues) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", v.name, len(v.labels), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+	s, ok := v.series[key]
+	if !ok {
+		s = &series{labelValues: append([]string(nil), labelValues...)}
+		v.series[key] = s
+	}
+	return s
+}
+
+// sorted returns copies of all series ordered by label values.
+func (v *vec) sorted() []series {
+	v.mu.Lock()
+	list := make([]series, 0, len(v.series))
+	for _, s := range v.series {
+		cp := *s
+		cp.buckets = append([]uint64(nil), s.buckets...)
+		list = append(list, cp)
+	}
+	v.mu.Unlock()
+	sortSeries(list)
+	return list
+}
+
+// CounterVec is a counter partitioned by labels.
+type CounterVec struct {
+	vec
+}
+
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return &CounterVec{vec: newVec(name, help, labels)}
+}
+
+// Inc adds 1 to the counter of labelValues.
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds delta, which must not be negative, to the counter of labelValues.
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		return
+	}
+	c.mu.Lock()
+	c.get(labelValues).value += delta
+	c.mu.Unlock()
+}
+
+func (c *CounterVec) write(w *bufio.Writer) {
+	writeHeader(w, c.name, c.help, "counter")
+	for _, s := range c.sorted() {
+		writeSample(w, c.name, c.labels, s.labelValues, "", "", s.value)
+	}
+}
+
+// HistogramVec counts observations in buckets, partitioned by labels.
+type HistogramVec struct {
+	vec
+	bounds []float64
+}
+
+// NewHistogramVec returns a histogram of buckets with the upper bounds in
+// increasing order, the +Inf bucket is implied.
+func NewHistogramVec(name, help string, bounds []float64, labels ...string) *HistogramVec {
+	return &HistogramVec{
+		vec:    newVec(name, help, labels),
+		bounds: bounds,
+	}
+}
+
+// Observe adds v to the histogram of labelValues.
+func (h *HistogramVec) Observe(v float64, labelValues ...string) {
+	i := sort.SearchFloat64s(h.bounds, v)
+	h.mu.Lock()
+	s := h.get(labelValues)
+	if s.buckets == nil {
+		s.buckets = make([]uint64, len(h.bounds)+1)
+	}
+	s.buckets[i]++
+	s.sum += v
+	s.count++
+	h.mu.Unlock()
+}
+
+func (h *HistogramVec) write(w *bufio.Writer) {
+	writeHeader(w, h.name, h.help, "histogram")
+	for _, s := range h.sorted() {
+		var cumulative uint64
+		for i, n := range s.buckets {
+			cumulative += n
+			le := math.Inf(1)
+			if i < len(h.bounds) {
+				le = h.bounds[i]
+			}
+			writeSample(w, h.name+"_bucket", h.labels, s.labelValues, "le", formatFloat(le), float64(cumulative))
+		}
+		writeSample(w, h.name+"_sum", h.labels, s.labelValues, "", "", s.sum)
+		writeSample(w, h.name+"_count", h.labels, s.labelValues, "", "", float64(s.count))
+	}
+}
+
+// GaugeFunc is a gauge read on each scrape.
+type GaugeFunc struct {
+	name    string
+	help    string
+	labels  []string
+	collect func(emit func(value float64, labelValues ...string))
+}
+
+// NewGaugeFunc returns a gauge of the value returned by f.
+func NewGaugeFunc(name, help string, f func() float64) *GaugeFunc {
+	return NewGaugeVecFunc(name, help, nil, func(emit func(float64, ...string)) {
+		emit(f())
+	})
+}
+
+// NewGaugeVecFunc returns a gauge partitioned by labels, collect emits the value
+// of each set of label values.
+func NewGaugeVecFunc(name, help string, labels []string,
+	collect func(emit func(value float64, labelValues ...string))) *GaugeFunc {
+	return &GaugeFunc{
+		name:    name,
+		help:    help,
+		labels:  labels,
+		collect: collect,
+	}
+}
+
+func (g *GaugeFunc) Name() string {
+	return g.name
+}
+
+func (g *GaugeFunc) write(w *bufio.Writer) {
+	var list []series
+	g.collect(func(value float64, labelValues ...string) {
+		if len(labelValues) != len(g.labels) {
+			return
+		}
+		list = append(list, series{labelValues: labelValues, value: value})
+	})
+	sortSeries(list)
+	writeHeader(w, g.name, g.help, "gauge")
+	for _, s := range list {
+		writeSample(w, g.name, g.labels, s.labelValues, "", "", s.value)
+	}
+}
+
+func sortSeries(list []series) {
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i].labelValues, list[j].labelValues
+		for k := range a {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return false
+	})
+}
+
+func writeHeader(w *bufio.Writer, name, help, typ string) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, typ)
+}
+
+// writeSample writes a sample line, extraLabel is appended to labels if not empty.
+func writeSample(w *bufio.Writer, name string, labels, labelValues []string, extraLabel, extraValue string, value float64) {
+	w.WriteString(name)
+	if len(labels) > 0 || extraLabel != "" {
+		w.WriteByte('{')
+		for i, label := range labels {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			writeLabel(w, label, labelValues[i])
+		}
+		if extraLabel != "" {
+			if len(labels) > 0 {
+				w.WriteByte(',')
+			}
+			writeLabel(w, extraLabel, extraValue)
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(value))
+	w.WriteByte('\n')
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func writeLabel(w *bufio.Writer, label, value string) {
+	w.WriteString(label)
+	w.WriteString(`="`)
+	w.WriteString(labelValueEscaper.Replace(value))
+	w.WriteByte('"')
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
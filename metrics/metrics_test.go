@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry_WriteTo(t *testing.T) {
+	r := NewRegistry()
+
+	counter := NewCounterVec("test_requests_total", "Requests.", "method", "code")
+	counter.Inc("Wallets", "0")
+	counter.Add(2, "Wallets", "0")
+	counter.Inc("GetUtxo", "1101")
+	counter.Add(-1, "GetUtxo", "1101")
+	r.Register(counter)
+
+	histogram := NewHistogramVec("test_duration_seconds", "Duration.", []float64{0.1, 1}, "op")
+	histogram.Observe(0.05, "view")
+	histogram.Observe(0.1, "view")
+	histogram.Observe(5, "view")
+	r.Register(histogram)
+
+	r.Register(NewGaugeFunc("test_height", "Height.", func() float64 { return 100 }))
+	r.Register(NewGaugeVecFunc("test_balance", "Balance\nof \\wallets.", []string{"wallet_id"},
+		func(emit func(float64, ...string)) {
+			emit(2, "w\"2")
+			emit(1, "w1")
+			emit(3) // mismatched labels are dropped
+		}))
+
+	var buf bytes.Buffer
+	_, err := r.WriteTo(&buf)
+	assert.Nil(t, err)
+	assert.Equal(t, `# HELP test_requests_total Requests.
+# TYPE test_requests_total counter
+test_requests_total{method="GetUtxo",code="1101"} 1
+test_requests_total{method="Wallets",code="0"} 3
+# HELP test_duration_seconds Duration.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{op="view",le="0.1"} 2
+test_duration_seconds_bucket{op="view",le="1"} 2
+test_duration_seconds_bucket{op="view",le="+Inf"} 3
+test_duration_seconds_sum{op="view"} 5.15
+test_duration_seconds_count{op="view"} 3
+# HELP test_height Height.
+# TYPE test_height gauge
+test_height 100
+# HELP test_balance Balance\nof \\wallets.
+# TYPE test_balance gauge
+test_balance{wallet_id="w\"2"} 2
+test_balance{wallet_id="w1"} 1
+`, buf.String())
+
+	// registering the same name replaces the metric
+	r.Register(NewGaugeFunc("test_height", "Height.", func() float64 { return 200 }))
+	rec := httptest.NewRecorder()
+	Handler(r).ServeHTTP(rec, httptest.NewRequest("GET", Path, nil))
+	body, _ := ioutil.ReadAll(rec.Body)
+	assert.Contains(t, string(body), "test_height 200\n")
+	assert.NotContains(t, string(body), "test_height 100\n")
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+
+	assert.Panics(t, func() { counter.Inc("Wallets") })
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/massnetorg/mass-core/logging"
+)
+
+// Path is the http path metrics are served at.
+const Path = "/metrics"
+
+// Handler serves the metrics of r in the Prometheus text format.
+func Handler(r *Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		if _, err := r.WriteTo(w); err != nil {
+			logging.CPrint(logging.DEBUG, "failed to write metrics", logging.LogFormat{"err": err})
+		}
+	})
+}
+
+// Server serves a registry at Path.
+type Server struct {
+	addr string
+	srv  *http.Server
+}
+
+func NewServer(addr string, r *Registry) *Server {
+	mux := http.NewServeMux()
+	mux.Handle(Path, Handler(r))
+	return &Server{
+		addr: addr,
+		srv:  &http.Server{Addr: addr, Handler: mux},
+	}
+}
+
+// Start listens on the address of the server and serves in background.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		logging.CPrint(logging.ERROR, "failed to start metrics server", logging.LogFormat{"address": s.addr, "error": err})
+		return err
+	}
+	go func() {
+		if err := s.srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logging.CPrint(logging.ERROR, "metrics server stopped", logging.LogFormat{"address": s.addr, "error": err})
+		}
+	}()
+	logging.CPrint(logging.INFO, "metrics server started", logging.LogFormat{"address": s.addr})
+	return nil
+}
+
+func (s *Server) Stop() {
+	s.srv.Close()
+	logging.CPrint(logging.INFO, "metrics server stopped", logging.LogFormat{"address": s.addr})
+}
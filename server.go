@@ -13,6 +13,7 @@ import (
 	"github.com/massnetorg/mass-core/blockchain/state"
 	chaincfg "github.com/massnetorg/mass-core/config"
 	"github.com/massnetorg/mass-core/logging"
+	"github.com/massnetorg/mass-core/massutil"
 
 	"github.com/massnetorg/mass-core/blockchain"
 	"github.com/massnetorg/mass-core/database"
@@ -20,6 +21,9 @@ import (
 	"github.com/massnetorg/mass-core/wire"
 
 	"github.com/massnetorg/mass-core/consensus"
+	"massnet.org/mass-wallet/masswallet"
+	"massnet.org/mass-wallet/masswallet/ifc"
+	"massnet.org/mass-wallet/masswallet/txmgr"
 )
 
 // server provides a bitcoin server for handling communications to and from
@@ -243,3 +247,70 @@ func (s *server) TxMemPool() *blockchain.TxPool {
 func (s *server) SyncManager() *netsync.SyncManager {
 	return s.syncManager
 }
+
+// walletChain is the chain source of the wallet backed by the embedded node.
+type walletChain struct {
+	server  *server
+	fetcher ifc.ChainFetcher
+}
+
+func (s *server) WalletChain() masswallet.Server {
+	return &walletChain{
+		server:  s,
+		fetcher: ifc.NewChainFetcher(s.db),
+	}
+}
+
+func (c *walletChain) ChainFetcher() ifc.ChainFetcher {
+	return c.fetcher
+}
+
+func (c *walletChain) TxMemPool() txmgr.TxMemPool {
+	return c.server.chain.GetTxPool()
+}
+
+func (c *walletChain) BestBlockHeight() uint64 {
+	return c.server.chain.BestBlockHeight()
+}
+
+func (c *walletChain) BestPeerHeight() uint64 {
+	if bestPeer := c.server.syncManager.BestPeer(); bestPeer != nil {
+		return bestPeer.Height
+	}
+	return 0
+}
+
+// MempoolTxs returns the transactions in the mempool, it serves the mempool of
+// the chain api to remote wallets.
+func (c *walletChain) MempoolTxs() []*wire.MsgTx {
+	descs := c.server.chain.GetTxPool().TxDescs()
+	txs := make([]*wire.MsgTx, 0, len(descs))
+	for _, desc := range descs {
+		txs = append(txs, desc.Tx.MsgTx())
+	}
+	return txs
+}
+
+// MempoolTxFee returns the fee of a transaction in the mempool, it lets the
+// wallet estimate fees without fetching the inputs of the transaction.
+func (c *walletChain) MempoolTxFee(hash *wire.Hash) (massutil.Amount, bool) {
+	for _, desc := range c.server.chain.GetTxPool().TxDescs() {
+		if desc.Tx.Hash().IsEqual(hash) {
+			return desc.Fee, true
+		}
+	}
+	return massutil.ZeroAmount(), false
+}
+
+func (c *walletChain) SubmitTx(tx *wire.MsgTx) error {
+	_, err := c.server.chain.ProcessTx(massutil.NewTx(tx))
+	return err
+}
+
+func (c *walletChain) RegisterListener(listener ifc.ChainListener) {
+	c.server.chain.RegisterListener(listener)
+}
+
+func (c *walletChain) UnregisterListener(listener ifc.ChainListener) {
+	c.server.chain.UnregisterListener(listener)
+}